@@ -0,0 +1,208 @@
+// Package client is the Go client for applications embedding SeaweedFS.
+//
+// It puts, reads, stats and deletes files by file id, splitting large files into chunks
+// under a chunk manifest, and reads and writes files by path through a filer.
+// Reads go to the replicas in the preferred data center first and fail over to the other replicas,
+// and every request is retried with the configured backoff.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/wdclient"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+)
+
+// ErrNotFound is returned when the file id or the path does not exist. It is not retried.
+var ErrNotFound = errors.New("not found")
+
+// RetryPolicy is how often and how long to wait before retrying a failed request.
+// The backoff doubles after each failure up to MaxBackoff, with random jitter.
+type RetryPolicy struct {
+	Attempts       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	Attempts:       3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// backoff is the wait after the failed attempt, counting from 1
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// wait between half and the full backoff, so the clients do not retry in lock step
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+type Options struct {
+	// Masters are the master http addresses, e.g. localhost:9333
+	Masters    []string
+	ClientName string
+	// DataCenter is preferred when reading from replicas, optional
+	DataCenter string
+
+	// GrpcDialOption is loaded from security.toml if not set, together with the empty signing keys
+	GrpcDialOption grpc.DialOption
+
+	// Collection, Replication and Ttl are the defaults for the new files
+	Collection  string
+	Replication string
+	Ttl         string
+
+	// ChunkSizeMB splits the larger files into chunks under a chunk manifest, 32 by default
+	ChunkSizeMB int
+
+	// Retry is DefaultRetryPolicy if Attempts is 0
+	Retry RetryPolicy
+
+	// SigningKey signs the jwt to delete files, otherwise the jwt is looked up from the master
+	SigningKey      security.SigningKey
+	ExpiresAfterSec int
+	// ReadSigningKey signs the jwt to read files, if the volume servers require it
+	ReadSigningKey      security.SigningKey
+	ReadExpiresAfterSec int
+}
+
+type Client struct {
+	option       Options
+	masterClient *wdclient.MasterClient
+	httpClient   *http.Client
+
+	// lookupFileIdUrls lists the urls of all replicas of the file id
+	lookupFileIdUrls func(fileId string) ([]string, error)
+}
+
+// New connects to the masters, and returns when connected or when ctx is done.
+func New(ctx context.Context, option Options) (*Client, error) {
+
+	if len(option.Masters) == 0 {
+		return nil, fmt.Errorf("no master is specified")
+	}
+	if option.ClientName == "" {
+		option.ClientName = "client"
+	}
+	if option.ChunkSizeMB <= 0 {
+		option.ChunkSizeMB = 32
+	}
+	if option.Retry.Attempts <= 0 {
+		option.Retry = DefaultRetryPolicy
+	}
+
+	if option.GrpcDialOption == nil {
+		util.LoadConfiguration("security", false)
+		v := viper.GetViper()
+		option.GrpcDialOption = security.LoadClientTLS(v.Sub("grpc"), "client")
+		if len(option.SigningKey) == 0 {
+			option.SigningKey = security.SigningKey(v.GetString("jwt.signing.key"))
+			v.SetDefault("jwt.signing.expires_after_seconds", 10)
+			option.ExpiresAfterSec = v.GetInt("jwt.signing.expires_after_seconds")
+		}
+		if len(option.ReadSigningKey) == 0 {
+			option.ReadSigningKey = security.SigningKey(v.GetString("jwt.signing.read.key"))
+			v.SetDefault("jwt.signing.read.expires_after_seconds", 60)
+			option.ReadExpiresAfterSec = v.GetInt("jwt.signing.read.expires_after_seconds")
+		}
+	}
+
+	masterClient := wdclient.NewMasterClient(ctx, option.GrpcDialOption, option.ClientName, option.Masters)
+	masterClient.DataCenter = option.DataCenter
+	go masterClient.KeepConnectedToMaster()
+
+	for masterClient.GetMaster() == "" {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("connect to masters %v: %v", option.Masters, ctx.Err())
+		case <-time.After(time.Duration(50+rand.Int31n(150)) * time.Millisecond):
+		}
+	}
+
+	return &Client{
+		option:           option,
+		masterClient:     masterClient,
+		httpClient:       &http.Client{Transport: util.Transport},
+		lookupFileIdUrls: masterClient.LookupFileIdUrls,
+	}, nil
+}
+
+// MasterClient is the underlying connection to the masters, for the operations not covered here
+func (c *Client) MasterClient() *wdclient.MasterClient {
+	return c.masterClient
+}
+
+func (c *Client) GrpcDialOption() grpc.DialOption {
+	return c.option.GrpcDialOption
+}
+
+// retry runs fn until it succeeds, fails with a permanent error, or the attempts are used up
+func (c *Client) retry(ctx context.Context, name string, fn func() error) (err error) {
+	for attempt := 1; ; attempt++ {
+		if err = fn(); err == nil || !isRetryable(err) || attempt >= c.option.Retry.Attempts {
+			return err
+		}
+		backoff := c.option.Retry.backoff(attempt)
+		glog.V(1).Infof("%s attempt %d: %v, retry in %v", name, attempt, err, backoff)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// httpStatusError is the unexpected http status from a volume server
+type httpStatusError struct {
+	url        string
+	statusCode int
+	status     string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.url, e.status)
+}
+
+func isRetryable(err error) bool {
+	if err == ErrNotFound || err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+	if statusErr, ok := err.(*httpStatusError); ok {
+		switch statusErr.statusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		}
+		return statusErr.statusCode >= 500
+	}
+	return true
+}
+
+func (c *Client) readJwt(fileId string) security.EncodedJwt {
+	return security.GenJwt(c.option.ReadSigningKey, c.option.ReadExpiresAfterSec, fileId)
+}
+
+func (c *Client) writeJwt(fileId string) security.EncodedJwt {
+	if len(c.option.SigningKey) > 0 {
+		return security.GenJwt(c.option.SigningKey, c.option.ExpiresAfterSec, fileId)
+	}
+	return operation.LookupJwt(c.masterClient.GetMaster(), fileId)
+}
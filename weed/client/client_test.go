@@ -0,0 +1,181 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+
+	p := RetryPolicy{Attempts: 5, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+	for attempt, expected := range map[int]time.Duration{1: 100, 2: 200, 3: 300, 10: 300} {
+		expected *= time.Millisecond
+		for i := 0; i < 20; i++ {
+			if d := p.backoff(attempt); d < expected/2 || d > expected {
+				t.Errorf("attempt %d backoff %v, expected %v with jitter", attempt, d, expected)
+			}
+		}
+	}
+	if d := (RetryPolicy{}).backoff(1); d != 0 {
+		t.Errorf("zero policy backoff %v", d)
+	}
+}
+
+func TestRetry(t *testing.T) {
+
+	c := &Client{option: Options{Retry: RetryPolicy{Attempts: 3, InitialBackoff: time.Millisecond}}}
+
+	tries := 0
+	err := c.retry(context.Background(), "test", func() error {
+		tries++
+		if tries < 3 {
+			return &httpStatusError{statusCode: http.StatusServiceUnavailable}
+		}
+		return nil
+	})
+	if err != nil || tries != 3 {
+		t.Errorf("retried %d times: %v", tries, err)
+	}
+
+	tries = 0
+	err = c.retry(context.Background(), "test", func() error {
+		tries++
+		return ErrNotFound
+	})
+	if err != ErrNotFound || tries != 1 {
+		t.Errorf("not found is retried %d times: %v", tries, err)
+	}
+
+	tries = 0
+	err = c.retry(context.Background(), "test", func() error {
+		tries++
+		return &httpStatusError{statusCode: http.StatusUnauthorized}
+	})
+	if err == nil || tries != 1 {
+		t.Errorf("unauthorized is retried %d times: %v", tries, err)
+	}
+}
+
+// newTestVolumeServer serves the files by "/<fid>" with range requests
+func newTestVolumeServer(files map[string][]byte, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		data, found := files[strings.TrimPrefix(r.URL.Path, "/")]
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	}))
+}
+
+func TestFileReadAtWithFailover(t *testing.T) {
+
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	files := map[string][]byte{
+		"3,01": content[0:10],
+		"4,02": content[10:25],
+		"5,03": content[25:],
+	}
+
+	var downRequests, missingRequests, upRequests int
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downRequests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer down.Close()
+	missing := newTestVolumeServer(map[string][]byte{}, &missingRequests)
+	defer missing.Close()
+	up := newTestVolumeServer(files, &upRequests)
+	defer up.Close()
+
+	c := &Client{
+		option:     Options{Retry: RetryPolicy{Attempts: 2, InitialBackoff: time.Millisecond}},
+		httpClient: http.DefaultClient,
+		lookupFileIdUrls: func(fileId string) ([]string, error) {
+			return []string{down.URL + "/" + fileId, missing.URL + "/" + fileId, up.URL + "/" + fileId}, nil
+		},
+	}
+
+	chunks := operation.ChunkList{
+		{Fid: "3,01", Offset: 0, Size: 10},
+		{Fid: "4,02", Offset: 10, Size: 15},
+		{Fid: "5,03", Offset: 25, Size: int64(len(content) - 25)},
+	}
+	ctx := context.Background()
+	f := newFile(&FileInfo{Size: int64(len(content))}, func(p []byte, off int64) (int, error) {
+		return c.readManifestChunks(ctx, chunks, p, off)
+	})
+
+	buf := make([]byte, 20)
+	n, err := f.ReadAt(buf, 5)
+	if err != nil || n != 20 || string(buf) != string(content[5:25]) {
+		t.Errorf("read across chunks: %d %q %v", n, buf[:n], err)
+	}
+	if downRequests != 2 || missingRequests != 2 || upRequests != 2 {
+		t.Errorf("requests down:%d missing:%d up:%d", downRequests, missingRequests, upRequests)
+	}
+
+	n, err = f.ReadAt(buf, int64(len(content)-5))
+	if err != io.EOF || n != 5 || string(buf[:n]) != string(content[len(content)-5:]) {
+		t.Errorf("read the end: %d %q %v", n, buf[:n], err)
+	}
+	if n, err = f.ReadAt(buf, int64(len(content))); err != io.EOF || n != 0 {
+		t.Errorf("read past the end: %d %v", n, err)
+	}
+
+	f.Seek(3, io.SeekStart)
+	all, err := ioutil.ReadAll(f)
+	if err != nil || string(all) != string(content[3:]) {
+		t.Errorf("read all: %q %v", all, err)
+	}
+
+	// not on any replica
+	c.lookupFileIdUrls = func(fileId string) ([]string, error) {
+		return []string{missing.URL + "/" + fileId}, nil
+	}
+	if _, err = c.readRange(ctx, "6,04", buf, 0); err != ErrNotFound {
+		t.Errorf("missing file: %v", err)
+	}
+}
+
+func TestReadUrlRangeWithoutRangeSupport(t *testing.T) {
+
+	content := []byte("0123456789abcdefghij")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "20")
+		if r.URL.Path == "/truncated" {
+			w.Write(content[:8])
+			return
+		}
+		w.Write(content)
+	}))
+	defer server.Close()
+
+	c := &Client{httpClient: http.DefaultClient}
+	ctx := context.Background()
+	buf := make([]byte, 5)
+
+	n, err := c.readUrlRange(ctx, "3,01", server.URL+"/full", buf, 12)
+	if err != nil || n != 5 || string(buf) != "cdefg" {
+		t.Errorf("read the middle: %d %q %v", n, buf[:n], err)
+	}
+	if n, err = c.readUrlRange(ctx, "3,01", server.URL+"/full", buf, 20); err != nil || n != 0 {
+		t.Errorf("read past the end: %d %v", n, err)
+	}
+	if n, err = c.readUrlRange(ctx, "3,01", server.URL+"/truncated", buf, 12); err != io.ErrUnexpectedEOF || n != 0 {
+		t.Errorf("skip over a truncated body: %d %v", n, err)
+	}
+	if n, err = c.readUrlRange(ctx, "3,01", server.URL+"/truncated", buf, 5); err != io.ErrUnexpectedEOF || n != 3 {
+		t.Errorf("read a truncated body: %d %v", n, err)
+	}
+}
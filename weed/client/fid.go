@@ -0,0 +1,504 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+)
+
+type PutOptions struct {
+	Name     string
+	MimeType string
+	// Collection, Replication and Ttl override the client defaults if set
+	Collection  string
+	Replication string
+	Ttl         string
+}
+
+type FileInfo struct {
+	FileId       string
+	Name         string
+	MimeType     string
+	Size         int64
+	ETag         string
+	LastModified time.Time
+	// IsChunked is true if the file is split into chunks under a chunk manifest
+	IsChunked bool
+}
+
+// Put uploads the content, and returns the file id.
+// The content larger than the chunk size is uploaded in chunks, followed by the chunk manifest.
+func (c *Client) Put(ctx context.Context, reader io.Reader, option PutOptions) (fileId string, err error) {
+
+	assign := func() (*operation.AssignResult, error) {
+		return c.assign(option)
+	}
+
+	buf := make([]byte, int64(c.option.ChunkSizeMB)<<20)
+	n, err := io.ReadFull(reader, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		assignResult, _, err := c.upload(ctx, assign, buf[:n], option.Name, option.MimeType, false)
+		if err != nil {
+			return "", err
+		}
+		return assignResult.Fid, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("read %s: %v", option.Name, err)
+	}
+
+	manifest := &operation.ChunkManifest{
+		Name: option.Name,
+		Mime: option.MimeType,
+	}
+	for n > 0 {
+		assignResult, _, err := c.upload(ctx, assign, buf[:n], fmt.Sprintf("%s-%d", option.Name, len(manifest.Chunks)+1), "application/octet-stream", false)
+		if err != nil {
+			c.deleteChunks(ctx, manifest)
+			return "", err
+		}
+		manifest.Chunks = append(manifest.Chunks, &operation.ChunkInfo{
+			Fid:    assignResult.Fid,
+			Offset: manifest.Size,
+			Size:   int64(n),
+		})
+		manifest.Size += int64(n)
+
+		var readErr error
+		n, readErr = io.ReadFull(reader, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			c.deleteChunks(ctx, manifest)
+			return "", fmt.Errorf("read %s: %v", option.Name, readErr)
+		}
+	}
+
+	data, err := manifest.Marshal()
+	if err != nil {
+		c.deleteChunks(ctx, manifest)
+		return "", err
+	}
+	assignResult, _, err := c.upload(ctx, assign, data, option.Name, "application/json", true)
+	if err != nil {
+		c.deleteChunks(ctx, manifest)
+		return "", fmt.Errorf("upload chunk manifest: %v", err)
+	}
+
+	return assignResult.Fid, nil
+}
+
+func (c *Client) assign(option PutOptions) (*operation.AssignResult, error) {
+	request := &operation.VolumeAssignRequest{
+		Count:       1,
+		Collection:  c.option.Collection,
+		Replication: c.option.Replication,
+		Ttl:         c.option.Ttl,
+		DataCenter:  c.option.DataCenter,
+	}
+	if option.Collection != "" {
+		request.Collection = option.Collection
+	}
+	if option.Replication != "" {
+		request.Replication = option.Replication
+	}
+	if option.Ttl != "" {
+		request.Ttl = option.Ttl
+	}
+	return operation.Assign(c.masterClient.GetMaster(), c.option.GrpcDialOption, request)
+}
+
+// upload assigns a file id and uploads the data to it, with a new file id on each retry
+func (c *Client) upload(ctx context.Context, assign func() (*operation.AssignResult, error), data []byte, name, mimeType string, isManifest bool) (assignResult *operation.AssignResult, uploadResult *operation.UploadResult, err error) {
+	err = c.retry(ctx, "upload "+name, func() error {
+		assignResult, err = assign()
+		if err != nil {
+			return fmt.Errorf("assign volume: %v", err)
+		}
+		targetUrl := "http://" + assignResult.Url + "/" + assignResult.Fid
+		if isManifest {
+			targetUrl += "?cm=true"
+		}
+		uploadResult, err = operation.Upload(targetUrl, name, bytes.NewReader(data), false, mimeType, nil, assignResult.Auth)
+		if err != nil {
+			return fmt.Errorf("upload %s to %s: %v", name, targetUrl, err)
+		}
+		return nil
+	})
+	return
+}
+
+// Stat reads the file information without the content
+func (c *Client) Stat(ctx context.Context, fileId string) (info *FileInfo, err error) {
+	err = c.retry(ctx, "stat "+fileId, func() error {
+		return c.onReplicas(fileId, func(fileUrl string) error {
+			info, err = c.head(ctx, fileId, fileUrl)
+			return err
+		})
+	})
+	return
+}
+
+func (c *Client) head(ctx context.Context, fileId, fileUrl string) (*FileInfo, error) {
+	resp, err := c.do(ctx, "HEAD", fileUrl, c.readJwt(fileId), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	info := &FileInfo{
+		FileId:    fileId,
+		Size:      resp.ContentLength,
+		MimeType:  resp.Header.Get("Content-Type"),
+		ETag:      strings.Trim(resp.Header.Get("ETag"), `"`),
+		IsChunked: resp.Header.Get("X-File-Store") == "chunked",
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		info.Name = params["filename"]
+	}
+	if lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.LastModified = lastModified
+	}
+	return info, nil
+}
+
+// Get reads the whole file into memory
+func (c *Client) Get(ctx context.Context, fileId string) (data []byte, info *FileInfo, err error) {
+	f, err := c.Open(ctx, fileId)
+	if err != nil {
+		return nil, nil, err
+	}
+	data = make([]byte, f.Size())
+	if n, err := f.ReadAt(data, 0); err != nil && !(err == io.EOF && n == len(data)) {
+		return nil, nil, err
+	}
+	return data, f.Info(), nil
+}
+
+// Delete deletes the files, including the chunks of the chunked files.
+// The files that do not exist are skipped.
+func (c *Client) Delete(ctx context.Context, fileIds ...string) error {
+
+	pending := fileIds
+	return c.retry(ctx, "delete", func() error {
+
+		// a file has one result from each replica
+		errs := make(map[string]string)
+		isManifest := make(map[string]bool)
+		for _, result := range c.batchDelete(ctx, pending) {
+			switch {
+			case result.Error == "" || result.Status == http.StatusNotFound:
+			case result.Status == http.StatusNotAcceptable:
+				isManifest[result.FileId] = true
+			default:
+				errs[result.FileId] = result.Error
+			}
+		}
+
+		// batch delete skips the chunk manifests, which are deleted with their chunks by the volume server
+		for fileId := range isManifest {
+			if _, found := errs[fileId]; found {
+				continue
+			}
+			if err := c.onReplicas(fileId, func(fileUrl string) error {
+				return c.deleteUrl(ctx, fileId, fileUrl)
+			}); err != nil && err != ErrNotFound {
+				errs[fileId] = err.Error()
+			}
+		}
+
+		if len(errs) == 0 {
+			return nil
+		}
+		var failed, messages []string
+		for _, fileId := range pending {
+			if message, found := errs[fileId]; found {
+				failed = append(failed, fileId)
+				messages = append(messages, fileId+": "+message)
+			}
+		}
+		pending = failed
+		return fmt.Errorf("delete %s", strings.Join(messages, ", "))
+	})
+}
+
+// batchDelete deletes the files on all replicas, and returns the result of each file on each replica
+func (c *Client) batchDelete(ctx context.Context, fileIds []string) (results []*volume_server_pb.DeleteResult) {
+
+	serverToFileIds := make(map[string][]string)
+	for _, fileId := range fileIds {
+		vid, _, err := operation.ParseFileId(fileId)
+		if err != nil {
+			results = append(results, &volume_server_pb.DeleteResult{FileId: fileId, Status: http.StatusBadRequest, Error: err.Error()})
+			continue
+		}
+		locations, err := c.masterClient.GetVidLocations(vid)
+		if err != nil {
+			results = append(results, &volume_server_pb.DeleteResult{FileId: fileId, Status: http.StatusInternalServerError, Error: err.Error()})
+			continue
+		}
+		for _, location := range locations {
+			serverToFileIds[location.Url] = append(serverToFileIds[location.Url], fileId)
+		}
+	}
+
+	var wg sync.WaitGroup
+	var resultsLock sync.Mutex
+	for server, serverFileIds := range serverToFileIds {
+		wg.Add(1)
+		go func(server string, serverFileIds []string) {
+			defer wg.Done()
+			var serverResults []*volume_server_pb.DeleteResult
+			err := operation.WithVolumeServerClient(server, c.option.GrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+				resp, err := client.BatchDelete(ctx, &volume_server_pb.BatchDeleteRequest{FileIds: serverFileIds})
+				if err != nil {
+					return err
+				}
+				serverResults = resp.Results
+				return nil
+			})
+			if err != nil {
+				serverResults = nil
+				for _, fileId := range serverFileIds {
+					serverResults = append(serverResults, &volume_server_pb.DeleteResult{FileId: fileId, Status: http.StatusInternalServerError, Error: err.Error()})
+				}
+			}
+			resultsLock.Lock()
+			results = append(results, serverResults...)
+			resultsLock.Unlock()
+		}(server, serverFileIds)
+	}
+	wg.Wait()
+
+	return
+}
+
+func (c *Client) deleteUrl(ctx context.Context, fileId, fileUrl string) error {
+	resp, err := c.do(ctx, "DELETE", fileUrl, c.writeJwt(fileId), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// deleteChunks cleans up the uploaded chunks of a failed upload
+func (c *Client) deleteChunks(ctx context.Context, manifest *operation.ChunkManifest) {
+	var fileIds []string
+	for _, chunk := range manifest.Chunks {
+		fileIds = append(fileIds, chunk.Fid)
+	}
+	if len(fileIds) == 0 {
+		return
+	}
+	if err := c.Delete(ctx, fileIds...); err != nil {
+		glog.V(0).Infof("delete chunks of failed upload %s: %v", manifest.Name, err)
+	}
+}
+
+// onReplicas calls fn with the url of each replica until it succeeds.
+// It returns ErrNotFound only if the file is not found on any replica.
+func (c *Client) onReplicas(fileId string, fn func(fileUrl string) error) error {
+	fileUrls, err := c.lookupFileIdUrls(fileId)
+	if err != nil {
+		return err
+	}
+	notFound := 0
+	for _, fileUrl := range fileUrls {
+		if err = fn(fileUrl); err == nil {
+			return nil
+		}
+		if err == ErrNotFound {
+			notFound++
+			continue
+		}
+		glog.V(1).Infof("replica %s: %v", fileUrl, err)
+	}
+	if notFound == len(fileUrls) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// readRange reads the file from offset into buf, returning less than len(buf) at the end of the file
+func (c *Client) readRange(ctx context.Context, fileId string, buf []byte, offset int64) (n int, err error) {
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	err = c.retry(ctx, "read "+fileId, func() error {
+		return c.onReplicas(fileId, func(fileUrl string) error {
+			n, err = c.readUrlRange(ctx, fileId, fileUrl, buf, offset)
+			return err
+		})
+	})
+	return
+}
+
+func (c *Client) readUrlRange(ctx context.Context, fileId, fileUrl string, buf []byte, offset int64) (int, error) {
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+int64(len(buf))-1))
+	resp, err := c.do(ctx, "GET", fileUrl, c.readJwt(fileId), header)
+	if err != nil {
+		if statusErr, ok := err.(*httpStatusError); ok && statusErr.statusCode == http.StatusRequestedRangeNotSatisfiable {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body := io.Reader(resp.Body)
+	expected := resp.ContentLength
+	if resp.StatusCode != http.StatusPartialContent {
+		// the whole content, without range support
+		if _, err := io.CopyN(ioutil.Discard, body, offset); err != nil {
+			if err == io.EOF && expected >= 0 && expected <= offset {
+				// the offset is past the end
+				return 0, nil
+			}
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		if expected >= 0 {
+			expected -= offset
+		}
+	}
+	n, err := io.ReadFull(body, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+		if expected >= 0 && int64(n) < expected && n < len(buf) {
+			// the body is shorter than its content length
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	return n, err
+}
+
+func (c *Client) do(ctx context.Context, method, fileUrl string, jwt security.EncodedJwt, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequest(method, fileUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if jwt != "" {
+		req.Header.Set("Authorization", "BEARER "+string(jwt))
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotFound
+	case resp.StatusCode >= 400:
+		resp.Body.Close()
+		return nil, &httpStatusError{url: fileUrl, statusCode: resp.StatusCode, status: resp.Status}
+	}
+	return resp, nil
+}
+
+// fileUrlWithQuery adds the query parameter to the file url
+func fileUrlWithQuery(fileUrl, name, value string) string {
+	u, err := url.Parse(fileUrl)
+	if err != nil {
+		return fileUrl
+	}
+	q := u.Query()
+	q.Set(name, value)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// Open looks up the file size, and the chunks if the file is chunked. The ctx is used by all reads.
+func (c *Client) Open(ctx context.Context, fileId string) (*File, error) {
+	info, err := c.Stat(ctx, fileId)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsChunked {
+		return newFile(info, func(p []byte, off int64) (int, error) {
+			return c.readRange(ctx, fileId, p, off)
+		}), nil
+	}
+
+	var manifest *operation.ChunkManifest
+	err = c.retry(ctx, "read chunk manifest "+fileId, func() error {
+		return c.onReplicas(fileId, func(fileUrl string) error {
+			resp, err := c.do(ctx, "GET", fileUrlWithQuery(fileUrl, "cm", "false"), c.readJwt(fileId), nil)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return err
+			}
+			manifest, err = operation.LoadChunkManifest(data, false)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	info.Size = manifest.Size
+	if info.Name == "" {
+		info.Name = manifest.Name
+	}
+	return newFile(info, func(p []byte, off int64) (int, error) {
+		return c.readManifestChunks(ctx, manifest.Chunks, p, off)
+	}), nil
+}
+
+// readManifestChunks reads the chunks overlapping [off, off+len(p)) into p
+func (c *Client) readManifestChunks(ctx context.Context, chunks operation.ChunkList, p []byte, off int64) (n int, err error) {
+	stop := off + int64(len(p))
+	for _, chunk := range chunks {
+		chunkStart, chunkStop := max(off, chunk.Offset), min(stop, chunk.Offset+chunk.Size)
+		if chunkStart >= chunkStop {
+			continue
+		}
+		buf := p[chunkStart-off : chunkStop-off]
+		m, err := c.readRange(ctx, chunk.Fid, buf, chunkStart-chunk.Offset)
+		if err != nil {
+			return n, fmt.Errorf("read chunk %s: %v", chunk.Fid, err)
+		}
+		n += m
+		if m < len(buf) {
+			return n, fmt.Errorf("read chunk %s: %d of %d bytes", chunk.Fid, m, len(buf))
+		}
+	}
+	return n, nil
+}
+
+func min(x, y int64) int64 {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+func max(x, y int64) int64 {
+	if x > y {
+		return x
+	}
+	return y
+}
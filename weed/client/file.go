@@ -0,0 +1,71 @@
+package client
+
+import (
+	"fmt"
+	"io"
+)
+
+// File reads a file opened by file id or by path.
+// It is safe for concurrent ReadAt calls, but not for Read and Seek.
+type File struct {
+	info *FileInfo
+	// readAt reads within the file size
+	readAt func(p []byte, off int64) (n int, err error)
+	offset int64
+}
+
+var _ = io.ReaderAt(&File{})
+var _ = io.ReadSeeker(&File{})
+
+func newFile(info *FileInfo, readAt func(p []byte, off int64) (n int, err error)) *File {
+	return &File{
+		info:   info,
+		readAt: readAt,
+	}
+}
+
+func (f *File) Info() *FileInfo {
+	return f.info
+}
+
+func (f *File) Size() int64 {
+	return f.info.Size
+}
+
+func (f *File) ReadAt(p []byte, off int64) (n int, err error) {
+	if off >= f.info.Size {
+		return 0, io.EOF
+	}
+	want := len(p)
+	if remaining := f.info.Size - off; int64(want) > remaining {
+		p = p[:remaining]
+	}
+	n, err = f.readAt(p, off)
+	if err == nil && n < want {
+		err = io.EOF
+	}
+	return
+}
+
+func (f *File) Read(p []byte) (n int, err error) {
+	n, err = f.ReadAt(p, f.offset)
+	f.offset += int64(n)
+	return
+}
+
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.info.Size
+	default:
+		return f.offset, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return f.offset, fmt.Errorf("negative offset %d", offset)
+	}
+	f.offset = offset
+	return offset, nil
+}
@@ -0,0 +1,302 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+)
+
+// Filer reads and writes files by path through a filer.
+// The metadata goes through the filer, and the content goes directly to the volume servers.
+type Filer struct {
+	client           *Client
+	filerGrpcAddress string
+}
+
+// Filer returns the path level API of the filer, e.g. localhost:18888
+func (c *Client) Filer(filerGrpcAddress string) *Filer {
+	return &Filer{
+		client:           c,
+		filerGrpcAddress: filerGrpcAddress,
+	}
+}
+
+var _ = filer2.FilerClient(&Filer{})
+
+func (f *Filer) WithFilerClient(ctx context.Context, fn func(filer_pb.SeaweedFilerClient) error) error {
+	return util.WithCachedGrpcClient(ctx, func(clientConn *grpc.ClientConn) error {
+		client := filer_pb.NewSeaweedFilerClient(clientConn)
+		return fn(client)
+	}, f.filerGrpcAddress, f.client.option.GrpcDialOption)
+}
+
+type WriteOptions struct {
+	MimeType string
+	// Mode is 0644 if not set
+	Mode os.FileMode
+	// Collection, Replication and Ttl override the client defaults if set
+	Collection  string
+	Replication string
+	Ttl         string
+}
+
+// WriteFile uploads the content in chunks, and creates or replaces the file entry.
+// The chunks of a replaced file are deleted by the filer.
+func (f *Filer) WriteFile(ctx context.Context, fullPath string, reader io.Reader, option WriteOptions) (*filer_pb.Entry, error) {
+
+	dir, name := filer2.FullPath(fullPath).DirAndName()
+	if option.Mode == 0 {
+		option.Mode = 0644
+	}
+	collection, replication, ttl := f.client.option.Collection, f.client.option.Replication, f.client.option.Ttl
+	if option.Collection != "" {
+		collection = option.Collection
+	}
+	if option.Replication != "" {
+		replication = option.Replication
+	}
+	if option.Ttl != "" {
+		ttl = option.Ttl
+	}
+	ttlSec := ttlSeconds(ttl)
+
+	// the filer decides the collection and ttl by the parent folder
+	assign := func() (assignResult *operation.AssignResult, err error) {
+		err = f.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.AssignVolume(ctx, &filer_pb.AssignVolumeRequest{
+				Count:       1,
+				Collection:  collection,
+				Replication: replication,
+				TtlSec:      ttlSec,
+				DataCenter:  f.client.option.DataCenter,
+				ParentPath:  dir,
+			})
+			if err != nil {
+				return err
+			}
+			assignResult = &operation.AssignResult{
+				Fid:       resp.FileId,
+				Url:       resp.Url,
+				PublicUrl: resp.PublicUrl,
+				Count:     uint64(resp.Count),
+				Auth:      security.EncodedJwt(resp.Auth),
+			}
+			return nil
+		})
+		return
+	}
+
+	var chunks []*filer_pb.FileChunk
+	deleteChunks := func() {
+		var fileIds []string
+		for _, chunk := range chunks {
+			fileIds = append(fileIds, chunk.FileId)
+		}
+		if len(fileIds) > 0 {
+			f.client.Delete(ctx, fileIds...)
+		}
+	}
+
+	var offset int64
+	buf := make([]byte, int64(f.client.option.ChunkSizeMB)<<20)
+	for {
+		n, err := io.ReadFull(reader, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			deleteChunks()
+			return nil, fmt.Errorf("read %s: %v", fullPath, err)
+		}
+		if n == 0 {
+			break
+		}
+		assignResult, uploadResult, uploadErr := f.client.upload(ctx, assign, buf[:n], name, "application/octet-stream", false)
+		if uploadErr != nil {
+			deleteChunks()
+			return nil, uploadErr
+		}
+		chunks = append(chunks, &filer_pb.FileChunk{
+			FileId: assignResult.Fid,
+			Offset: offset,
+			Size:   uint64(n),
+			Mtime:  time.Now().UnixNano(),
+			ETag:   uploadResult.ETag,
+		})
+		offset += int64(n)
+		if err != nil {
+			break
+		}
+	}
+
+	now := time.Now().Unix()
+	entry := &filer_pb.Entry{
+		Name: name,
+		Attributes: &filer_pb.FuseAttributes{
+			Crtime:      now,
+			Mtime:       now,
+			FileSize:    uint64(offset),
+			FileMode:    uint32(option.Mode),
+			Mime:        option.MimeType,
+			Collection:  collection,
+			Replication: replication,
+			TtlSec:      ttlSec,
+		},
+		Chunks: chunks,
+	}
+	if err := f.createEntry(ctx, dir, entry); err != nil {
+		deleteChunks()
+		return nil, err
+	}
+	return entry, nil
+}
+
+// ReadFile reads the whole file into memory
+func (f *Filer) ReadFile(ctx context.Context, fullPath string) ([]byte, error) {
+	file, err := f.Open(ctx, fullPath)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, file.Size())
+	if n, err := file.ReadAt(data, 0); err != nil && !(err == io.EOF && n == len(data)) {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Open looks up the file entry. The ctx is used by all reads.
+func (f *Filer) Open(ctx context.Context, fullPath string) (*File, error) {
+	entry, err := f.Stat(ctx, fullPath)
+	if err != nil {
+		return nil, err
+	}
+	if entry.IsDirectory {
+		return nil, fmt.Errorf("%s is a directory", fullPath)
+	}
+
+	info := &FileInfo{
+		Name:         entry.Name,
+		MimeType:     entry.Attributes.Mime,
+		Size:         int64(filer2.TotalSize(entry.Chunks)),
+		ETag:         filer2.ETag(entry.Chunks),
+		LastModified: time.Unix(entry.Attributes.Mtime, 0),
+	}
+	visibles := filer2.NonOverlappingVisibleIntervals(entry.Chunks)
+	return newFile(info, func(p []byte, off int64) (n int, err error) {
+		// the holes between the chunks are read as zeros
+		for i := range p {
+			p[i] = 0
+		}
+		for _, view := range filer2.ViewFromVisibleIntervals(visibles, off, len(p)) {
+			buf := p[view.LogicOffset-off : view.LogicOffset-off+int64(view.Size)]
+			m, err := f.client.readRange(ctx, view.FileId, buf, view.Offset)
+			if err != nil {
+				return 0, fmt.Errorf("read %s chunk %s: %v", fullPath, view.FileId, err)
+			}
+			if m < len(buf) {
+				return 0, fmt.Errorf("read %s chunk %s: %d of %d bytes", fullPath, view.FileId, m, len(buf))
+			}
+		}
+		return len(p), nil
+	}), nil
+}
+
+// Stat returns the entry of the file or directory, or ErrNotFound
+func (f *Filer) Stat(ctx context.Context, fullPath string) (entry *filer_pb.Entry, err error) {
+	err = f.client.retry(ctx, "stat "+fullPath, func() error {
+		entry, err = filer2.GetEntry(ctx, f, fullPath)
+		return err
+	})
+	if err == nil && entry == nil {
+		err = ErrNotFound
+	}
+	return
+}
+
+// List calls fn with each entry in the directory, ordered by name
+func (f *Filer) List(ctx context.Context, dirPath string, fn func(entry *filer_pb.Entry)) error {
+	return filer2.ReadDirAllEntries(ctx, f, dirPath, fn)
+}
+
+// Mkdir creates the directory, and the missing parent directories
+func (f *Filer) Mkdir(ctx context.Context, fullPath string, mode os.FileMode) error {
+	dir, name := filer2.FullPath(fullPath).DirAndName()
+	now := time.Now().Unix()
+	return f.createEntry(ctx, dir, &filer_pb.Entry{
+		Name:        name,
+		IsDirectory: true,
+		Attributes: &filer_pb.FuseAttributes{
+			Crtime:   now,
+			Mtime:    now,
+			FileMode: uint32(os.ModeDir | mode),
+		},
+	})
+}
+
+func (f *Filer) createEntry(ctx context.Context, dir string, entry *filer_pb.Entry) error {
+	return f.client.retry(ctx, "create "+dir+"/"+entry.Name, func() error {
+		return f.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+			if _, err := client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+				Directory: dir,
+				Entry:     entry,
+			}); err != nil {
+				return fmt.Errorf("create %s/%s: %v", dir, entry.Name, err)
+			}
+			return nil
+		})
+	})
+}
+
+// Delete deletes the file, or the directory with everything under it if isRecursive is set.
+// The chunks are deleted by the filer.
+func (f *Filer) Delete(ctx context.Context, fullPath string, isRecursive bool) error {
+	dir, name := filer2.FullPath(fullPath).DirAndName()
+	return f.client.retry(ctx, "delete "+fullPath, func() error {
+		return f.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+			if _, err := client.DeleteEntry(ctx, &filer_pb.DeleteEntryRequest{
+				Directory:    dir,
+				Name:         name,
+				IsDeleteData: true,
+				IsRecursive:  isRecursive,
+			}); err != nil {
+				return fmt.Errorf("delete %s: %v", fullPath, err)
+			}
+			return nil
+		})
+	})
+}
+
+// Rename moves the file or directory atomically
+func (f *Filer) Rename(ctx context.Context, oldPath, newPath string) error {
+	oldDir, oldName := filer2.FullPath(oldPath).DirAndName()
+	newDir, newName := filer2.FullPath(newPath).DirAndName()
+	return f.client.retry(ctx, "rename "+oldPath, func() error {
+		return f.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+			if _, err := client.AtomicRenameEntry(ctx, &filer_pb.AtomicRenameEntryRequest{
+				OldDirectory: oldDir,
+				OldName:      oldName,
+				NewDirectory: newDir,
+				NewName:      newName,
+			}); err != nil {
+				return fmt.Errorf("rename %s to %s: %v", oldPath, newPath, err)
+			}
+			return nil
+		})
+	})
+}
+
+// ttlSeconds is the entry ttl matching the volume ttl, e.g. 3m, 1d
+func ttlSeconds(ttlString string) int32 {
+	ttl, err := needle.ReadTTL(ttlString)
+	if err != nil {
+		return 0
+	}
+	return int32(ttl.Minutes()) * 60
+}
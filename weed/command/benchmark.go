@@ -5,12 +5,10 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"math"
 	"math/rand"
 	"os"
 	"runtime"
 	"runtime/pprof"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -39,8 +37,24 @@ type BenchmarkOptions struct {
 	replication      *string
 	cpuprofile       *string
 	maxCpu           *int
+	mode             *string
+	sizes            *string
+	filer            *string
+	filerDir         *string
+	s3Endpoint       *string
+	s3Bucket         *string
+	s3AccessKey      *string
+	s3SecretKey      *string
+	s3PartSizeMB     *int
+	listRequests     *int
+	listLimit        *int
+	report           *string
+	reportFormat     *string
 	grpcDialOption   grpc.DialOption
 	masterClient     *wdclient.MasterClient
+	sizeDistribution *sizeDistribution
+	startedAt        time.Time
+	results          []*benchmarkResult
 }
 
 var (
@@ -65,6 +79,19 @@ func init() {
 	b.replication = cmdBenchmark.Flag.String("replication", "000", "replication type")
 	b.cpuprofile = cmdBenchmark.Flag.String("cpuprofile", "", "cpu profile output file")
 	b.maxCpu = cmdBenchmark.Flag.Int("maxCpu", 0, "maximum number of CPUs. 0 means all available CPUs")
+	b.mode = cmdBenchmark.Flag.String("mode", "volume", "[volume|filer|s3|meta] the layer to benchmark")
+	b.sizes = cmdBenchmark.Flag.String("sizes", "", "file size distribution, e.g., \"4KiB:60,64KiB-1MiB:30,16MiB:10\", overrides -size if not empty")
+	b.filer = cmdBenchmark.Flag.String("filer", "localhost:8888", "filer address, for the filer and meta modes")
+	b.filerDir = cmdBenchmark.Flag.String("filer.dir", "/benchmark", "filer folder to write files to, for the filer and meta modes")
+	b.s3Endpoint = cmdBenchmark.Flag.String("s3", "localhost:8333", "s3 gateway address, for the s3 mode")
+	b.s3Bucket = cmdBenchmark.Flag.String("s3.bucket", "benchmark", "s3 bucket to write objects to, created if missing")
+	b.s3AccessKey = cmdBenchmark.Flag.String("s3.accessKey", "", "s3 access key, anonymous if empty")
+	b.s3SecretKey = cmdBenchmark.Flag.String("s3.secretKey", "", "s3 secret key")
+	b.s3PartSizeMB = cmdBenchmark.Flag.Int("s3.partSizeMB", 8, "objects larger than this are written with multipart uploads of this part size, 0 to disable")
+	b.listRequests = cmdBenchmark.Flag.Int("listRequests", 1000, "number of list requests in the s3 and meta modes, 0 to skip listing")
+	b.listLimit = cmdBenchmark.Flag.Int("listLimit", 1000, "max number of entries for each list request")
+	b.report = cmdBenchmark.Flag.String("report", "", "write the results to this file, to compare between runs")
+	b.reportFormat = cmdBenchmark.Flag.String("report.format", "", "[json|csv] the report format, guessed by the -report file extension if empty")
 	sharedBytes = make([]byte, 1024)
 }
 
@@ -78,6 +105,15 @@ var cmdBenchmark = &Command{
   2) read the files out
 
   The file content is mostly zero, but no compression is done.
+  The file sizes can follow a distribution of weighted sizes or size ranges:
+    weed benchmark -sizes=4KiB:60,64KiB-1MiB:30,16MiB:10
+
+  The -mode option chooses the layer to benchmark:
+    volume: assign file ids from the master, and write to or read from volume servers directly
+    filer:  write and read files by the filer HTTP API
+    s3:     PUT, GET and LIST objects by the S3 API, with multipart uploads for large objects
+    meta:   create, stat, list and rename entries by the filer gRPC API, without any file content
+  The filer, s3 and meta modes store the written file paths or object keys in the "-list" file.
 
   You can choose to only benchmark read or write.
   During write, the list of uploaded file ids is stored in "-list" specified file.
@@ -97,6 +133,9 @@ var cmdBenchmark = &Command{
   After benchmarking, you can clean up the written data by deleting the benchmark collection
     http://localhost:9333/col/delete?collection=benchmark
 
+  The results, including the latency percentiles and histograms, can be saved as a JSON or CSV report
+  with "-report", to track the performance between releases.
+
   `,
 }
 
@@ -125,16 +164,50 @@ func runBenchmark(cmd *Command, args []string) bool {
 		defer pprof.StopCPUProfile()
 	}
 
-	b.masterClient = wdclient.NewMasterClient(context.Background(), b.grpcDialOption, "client", strings.Split(*b.masters, ","))
-	go b.masterClient.KeepConnectedToMaster()
-	b.masterClient.WaitUntilConnected()
+	sizes, err := parseSizeDistribution(*b.sizes, *b.fileSize)
+	if err != nil {
+		fmt.Printf("invalid -sizes %s: %v\n", *b.sizes, err)
+		return false
+	}
+	b.sizeDistribution = sizes
+	b.startedAt = time.Now()
+
+	switch *b.mode {
+	case "volume":
+		b.masterClient = wdclient.NewMasterClient(context.Background(), b.grpcDialOption, "client", strings.Split(*b.masters, ","))
+		go b.masterClient.KeepConnectedToMaster()
+		b.masterClient.WaitUntilConnected()
+
+		if *b.write {
+			benchWrite()
+		}
 
-	if *b.write {
-		benchWrite()
+		if *b.read {
+			benchRead()
+		}
+	case "filer":
+		benchFiler()
+	case "s3":
+		if err := benchS3(); err != nil {
+			fmt.Printf("s3 benchmark: %v\n", err)
+			return false
+		}
+	case "meta":
+		if err := benchMeta(); err != nil {
+			fmt.Printf("meta benchmark: %v\n", err)
+			return false
+		}
+	default:
+		fmt.Printf("unknown benchmark mode %s\n", *b.mode)
+		return false
 	}
 
-	if *b.read {
-		benchRead()
+	if *b.report != "" {
+		if err := writeBenchmarkReport(*b.report, *b.reportFormat, b.results); err != nil {
+			fmt.Printf("write report %s: %v\n", *b.report, err)
+			return false
+		}
+		fmt.Printf("\nReport is written to %s\n", *b.report)
 	}
 
 	return true
@@ -164,14 +237,14 @@ func benchWrite() {
 	finishChan <- true
 	wait.Wait()
 	close(finishChan)
-	writeStats.printStats()
+	writeStats.report("Writing Benchmark")
 }
 
 func benchRead() {
 	fileIdLineChan := make(chan string)
 	finishChan := make(chan bool)
 	readStats = newStats(*b.concurrency)
-	go readFileIds(*b.idListFile, fileIdLineChan)
+	go readFileIds(*b.idListFile, fileIdLineChan, *b.numberOfFiles)
 	readStats.start = time.Now()
 	readStats.total = *b.numberOfFiles
 	go readStats.checkProgress("Randomly Reading Benchmark", finishChan)
@@ -185,7 +258,7 @@ func benchRead() {
 	wait.Wait()
 	close(finishChan)
 	readStats.end = time.Now()
-	readStats.printStats()
+	readStats.report("Randomly Reading Benchmark")
 }
 
 type delayedFile struct {
@@ -223,7 +296,7 @@ func writeFiles(idChan chan int, fileIdLineChan chan string, s *stat) {
 
 	for id := range idChan {
 		start := time.Now()
-		fileSize := int64(*b.fileSize + random.Intn(64))
+		fileSize := b.sizeDistribution.pick(random)
 		fp := &operation.FilePart{
 			Reader:   &FakeReader{id: uint64(id), size: fileSize},
 			FileSize: fileSize,
@@ -315,7 +388,7 @@ func writeFileIds(fileName string, fileIdLineChan chan string, finishChan chan b
 	}
 }
 
-func readFileIds(fileName string, fileIdLineChan chan string) {
+func readFileIds(fileName string, fileIdLineChan chan string, total int) {
 	file, err := os.Open(fileName) // For read access.
 	if err != nil {
 		glog.Fatalf("File to read file %s: %s\n", fileName, err)
@@ -334,7 +407,7 @@ func readFileIds(fileName string, fileIdLineChan chan string) {
 			}
 		}
 	} else {
-		lines := make([]string, 0, readStats.total)
+		lines := make([]string, 0, total)
 		for {
 			if line, err := Readln(r); err == nil {
 				lines = append(lines, string(line))
@@ -343,7 +416,7 @@ func readFileIds(fileName string, fileIdLineChan chan string) {
 			}
 		}
 		if len(lines) > 0 {
-			for i := 0; i < readStats.total; i++ {
+			for i := 0; i < total; i++ {
 				fileIdLineChan <- lines[random.Intn(len(lines))]
 			}
 		}
@@ -359,6 +432,7 @@ const (
 
 // An efficient statics collecting and rendering
 type stats struct {
+	sync.Mutex
 	data       []int
 	overflow   []int
 	localStats []stat
@@ -384,6 +458,8 @@ func newStats(n int) *stats {
 }
 
 func (s *stats) addSample(d time.Duration) {
+	s.Lock()
+	defer s.Unlock()
 	index := int(d / benchBucket)
 	if index < 0 {
 		fmt.Printf("This request takes %3.1f seconds, skipping!\n", float64(index)/10000)
@@ -420,94 +496,6 @@ func (s *stats) checkProgress(testName string, finishChan chan bool) {
 	}
 }
 
-func (s *stats) printStats() {
-	completed, failed, transferred, total := 0, 0, int64(0), s.total
-	for _, localStat := range s.localStats {
-		completed += localStat.completed
-		failed += localStat.failed
-		transferred += localStat.transferred
-		total += localStat.total
-	}
-	timeTaken := float64(int64(s.end.Sub(s.start))) / 1000000000
-	fmt.Printf("\nConcurrency Level:      %d\n", *b.concurrency)
-	fmt.Printf("Time taken for tests:   %.3f seconds\n", timeTaken)
-	fmt.Printf("Complete requests:      %d\n", completed)
-	fmt.Printf("Failed requests:        %d\n", failed)
-	fmt.Printf("Total transferred:      %d bytes\n", transferred)
-	fmt.Printf("Requests per second:    %.2f [#/sec]\n", float64(completed)/timeTaken)
-	fmt.Printf("Transfer rate:          %.2f [Kbytes/sec]\n", float64(transferred)/1024/timeTaken)
-	n, sum := 0, 0
-	min, max := 10000000, 0
-	for i := 0; i < len(s.data); i++ {
-		n += s.data[i]
-		sum += s.data[i] * i
-		if s.data[i] > 0 {
-			if min > i {
-				min = i
-			}
-			if max < i {
-				max = i
-			}
-		}
-	}
-	n += len(s.overflow)
-	for i := 0; i < len(s.overflow); i++ {
-		sum += s.overflow[i]
-		if min > s.overflow[i] {
-			min = s.overflow[i]
-		}
-		if max < s.overflow[i] {
-			max = s.overflow[i]
-		}
-	}
-	avg := float64(sum) / float64(n)
-	varianceSum := 0.0
-	for i := 0; i < len(s.data); i++ {
-		if s.data[i] > 0 {
-			d := float64(i) - avg
-			varianceSum += d * d * float64(s.data[i])
-		}
-	}
-	for i := 0; i < len(s.overflow); i++ {
-		d := float64(s.overflow[i]) - avg
-		varianceSum += d * d
-	}
-	std := math.Sqrt(varianceSum / float64(n))
-	fmt.Printf("\nConnection Times (ms)\n")
-	fmt.Printf("              min      avg        max      std\n")
-	fmt.Printf("Total:        %2.1f      %3.1f       %3.1f      %3.1f\n", float32(min)/10, float32(avg)/10, float32(max)/10, std/10)
-	//printing percentiles
-	fmt.Printf("\nPercentage of the requests served within a certain time (ms)\n")
-	percentiles := make([]int, len(percentages))
-	for i := 0; i < len(percentages); i++ {
-		percentiles[i] = n * percentages[i] / 100
-	}
-	percentiles[len(percentiles)-1] = n
-	percentileIndex := 0
-	currentSum := 0
-	for i := 0; i < len(s.data); i++ {
-		currentSum += s.data[i]
-		if s.data[i] > 0 && percentileIndex < len(percentiles) && currentSum >= percentiles[percentileIndex] {
-			fmt.Printf("  %3d%%    %5.1f ms\n", percentages[percentileIndex], float32(i)/10.0)
-			percentileIndex++
-			for percentileIndex < len(percentiles) && currentSum >= percentiles[percentileIndex] {
-				percentileIndex++
-			}
-		}
-	}
-	sort.Ints(s.overflow)
-	for i := 0; i < len(s.overflow); i++ {
-		currentSum++
-		if percentileIndex < len(percentiles) && currentSum >= percentiles[percentileIndex] {
-			fmt.Printf("  %3d%%    %5.1f ms\n", percentages[percentileIndex], float32(s.overflow[i])/10.0)
-			percentileIndex++
-			for percentileIndex < len(percentiles) && currentSum >= percentiles[percentileIndex] {
-				percentileIndex++
-			}
-		}
-	}
-}
-
 // a fake reader to generate content to upload
 type FakeReader struct {
 	id   uint64 // an id number
@@ -0,0 +1,236 @@
+package command
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// the upper bounds of the latency histogram buckets, in milliseconds
+var histogramBoundsMs = []float64{0.5, 1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000, 10000}
+
+type benchmarkResult struct {
+	Name              string                     `json:"name"`
+	Concurrency       int                        `json:"concurrency"`
+	Seconds           float64                    `json:"seconds"`
+	Completed         int                        `json:"completed"`
+	Failed            int                        `json:"failed"`
+	TransferredBytes  int64                      `json:"transferred_bytes"`
+	RequestsPerSecond float64                    `json:"requests_per_second"`
+	KBytesPerSecond   float64                    `json:"kbytes_per_second"`
+	MinMs             float64                    `json:"min_ms"`
+	AvgMs             float64                    `json:"avg_ms"`
+	MaxMs             float64                    `json:"max_ms"`
+	StdMs             float64                    `json:"std_ms"`
+	Percentiles       []benchmarkPercentile      `json:"percentiles"`
+	Histogram         []benchmarkHistogramBucket `json:"histogram"`
+}
+
+type benchmarkPercentile struct {
+	Percent int     `json:"percent"`
+	Ms      float64 `json:"ms"`
+}
+
+// benchmarkHistogramBucket counts the requests taking more than the previous bucket's UpToMs, and at most UpToMs.
+// The last bucket has no upper bound, and its UpToMs is the max latency.
+type benchmarkHistogramBucket struct {
+	UpToMs float64 `json:"up_to_ms"`
+	Count  int     `json:"count"`
+}
+
+type benchmarkReport struct {
+	Version   string             `json:"version"`
+	Mode      string             `json:"mode"`
+	Sizes     string             `json:"sizes"`
+	StartedAt time.Time          `json:"started_at"`
+	Results   []*benchmarkResult `json:"results"`
+}
+
+// report prints the stats, and keeps the result for the report file
+func (s *stats) report(testName string) {
+	result := s.result(testName)
+	result.print()
+	b.results = append(b.results, result)
+}
+
+func (s *stats) result(testName string) *benchmarkResult {
+	s.Lock()
+	defer s.Unlock()
+
+	r := &benchmarkResult{
+		Name:        testName,
+		Concurrency: len(s.localStats),
+	}
+	for _, localStat := range s.localStats {
+		r.Completed += localStat.completed
+		r.Failed += localStat.failed
+		r.TransferredBytes += localStat.transferred
+	}
+	r.Seconds = float64(int64(s.end.Sub(s.start))) / 1000000000
+	if r.Seconds > 0 {
+		r.RequestsPerSecond = float64(r.Completed) / r.Seconds
+		r.KBytesPerSecond = float64(r.TransferredBytes) / 1024 / r.Seconds
+	}
+
+	// all samples in increasing order, as bucket indexes with counts
+	type bucket struct{ index, count int }
+	var buckets []bucket
+	for i := 0; i < len(s.data); i++ {
+		if s.data[i] > 0 {
+			buckets = append(buckets, bucket{i, s.data[i]})
+		}
+	}
+	sort.Ints(s.overflow)
+	for _, index := range s.overflow {
+		buckets = append(buckets, bucket{index, 1})
+	}
+	if len(buckets) == 0 {
+		return r
+	}
+
+	n, sum := 0, 0
+	for _, bk := range buckets {
+		n += bk.count
+		sum += bk.index * bk.count
+	}
+	avg := float64(sum) / float64(n)
+	varianceSum := 0.0
+	for _, bk := range buckets {
+		d := float64(bk.index) - avg
+		varianceSum += d * d * float64(bk.count)
+	}
+	r.MinMs = float64(buckets[0].index) / 10
+	r.AvgMs = avg / 10
+	r.MaxMs = float64(buckets[len(buckets)-1].index) / 10
+	r.StdMs = math.Sqrt(varianceSum/float64(n)) / 10
+
+	percentileIndex, currentSum := 0, 0
+	for _, bk := range buckets {
+		currentSum += bk.count
+		for percentileIndex < len(percentages) && currentSum >= n*percentages[percentileIndex]/100 {
+			r.Percentiles = append(r.Percentiles, benchmarkPercentile{Percent: percentages[percentileIndex], Ms: float64(bk.index) / 10})
+			percentileIndex++
+		}
+	}
+
+	for _, upTo := range histogramBoundsMs {
+		r.Histogram = append(r.Histogram, benchmarkHistogramBucket{UpToMs: upTo})
+	}
+	r.Histogram = append(r.Histogram, benchmarkHistogramBucket{UpToMs: math.Max(r.MaxMs, histogramBoundsMs[len(histogramBoundsMs)-1])})
+	for _, bk := range buckets {
+		ms := float64(bk.index) / 10
+		i := sort.SearchFloat64s(histogramBoundsMs, ms)
+		r.Histogram[i].Count += bk.count
+	}
+
+	return r
+}
+
+func (r *benchmarkResult) print() {
+	fmt.Printf("\nConcurrency Level:      %d\n", r.Concurrency)
+	fmt.Printf("Time taken for tests:   %.3f seconds\n", r.Seconds)
+	fmt.Printf("Complete requests:      %d\n", r.Completed)
+	fmt.Printf("Failed requests:        %d\n", r.Failed)
+	fmt.Printf("Total transferred:      %d bytes\n", r.TransferredBytes)
+	fmt.Printf("Requests per second:    %.2f [#/sec]\n", r.RequestsPerSecond)
+	fmt.Printf("Transfer rate:          %.2f [Kbytes/sec]\n", r.KBytesPerSecond)
+	fmt.Printf("\nConnection Times (ms)\n")
+	fmt.Printf("              min      avg        max      std\n")
+	fmt.Printf("Total:        %2.1f      %3.1f       %3.1f      %3.1f\n", r.MinMs, r.AvgMs, r.MaxMs, r.StdMs)
+	//printing percentiles
+	fmt.Printf("\nPercentage of the requests served within a certain time (ms)\n")
+	for _, p := range r.Percentiles {
+		fmt.Printf("  %3d%%    %5.1f ms\n", p.Percent, p.Ms)
+	}
+	//printing the histogram, with a bar for each bucket
+	fmt.Printf("\nLatency histogram (ms)\n")
+	maxCount := 0
+	for _, bucket := range r.Histogram {
+		if bucket.Count > maxCount {
+			maxCount = bucket.Count
+		}
+	}
+	for i, bucket := range r.Histogram {
+		if bucket.Count == 0 {
+			continue
+		}
+		label := fmt.Sprintf("<= %.1f", bucket.UpToMs)
+		if i == len(r.Histogram)-1 {
+			label = fmt.Sprintf("> %.1f", histogramBoundsMs[len(histogramBoundsMs)-1])
+		}
+		fmt.Printf("  %10s %8d %s\n", label, bucket.Count, strings.Repeat("*", (bucket.Count*40+maxCount-1)/maxCount))
+	}
+}
+
+func writeBenchmarkReport(fileName, format string, results []*benchmarkResult) error {
+	if format == "" {
+		format = "json"
+		if strings.ToLower(filepath.Ext(fileName)) == ".csv" {
+			format = "csv"
+		}
+	}
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("unknown report format %s", format)
+	}
+
+	file, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if format == "csv" {
+		return writeBenchmarkCsv(file, results)
+	}
+	report := &benchmarkReport{
+		Version:   fmt.Sprintf("%s %s %s", util.VERSION, runtime.GOOS, runtime.GOARCH),
+		Mode:      *b.mode,
+		Sizes:     b.sizeDistribution.String(),
+		StartedAt: b.startedAt,
+		Results:   results,
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// writeBenchmarkCsv writes one row for each test, with the percentiles as columns, but without the histograms
+func writeBenchmarkCsv(file *os.File, results []*benchmarkResult) error {
+	w := csv.NewWriter(file)
+	header := []string{"name", "mode", "concurrency", "seconds", "completed", "failed", "transferred_bytes",
+		"requests_per_second", "kbytes_per_second", "min_ms", "avg_ms", "max_ms", "std_ms"}
+	for _, percent := range percentages {
+		header = append(header, fmt.Sprintf("p%d_ms", percent))
+	}
+	w.Write(header)
+
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 3, 64)
+	}
+	for _, r := range results {
+		row := []string{r.Name, *b.mode, strconv.Itoa(r.Concurrency), formatFloat(r.Seconds),
+			strconv.Itoa(r.Completed), strconv.Itoa(r.Failed), strconv.FormatInt(r.TransferredBytes, 10),
+			formatFloat(r.RequestsPerSecond), formatFloat(r.KBytesPerSecond),
+			formatFloat(r.MinMs), formatFloat(r.AvgMs), formatFloat(r.MaxMs), formatFloat(r.StdMs)}
+		for i := range percentages {
+			if i < len(r.Percentiles) {
+				row = append(row, formatFloat(r.Percentiles[i].Ms))
+			} else {
+				row = append(row, "")
+			}
+		}
+		w.Write(row)
+	}
+	w.Flush()
+	return w.Error()
+}
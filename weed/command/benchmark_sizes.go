@@ -0,0 +1,86 @@
+package command
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+)
+
+// sizeDistribution picks the file sizes from weighted sizes or size ranges
+type sizeDistribution struct {
+	spec        string
+	choices     []sizeChoice
+	totalWeight int
+}
+
+type sizeChoice struct {
+	min, max int64
+	weight   int
+}
+
+// parseSizeDistribution parses comma separated "size[:weight]" or "minSize-maxSize[:weight]",
+// e.g., "4KiB:60,64KiB-1MiB:30,16MiB:10". An empty spec means the fixed size with random(0~63) bytes padding.
+func parseSizeDistribution(spec string, fixedSize int) (*sizeDistribution, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return &sizeDistribution{
+			spec:        fmt.Sprintf("%d-%d", fixedSize, fixedSize+63),
+			choices:     []sizeChoice{{min: int64(fixedSize), max: int64(fixedSize + 63), weight: 1}},
+			totalWeight: 1,
+		}, nil
+	}
+
+	d := &sizeDistribution{spec: spec}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		sizes, weight := item, 1
+		if colon := strings.LastIndex(item, ":"); colon >= 0 {
+			sizes = item[:colon]
+			w, err := strconv.Atoi(strings.TrimSpace(item[colon+1:]))
+			if err != nil || w <= 0 {
+				return nil, fmt.Errorf("invalid weight in %s", item)
+			}
+			weight = w
+		}
+		minSize, maxSize := sizes, sizes
+		if dash := strings.Index(sizes, "-"); dash >= 0 {
+			minSize, maxSize = sizes[:dash], sizes[dash+1:]
+		}
+		min, err := humanize.ParseBytes(strings.TrimSpace(minSize))
+		if err != nil {
+			return nil, fmt.Errorf("invalid size in %s: %v", item, err)
+		}
+		max, err := humanize.ParseBytes(strings.TrimSpace(maxSize))
+		if err != nil {
+			return nil, fmt.Errorf("invalid size in %s: %v", item, err)
+		}
+		if min > max {
+			return nil, fmt.Errorf("invalid size range in %s", item)
+		}
+		d.choices = append(d.choices, sizeChoice{min: int64(min), max: int64(max), weight: weight})
+		d.totalWeight += weight
+	}
+	return d, nil
+}
+
+// pick is not thread safe, because of the random
+func (d *sizeDistribution) pick(random *rand.Rand) int64 {
+	w := random.Intn(d.totalWeight)
+	for _, choice := range d.choices {
+		if w < choice.weight {
+			if choice.max == choice.min {
+				return choice.min
+			}
+			return choice.min + random.Int63n(choice.max-choice.min+1)
+		}
+		w -= choice.weight
+	}
+	return d.choices[len(d.choices)-1].max
+}
+
+func (d *sizeDistribution) String() string {
+	return d.spec
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestParseSizeDistribution(t *testing.T) {
+
+	d, err := parseSizeDistribution("", 1024)
+	if err != nil {
+		t.Fatalf("parse empty: %v", err)
+	}
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		if size := d.pick(random); size < 1024 || size > 1024+63 {
+			t.Fatalf("fixed size with padding: %d", size)
+		}
+	}
+
+	d, err = parseSizeDistribution("4KiB:3, 64KiB-1MiB", 0)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	small := 0
+	for i := 0; i < 4000; i++ {
+		size := d.pick(random)
+		switch {
+		case size == 4096:
+			small++
+		case size < 64*1024 || size > 1024*1024:
+			t.Fatalf("unexpected size %d", size)
+		}
+	}
+	if small < 2800 || small > 3200 {
+		t.Errorf("picked 4KiB %d times out of 4000, expected about 3000", small)
+	}
+
+	for _, invalid := range []string{"4KiB:0", "abc", "1MiB-4KiB", "4KiB:x"} {
+		if _, err := parseSizeDistribution(invalid, 0); err == nil {
+			t.Errorf("%s is parsed", invalid)
+		}
+	}
+}
+
+func TestStatsResult(t *testing.T) {
+
+	s := newStats(2)
+	s.start = time.Now()
+	s.end = s.start.Add(2 * time.Second)
+	for i := 1; i <= 100; i++ {
+		s.addSample(time.Duration(i) * time.Millisecond)
+	}
+	s.addSample(20 * time.Second) // overflow
+	s.localStats[0].completed = 60
+	s.localStats[1].completed = 41
+
+	r := s.result("test")
+	if r.Completed != 101 || r.RequestsPerSecond != 50.5 {
+		t.Errorf("completed %d in %.1f/s", r.Completed, r.RequestsPerSecond)
+	}
+	if r.MinMs != 1 || r.MaxMs != 20000 {
+		t.Errorf("min %.1f max %.1f", r.MinMs, r.MaxMs)
+	}
+	if len(r.Percentiles) != len(percentages) {
+		t.Fatalf("percentiles %+v", r.Percentiles)
+	}
+	if p50 := r.Percentiles[0]; p50.Percent != 50 || p50.Ms != 50 {
+		t.Errorf("p50 %+v", p50)
+	}
+	if p100 := r.Percentiles[len(r.Percentiles)-1]; p100.Ms != 20000 {
+		t.Errorf("p100 %+v", p100)
+	}
+
+	total := 0
+	for _, bucket := range r.Histogram {
+		total += bucket.Count
+	}
+	if total != 101 || r.Histogram[len(r.Histogram)-1].Count != 1 {
+		t.Errorf("histogram %+v", r.Histogram)
+	}
+}
@@ -0,0 +1,357 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// benchmarkRequest runs one request for the key, and returns the number of bytes transferred
+type benchmarkRequest func(key string, random *rand.Rand) (transferred int64, err error)
+
+// benchPhase runs the requests for all keys from the keyChan by the concurrent workers.
+// With recordKeys, the keys of the successful requests are written to the "-list" file.
+func benchPhase(testName string, keyChan chan string, total int, recordKeys bool, request benchmarkRequest) {
+	phaseStats := newStats(*b.concurrency)
+	phaseStats.total = total
+	finishChan := make(chan bool)
+	var fileIdLineChan chan string
+	if recordKeys {
+		fileIdLineChan = make(chan string)
+		go writeFileIds(*b.idListFile, fileIdLineChan, finishChan)
+	}
+
+	phaseStats.start = time.Now()
+	go phaseStats.checkProgress(testName, finishChan)
+	var workers sync.WaitGroup
+	for i := 0; i < *b.concurrency; i++ {
+		workers.Add(1)
+		go func(i int, s *stat) {
+			defer workers.Done()
+			random := rand.New(rand.NewSource(time.Now().UnixNano() + int64(i)))
+			for key := range keyChan {
+				if *cmdBenchmark.IsDebug {
+					fmt.Printf("%s %s\n", testName, key)
+				}
+				start := time.Now()
+				transferred, err := request(key, random)
+				if err != nil {
+					s.failed++
+					fmt.Printf("%s %s: %v\n", testName, key, err)
+					continue
+				}
+				phaseStats.addSample(time.Now().Sub(start))
+				s.completed++
+				s.transferred += transferred
+				if recordKeys {
+					fileIdLineChan <- key
+				}
+			}
+		}(i, &phaseStats.localStats[i])
+	}
+	workers.Wait()
+	phaseStats.end = time.Now()
+
+	if recordKeys {
+		wait.Add(2)
+		finishChan <- true
+		finishChan <- true
+	} else {
+		wait.Add(1)
+		finishChan <- true
+	}
+	wait.Wait()
+	close(finishChan)
+	phaseStats.report(testName)
+}
+
+// generatedKeys sends the names of the files to write
+func generatedKeys(dir string, n int) chan string {
+	keyChan := make(chan string)
+	go func() {
+		for i := 0; i < n; i++ {
+			keyChan <- path.Join(dir, fmt.Sprintf("bench_%08d", i))
+		}
+		close(keyChan)
+	}()
+	return keyChan
+}
+
+// listedKeys sends the keys from the "-list" file, randomly unless "-readSequentially"
+func listedKeys(total int) chan string {
+	keyChan := make(chan string)
+	go readFileIds(*b.idListFile, keyChan, total)
+	return keyChan
+}
+
+func fakeContent(id uint64, size int64) []byte {
+	data := make([]byte, size)
+	for i := 0; i < 8 && i < len(data); i++ {
+		data[i] = byte(id >> uint(i*8))
+	}
+	return data
+}
+
+func benchFiler() {
+	filerUrl := "http://" + *b.filer
+	query := url.Values{}
+	query.Set("collection", *b.collection)
+	query.Set("replication", *b.replication)
+
+	if *b.write {
+		benchPhase("Filer Writing Benchmark", generatedKeys(*b.filerDir, *b.numberOfFiles), *b.numberOfFiles, true,
+			func(key string, random *rand.Rand) (int64, error) {
+				fileSize := b.sizeDistribution.pick(random)
+				reader := &FakeReader{id: random.Uint64(), size: fileSize}
+				// the mime type prevents gzip benchmark content
+				_, err := operation.Upload(filerUrl+key+"?"+query.Encode(), path.Base(key), reader, false, "image/bench", nil, "")
+				return fileSize, err
+			})
+	}
+
+	if *b.read {
+		benchPhase("Filer Reading Benchmark", listedKeys(*b.numberOfFiles), *b.numberOfFiles, false,
+			func(key string, random *rand.Rand) (int64, error) {
+				data, err := util.Get(filerUrl + key)
+				return int64(len(data)), err
+			})
+	}
+}
+
+func benchS3() error {
+	config := &aws.Config{
+		Endpoint:         aws.String("http://" + *b.s3Endpoint),
+		Region:           aws.String("us-east-1"),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.AnonymousCredentials,
+	}
+	if *b.s3AccessKey != "" && *b.s3SecretKey != "" {
+		config.Credentials = credentials.NewStaticCredentials(*b.s3AccessKey, *b.s3SecretKey, "")
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return fmt.Errorf("create aws session: %v", err)
+	}
+	client := s3.New(sess)
+
+	bucket := aws.String(*b.s3Bucket)
+	if _, err := client.HeadBucket(&s3.HeadBucketInput{Bucket: bucket}); err != nil {
+		if _, err := client.CreateBucket(&s3.CreateBucketInput{Bucket: bucket}); err != nil {
+			return fmt.Errorf("create bucket %s: %v", *b.s3Bucket, err)
+		}
+	}
+	partSize := int64(*b.s3PartSizeMB) * 1024 * 1024
+
+	if *b.write {
+		benchPhase("S3 PUT Benchmark", generatedKeys("", *b.numberOfFiles), *b.numberOfFiles, true,
+			func(key string, random *rand.Rand) (int64, error) {
+				data := fakeContent(random.Uint64(), b.sizeDistribution.pick(random))
+				if partSize > 0 && int64(len(data)) > partSize {
+					return int64(len(data)), multipartUpload(client, bucket, key, data, partSize)
+				}
+				_, err := client.PutObject(&s3.PutObjectInput{
+					Bucket: bucket,
+					Key:    aws.String(key),
+					Body:   bytes.NewReader(data),
+				})
+				return int64(len(data)), err
+			})
+	}
+
+	if *b.read {
+		benchPhase("S3 GET Benchmark", listedKeys(*b.numberOfFiles), *b.numberOfFiles, false,
+			func(key string, random *rand.Rand) (int64, error) {
+				output, err := client.GetObject(&s3.GetObjectInput{
+					Bucket: bucket,
+					Key:    aws.String(key),
+				})
+				if err != nil {
+					return 0, err
+				}
+				defer output.Body.Close()
+				return io.Copy(ioutil.Discard, output.Body)
+			})
+	}
+
+	if *b.listRequests > 0 {
+		benchPhase("S3 LIST Benchmark", listedKeys(*b.listRequests), *b.listRequests, false,
+			func(key string, random *rand.Rand) (int64, error) {
+				_, err := client.ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:     bucket,
+					StartAfter: aws.String(key),
+					MaxKeys:    aws.Int64(int64(*b.listLimit)),
+				})
+				return 0, err
+			})
+	}
+
+	return nil
+}
+
+func multipartUpload(client *s3.S3, bucket *string, key string, data []byte, partSize int64) error {
+	upload, err := client.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket: bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("create multipart upload: %v", err)
+	}
+
+	var completedParts []*s3.CompletedPart
+	for partNumber, offset := int64(1), int64(0); offset < int64(len(data)); partNumber, offset = partNumber+1, offset+partSize {
+		end := offset + partSize
+		if end > int64(len(data)) {
+			end = int64(len(data))
+		}
+		part, err := client.UploadPart(&s3.UploadPartInput{
+			Bucket:     bucket,
+			Key:        aws.String(key),
+			UploadId:   upload.UploadId,
+			PartNumber: aws.Int64(partNumber),
+			Body:       bytes.NewReader(data[offset:end]),
+		})
+		if err != nil {
+			client.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+				Bucket:   bucket,
+				Key:      aws.String(key),
+				UploadId: upload.UploadId,
+			})
+			return fmt.Errorf("upload part %d: %v", partNumber, err)
+		}
+		completedParts = append(completedParts, &s3.CompletedPart{ETag: part.ETag, PartNumber: aws.Int64(partNumber)})
+	}
+
+	_, err = client.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          bucket,
+		Key:             aws.String(key),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completedParts},
+	})
+	if err != nil {
+		return fmt.Errorf("complete multipart upload: %v", err)
+	}
+	return nil
+}
+
+func benchMeta() error {
+	host, port, err := net.SplitHostPort(*b.filer)
+	if err != nil {
+		return fmt.Errorf("parse filer address %s: %v", *b.filer, err)
+	}
+	filerPort, err := strconv.Atoi(port)
+	if err != nil {
+		return fmt.Errorf("parse filer port %s: %v", port, err)
+	}
+	filerGrpcAddress := fmt.Sprintf("%s:%d", host, filerPort+10000)
+	ctx := context.Background()
+	uid, gid := uint32(os.Getuid()), uint32(os.Getgid())
+
+	withClient := func(fn func(client filer_pb.SeaweedFilerClient) error) error {
+		return withFilerClient(ctx, filerGrpcAddress, b.grpcDialOption, fn)
+	}
+
+	if *b.write {
+		benchPhase("Meta Create Benchmark", generatedKeys(*b.filerDir, *b.numberOfFiles), *b.numberOfFiles, true,
+			func(key string, random *rand.Rand) (int64, error) {
+				dir, name := filer2.FullPath(key).DirAndName()
+				now := time.Now().Unix()
+				return 0, withClient(func(client filer_pb.SeaweedFilerClient) error {
+					_, err := client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+						Directory: dir,
+						Entry: &filer_pb.Entry{
+							Name: name,
+							Attributes: &filer_pb.FuseAttributes{
+								Mtime:    now,
+								Crtime:   now,
+								FileMode: 0644,
+								Uid:      uid,
+								Gid:      gid,
+							},
+						},
+					})
+					return err
+				})
+			})
+	}
+
+	if *b.read {
+		benchPhase("Meta Stat Benchmark", listedKeys(*b.numberOfFiles), *b.numberOfFiles, false,
+			func(key string, random *rand.Rand) (int64, error) {
+				dir, name := filer2.FullPath(key).DirAndName()
+				return 0, withClient(func(client filer_pb.SeaweedFilerClient) error {
+					resp, err := client.LookupDirectoryEntry(ctx, &filer_pb.LookupDirectoryEntryRequest{
+						Directory: dir,
+						Name:      name,
+					})
+					if err == nil && resp.Entry == nil {
+						err = fmt.Errorf("not found")
+					}
+					return err
+				})
+			})
+	}
+
+	if *b.listRequests > 0 {
+		benchPhase("Meta List Benchmark", listedKeys(*b.listRequests), *b.listRequests, false,
+			func(key string, random *rand.Rand) (int64, error) {
+				dir, name := filer2.FullPath(key).DirAndName()
+				return 0, withClient(func(client filer_pb.SeaweedFilerClient) error {
+					stream, err := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
+						Directory:         dir,
+						StartFromFileName: name,
+						Limit:             uint32(*b.listLimit),
+					})
+					if err != nil {
+						return err
+					}
+					for {
+						if _, err := stream.Recv(); err != nil {
+							if err == io.EOF {
+								return nil
+							}
+							return err
+						}
+					}
+				})
+			})
+	}
+
+	// renaming is the last, since it changes the names of the created entries
+	if *b.write {
+		benchPhase("Meta Rename Benchmark", generatedKeys(*b.filerDir, *b.numberOfFiles), *b.numberOfFiles, false,
+			func(key string, random *rand.Rand) (int64, error) {
+				dir, name := filer2.FullPath(key).DirAndName()
+				return 0, withClient(func(client filer_pb.SeaweedFilerClient) error {
+					_, err := client.AtomicRenameEntry(ctx, &filer_pb.AtomicRenameEntryRequest{
+						OldDirectory: dir,
+						OldName:      name,
+						NewDirectory: dir,
+						NewName:      name + ".renamed",
+					})
+					return err
+				})
+			})
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ var Commands = []*Command{
 	cmdVersion,
 	cmdVolume,
 	cmdExport,
+	cmdImport,
 	cmdMount,
 	cmdWebDav,
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/server"
 	"github.com/chrislusf/seaweedfs/weed/util"
@@ -34,6 +35,8 @@ type FilerOptions struct {
 	dataCenter              *string
 	enableNotification      *bool
 	disableHttp             *bool
+	ttlSweepIntervalMinutes *int
+	fileIdBatchSize         *int
 
 	// default leveldb directory, used in "weed server" mode
 	defaultLevelDbDirectory *string
@@ -53,6 +56,8 @@ func init() {
 	f.dirListingLimit = cmdFiler.Flag.Int("dirListLimit", 100000, "limit sub dir listing size")
 	f.dataCenter = cmdFiler.Flag.String("dataCenter", "", "prefer to write to volumes in this data center")
 	f.disableHttp = cmdFiler.Flag.Bool("disableHttp", false, "disable http request, only gRpc operations are allowed")
+	f.fileIdBatchSize = cmdFiler.Flag.Int("fileIdBatchSize", operation.DefaultFileIdBatchSize, "file ids leased from the master at a time, 1 to disable")
+	f.ttlSweepIntervalMinutes = cmdFiler.Flag.Int("ttlSweepIntervalMinutes", 10, "interval to delete the entries past their ttl, 0 to disable")
 }
 
 var cmdFiler = &Command{
@@ -68,6 +73,10 @@ var cmdFiler = &Command{
 	POST /path/to/
 	//return a json format subdirectory and files listing
 	GET /path/to/
+	//create the file, which is not visible after 3 days, and deleted later by the ttl sweeper
+	POST /path/to/file?ttl=3d
+	//set the default ttl, in seconds, for the new files in the folder
+	PUT -H "X-Seaweed-Ttl: 86400" /path/to/?metadata
 
 	The configuration file "filer.toml" is read from ".", "$HOME/.seaweedfs/", or "/etc/seaweedfs/", in that order.
 
@@ -100,17 +109,19 @@ func (fo *FilerOptions) startFiler() {
 	}
 
 	fs, nfs_err := weed_server.NewFilerServer(defaultMux, publicVolumeMux, &weed_server.FilerOption{
-		Masters:            strings.Split(*fo.masters, ","),
-		Collection:         *fo.collection,
-		DefaultReplication: *fo.defaultReplicaPlacement,
-		RedirectOnRead:     *fo.redirectOnRead,
-		DisableDirListing:  *fo.disableDirListing,
-		MaxMB:              *fo.maxMB,
-		DirListingLimit:    *fo.dirListingLimit,
-		DataCenter:         *fo.dataCenter,
-		DefaultLevelDbDir:  defaultLevelDbDirectory,
-		DisableHttp:        *fo.disableHttp,
-		Port:               *fo.port,
+		Masters:                 strings.Split(*fo.masters, ","),
+		Collection:              *fo.collection,
+		DefaultReplication:      *fo.defaultReplicaPlacement,
+		RedirectOnRead:          *fo.redirectOnRead,
+		DisableDirListing:       *fo.disableDirListing,
+		MaxMB:                   *fo.maxMB,
+		DirListingLimit:         *fo.dirListingLimit,
+		DataCenter:              *fo.dataCenter,
+		DefaultLevelDbDir:       defaultLevelDbDirectory,
+		DisableHttp:             *fo.disableHttp,
+		TtlSweepIntervalMinutes: *fo.ttlSweepIntervalMinutes,
+		FileIdBatchSize:         *fo.fileIdBatchSize,
+		Port:                    *fo.port,
 	})
 	if nfs_err != nil {
 		glog.Fatalf("Filer startup error: %v", nfs_err)
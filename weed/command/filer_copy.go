@@ -17,6 +17,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/chrislusf/seaweedfs/weed/wdclient"
 	"github.com/spf13/viper"
@@ -323,7 +324,7 @@ func (worker *FileCopyWorker) uploadFileAsOne(ctx context.Context, task FileCopy
 					Mime:        mimeType,
 					Replication: *worker.options.replication,
 					Collection:  *worker.options.collection,
-					TtlSec:      int32(util.ParseInt(*worker.options.ttl, 0)),
+					TtlSec:      ttlSeconds(*worker.options.ttl),
 				},
 				Chunks: chunks,
 			},
@@ -397,7 +398,7 @@ func (worker *FileCopyWorker) uploadFileInChunks(ctx context.Context, task FileC
 					Mime:        mimeType,
 					Replication: *worker.options.replication,
 					Collection:  *worker.options.collection,
-					TtlSec:      int32(util.ParseInt(*worker.options.ttl, 0)),
+					TtlSec:      ttlSeconds(*worker.options.ttl),
 				},
 				Chunks: chunks,
 			},
@@ -416,6 +417,15 @@ func (worker *FileCopyWorker) uploadFileInChunks(ctx context.Context, task FileC
 	return nil
 }
 
+// ttlSeconds is the entry ttl matching the volume ttl, e.g. 3m, 1d
+func ttlSeconds(ttlString string) int32 {
+	ttl, err := needle.ReadTTL(ttlString)
+	if err != nil {
+		return 0
+	}
+	return int32(ttl.Minutes()) * 60
+}
+
 func detectMimeType(f *os.File) string {
 	head := make([]byte, 512)
 	f.Seek(0, io.SeekStart)
@@ -0,0 +1,728 @@
+package command
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+var (
+	imp ImportOptions
+)
+
+type ImportOptions struct {
+	input          *string
+	fileNameFormat *string
+	dir            *string
+	volumeId       *int
+	collection     *string
+	replication    *string
+	ttl            *string
+	preserveIds    *bool
+	filer          *string
+	filerDir       *string
+	concurrency    *int
+	resume         *string
+	maxMB          *int
+}
+
+var cmdImport = &Command{
+	UsageLine: "import -i=/dir/name.tar -dir=/tmp -volumeId=234 -preserveIds | import -i=/dir/name.tar -filer=localhost:8888 -filer.dir=/restored",
+	Short:     "import files from a tar file or a local folder into a new volume or the filer",
+	Long: `Import files from a tar file, e.g., the output of "weed export", or from a local folder.
+
+	The files can be written into a new volume data file, specified by -dir, -collection and -volumeId.
+	Just like "weed export", the volume server should not be running on the volume.
+	The file names in the tar file are parsed with -fileNameFormat, the same format used by "weed export",
+	to restore the needle id, cookie, name and mime type. The names not matching the format are kept as they are.
+	With -preserveIds, the needle ids are kept, and the cookies are also kept if the format has {{.Key}},
+	so the existing file ids stay valid if the volume id is the same.
+	The files without a needle id in the name, or with a needle id already in the volume, fail to import:
+	  weed export -dir=/data -volumeId=234 -o=234.tar -fileNameFormat={{.Key}}/{{.Name}}
+	  weed import -i=234.tar -dir=/restored -volumeId=234 -fileNameFormat={{.Key}}/{{.Name}} -preserveIds
+	Otherwise, new needle ids and cookies are generated, and the new file ids are printed out.
+
+	The files can also be written into a filer folder with -filer, keeping their paths in the tar file or the local folder.
+	The file content is sent through the filer, so the volume servers do not need to be reachable.
+	The files are streamed, and the files larger than -maxMB are split into chunks.
+	Importing into a volume reads each file into memory, and fails the files over the needle size limit.
+
+	With -resume, the imported files are recorded in the specified file, and the recorded files are skipped,
+	to continue an interrupted import.
+
+  `,
+}
+
+func init() {
+	cmdImport.Run = runImport // break init cycle
+	imp.input = cmdImport.Flag.String("i", "", "input tar file, \"-\" for stdin, or a local folder")
+	imp.fileNameFormat = cmdImport.Flag.String("fileNameFormat", defaultFnFormat, "format of the file names in the tar file, with {{.Mime}} {{.Id}} {{.Key}} {{.Name}} {{.Ext}}, the same as \"weed export\"")
+	imp.dir = cmdImport.Flag.String("dir", ".", "output data directory to store the new volume data files")
+	imp.volumeId = cmdImport.Flag.Int("volumeId", -1, "the new volume id, to import into a volume")
+	imp.collection = cmdImport.Flag.String("collection", "", "the collection name")
+	imp.replication = cmdImport.Flag.String("replication", "000", "the replication type")
+	imp.ttl = cmdImport.Flag.String("ttl", "", "time to live, e.g.: 1m, 1h, 1d, 1M, 1y")
+	imp.preserveIds = cmdImport.Flag.Bool("preserveIds", false, "keep the needle ids and cookies from the file names, to import into a volume")
+	imp.filer = cmdImport.Flag.String("filer", "", "filer address, to import into the filer instead of a volume")
+	imp.filerDir = cmdImport.Flag.String("filer.dir", "/", "the filer folder to import into")
+	imp.concurrency = cmdImport.Flag.Int("c", 8, "number of concurrent writes to the filer")
+	imp.resume = cmdImport.Flag.String("resume", "", "record the imported files in this file, and skip the files already recorded")
+	imp.maxMB = cmdImport.Flag.Int("maxMB", 32, "split files larger than the limit into chunks, to import into the filer")
+}
+
+// importEntry is one file from the tar file or the local folder
+type importEntry struct {
+	path    string // the path in the tar file or relative to the local folder, with "/" separators
+	size    int64
+	content io.Reader // only valid until the entry is handled, since the tar file is read sequentially
+	modTime time.Time
+	mode    os.FileMode
+}
+
+// importedFileName is the needle attributes parsed from the file name
+type importedFileName struct {
+	name      string
+	mime      string
+	id        types.NeedleId
+	cookie    types.Cookie
+	hasId     bool
+	hasCookie bool
+	isGzipped bool
+}
+
+type importCounter struct {
+	sync.Mutex
+	imported, failed int
+}
+
+func (c *importCounter) count(filePath string, err error) {
+	c.Lock()
+	defer c.Unlock()
+	if err != nil {
+		c.failed++
+		fmt.Printf("import %s: %v\n", filePath, err)
+		return
+	}
+	c.imported++
+}
+
+// importer handles the files one by one. A returned error stops the import,
+// while the failure of one file is only counted.
+type importer interface {
+	importFile(entry *importEntry) error
+	close()
+}
+
+func runImport(cmd *Command, args []string) bool {
+
+	if *imp.input == "" {
+		fmt.Println("missing the input tar file or folder: -i")
+		return false
+	}
+	if (*imp.filer == "") == (*imp.volumeId == -1) {
+		fmt.Println("specify either -volumeId to import into a volume, or -filer to import into the filer")
+		return false
+	}
+	if *imp.maxMB <= 0 {
+		fmt.Println("-maxMB should be positive")
+		return false
+	}
+
+	nameParser, err := newImportNameParser(*imp.fileNameFormat)
+	if err != nil {
+		fmt.Printf("cannot parse format %s: %v\n", *imp.fileNameFormat, err)
+		return false
+	}
+
+	journal, err := openImportJournal(*imp.resume)
+	if err != nil {
+		fmt.Printf("open %s: %v\n", *imp.resume, err)
+		return false
+	}
+	defer journal.close()
+
+	counter := &importCounter{}
+	var imported importer
+	if *imp.filer != "" {
+		imported, err = newFilerImporter(nameParser, journal, counter)
+	} else {
+		imported, err = newVolumeImporter(nameParser, journal, counter)
+	}
+	if err != nil {
+		fmt.Printf("import %s: %v\n", *imp.input, err)
+		return false
+	}
+
+	err = scanImportInput(*imp.input, journal, imported.importFile)
+	imported.close()
+	if err != nil {
+		fmt.Printf("import %s: %v\n", *imp.input, err)
+	}
+
+	fmt.Printf("imported %d files, skipped %d already imported files, failed %d files\n", counter.imported, journal.skipped, counter.failed)
+	return err == nil && counter.failed == 0
+}
+
+// scanImportInput passes the files not imported yet from the tar file or the local folder to fn
+func scanImportInput(input string, journal *importJournal, fn func(entry *importEntry) error) error {
+
+	fileInfo, err := os.Stat(input)
+	if input != "-" && err != nil {
+		return err
+	}
+
+	if input != "-" && fileInfo.IsDir() {
+		return filepath.Walk(input, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			relativePath, err := filepath.Rel(input, filePath)
+			if err != nil {
+				return err
+			}
+			relativePath = filepath.ToSlash(relativePath)
+			if journal.isImported(relativePath) {
+				return nil
+			}
+			file, err := os.Open(filePath)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			return fn(&importEntry{path: relativePath, size: info.Size(), content: file, modTime: info.ModTime(), mode: info.Mode().Perm()})
+		})
+	}
+
+	var inputFile *os.File
+	if input == "-" {
+		inputFile = os.Stdin
+	} else {
+		if inputFile, err = os.Open(input); err != nil {
+			return err
+		}
+		defer inputFile.Close()
+	}
+
+	tarReader := tar.NewReader(bufio.NewReader(inputFile))
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+		if journal.isImported(header.Name) {
+			continue
+		}
+		if err = fn(&importEntry{path: header.Name, size: header.Size, content: tarReader, modTime: header.ModTime, mode: os.FileMode(header.Mode).Perm()}); err != nil {
+			return err
+		}
+	}
+}
+
+// importNeedleSizeLimit leaves room for the name, mime and pairs in the needle size, which is an uint32
+const importNeedleSizeLimit = types.TombstoneFileSize - 128*1024
+
+// volumeImporter writes the files as needles into a new volume
+type volumeImporter struct {
+	nameParser *importNameParser
+	journal    *importJournal
+	counter    *importCounter
+	volume     *storage.Volume
+	ttl        *needle.TTL
+}
+
+func newVolumeImporter(nameParser *importNameParser, journal *importJournal, counter *importCounter) (*volumeImporter, error) {
+
+	replicaPlacement, err := storage.NewReplicaPlacementFromString(*imp.replication)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := needle.ReadTTL(*imp.ttl)
+	if err != nil {
+		return nil, err
+	}
+	if *imp.preserveIds && !nameParser.hasId {
+		return nil, fmt.Errorf("-preserveIds needs {{.Id}} or {{.Key}} in -fileNameFormat")
+	}
+	if *imp.preserveIds && !nameParser.hasCookie {
+		fmt.Println("the cookies are not in the file names, so new cookies are generated, and the file ids will change")
+	}
+
+	vid := needle.VolumeId(*imp.volumeId)
+	fileName := strconv.Itoa(*imp.volumeId)
+	if *imp.collection != "" {
+		fileName = *imp.collection + "_" + fileName
+	}
+	if _, err := os.Stat(path.Join(*imp.dir, fileName+".dat")); err == nil && *imp.resume == "" {
+		return nil, fmt.Errorf("volume %s already exists in %s, use -resume to continue an interrupted import", fileName, *imp.dir)
+	}
+
+	v, err := storage.NewVolume(*imp.dir, *imp.collection, vid, storage.NeedleMapInMemory, replicaPlacement, ttl, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("load volume %d: %v", vid, err)
+	}
+
+	return &volumeImporter{
+		nameParser: nameParser,
+		journal:    journal,
+		counter:    counter,
+		volume:     v,
+		ttl:        ttl,
+	}, nil
+}
+
+func (vi *volumeImporter) importFile(entry *importEntry) error {
+
+	fid, err := vi.writeNeedle(entry)
+	vi.counter.count(entry.path, err)
+	if err != nil {
+		return nil
+	}
+	fmt.Printf("imported %s => %s\n", entry.path, fid)
+	return vi.journal.record(entry.path, fid)
+}
+
+func (vi *volumeImporter) writeNeedle(entry *importEntry) (fid string, err error) {
+
+	if entry.size > importNeedleSizeLimit {
+		return "", fmt.Errorf("size %d is over the needle size limit %d", entry.size, importNeedleSizeLimit)
+	}
+	data, err := ioutil.ReadAll(entry.content)
+	if err != nil {
+		return "", err
+	}
+
+	fileName := vi.nameParser.parse(entry.path, data)
+	n := needle.NewNeedle(fileName.name, data, fileName.mime, nil, fileName.isGzipped, uint64(entry.modTime.Unix()), vi.ttl, false, false)
+	if *imp.preserveIds {
+		// the generated ids could be claimed by the following files, so all ids must be preserved
+		if !fileName.hasId {
+			return "", fmt.Errorf("no needle id in the file name to preserve")
+		}
+		if vi.volume.HasNeedle(fileName.id) {
+			return "", fmt.Errorf("needle id %s already exists in volume %d", fileName.id, *imp.volumeId)
+		}
+		n.Id, n.Cookie = fileName.id, types.Cookie(rand.Uint32())
+		if fileName.hasCookie {
+			n.Cookie = fileName.cookie
+		}
+	} else {
+		n.Id, n.Cookie = vi.volume.MaxFileKey()+1, types.Cookie(rand.Uint32())
+	}
+
+	if _, _, err := vi.volume.WriteNeedle(n); err != nil {
+		return "", fmt.Errorf("write: %v", err)
+	}
+	return needle.NewFileIdFromNeedle(needle.VolumeId(*imp.volumeId), n).String(), nil
+}
+
+func (vi *volumeImporter) close() {
+	vi.volume.Close()
+}
+
+type importFilerClient struct {
+	grpcAddress    string
+	grpcDialOption grpc.DialOption
+}
+
+func (c *importFilerClient) WithFilerClient(ctx context.Context, fn func(filer_pb.SeaweedFilerClient) error) error {
+	return withFilerClient(ctx, c.grpcAddress, c.grpcDialOption, fn)
+}
+
+// filerImporter streams each file in pieces of -maxMB, which are uploaded concurrently,
+// and creates the entry once all pieces of the file are uploaded.
+// At most -c pieces are uploaded and -c more are queued, which bounds the memory used.
+type filerImporter struct {
+	nameParser  *importNameParser
+	journal     *importJournal
+	counter     *importCounter
+	filerClient *importFilerClient
+	uid, gid    uint32
+	ttlSec      int32
+	pieces      chan *filerImportPiece
+	uploaders   sync.WaitGroup
+	files       sync.WaitGroup
+}
+
+// filerImportFile collects the chunks of the pieces of one file
+type filerImportFile struct {
+	sync.Mutex
+	entry    *importEntry
+	fileName *importedFileName
+	pieces   sync.WaitGroup
+	chunks   []*filer_pb.FileChunk
+	size     int64
+	err      error
+}
+
+type filerImportPiece struct {
+	file   *filerImportFile
+	offset int64
+	data   []byte
+}
+
+func newFilerImporter(nameParser *importNameParser, journal *importJournal, counter *importCounter) (*filerImporter, error) {
+
+	host, port, err := net.SplitHostPort(*imp.filer)
+	if err != nil {
+		return nil, fmt.Errorf("parse filer address %s: %v", *imp.filer, err)
+	}
+	filerPort, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("parse filer port %s: %v", port, err)
+	}
+	util.LoadConfiguration("security", false)
+
+	fi := &filerImporter{
+		nameParser: nameParser,
+		journal:    journal,
+		counter:    counter,
+		filerClient: &importFilerClient{
+			grpcAddress:    fmt.Sprintf("%s:%d", host, filerPort+10000),
+			grpcDialOption: security.LoadClientTLS(viper.Sub("grpc"), "client"),
+		},
+		uid:    uint32(os.Getuid()),
+		gid:    uint32(os.Getgid()),
+		ttlSec: ttlSeconds(*imp.ttl),
+		pieces: make(chan *filerImportPiece, *imp.concurrency),
+	}
+	for i := 0; i < *imp.concurrency; i++ {
+		fi.uploaders.Add(1)
+		go func() {
+			defer fi.uploaders.Done()
+			for piece := range fi.pieces {
+				fi.uploadPiece(piece)
+			}
+		}()
+	}
+	return fi, nil
+}
+
+func (fi *filerImporter) importFile(entry *importEntry) error {
+
+	// the gzip header is enough to tell whether "weed export" gzipped the file
+	content := bufio.NewReader(entry.content)
+	header, _ := content.Peek(2)
+	file := &filerImportFile{
+		entry:    entry,
+		fileName: fi.nameParser.parse(entry.path, header),
+	}
+	var reader io.Reader = content
+	if file.fileName.isGzipped {
+		gzipReader, err := gzip.NewReader(content)
+		if err != nil {
+			fi.counter.count(entry.path, fmt.Errorf("ungzip: %v", err))
+			return nil
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	fi.files.Add(1)
+	var readErr error
+	pieceSize := int64(*imp.maxMB) * 1024 * 1024
+	for offset := int64(0); ; {
+		var piece bytes.Buffer
+		n, err := io.CopyN(&piece, reader, pieceSize)
+		if n > 0 {
+			file.pieces.Add(1)
+			fi.pieces <- &filerImportPiece{file: file, offset: offset, data: piece.Bytes()}
+			offset += n
+		}
+		if err != nil && err != io.EOF {
+			readErr = err
+			break
+		}
+		if n < pieceSize {
+			break
+		}
+	}
+
+	// a broken tar file fails on the next file, so the read error only fails this file
+	go func() {
+		defer fi.files.Done()
+		file.pieces.Wait()
+		if readErr != nil {
+			file.err = fmt.Errorf("read: %v", readErr)
+		}
+		if file.err == nil {
+			file.err = fi.createEntry(file)
+		}
+		if file.err == nil {
+			file.err = fi.journal.record(entry.path, path.Join(*imp.filerDir, entry.path))
+		}
+		fi.counter.count(entry.path, file.err)
+	}()
+
+	return nil
+}
+
+func (fi *filerImporter) uploadPiece(piece *filerImportPiece) {
+	defer piece.file.pieces.Done()
+
+	ctx := context.Background()
+	dir, name := filer2.FullPath(path.Join(*imp.filerDir, piece.file.entry.path)).DirAndName()
+	chunks, err := filer2.WriteThroughFiler(ctx, fi.filerClient, &filer_pb.WriteFileRequest{
+		Directory:   dir,
+		Name:        name,
+		Offset:      piece.offset,
+		Collection:  *imp.collection,
+		Replication: *imp.replication,
+		TtlSec:      fi.ttlSec,
+	}, piece.data)
+
+	piece.file.Lock()
+	defer piece.file.Unlock()
+	if err != nil {
+		if piece.file.err == nil {
+			piece.file.err = err
+		}
+		return
+	}
+	piece.file.chunks = append(piece.file.chunks, chunks...)
+	piece.file.size += int64(len(piece.data))
+}
+
+func (fi *filerImporter) createEntry(file *filerImportFile) error {
+
+	ctx := context.Background()
+	fullPath := filer2.FullPath(path.Join(*imp.filerDir, file.entry.path))
+	dir, name := fullPath.DirAndName()
+
+	mode := file.entry.mode
+	if mode == 0 {
+		mode = 0644
+	}
+	return fi.filerClient.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+			Directory: dir,
+			Entry: &filer_pb.Entry{
+				Name: name,
+				Attributes: &filer_pb.FuseAttributes{
+					Crtime:      time.Now().Unix(),
+					Mtime:       file.entry.modTime.Unix(),
+					Uid:         fi.uid,
+					Gid:         fi.gid,
+					FileSize:    uint64(file.size),
+					FileMode:    uint32(mode),
+					Mime:        file.fileName.mime,
+					Replication: *imp.replication,
+					Collection:  *imp.collection,
+					TtlSec:      fi.ttlSec,
+				},
+				Chunks: file.chunks,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("create entry %s: %v", fullPath, err)
+		}
+		return nil
+	})
+}
+
+func (fi *filerImporter) close() {
+	close(fi.pieces)
+	fi.uploaders.Wait()
+	fi.files.Wait()
+}
+
+// importNameParser parses the file names made by the "weed export" -fileNameFormat
+type importNameParser struct {
+	pattern   *regexp.Regexp
+	hasName   bool
+	hasId     bool
+	hasCookie bool
+}
+
+var fileNameFormatField = regexp.MustCompile(`\{\{\s*\.(\w+)\s*\}\}`)
+
+func newImportNameParser(fileNameFormat string) (*importNameParser, error) {
+	p := &importNameParser{}
+	var expr strings.Builder
+	expr.WriteString("^")
+	last := 0
+	for _, loc := range fileNameFormatField.FindAllStringSubmatchIndex(fileNameFormat, -1) {
+		expr.WriteString(regexp.QuoteMeta(fileNameFormat[last:loc[0]]))
+		last = loc[1]
+		field := fileNameFormat[loc[2]:loc[3]]
+		switch field {
+		case "Mime":
+			expr.WriteString(`(?P<Mime>[^/]*/[^/]*|)`)
+		case "Id":
+			expr.WriteString(`(?P<Id>[0-9a-f]+)`)
+			p.hasId = true
+		case "Key":
+			expr.WriteString(`(?P<Key>[0-9]+,[0-9a-f]+)`)
+			p.hasId, p.hasCookie = true, true
+		case "Name":
+			expr.WriteString(`(?P<Name>.*)`)
+			p.hasName = true
+		case "Ext":
+			expr.WriteString(`(?P<Ext>(?:\.[^./]*)?)`)
+		default:
+			return nil, fmt.Errorf("unknown field %s", field)
+		}
+	}
+	if strings.Contains(fileNameFormat[last:], "{{") {
+		return nil, fmt.Errorf("unsupported template %s", fileNameFormat[last:])
+	}
+	expr.WriteString(regexp.QuoteMeta(fileNameFormat[last:]))
+	expr.WriteString("$")
+
+	pattern, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, err
+	}
+	p.pattern = pattern
+	return p, nil
+}
+
+// parse restores the needle attributes from the file name.
+// "weed export" appends ".gz" to the gzipped files, so the suffix is removed if the data is gzipped.
+func (p *importNameParser) parse(filePath string, data []byte) *importedFileName {
+	if strings.HasSuffix(filePath, ".gz") && len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		if fileName := p.match(strings.TrimSuffix(filePath, ".gz")); fileName != nil && !strings.HasSuffix(fileName.name, ".gz") {
+			fileName.isGzipped = true
+			return fileName
+		}
+	}
+	if fileName := p.match(filePath); fileName != nil {
+		return fileName
+	}
+	// the file name does not match the format
+	return &importedFileName{name: path.Base(filePath)}
+}
+
+func (p *importNameParser) match(filePath string) *importedFileName {
+	matches := p.pattern.FindStringSubmatch(filePath)
+	if matches == nil {
+		return nil
+	}
+	fileName := &importedFileName{}
+	for i, groupName := range p.pattern.SubexpNames() {
+		value := matches[i]
+		switch groupName {
+		case "Mime":
+			fileName.mime = value
+		case "Name":
+			fileName.name = value
+		case "Id":
+			id, err := types.ParseNeedleId(value)
+			if err != nil {
+				return nil
+			}
+			fileName.id, fileName.hasId = id, true
+		case "Key":
+			fid, err := needle.ParseFileIdFromString(value)
+			if err != nil {
+				return nil
+			}
+			fileName.id, fileName.cookie = fid.Key, fid.Cookie
+			fileName.hasId, fileName.hasCookie = true, true
+		}
+	}
+	if !p.hasName {
+		fileName.name = path.Base(filePath)
+	}
+	return fileName
+}
+
+// importJournal records the imported files, one quoted path and its destination per line
+type importJournal struct {
+	sync.Mutex
+	file     *os.File
+	imported map[string]bool
+	skipped  int
+}
+
+func openImportJournal(fileName string) (*importJournal, error) {
+	journal := &importJournal{imported: make(map[string]bool)}
+	if fileName == "" {
+		return journal, nil
+	}
+
+	file, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if tab := strings.Index(line, "\t"); tab > 0 {
+			line = line[:tab]
+		}
+		if filePath, err := strconv.Unquote(line); err == nil {
+			journal.imported[filePath] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if len(journal.imported) > 0 {
+		glog.V(0).Infof("resuming the import with %d files already imported", len(journal.imported))
+	}
+	journal.file = file
+	return journal, nil
+}
+
+func (j *importJournal) isImported(filePath string) bool {
+	j.Lock()
+	defer j.Unlock()
+	if j.imported[filePath] {
+		j.skipped++
+		return true
+	}
+	return false
+}
+
+func (j *importJournal) record(filePath, destination string) error {
+	if j.file == nil {
+		return nil
+	}
+	j.Lock()
+	defer j.Unlock()
+	_, err := fmt.Fprintf(j.file, "%s\t%s\n", strconv.Quote(filePath), destination)
+	return err
+}
+
+func (j *importJournal) close() {
+	if j.file != nil {
+		j.file.Close()
+	}
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestImportNameParser(t *testing.T) {
+
+	p, err := newImportNameParser(defaultFnFormat)
+	if err != nil {
+		t.Fatalf("parse default format: %v", err)
+	}
+	fileName := p.parse("image/jpeg/1a2b:photo.jpg", []byte("data"))
+	if fileName.mime != "image/jpeg" || !fileName.hasId || fileName.id != 0x1a2b || fileName.hasCookie || fileName.name != "photo.jpg" {
+		t.Errorf("default format: %+v", fileName)
+	}
+	// empty mime
+	fileName = p.parse("/3:notes.txt", []byte("data"))
+	if fileName.mime != "" || fileName.id != 3 || fileName.name != "notes.txt" {
+		t.Errorf("empty mime: %+v", fileName)
+	}
+	// not matching the format
+	fileName = p.parse("some/folder/file.txt", []byte("data"))
+	if fileName.hasId || fileName.name != "file.txt" {
+		t.Errorf("not matching: %+v", fileName)
+	}
+
+	p, err = newImportNameParser("{{.Key}}/{{.Name}}")
+	if err != nil {
+		t.Fatalf("parse key format: %v", err)
+	}
+	var gzipped bytes.Buffer
+	w := gzip.NewWriter(&gzipped)
+	w.Write([]byte("hello"))
+	w.Close()
+	fileName = p.parse("3,01637037d6/a.txt.gz", gzipped.Bytes())
+	if !fileName.hasCookie || fileName.id != 1 || fileName.cookie != 0x637037d6 || !fileName.isGzipped || fileName.name != "a.txt" {
+		t.Errorf("gzipped key format: %+v", fileName)
+	}
+	// a .gz file, not gzipped by the volume server
+	fileName = p.parse("3,01637037d6/b.gz", []byte("plain"))
+	if fileName.isGzipped || fileName.name != "b.gz" {
+		t.Errorf("plain .gz file: %+v", fileName)
+	}
+
+	if _, err = newImportNameParser("{{.Size}}"); err == nil {
+		t.Errorf("unknown field is accepted")
+	}
+}
+
+func TestImportJournal(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "import_journal")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "journal")
+
+	journal, err := openImportJournal(fileName)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	journal.record("a\tb.txt", "3,01637037d6")
+	journal.record("c.txt", "3,02637037d6")
+	journal.close()
+
+	journal, err = openImportJournal(fileName)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer journal.close()
+	if !journal.isImported("a\tb.txt") || !journal.isImported("c.txt") || journal.isImported("d.txt") {
+		t.Errorf("imported files %v", journal.imported)
+	}
+	if journal.skipped != 2 {
+		t.Errorf("skipped %d", journal.skipped)
+	}
+}
@@ -2,8 +2,11 @@ package command
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
 )
 
 type MountOptions struct {
@@ -18,6 +21,12 @@ type MountOptions struct {
 	dataCenter         *string
 	allowOthers        *bool
 	umaskString        *string
+	fileIdBatchSize    *int
+	filerProxy         *bool
+	dirtyMemoryMB      *int
+	dirtyLimitMB       *int
+	cacheDir           *string
+	compactChunks      *int
 }
 
 var (
@@ -39,6 +48,12 @@ func init() {
 	mountOptions.dataCenter = cmdMount.Flag.String("dataCenter", "", "prefer to write to the data center")
 	mountOptions.allowOthers = cmdMount.Flag.Bool("allowOthers", true, "allows other users to access the file system")
 	mountOptions.umaskString = cmdMount.Flag.String("umask", "022", "octal umask, e.g., 022, 0111")
+	mountOptions.filerProxy = cmdMount.Flag.Bool("filerProxy", false, "read and write the file content through the filer, if the volume servers are not reachable")
+	mountOptions.fileIdBatchSize = cmdMount.Flag.Int("fileIdBatchSize", operation.DefaultFileIdBatchSize, "file ids leased from the filer at a time, 1 to disable, not used with -filerProxy")
+	mountOptions.dirtyMemoryMB = cmdMount.Flag.Int("dirtyMemoryMB", 16, "unsaved writes of a file kept in memory, before spilling to a local file")
+	mountOptions.dirtyLimitMB = cmdMount.Flag.Int("dirtyLimitMB", 1024, "unsaved writes of a file kept locally, before saving them to the volume servers")
+	mountOptions.cacheDir = cmdMount.Flag.String("cacheDir", os.TempDir(), "local directory to spill the unsaved writes")
+	mountOptions.compactChunks = cmdMount.Flag.Int("compactChunks", 256, "rewrite the closed files fragmented into more chunks than this, 0 to disable")
 	mountCpuProfile = cmdMount.Flag.String("cpuprofile", "", "cpu profile output file")
 	mountMemProfile = cmdMount.Flag.String("memprofile", "", "memory profile output file")
 }
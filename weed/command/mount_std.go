@@ -17,10 +17,10 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/chrislusf/seaweedfs/weed/filesys"
+	"github.com/chrislusf/seaweedfs/weed/fuse"
+	"github.com/chrislusf/seaweedfs/weed/fuse/fs"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/util"
-	"github.com/seaweedfs/fuse"
-	"github.com/seaweedfs/fuse/fs"
 )
 
 func runMount(cmd *Command, args []string) bool {
@@ -44,12 +44,19 @@ func runMount(cmd *Command, args []string) bool {
 		*mountOptions.allowOthers,
 		*mountOptions.ttlSec,
 		*mountOptions.dirListingLimit,
+		*mountOptions.fileIdBatchSize,
+		*mountOptions.filerProxy,
+		*mountOptions.dirtyMemoryMB,
+		*mountOptions.dirtyLimitMB,
+		*mountOptions.cacheDir,
+		*mountOptions.compactChunks,
 		os.FileMode(umask),
 	)
 }
 
 func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCenter string, chunkSizeLimitMB int,
-	allowOthers bool, ttlSec int, dirListingLimit int, umask os.FileMode) bool {
+	allowOthers bool, ttlSec int, dirListingLimit int, fileIdBatchSize int, filerProxy bool,
+	dirtyMemoryMB, dirtyLimitMB int, cacheDir string, compactChunks int, umask os.FileMode) bool {
 
 	util.LoadConfiguration("security", false)
 
@@ -106,6 +113,8 @@ func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCente
 		fuse.AsyncRead(),
 		fuse.WritebackCache(),
 		fuse.AllowNonEmptyMount(),
+		fuse.LockingPOSIX(),
+		fuse.LockingFlock(),
 	}
 	if allowOthers {
 		options = append(options, fuse.AllowOther())
@@ -118,11 +127,6 @@ func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCente
 		return false
 	}
 
-	util.OnInterrupt(func() {
-		fuse.Unmount(dir)
-		c.Close()
-	})
-
 	filerGrpcAddress, err := parseFilerGrpcAddress(filer)
 	if err != nil {
 		glog.Fatal(err)
@@ -135,9 +139,7 @@ func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCente
 		mountRoot = mountRoot[0 : len(mountRoot)-1]
 	}
 
-	daemonize.SignalOutcome(nil)
-
-	err = fs.Serve(c, filesys.NewSeaweedFileSystem(&filesys.Option{
+	seaweedFileSystem := filesys.NewSeaweedFileSystem(&filesys.Option{
 		FilerGrpcAddress:   filerGrpcAddress,
 		GrpcDialOption:     security.LoadClientTLS(viper.Sub("grpc"), "client"),
 		FilerMountRootPath: mountRoot,
@@ -154,7 +156,24 @@ func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCente
 		MountCtime:         fileInfo.ModTime(),
 		MountMtime:         time.Now(),
 		Umask:              umask,
-	}))
+		FileIdBatchSize:    fileIdBatchSize,
+		FilerProxy:         filerProxy,
+
+		DirtyMemoryLimit:         int64(dirtyMemoryMB) * 1024 * 1024,
+		DirtyPagesLimit:          int64(dirtyLimitMB) * 1024 * 1024,
+		CacheDir:                 cacheDir,
+		CompactionChunkThreshold: compactChunks,
+	})
+
+	util.OnInterrupt(func() {
+		seaweedFileSystem.ReleaseAllLocks()
+		fuse.Unmount(dir)
+		c.Close()
+	})
+
+	daemonize.SignalOutcome(nil)
+
+	err = fs.Serve(c, seaweedFileSystem)
 	if err != nil {
 		fuse.Unmount(dir)
 	}
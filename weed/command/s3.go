@@ -26,6 +26,7 @@ type S3Options struct {
 	domainName       *string
 	tlsPrivateKey    *string
 	tlsCertificate   *string
+	lifecycleMinutes *int
 }
 
 func init() {
@@ -36,6 +37,7 @@ func init() {
 	s3StandaloneOptions.domainName = cmdS3.Flag.String("domainName", "", "suffix of the host name, {bucket}.{domainName}")
 	s3StandaloneOptions.tlsPrivateKey = cmdS3.Flag.String("key.file", "", "path to the TLS private key file")
 	s3StandaloneOptions.tlsCertificate = cmdS3.Flag.String("cert.file", "", "path to the TLS certificate file")
+	s3StandaloneOptions.lifecycleMinutes = cmdS3.Flag.Int("lifecycle.intervalMinutes", 60, "how often to apply bucket lifecycle rules, 0 to disable")
 }
 
 var cmdS3 = &Command{
@@ -65,11 +67,12 @@ func (s3opt *S3Options) startS3Server() bool {
 	router := mux.NewRouter().SkipClean(true)
 
 	_, s3ApiServer_err := s3api.NewS3ApiServer(router, &s3api.S3ApiServerOption{
-		Filer:            *s3opt.filer,
-		FilerGrpcAddress: filerGrpcAddress,
-		DomainName:       *s3opt.domainName,
-		BucketsPath:      *s3opt.filerBucketsPath,
-		GrpcDialOption:   security.LoadClientTLS(viper.Sub("grpc"), "client"),
+		Filer:             *s3opt.filer,
+		FilerGrpcAddress:  filerGrpcAddress,
+		DomainName:        *s3opt.domainName,
+		BucketsPath:       *s3opt.filerBucketsPath,
+		GrpcDialOption:    security.LoadClientTLS(viper.Sub("grpc"), "client"),
+		LifecycleInterval: time.Duration(*s3opt.lifecycleMinutes) * time.Minute,
 	})
 	if s3ApiServer_err != nil {
 		glog.Fatalf("S3 API Server startup error: %v", s3ApiServer_err)
@@ -74,6 +74,12 @@ dir = "."					# directory to store level db files
 enabled = true
 dir = "."					# directory to store level db files
 
+[sqlite]
+# local on disk, embedded sql database with transactions, in WAL mode
+# the "filemeta" table is created automatically, and can be inspected with the sqlite3 tool
+enabled = false
+dir = "."					# directory to store the filer.db file
+
 ####################################################
 # multiple filers on shared storage, fairly scalable
 ####################################################
@@ -104,6 +110,8 @@ connection_max_open = 100
 #   meta        bytea,
 #   PRIMARY KEY (dirhash, name)
 # );
+# -- only needed for prefix listing if the database collation is not "C"
+# CREATE INDEX IF NOT EXISTS filemeta_name_pattern ON filemeta (dirhash, name varchar_pattern_ops);
 enabled = false
 hostname = "localhost"
 port = 5432
@@ -150,6 +158,12 @@ enabled = false
 servers = "localhost:2379"
 timeout = "3s"
 
+# choose the disk type for new files by path prefix, the longest prefix wins
+# the "disk" query parameter of an upload overrides the rules
+# [[filer.disk_rule]]
+# location_prefix = "/buckets/hot/"
+# disk = "ssd"
+
 `
 
 	NOTIFICATION_TOML_EXAMPLE = `
@@ -333,13 +347,22 @@ key  = ""
 
 [master.maintenance]
 # periodically run these scripts are the same as running them from 'weed shell'
+# the leader master runs them while holding the admin lock, skipping the run if a 'weed shell' has run "lock"
+# the recent runs are shown on the master UI and at /maintenance/status
 scripts = """
   ec.encode -fullPercent=95 -quietFor=1h
   ec.rebuild -force
   ec.balance -force
+  volume.fix.replication
   volume.balance -force
 """
 sleep_minutes = 17          # sleep minutes between each script execution
+command_pause_seconds = 0   # pause between the commands of one run, to limit the load on the cluster
+dry_run = false             # only report the planned changes, skipping commands that can not run without changes
+
+[master.collection_disk]
+# the disk type for collections, used when the assign request does not choose one
+# hot_images = "ssd"
 
 `
 )
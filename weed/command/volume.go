@@ -16,6 +16,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/server"
 	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"google.golang.org/grpc/reflection"
 )
@@ -29,6 +30,7 @@ type VolumeServerOptions struct {
 	publicPort            *int
 	folders               []string
 	folderMaxLimits       []int
+	folderDiskTypes       []types.DiskType
 	ip                    *string
 	publicUrl             *string
 	bindIp                *string
@@ -44,6 +46,8 @@ type VolumeServerOptions struct {
 	cpuProfile            *string
 	memProfile            *string
 	compactionMBPerSecond *int
+	imageCacheDir         *string
+	imageCacheSizeMB      *int
 }
 
 func init() {
@@ -64,6 +68,8 @@ func init() {
 	v.cpuProfile = cmdVolume.Flag.String("cpuprofile", "", "cpu profile output file")
 	v.memProfile = cmdVolume.Flag.String("memprofile", "", "memory profile output file")
 	v.compactionMBPerSecond = cmdVolume.Flag.Int("compactionMBps", 0, "limit background compaction or copying speed in mega bytes per second")
+	v.imageCacheDir = cmdVolume.Flag.String("images.cache.dir", "", "directory to cache the cropped, rotated, resized or converted images, disabled if empty")
+	v.imageCacheSizeMB = cmdVolume.Flag.Int("images.cache.sizeMB", 1024, "limit of the image cache size in MB")
 }
 
 var cmdVolume = &Command{
@@ -77,6 +83,7 @@ var cmdVolume = &Command{
 var (
 	volumeFolders         = cmdVolume.Flag.String("dir", os.TempDir(), "directories to store data files. dir[,dir]...")
 	maxVolumeCounts       = cmdVolume.Flag.String("max", "7", "maximum numbers of volumes, count[,count]...")
+	volumeDiskTypes       = cmdVolume.Flag.String("disk", "", "[hdd|ssd] hard drive or solid state drive, one for all directories or type[,type]...")
 	volumeWhiteListOption = cmdVolume.Flag.String("whiteList", "", "comma separated Ip addresses having write permission. No limit if empty.")
 )
 
@@ -87,12 +94,12 @@ func runVolume(cmd *Command, args []string) bool {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 	util.SetupProfiling(*v.cpuProfile, *v.memProfile)
 
-	v.startVolumeServer(*volumeFolders, *maxVolumeCounts, *volumeWhiteListOption)
+	v.startVolumeServer(*volumeFolders, *maxVolumeCounts, *volumeDiskTypes, *volumeWhiteListOption)
 
 	return true
 }
 
-func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, volumeWhiteListOption string) {
+func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, volumeDiskTypes, volumeWhiteListOption string) {
 
 	//Set multiple folders and each folder's max volume count limit'
 	v.folders = strings.Split(volumeFolders, ",")
@@ -107,6 +114,21 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 	if len(v.folders) != len(v.folderMaxLimits) {
 		glog.Fatalf("%d directories by -dir, but only %d max is set by -max", len(v.folders), len(v.folderMaxLimits))
 	}
+	for _, diskTypeString := range strings.Split(volumeDiskTypes, ",") {
+		if diskType, e := types.ToDiskType(diskTypeString); e == nil {
+			v.folderDiskTypes = append(v.folderDiskTypes, diskType)
+		} else {
+			glog.Fatalf("The disk specified in -disk not valid: %v", e)
+		}
+	}
+	if len(v.folderDiskTypes) == 1 {
+		for len(v.folderDiskTypes) < len(v.folders) {
+			v.folderDiskTypes = append(v.folderDiskTypes, v.folderDiskTypes[0])
+		}
+	}
+	if len(v.folders) != len(v.folderDiskTypes) {
+		glog.Fatalf("%d directories by -dir, but %d disk types are set by -disk", len(v.folders), len(v.folderDiskTypes))
+	}
 	for _, folder := range v.folders {
 		if err := util.TestFolderWritable(folder); err != nil {
 			glog.Fatalf("Check Data Folder(-dir) Writable %s : %s", folder, err)
@@ -150,12 +172,13 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 
 	volumeServer := weed_server.NewVolumeServer(volumeMux, publicVolumeMux,
 		*v.ip, *v.port, *v.publicUrl,
-		v.folders, v.folderMaxLimits,
+		v.folders, v.folderMaxLimits, v.folderDiskTypes,
 		volumeNeedleMapKind,
 		strings.Split(masters, ","), *v.pulseSeconds, *v.dataCenter, *v.rack,
 		v.whiteList,
 		*v.fixJpgOrientation, *v.readRedirect,
 		*v.compactionMBPerSecond,
+		*v.imageCacheDir, *v.imageCacheSizeMB,
 	)
 
 	listeningAddress := *v.bindIp + ":" + strconv.Itoa(*v.port)
@@ -24,6 +24,7 @@ type WebDavOption struct {
 	collection     *string
 	tlsPrivateKey  *string
 	tlsCertificate *string
+	filerProxy     *bool
 }
 
 func init() {
@@ -33,6 +34,7 @@ func init() {
 	webDavStandaloneOptions.collection = cmdWebDav.Flag.String("collection", "", "collection to create the files")
 	webDavStandaloneOptions.tlsPrivateKey = cmdWebDav.Flag.String("key.file", "", "path to the TLS private key file")
 	webDavStandaloneOptions.tlsCertificate = cmdWebDav.Flag.String("cert.file", "", "path to the TLS certificate file")
+	webDavStandaloneOptions.filerProxy = cmdWebDav.Flag.Bool("filerProxy", false, "read and write the file content through the filer, if the volume servers are not reachable")
 }
 
 var cmdWebDav = &Command{
@@ -79,6 +81,7 @@ func (wo *WebDavOption) startWebDav() bool {
 		Collection:       *wo.collection,
 		Uid:              uid,
 		Gid:              gid,
+		FilerProxy:       *wo.filerProxy,
 	})
 	if webdavServer_err != nil {
 		glog.Fatalf("WebDav Server startup error: %v", webdavServer_err)
@@ -4,21 +4,25 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 )
 
 type AbstractSqlStore struct {
-	DB               *sql.DB
-	SqlInsert        string
-	SqlUpdate        string
-	SqlFind          string
-	SqlDelete        string
-	SqlListExclusive string
-	SqlListInclusive string
+	DB                      *sql.DB
+	SqlInsert               string
+	SqlUpdate               string
+	SqlFind                 string
+	SqlDelete               string
+	SqlDeleteFolderChildren string
+	SqlListExclusive        string
+	SqlListInclusive        string
 }
 
+const deleteFolderChildrenPageSize = 1024
+
 type TxOrDB interface {
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
@@ -132,16 +136,55 @@ func (store *AbstractSqlStore) DeleteEntry(ctx context.Context, fullpath filer2.
 	return nil
 }
 
-func (store *AbstractSqlStore) ListDirectoryEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool, limit int) (entries []*filer2.Entry, err error) {
+// DeleteFolderChildren walks the folders by their indexed directory hash, to report the deleted entries
+// and to find the sub folders, and deletes the children of each folder with one statement.
+func (store *AbstractSqlStore) DeleteFolderChildren(ctx context.Context, fullpath filer2.FullPath, eachDeletedFunc filer2.EachDeletedEntryFunc) error {
+
+	lastFileName := ""
+	for {
+		var entries []*filer2.Entry
+		err := store.ListDirectoryPrefixedEntries(ctx, fullpath, lastFileName, false, deleteFolderChildrenPageSize, "", func(entry *filer2.Entry) bool {
+			entries = append(entries, entry)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("delete folder children %s: %v", fullpath, err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDirectory() {
+				if err = store.DeleteFolderChildren(ctx, entry.FullPath, eachDeletedFunc); err != nil {
+					return err
+				}
+			}
+			if eachDeletedFunc != nil {
+				eachDeletedFunc(entry)
+			}
+			lastFileName = entry.Name()
+		}
+
+		if len(entries) < deleteFolderChildrenPageSize {
+			break
+		}
+	}
+
+	_, err := store.getTxOrDB(ctx).ExecContext(ctx, store.SqlDeleteFolderChildren, hashToLong(string(fullpath)), string(fullpath))
+	if err != nil {
+		return fmt.Errorf("delete folder children %s: %v", fullpath, err)
+	}
+	return nil
+}
+
+func (store *AbstractSqlStore) ListDirectoryPrefixedEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool, limit int, prefix string, eachEntryFunc filer2.ListEachEntryFunc) (err error) {
 
 	sqlText := store.SqlListExclusive
 	if inclusive {
 		sqlText = store.SqlListInclusive
 	}
 
-	rows, err := store.getTxOrDB(ctx).QueryContext(ctx, sqlText, hashToLong(string(fullpath)), startFileName, string(fullpath), limit)
+	rows, err := store.getTxOrDB(ctx).QueryContext(ctx, sqlText, hashToLong(string(fullpath)), startFileName, string(fullpath), escapeLikePattern(prefix)+"%", limit)
 	if err != nil {
-		return nil, fmt.Errorf("list %s : %v", fullpath, err)
+		return fmt.Errorf("list %s : %v", fullpath, err)
 	}
 	defer rows.Close()
 
@@ -150,7 +193,7 @@ func (store *AbstractSqlStore) ListDirectoryEntries(ctx context.Context, fullpat
 		var data []byte
 		if err = rows.Scan(&name, &data); err != nil {
 			glog.V(0).Infof("scan %s : %v", fullpath, err)
-			return nil, fmt.Errorf("scan %s: %v", fullpath, err)
+			return fmt.Errorf("scan %s: %v", fullpath, err)
 		}
 
 		entry := &filer2.Entry{
@@ -158,11 +201,18 @@ func (store *AbstractSqlStore) ListDirectoryEntries(ctx context.Context, fullpat
 		}
 		if err = entry.DecodeAttributesAndChunks(data); err != nil {
 			glog.V(0).Infof("scan decode %s : %v", entry.FullPath, err)
-			return nil, fmt.Errorf("scan decode %s : %v", entry.FullPath, err)
+			return fmt.Errorf("scan decode %s : %v", entry.FullPath, err)
 		}
 
-		entries = append(entries, entry)
+		if !eachEntryFunc(entry) {
+			break
+		}
 	}
 
-	return entries, nil
+	return rows.Err()
+}
+
+// escapeLikePattern escapes the LIKE wildcards, using the default escape character
+func escapeLikePattern(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(prefix)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/gocql/gocql"
+	"strings"
 )
 
 func init() {
@@ -58,9 +59,15 @@ func (store *CassandraStore) InsertEntry(ctx context.Context, entry *filer2.Entr
 		return fmt.Errorf("encode %s: %s", entry.FullPath, err)
 	}
 
+	// the ttl of a folder is only the default for its files
+	ttlSec := entry.TtlSec
+	if entry.IsDirectory() {
+		ttlSec = 0
+	}
+
 	if err := store.session.Query(
 		"INSERT INTO filemeta (directory,name,meta) VALUES(?,?,?) USING TTL ? ",
-		dir, name, meta, entry.TtlSec).Exec(); err != nil {
+		dir, name, meta, ttlSec).Exec(); err != nil {
 		return fmt.Errorf("insert %s: %s", entry.FullPath, err)
 	}
 
@@ -112,8 +119,13 @@ func (store *CassandraStore) DeleteEntry(ctx context.Context, fullpath filer2.Fu
 	return nil
 }
 
-func (store *CassandraStore) ListDirectoryEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
-	limit int) (entries []*filer2.Entry, err error) {
+func (store *CassandraStore) ListDirectoryPrefixedEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
+	limit int, prefix string, eachEntryFunc filer2.ListEachEntryFunc) (err error) {
+
+	// start the clustering range from the prefix, and stop at the first name without the prefix
+	if startFileName < prefix {
+		startFileName, inclusive = prefix, true
+	}
 
 	cqlStr := "SELECT NAME, meta FROM filemeta WHERE directory=? AND name>? ORDER BY NAME ASC LIMIT ?"
 	if inclusive {
@@ -124,6 +136,9 @@ func (store *CassandraStore) ListDirectoryEntries(ctx context.Context, fullpath
 	var name string
 	iter := store.session.Query(cqlStr, string(fullpath), startFileName, limit).Iter()
 	for iter.Scan(&name, &data) {
+		if !strings.HasPrefix(name, prefix) {
+			break
+		}
 		entry := &filer2.Entry{
 			FullPath: filer2.NewFullPath(string(fullpath), name),
 		}
@@ -132,11 +147,13 @@ func (store *CassandraStore) ListDirectoryEntries(ctx context.Context, fullpath
 			glog.V(0).Infof("list %s : %v", entry.FullPath, err)
 			break
 		}
-		entries = append(entries, entry)
+		if !eachEntryFunc(entry) {
+			break
+		}
 	}
 	if err := iter.Close(); err != nil {
 		glog.V(0).Infof("list iterator close: %v", err)
 	}
 
-	return entries, err
+	return err
 }
@@ -0,0 +1,202 @@
+package filer2
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+)
+
+const (
+	DefaultPrefetchCount = 4
+	chunkReadRetries     = 3
+)
+
+var chunkReadRetryInterval = time.Second
+
+// LookupFileIdFunctionType returns the urls of all replicas of the file id, in the order to try them
+type LookupFileIdFunctionType func(fileId string) (targetUrls []string, err error)
+
+// ChunkReader reads chunk views from volume servers.
+// Each chunk is tried on every replica, and the replicas are looked up again before each retry.
+// Up to prefetchCount chunks are read concurrently, which also bounds the memory used.
+type ChunkReader struct {
+	lookupFileIdFn LookupFileIdFunctionType
+	prefetchCount  int
+	useGrpc        bool
+	grpcDialOption grpc.DialOption
+}
+
+func NewChunkReader(lookupFileIdFn LookupFileIdFunctionType, prefetchCount int) *ChunkReader {
+	if prefetchCount <= 0 {
+		prefetchCount = 1
+	}
+	return &ChunkReader{
+		lookupFileIdFn: lookupFileIdFn,
+		prefetchCount:  prefetchCount,
+	}
+}
+
+// NewGrpcChunkReader reads the chunks with the ReadNeedle rpc of the volume servers,
+// and falls back to http for each replica the rpc fails on.
+func NewGrpcChunkReader(lookupFileIdFn LookupFileIdFunctionType, prefetchCount int, grpcDialOption grpc.DialOption) *ChunkReader {
+	r := NewChunkReader(lookupFileIdFn, prefetchCount)
+	r.useGrpc = true
+	r.grpcDialOption = grpcDialOption
+	return r
+}
+
+// ReadChunkView fills buf, which must be chunkView.Size long, with the chunk view content.
+// All replicas are tried in each round, and another round is only tried if all replicas failed with transient errors.
+func (r *ChunkReader) ReadChunkView(chunkView *ChunkView, buf []byte) (err error) {
+
+	for round := 0; round < chunkReadRetries; round++ {
+		if round > 0 {
+			time.Sleep(time.Duration(round) * chunkReadRetryInterval)
+		}
+
+		urls, lookupErr := r.lookupFileIdFn(chunkView.FileId)
+		if lookupErr != nil {
+			glog.V(1).Infof("lookup %s: %v", chunkView.FileId, lookupErr)
+			return fmt.Errorf("lookup %s: %v", chunkView.FileId, lookupErr)
+		}
+		if len(urls) == 0 {
+			return fmt.Errorf("failed to locate %s", chunkView.FileId)
+		}
+
+		isTransient := true
+		for _, fileUrl := range urls {
+			if r.useGrpc && r.readGrpc(fileUrl, chunkView, buf) == nil {
+				return nil
+			}
+			n, readErr := util.ReadUrl(fileUrl, chunkView.Offset, int(chunkView.Size), buf, !chunkView.IsFullChunk)
+			if readErr == nil && n != int64(chunkView.Size) {
+				readErr = fmt.Errorf("read %d bytes, expected %d", n, chunkView.Size)
+			}
+			if readErr == nil {
+				return nil
+			}
+			glog.V(0).Infof("read %s [%d,%d): %v", fileUrl, chunkView.Offset, chunkView.Offset+int64(chunkView.Size), readErr)
+			err = fmt.Errorf("read %s: %v", fileUrl, readErr)
+			isTransient = isTransient && isTransientReadError(readErr)
+		}
+		if !isTransient {
+			return err
+		}
+	}
+
+	return err
+}
+
+// readGrpc reads the chunk view from the volume server of the url with the ReadNeedle rpc
+func (r *ChunkReader) readGrpc(fileUrl string, chunkView *ChunkView, buf []byte) error {
+	u, err := url.Parse(fileUrl)
+	if err != nil {
+		return err
+	}
+	if err = operation.GrpcReadNeedleContent(u.Host, r.grpcDialOption, chunkView.FileId, chunkView.Offset, buf); err != nil {
+		glog.V(1).Infof("grpc read %s: %v, retry with http", fileUrl, err)
+	}
+	return err
+}
+
+// isTransientReadError is true for connection errors and server errors, which may be gone on a retry
+func isTransientReadError(err error) bool {
+	if statusErr, ok := err.(*util.HttpStatusError); ok {
+		return statusErr.StatusCode >= 500
+	}
+	if _, ok := err.(net.Error); ok {
+		return true
+	}
+	return err == io.ErrUnexpectedEOF
+}
+
+type chunkReadResult struct {
+	data []byte
+	err  error
+}
+
+// Stream writes the chunk views to w in order, while the following chunks are prefetched
+func (r *ChunkReader) Stream(w io.Writer, chunkViews []*ChunkView) error {
+
+	done := make(chan struct{})
+	defer close(done)
+
+	slots := make(chan struct{}, r.prefetchCount)
+	results := make(chan chan chunkReadResult, r.prefetchCount)
+
+	go func() {
+		defer close(results)
+		for _, chunkView := range chunkViews {
+			select {
+			case slots <- struct{}{}:
+			case <-done:
+				return
+			}
+			result := make(chan chunkReadResult, 1)
+			go func(chunkView *ChunkView) {
+				buf := make([]byte, chunkView.Size)
+				err := r.ReadChunkView(chunkView, buf)
+				result <- chunkReadResult{data: buf, err: err}
+			}(chunkView)
+			select {
+			case results <- result:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for result := range results {
+		res := <-result
+		if res.err != nil {
+			return res.err
+		}
+		if _, err := w.Write(res.data); err != nil {
+			return err
+		}
+		<-slots
+	}
+
+	return nil
+}
+
+// ReadChunkViews reads the chunk views into buff concurrently. buff starts at the logic offset baseOffset.
+func (r *ChunkReader) ReadChunkViews(buff []byte, chunkViews []*ChunkView, baseOffset int64) (totalRead int64, err error) {
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	slots := make(chan struct{}, r.prefetchCount)
+
+	for _, chunkView := range chunkViews {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(chunkView *ChunkView) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			start := chunkView.LogicOffset - baseOffset
+			readErr := r.ReadChunkView(chunkView, buff[start:start+int64(chunkView.Size)])
+
+			lock.Lock()
+			defer lock.Unlock()
+			if readErr != nil {
+				err = readErr
+				return
+			}
+			totalRead += int64(chunkView.Size)
+		}(chunkView)
+	}
+	wg.Wait()
+
+	return
+}
@@ -0,0 +1,155 @@
+package filer2
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func newChunkServer(t *testing.T, chunks map[string][]byte, failures *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures != nil && atomic.AddInt32(failures, -1) >= 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data, found := chunks[r.URL.Path[1:]]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if rangeReq := r.Header.Get("Range"); rangeReq != "" {
+			var start, end int
+			if _, err := fmt.Sscanf(rangeReq, "bytes=%d-%d", &start, &end); err != nil {
+				t.Errorf("range %s: %v", rangeReq, err)
+			}
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(data[start : end+1])
+			return
+		}
+		w.Write(data)
+	}))
+}
+
+func TestChunkReaderStream(t *testing.T) {
+
+	chunkReadRetryInterval = time.Millisecond
+
+	chunks := map[string][]byte{
+		"1,01": []byte("hello "),
+		"2,02": []byte("seaweed "),
+		"3,03": []byte("world"),
+	}
+	failures := int32(1 << 20)
+	broken := newChunkServer(t, chunks, &failures)
+	defer broken.Close()
+	healthy := newChunkServer(t, chunks, nil)
+	defer healthy.Close()
+
+	reader := NewChunkReader(func(fileId string) ([]string, error) {
+		return []string{broken.URL + "/" + fileId, healthy.URL + "/" + fileId}, nil
+	}, 2)
+
+	views := []*ChunkView{
+		{FileId: "1,01", Offset: 0, Size: 6, LogicOffset: 0, IsFullChunk: true},
+		{FileId: "2,02", Offset: 0, Size: 8, LogicOffset: 6, IsFullChunk: true},
+		{FileId: "3,03", Offset: 1, Size: 3, LogicOffset: 14},
+	}
+
+	var buf bytes.Buffer
+	if err := reader.Stream(&buf, views); err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	if buf.String() != "hello seaweed orl" {
+		t.Errorf("streamed %q", buf.String())
+	}
+
+	buff := make([]byte, 17)
+	totalRead, err := reader.ReadChunkViews(buff, views, 0)
+	if err != nil || totalRead != 17 || string(buff) != "hello seaweed orl" {
+		t.Errorf("read %d %q: %v", totalRead, buff, err)
+	}
+}
+
+func TestGrpcChunkReaderFallsBackToHttp(t *testing.T) {
+
+	chunks := map[string][]byte{
+		"1,01": []byte("hello "),
+	}
+	healthy := newChunkServer(t, chunks, nil)
+	defer healthy.Close()
+
+	// nothing listens on the grpc port of the test server
+	reader := NewGrpcChunkReader(func(fileId string) ([]string, error) {
+		return []string{healthy.URL + "/" + fileId}, nil
+	}, 1, grpc.WithInsecure())
+
+	buf := make([]byte, 4)
+	if err := reader.ReadChunkView(&ChunkView{FileId: "1,01", Offset: 1, Size: 4}, buf); err != nil || string(buf) != "ello" {
+		t.Errorf("read %q: %v", buf, err)
+	}
+}
+
+func TestChunkReaderRetry(t *testing.T) {
+
+	chunkReadRetryInterval = time.Millisecond
+
+	chunks := map[string][]byte{"1,01": []byte("hello")}
+	failures := int32(1)
+	flaky := newChunkServer(t, chunks, &failures)
+	defer flaky.Close()
+
+	var lookups int32
+	reader := NewChunkReader(func(fileId string) ([]string, error) {
+		atomic.AddInt32(&lookups, 1)
+		return []string{flaky.URL + "/" + fileId}, nil
+	}, 1)
+
+	buf := make([]byte, 5)
+	if err := reader.ReadChunkView(&ChunkView{FileId: "1,01", Size: 5, IsFullChunk: true}, buf); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(buf) != "hello" || lookups != 2 {
+		t.Errorf("read %q after %d lookups", buf, lookups)
+	}
+
+	var out bytes.Buffer
+	err := reader.Stream(&out, []*ChunkView{
+		{FileId: "1,01", Size: 5, IsFullChunk: true},
+		{FileId: "9,09", Size: 5, IsFullChunk: true},
+		{FileId: "1,01", Size: 5, IsFullChunk: true},
+	})
+	if err == nil {
+		t.Errorf("streamed a missing chunk")
+	}
+	if out.String() != "hello" {
+		t.Errorf("streamed %q before the missing chunk", out.String())
+	}
+}
+
+func TestChunkReaderNoRetryOnMissingChunk(t *testing.T) {
+
+	chunkReadRetryInterval = time.Millisecond
+
+	server := newChunkServer(t, map[string][]byte{}, nil)
+	defer server.Close()
+
+	var lookups int32
+	reader := NewChunkReader(func(fileId string) ([]string, error) {
+		atomic.AddInt32(&lookups, 1)
+		return []string{server.URL + "/" + fileId}, nil
+	}, 1)
+
+	buf := make([]byte, 5)
+	if err := reader.ReadChunkView(&ChunkView{FileId: "9,09", Size: 5, IsFullChunk: true}, buf); err == nil {
+		t.Fatalf("read a missing chunk")
+	}
+	if lookups != 1 {
+		t.Errorf("retried a missing chunk with %d lookups", lookups)
+	}
+}
@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -49,3 +50,28 @@ func validateOneEnabledStore(config *viper.Viper) {
 		}
 	}
 }
+
+// LoadStore initializes the store configured in the named section, whether it is enabled or not,
+// to be the target of a store migration
+func (f *Filer) LoadStore(config *viper.Viper, storeName string) (FilerStore, error) {
+
+	if f.store != nil && f.store.GetName() == storeName {
+		return nil, fmt.Errorf("filer store %s is already in use", storeName)
+	}
+
+	for _, store := range Stores {
+		if store.GetName() != storeName {
+			continue
+		}
+		viperSub := config.Sub(storeName)
+		if viperSub == nil {
+			return nil, fmt.Errorf("filer store %s is not configured", storeName)
+		}
+		if err := store.Initialize(viperSub); err != nil {
+			return nil, fmt.Errorf("initialize filer store %s: %v", storeName, err)
+		}
+		return store, nil
+	}
+
+	return nil, fmt.Errorf("filer store %s is not supported", storeName)
+}
@@ -33,6 +33,9 @@ type Entry struct {
 
 	// the following is for files
 	Chunks []*filer_pb.FileChunk `json:"chunks,omitempty"`
+
+	// extended attributes, e.g., user tags and dead properties
+	Extended map[string][]byte `json:"extended,omitempty"`
 }
 
 func (entry *Entry) Size() uint64 {
@@ -56,6 +59,7 @@ func (entry *Entry) ToProtoEntry() *filer_pb.Entry {
 		IsDirectory: entry.IsDirectory(),
 		Attributes:  EntryAttributeToPb(entry),
 		Chunks:      entry.Chunks,
+		Extended:    entry.Extended,
 	}
 }
 
@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"bytes"
 	"os"
 	"time"
 
@@ -13,6 +14,7 @@ func (entry *Entry) EncodeAttributesAndChunks() ([]byte, error) {
 	message := &filer_pb.Entry{
 		Attributes: EntryAttributeToPb(entry),
 		Chunks:     entry.Chunks,
+		Extended:   entry.Extended,
 	}
 	return proto.Marshal(message)
 }
@@ -29,6 +31,8 @@ func (entry *Entry) DecodeAttributesAndChunks(blob []byte) error {
 
 	entry.Chunks = message.Chunks
 
+	entry.Extended = message.Extended
+
 	return nil
 }
 
@@ -89,5 +93,13 @@ func EqualEntry(a, b *Entry) bool {
 			return false
 		}
 	}
+	if len(a.Extended) != len(b.Extended) {
+		return false
+	}
+	for k, v := range a.Extended {
+		if !bytes.Equal(v, b.Extended[k]) {
+			return false
+		}
+	}
 	return true
 }
@@ -1,6 +1,7 @@
 package etcd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -123,15 +124,56 @@ func (store *EtcdStore) DeleteEntry(ctx context.Context, fullpath filer2.FullPat
 	return nil
 }
 
-func (store *EtcdStore) ListDirectoryEntries(
-	ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool, limit int,
-) (entries []*filer2.Entry, err error) {
-	directoryPrefix := genDirectoryKeyPrefix(fullpath, "")
+func (store *EtcdStore) DeleteFolderChildren(ctx context.Context, fullpath filer2.FullPath, eachDeletedFunc filer2.EachDeletedEntryFunc) (err error) {
 
-	resp, err := store.client.Get(ctx, string(directoryPrefix),
-		clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend))
+	// the keys of the children start with "<dir>\x00", and the deeper ones with "<dir>/"
+	keyPrefixes := []string{string(genDirectoryKeyPrefix(fullpath, "")), strings.TrimSuffix(string(fullpath), "/") + "/"}
+	if fullpath == "/" {
+		keyPrefixes = keyPrefixes[1:]
+	}
+
+	for _, keyPrefix := range keyPrefixes {
+		resp, err := store.client.Delete(ctx, keyPrefix, clientv3.WithPrefix(), clientv3.WithPrevKV())
+		if err != nil {
+			return fmt.Errorf("delete folder children %s : %v", fullpath, err)
+		}
+		if eachDeletedFunc == nil {
+			continue
+		}
+		for _, kv := range resp.PrevKvs {
+			sepIndex := bytes.LastIndexByte(kv.Key, DIR_FILE_SEPARATOR)
+			if sepIndex < 0 {
+				continue
+			}
+			entry := &filer2.Entry{
+				FullPath: filer2.NewFullPath(string(kv.Key[:sepIndex]), string(kv.Key[sepIndex+1:])),
+			}
+			if decodeErr := entry.DecodeAttributesAndChunks(kv.Value); decodeErr == nil {
+				eachDeletedFunc(entry)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (store *EtcdStore) ListDirectoryPrefixedEntries(
+	ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool, limit int, prefix string, eachEntryFunc filer2.ListEachEntryFunc,
+) (err error) {
+	directoryPrefix := genDirectoryKeyPrefix(fullpath, prefix)
+
+	lastFileStart := genDirectoryKeyPrefix(fullpath, startFileName)
+	if startFileName < prefix {
+		lastFileStart = directoryPrefix
+	}
+
+	// one more for the excluded start file
+	resp, err := store.client.Get(ctx, string(lastFileStart),
+		clientv3.WithRange(clientv3.GetPrefixRangeEnd(string(directoryPrefix))),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+		clientv3.WithLimit(int64(limit+1)))
 	if err != nil {
-		return nil, fmt.Errorf("list %s : %v", fullpath, err)
+		return fmt.Errorf("list %s : %v", fullpath, err)
 	}
 
 	for _, kv := range resp.Kvs {
@@ -154,10 +196,12 @@ func (store *EtcdStore) ListDirectoryEntries(
 			glog.V(0).Infof("list %s : %v", entry.FullPath, err)
 			break
 		}
-		entries = append(entries, entry)
+		if !eachEntryFunc(entry) {
+			break
+		}
 	}
 
-	return entries, err
+	return err
 }
 
 func genKey(dirPath, fileName string) (key []byte) {
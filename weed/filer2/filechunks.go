@@ -65,6 +65,19 @@ func MinusChunks(as, bs []*filer_pb.FileChunk) (delta []*filer_pb.FileChunk) {
 	return
 }
 
+// SameChunks tells whether both lists have the same chunks in the same order
+func SameChunks(as, bs []*filer_pb.FileChunk) bool {
+	if len(as) != len(bs) {
+		return false
+	}
+	for i := range as {
+		if as[i].GetFileIdString() != bs[i].GetFileIdString() || as[i].Offset != bs[i].Offset || as[i].Size != bs[i].Size {
+			return false
+		}
+	}
+	return true
+}
+
 type ChunkView struct {
 	FileId      string
 	Offset      int64
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"google.golang.org/grpc"
+	"hash/fnv"
 	"math"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -25,7 +27,11 @@ type Filer struct {
 	directoryCache     *ccache.Cache
 	MasterClient       *wdclient.MasterClient
 	fileIdDeletionChan chan string
+	pendingFileIds     []string
+	pendingFileIdsLock sync.Mutex
 	GrpcDialOption     grpc.DialOption
+	LockManager        *LockManager
+	entryLocks         [64]sync.Mutex
 }
 
 func NewFiler(masters []string, grpcDialOption grpc.DialOption) *Filer {
@@ -35,8 +41,10 @@ func NewFiler(masters []string, grpcDialOption grpc.DialOption) *Filer {
 		fileIdDeletionChan: make(chan string, 4096),
 		GrpcDialOption:     grpcDialOption,
 	}
+	f.LockManager = NewLockManager(f, len(masters) > 0)
 
 	go f.loopProcessingDeletion()
+	go f.LockManager.loopExpiringLeases()
 
 	return f
 }
@@ -45,6 +53,10 @@ func (f *Filer) SetStore(store FilerStore) {
 	f.store = NewFilerStoreWrapper(store)
 }
 
+func (f *Filer) StoreName() string {
+	return f.store.GetName()
+}
+
 func (f *Filer) DisableDirectoryCache() {
 	f.directoryCache = nil
 }
@@ -79,7 +91,7 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 
 	// fmt.Printf("directory parts: %+v\n", dirParts)
 
-	var lastDirectoryEntry *Entry
+	var lastDirectoryEntry, parentDirectoryEntry *Entry
 
 	for i := 1; i < len(dirParts); i++ {
 		dirPath := "/" + filepath.ToSlash(filepath.Join(dirParts[:i]...))
@@ -112,6 +124,10 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 					Gid:    entry.Gid,
 				},
 			}
+			// new sub folders keep the default ttl of the folder
+			if parentDirectoryEntry != nil {
+				dirEntry.TtlSec = parentDirectoryEntry.TtlSec
+			}
 
 			glog.V(2).Infof("create directory: %s %v", dirPath, dirEntry.Mode)
 			mkdirErr := f.store.InsertEntry(ctx, dirEntry)
@@ -129,6 +145,7 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 
 		// cache the directory entry
 		f.cacheSetDirectory(dirPath, dirEntry, i)
+		parentDirectoryEntry = dirEntry
 
 		// remember the direct parent directory entry
 		if i == len(dirParts)-1 {
@@ -149,13 +166,23 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 		}
 	*/
 
-	oldEntry, _ := f.FindEntry(ctx, entry.FullPath)
+	unlock := f.LockEntry(entry.FullPath)
+	defer unlock()
+
+	// an expired entry is still in the store, and is replaced
+	oldEntry, _ := f.findEntry(ctx, entry.FullPath)
+
+	// new files without a ttl inherit the default ttl of the folder
+	if (oldEntry == nil || oldEntry.IsExpired(time.Now())) && !entry.IsDirectory() && entry.TtlSec == 0 {
+		entry.TtlSec = lastDirectoryEntry.TtlSec
+	}
 
 	if oldEntry == nil {
 		if err := f.store.InsertEntry(ctx, entry); err != nil {
 			glog.Errorf("insert entry %s: %v", entry.FullPath, err)
 			return fmt.Errorf("insert entry %s: %v", entry.FullPath, err)
 		}
+		f.indexTtl(ctx, entry)
 	} else {
 		if err := f.UpdateEntry(ctx, oldEntry, entry); err != nil {
 			glog.Errorf("update entry %s: %v", entry.FullPath, err)
@@ -170,6 +197,17 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 	return nil
 }
 
+// LockEntry serializes the changes of the same entry through this filer, so an entry can be read,
+// checked and written back without losing other writes. It returns the unlock function.
+func (f *Filer) LockEntry(p FullPath) (unlock func()) {
+	h := fnv.New32a()
+	h.Write([]byte(p))
+	lock := &f.entryLocks[h.Sum32()%uint32(len(f.entryLocks))]
+	lock.Lock()
+	return lock.Unlock
+}
+
+// UpdateEntry writes the entry, and should be called while holding the lock of the entry
 func (f *Filer) UpdateEntry(ctx context.Context, oldEntry, entry *Entry) (err error) {
 	if oldEntry != nil {
 		if oldEntry.IsDirectory() && !entry.IsDirectory() {
@@ -181,10 +219,26 @@ func (f *Filer) UpdateEntry(ctx context.Context, oldEntry, entry *Entry) (err er
 			return fmt.Errorf("existing %s is a file", entry.FullPath)
 		}
 	}
-	return f.store.UpdateEntry(ctx, entry)
+	if entry.IsDirectory() {
+		f.cacheDelDirectory(string(entry.FullPath))
+	}
+	if err = f.store.UpdateEntry(ctx, entry); err != nil {
+		return err
+	}
+	f.indexTtl(ctx, entry)
+	return nil
 }
 
+// FindEntry returns ErrNotFound for the entries past their ttl
 func (f *Filer) FindEntry(ctx context.Context, p FullPath) (entry *Entry, err error) {
+	entry, err = f.findEntry(ctx, p)
+	if err == nil && entry.IsExpired(time.Now()) {
+		return nil, ErrNotFound
+	}
+	return entry, err
+}
+
+func (f *Filer) findEntry(ctx context.Context, p FullPath) (entry *Entry, err error) {
 
 	now := time.Now()
 
@@ -204,46 +258,18 @@ func (f *Filer) FindEntry(ctx context.Context, p FullPath) (entry *Entry, err er
 }
 
 func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p FullPath, isRecursive bool, ignoreRecursiveError, shouldDeleteChunks bool) (err error) {
-	entry, err := f.FindEntry(ctx, p)
+	entry, err := f.findEntry(ctx, p)
 	if err != nil {
 		return err
 	}
 
 	if entry.IsDirectory() {
-		limit := int(1)
-		if isRecursive {
-			limit = math.MaxInt32
-		}
-		lastFileName := ""
-		includeLastFile := false
-		for limit > 0 {
-			entries, err := f.ListDirectoryEntries(ctx, p, lastFileName, includeLastFile, 1024)
-			if err != nil {
-				glog.Errorf("list folder %s: %v", p, err)
-				return fmt.Errorf("list folder %s: %v", p, err)
-			}
-
-			if len(entries) == 0 {
-				break
-			}
-
-			if isRecursive {
-				for _, sub := range entries {
-					lastFileName = sub.Name()
-					err = f.DeleteEntryMetaAndData(ctx, sub.FullPath, isRecursive, ignoreRecursiveError, shouldDeleteChunks)
-					if err != nil && !ignoreRecursiveError {
-						return err
-					}
-					limit--
-					if limit <= 0 {
-						break
-					}
-				}
-			}
-
-			if len(entries) < 1024 {
-				break
+		if isRecursive && f.store.CanDeleteFolderChildren() {
+			if err = f.bulkDeleteFolderChildren(ctx, p, ignoreRecursiveError, shouldDeleteChunks); err != nil {
+				return err
 			}
+		} else if err = f.doDeleteFolderChildren(ctx, p, isRecursive, ignoreRecursiveError, shouldDeleteChunks); err != nil {
+			return err
 		}
 
 		f.cacheDelDirectory(string(p))
@@ -264,13 +290,135 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p FullPath, isRecurs
 	return f.store.DeleteEntry(ctx, p)
 }
 
-func (f *Filer) ListDirectoryEntries(ctx context.Context, p FullPath, startFileName string, inclusive bool, limit int) ([]*Entry, error) {
+// doDeleteFolderChildren deletes the entries one by one, each with its own notification
+func (f *Filer) doDeleteFolderChildren(ctx context.Context, p FullPath, isRecursive bool, ignoreRecursiveError, shouldDeleteChunks bool) (err error) {
+	limit := int(1)
+	if isRecursive {
+		limit = math.MaxInt32
+	}
+	lastFileName := ""
+	includeLastFile := false
+	for limit > 0 {
+		entries, err := f.ListDirectoryRawEntries(ctx, p, lastFileName, includeLastFile, 1024)
+		if err != nil {
+			glog.Errorf("list folder %s: %v", p, err)
+			return fmt.Errorf("list folder %s: %v", p, err)
+		}
+
+		if len(entries) == 0 {
+			break
+		}
+
+		if isRecursive {
+			for _, sub := range entries {
+				lastFileName = sub.Name()
+				err = f.DeleteEntryMetaAndData(ctx, sub.FullPath, isRecursive, ignoreRecursiveError, shouldDeleteChunks)
+				if err != nil && !ignoreRecursiveError {
+					return err
+				}
+				limit--
+				if limit <= 0 {
+					break
+				}
+			}
+		}
+
+		if len(entries) < 1024 {
+			break
+		}
+	}
+
+	return nil
+}
+
+// bulkDeleteFolderChildren removes the folder tree from the store at once, leaving only the folder level notification.
+// The chunks of exactly the deleted files are queued for deletion afterwards, without blocking on the deletion queue.
+func (f *Filer) bulkDeleteFolderChildren(ctx context.Context, p FullPath, ignoreRecursiveError, shouldDeleteChunks bool) (err error) {
+	var fileIds []string
+	var eachDeletedFunc EachDeletedEntryFunc
+	if shouldDeleteChunks {
+		eachDeletedFunc = func(entry *Entry) {
+			for _, chunk := range entry.Chunks {
+				fileIds = append(fileIds, chunk.GetFileIdString())
+			}
+		}
+	}
+
+	glog.V(3).Infof("deleting folder children %v", p)
+	err = f.store.DeleteFolderChildren(ctx, p, eachDeletedFunc)
+
+	// the entries deleted before a failure are gone, so their chunks are deleted anyway
+	f.queueFileIdsForDeletion(fileIds)
+
+	// the sub folders are not tracked individually in the directory cache
+	if f.directoryCache != nil {
+		f.directoryCache.Clear()
+	}
+
+	if err != nil {
+		glog.Errorf("delete folder children %s: %v", p, err)
+		if !ignoreRecursiveError {
+			return fmt.Errorf("delete folder children %s: %v", p, err)
+		}
+	}
+
+	return nil
+}
+
+// ListDirectoryEntries skips the entries past their ttl
+func (f *Filer) ListDirectoryEntries(ctx context.Context, p FullPath, startFileName string, inclusive bool, limit int) (entries []*Entry, err error) {
+	err = f.ListDirectoryPrefixedEntries(ctx, p, startFileName, inclusive, limit, "", func(entry *Entry) bool {
+		entries = append(entries, entry)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ListDirectoryRawEntries also returns the expired entries, for moving or deleting the whole folder
+func (f *Filer) ListDirectoryRawEntries(ctx context.Context, p FullPath, startFileName string, inclusive bool, limit int) ([]*Entry, error) {
 	if strings.HasSuffix(string(p), "/") && len(p) > 1 {
 		p = p[0 : len(p)-1]
 	}
 	return f.store.ListDirectoryEntries(ctx, p, startFileName, inclusive, limit)
 }
 
+// ListDirectoryPrefixedEntries streams the entries with names starting with the prefix, without buffering them.
+// The entries past their ttl are skipped, and more entries are listed in place of them.
+func (f *Filer) ListDirectoryPrefixedEntries(ctx context.Context, p FullPath, startFileName string, inclusive bool, limit int, prefix string, eachEntryFunc ListEachEntryFunc) error {
+	if strings.HasSuffix(string(p), "/") && len(p) > 1 {
+		p = p[0 : len(p)-1]
+	}
+	now := time.Now()
+	for limit > 0 {
+		listed, visited, isStopped := 0, 0, false
+		err := f.store.ListDirectoryPrefixedEntries(ctx, p, startFileName, inclusive, limit, prefix, func(entry *Entry) bool {
+			listed++
+			startFileName = entry.Name()
+			if entry.IsExpired(now) {
+				return true
+			}
+			visited++
+			if !eachEntryFunc(entry) {
+				isStopped = true
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if isStopped || listed < limit {
+			return nil
+		}
+		limit -= visited
+		inclusive = false
+	}
+	return nil
+}
+
 func (f *Filer) cacheDelDirectory(dirpath string) {
 
 	if dirpath == "/" {
@@ -309,3 +457,34 @@ func (f *Filer) cacheSetDirectory(dirpath string, dirEntry *Entry, level int) {
 
 	f.directoryCache.Set(dirpath, dirEntry, time.Duration(minutes)*time.Minute)
 }
+
+// saveSystemEntry writes the entry to the store directly, without creating the parent folders,
+// so it is not visible when listing the folders
+func (f *Filer) saveSystemEntry(ctx context.Context, p FullPath, extended map[string][]byte) error {
+	now := time.Now()
+	entry := &Entry{
+		FullPath: p,
+		Attr: Attr{
+			Mtime:  now,
+			Crtime: now,
+			Mode:   os.FileMode(0600),
+			Uid:    OS_UID,
+			Gid:    OS_GID,
+		},
+		Extended: extended,
+	}
+	// some stores fail to insert an existing entry, and some do not fail to update a missing one
+	if err := f.store.InsertEntry(ctx, entry); err != nil {
+		if err = f.store.UpdateEntry(ctx, entry); err != nil {
+			return fmt.Errorf("save %s: %v", p, err)
+		}
+	}
+	return nil
+}
+
+func (f *Filer) deleteSystemEntry(ctx context.Context, p FullPath) error {
+	if err := f.store.DeleteEntry(ctx, p); err != nil && err != ErrNotFound {
+		return fmt.Errorf("delete %s: %v", p, err)
+	}
+	return nil
+}
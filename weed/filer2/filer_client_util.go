@@ -3,14 +3,16 @@ package filer2
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
-	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
+const writeThroughFilerBufferSize = 1024 * 1024
+
 func VolumeId(fileId string) string {
 	lastCommaIndex := strings.LastIndex(fileId, ",")
 	if lastCommaIndex > 0 {
@@ -23,13 +25,114 @@ type FilerClient interface {
 	WithFilerClient(ctx context.Context, fn func(filer_pb.SeaweedFilerClient) error) error
 }
 
-func ReadIntoBuffer(ctx context.Context, filerClient FilerClient, fullFilePath string, buff []byte, chunkViews []*ChunkView, baseOffset int64) (totalRead int64, err error) {
+// ReadIntoBuffer reads the chunk views into buff, trying the replicas in dataCenter first
+func ReadIntoBuffer(ctx context.Context, filerClient FilerClient, fullFilePath string, buff []byte, chunkViews []*ChunkView, baseOffset int64, dataCenter string) (totalRead int64, err error) {
 	var vids []string
 	for _, chunkView := range chunkViews {
 		vids = append(vids, VolumeId(chunkView.FileId))
 	}
 
-	vid2Locations := make(map[string]*filer_pb.Locations)
+	vid2Locations, err := lookupVolumes(ctx, filerClient, vids)
+	if err != nil {
+		return 0, err
+	}
+
+	reader := NewChunkReader(filerLookupFn(ctx, filerClient, vid2Locations, dataCenter), DefaultPrefetchCount)
+
+	totalRead, err = reader.ReadChunkViews(buff, chunkViews, baseOffset)
+	if err != nil {
+		glog.V(0).Infof("%v read %d bytes: %v", fullFilePath, totalRead, err)
+	}
+	return
+}
+
+// ReadThroughFiler reads the chunks from offset into buff with the filer ReadFile rpc,
+// for the clients that can not reach the volume servers. The holes in the file are read as zeros.
+func ReadThroughFiler(ctx context.Context, filerClient FilerClient, fullFilePath string, buff []byte, chunks []*filer_pb.FileChunk, offset int64) (totalRead int64, err error) {
+
+	if len(buff) == 0 {
+		return 0, nil
+	}
+
+	dir, name := FullPath(fullFilePath).DirAndName()
+
+	err = filerClient.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+		stream, err := client.ReadFile(ctx, &filer_pb.ReadFileRequest{
+			Directory: dir,
+			Name:      name,
+			Offset:    offset,
+			Size:      int64(len(buff)),
+			Chunks:    chunks,
+		})
+		if err != nil {
+			return err
+		}
+
+		for {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				return nil
+			}
+			if recvErr != nil {
+				return recvErr
+			}
+			totalRead += int64(copy(buff[totalRead:], resp.Data))
+		}
+	})
+
+	if err != nil {
+		glog.V(0).Infof("%v read %d bytes through filer: %v", fullFilePath, totalRead, err)
+	}
+	return
+}
+
+// WriteThroughFiler saves the data as new chunks with the filer WriteFile rpc,
+// for the clients that can not reach the volume servers. The request carries the metadata without the data.
+func WriteThroughFiler(ctx context.Context, filerClient FilerClient, request *filer_pb.WriteFileRequest, data []byte) (chunks []*filer_pb.FileChunk, err error) {
+
+	err = filerClient.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+		stream, err := client.WriteFile(ctx)
+		if err != nil {
+			return err
+		}
+
+		req := request
+		for start := 0; start == 0 || start < len(data); start += writeThroughFilerBufferSize {
+			stop := start + writeThroughFilerBufferSize
+			if stop > len(data) {
+				stop = len(data)
+			}
+			if start > 0 {
+				req = &filer_pb.WriteFileRequest{}
+			}
+			req.Data = data[start:stop]
+			if sendErr := stream.Send(req); sendErr != nil {
+				if sendErr == io.EOF {
+					// the filer has closed the stream, and the reason comes with CloseAndRecv
+					break
+				}
+				return sendErr
+			}
+		}
+		request.Data = nil
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+		chunks = resp.Chunks
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("write %s/%s through filer: %v", request.Directory, request.Name, err)
+	}
+	return
+}
+
+func lookupVolumes(ctx context.Context, filerClient FilerClient, vids []string) (vid2Locations map[string]*filer_pb.Locations, err error) {
 
 	err = filerClient.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
@@ -47,48 +150,56 @@ func ReadIntoBuffer(ctx context.Context, filerClient FilerClient, fullFilePath s
 	})
 
 	if err != nil {
-		return 0, fmt.Errorf("failed to lookup volume ids %v: %v", vids, err)
+		return nil, fmt.Errorf("failed to lookup volume ids %v: %v", vids, err)
 	}
+	if vid2Locations == nil {
+		vid2Locations = make(map[string]*filer_pb.Locations)
+	}
+	return
+}
 
-	var wg sync.WaitGroup
-	for _, chunkView := range chunkViews {
-		wg.Add(1)
-		go func(chunkView *ChunkView) {
-			defer wg.Done()
-
-			glog.V(4).Infof("read fh reading chunk: %+v", chunkView)
-
-			locations := vid2Locations[VolumeId(chunkView.FileId)]
-			if locations == nil || len(locations.Locations) == 0 {
-				glog.V(0).Infof("failed to locate %s", chunkView.FileId)
-				err = fmt.Errorf("failed to locate %s", chunkView.FileId)
-				return
-			}
+// filerLookupFn uses the already looked up locations first,
+// and asks the filer again when a file id is retried, in case the volume has moved.
+func filerLookupFn(ctx context.Context, filerClient FilerClient, vid2Locations map[string]*filer_pb.Locations, dataCenter string) LookupFileIdFunctionType {
 
-			var n int64
-			n, err = util.ReadUrl(
-				fmt.Sprintf("http://%s/%s", locations.Locations[0].Url, chunkView.FileId),
-				chunkView.Offset,
-				int(chunkView.Size),
-				buff[chunkView.LogicOffset-baseOffset:chunkView.LogicOffset-baseOffset+int64(chunkView.Size)],
-				!chunkView.IsFullChunk)
+	var lock sync.Mutex
+	tried := make(map[string]bool)
 
-			if err != nil {
+	return func(fileId string) (targetUrls []string, err error) {
+		vid := VolumeId(fileId)
 
-				glog.V(0).Infof("%v read http://%s/%v %v bytes: %v", fullFilePath, locations.Locations[0].Url, chunkView.FileId, n, err)
+		lock.Lock()
+		locations, found := vid2Locations[vid]
+		refresh := !found || tried[fileId]
+		tried[fileId] = true
+		lock.Unlock()
 
-				err = fmt.Errorf("failed to read http://%s/%s: %v",
-					locations.Locations[0].Url, chunkView.FileId, err)
-				return
+		if refresh {
+			refreshed, lookupErr := lookupVolumes(ctx, filerClient, []string{vid})
+			if lookupErr != nil {
+				return nil, lookupErr
 			}
+			locations = refreshed[vid]
+			lock.Lock()
+			vid2Locations[vid] = locations
+			lock.Unlock()
+		}
 
-			glog.V(4).Infof("read fh read %d bytes: %+v", n, chunkView)
-			totalRead += n
+		if locations == nil || len(locations.Locations) == 0 {
+			return nil, fmt.Errorf("failed to locate %s", fileId)
+		}
 
-		}(chunkView)
+		var otherDcUrls []string
+		for _, loc := range locations.Locations {
+			url := fmt.Sprintf("http://%s/%s", loc.Url, fileId)
+			if dataCenter != "" && loc.DataCenter == dataCenter {
+				targetUrls = append(targetUrls, url)
+			} else {
+				otherDcUrls = append(otherDcUrls, url)
+			}
+		}
+		return append(targetUrls, otherDcUrls...), nil
 	}
-	wg.Wait()
-	return
 }
 
 func GetEntry(ctx context.Context, filerClient FilerClient, fullFilePath string) (entry *filer_pb.Entry, err error) {
@@ -139,17 +250,18 @@ func ReadDirAllEntries(ctx context.Context, filerClient FilerClient, fullDirPath
 			}
 
 			glog.V(3).Infof("read directory: %v", request)
-			resp, err := client.ListEntries(ctx, request)
-			if err != nil {
-				return fmt.Errorf("list %s: %v", fullDirPath, err)
-			}
-
-			for _, entry := range resp.Entries {
+			count := 0
+			err := filer_pb.ListEntries(ctx, client, request, func(entry *filer_pb.Entry) error {
 				fn(entry)
 				lastEntryName = entry.Name
+				count++
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("list %s: %v", fullDirPath, err)
 			}
 
-			if len(resp.Entries) < paginationLimit {
+			if count < paginationLimit {
 				break
 			}
 
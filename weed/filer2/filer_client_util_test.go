@@ -0,0 +1,106 @@
+package filer2
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"google.golang.org/grpc"
+)
+
+// proxyFiler keeps the written data in memory, as one chunk per WriteFile
+type proxyFiler struct {
+	filer_pb.SeaweedFilerServer
+	data []byte
+}
+
+func (f *proxyFiler) ReadFile(req *filer_pb.ReadFileRequest, stream filer_pb.SeaweedFiler_ReadFileServer) error {
+	stop := int64(len(f.data))
+	if req.Size > 0 && req.Offset+req.Size < stop {
+		stop = req.Offset + req.Size
+	}
+	for offset := req.Offset; offset < stop; offset += 3 {
+		end := offset + 3
+		if end > stop {
+			end = stop
+		}
+		if err := stream.Send(&filer_pb.ReadFileResponse{Data: f.data[offset:end]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *proxyFiler) WriteFile(stream filer_pb.SeaweedFiler_WriteFileServer) error {
+	var first *filer_pb.WriteFileRequest
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first == nil {
+			first = req
+		}
+		data = append(data, req.Data...)
+	}
+	f.data = append(f.data[:first.Offset], data...)
+	return stream.SendAndClose(&filer_pb.WriteFileResponse{
+		Chunks: []*filer_pb.FileChunk{{FileId: first.Directory + "/" + first.Name, Offset: first.Offset, Size: uint64(len(data))}},
+	})
+}
+
+type proxyFilerClient struct {
+	conn *grpc.ClientConn
+}
+
+func (c *proxyFilerClient) WithFilerClient(ctx context.Context, fn func(filer_pb.SeaweedFilerClient) error) error {
+	return fn(filer_pb.NewSeaweedFilerClient(c.conn))
+}
+
+func TestReadWriteThroughFiler(t *testing.T) {
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	filer_pb.RegisterSeaweedFilerServer(grpcServer, &proxyFiler{})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+	client := &proxyFilerClient{conn: conn}
+	ctx := context.Background()
+
+	content := bytes.Repeat([]byte("abcdefghij"), writeThroughFilerBufferSize/5+1)
+	chunks, err := WriteThroughFiler(ctx, client, &filer_pb.WriteFileRequest{Directory: "/dir", Name: "file"}, content)
+	if err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if len(chunks) != 1 || chunks[0].FileId != "/dir/file" || chunks[0].Size != uint64(len(content)) {
+		t.Errorf("written chunks %+v", chunks)
+	}
+
+	buff := make([]byte, 16)
+	totalRead, err := ReadThroughFiler(ctx, client, "/dir/file", buff, chunks, 5)
+	if err != nil || totalRead != 16 || string(buff) != "fghijabcdefghija" {
+		t.Errorf("read %d %q: %v", totalRead, buff, err)
+	}
+
+	// reading past the end
+	totalRead, err = ReadThroughFiler(ctx, client, "/dir/file", buff, chunks, int64(len(content))-4)
+	if err != nil || totalRead != 4 || string(buff[:4]) != "ghij" {
+		t.Errorf("read the tail %d %q: %v", totalRead, buff[:totalRead], err)
+	}
+}
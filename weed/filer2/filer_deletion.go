@@ -42,6 +42,12 @@ func (f *Filer) loopProcessingDeletion() {
 				fileIds = fileIds[:0]
 			}
 		case <-ticker.C:
+			fileIds = append(fileIds, f.takePendingFileIds()...)
+			for len(fileIds) > 4096 {
+				glog.V(1).Infof("deleting pending fileIds len=%d", 4096)
+				operation.DeleteFilesWithLookupVolumeId(f.GrpcDialOption, fileIds[:4096], lookupFunc)
+				fileIds = fileIds[4096:]
+			}
 			if len(fileIds) > 0 {
 				glog.V(1).Infof("timed deletion fileIds len=%d", len(fileIds))
 				operation.DeleteFilesWithLookupVolumeId(f.GrpcDialOption, fileIds, lookupFunc)
@@ -58,6 +64,24 @@ func (f *Filer) DeleteChunks(fullpath FullPath, chunks []*filer_pb.FileChunk) {
 	}
 }
 
+// queueFileIdsForDeletion queues any number of file ids without blocking,
+// for the deletion loop to pick up on its next tick
+func (f *Filer) queueFileIdsForDeletion(fileIds []string) {
+	if len(fileIds) == 0 {
+		return
+	}
+	f.pendingFileIdsLock.Lock()
+	f.pendingFileIds = append(f.pendingFileIds, fileIds...)
+	f.pendingFileIdsLock.Unlock()
+}
+
+func (f *Filer) takePendingFileIds() (fileIds []string) {
+	f.pendingFileIdsLock.Lock()
+	fileIds, f.pendingFileIds = f.pendingFileIds, nil
+	f.pendingFileIdsLock.Unlock()
+	return
+}
+
 // DeleteFileByFileId direct delete by file id.
 // Only used when the fileId is not being managed by snapshots.
 func (f *Filer) DeleteFileByFileId(fileId string) {
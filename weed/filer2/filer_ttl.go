@@ -0,0 +1,228 @@
+package filer2
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/stats"
+)
+
+const (
+	ttlSweepPageSize = 1024
+
+	// the files with a ttl are indexed by their expiry, outside of the visible tree,
+	// so the sweeper only visits the entries that are due
+	ttlIndexFolder = "/.seaweedfs/ttl"
+	// the expiry in unix seconds, padded so the index is listed in the order of expiry
+	ttlIndexExpiryWidth = 20
+
+	// the master lock lets only one of the filers sharing the store sweep at a time
+	ttlSweepLockName          = "filer.ttl"
+	ttlSweepLockRenewInterval = 4 * time.Second
+)
+
+// IsExpired tells whether the file is past Crtime+TtlSec.
+// The ttl of a folder is the default ttl for the new files in it, and folders never expire.
+func (entry *Entry) IsExpired(now time.Time) bool {
+	if entry == nil || entry.TtlSec <= 0 || entry.IsDirectory() || entry.Crtime.Unix() <= 0 {
+		return false
+	}
+	return !now.Before(entry.Crtime.Add(time.Duration(entry.TtlSec) * time.Second))
+}
+
+// DirectoryTtlSec returns the default ttl of the new files in the folder
+func (f *Filer) DirectoryTtlSec(ctx context.Context, dir FullPath) int32 {
+	dirEntry := f.cacheGetDirectory(string(dir))
+	if dirEntry == nil {
+		dirEntry, _ = f.FindEntry(ctx, dir)
+	}
+	if dirEntry == nil || !dirEntry.IsDirectory() {
+		return 0
+	}
+	return dirEntry.TtlSec
+}
+
+// LoopDeletingExpiredEntries removes the expired entries from the store, so they are not kept forever
+// after the volume servers have dropped their chunks.
+func (f *Filer) LoopDeletingExpiredEntries(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		start := time.Now()
+		count, err := f.DeleteExpiredEntries(context.Background())
+		if err != nil {
+			glog.Errorf("delete expired entries: %v", err)
+		}
+		if count > 0 {
+			glog.V(0).Infof("deleted %d expired entries in %v", count, time.Since(start))
+		}
+	}
+}
+
+// DeleteExpiredEntries pages through the ttl index in the order of expiry, and deletes the expired files
+// with their chunks. With masters, it is skipped if another filer is sweeping.
+func (f *Filer) DeleteExpiredEntries(ctx context.Context) (count int, err error) {
+	lock := &ttlSweepLock{filer: f}
+	if f.LockManager.useMasterLocks {
+		if err = lock.lease(ctx); err != nil {
+			glog.V(1).Infof("skip sweeping expired entries: %v", err)
+			return 0, nil
+		}
+		defer lock.release(ctx)
+	}
+
+	now := time.Now()
+	lastFileName := ""
+	for {
+		var duePaths []FullPath
+		var indexNames []string
+		listed, isDone := 0, false
+		err = f.store.ListDirectoryPrefixedEntries(ctx, ttlIndexFolder, lastFileName, false, ttlSweepPageSize, "", func(entry *Entry) bool {
+			listed++
+			lastFileName = entry.Name()
+			expiry, p, ok := parseTtlIndexName(lastFileName)
+			if ok && expiry > now.Unix() {
+				isDone = true
+				return false
+			}
+			indexNames = append(indexNames, lastFileName)
+			duePaths = append(duePaths, p)
+			return true
+		})
+		if err != nil {
+			return count, fmt.Errorf("list %s: %v", ttlIndexFolder, err)
+		}
+
+		// change the store after the listing is closed
+		for i, name := range indexNames {
+			if lock.token != 0 && time.Since(lock.leasedAt) > ttlSweepLockRenewInterval {
+				if err = lock.lease(ctx); err != nil {
+					return count, fmt.Errorf("renew lock %s: %v", ttlSweepLockName, err)
+				}
+			}
+			if duePaths[i] != "" {
+				isDeleted, deleteErr := f.deleteExpiredEntry(ctx, duePaths[i], now)
+				if deleteErr != nil {
+					glog.Errorf("delete expired %s: %v", duePaths[i], deleteErr)
+					continue
+				}
+				if isDeleted {
+					count++
+				}
+			}
+			// the entry is deleted, gone, or written again with a later expiry indexed separately
+			if err = f.deleteSystemEntry(ctx, NewFullPath(ttlIndexFolder, name)); err != nil {
+				return count, err
+			}
+		}
+
+		if isDone || listed < ttlSweepPageSize {
+			return count, nil
+		}
+	}
+}
+
+// indexTtl adds the file to the ttl index, after it is written with a ttl
+func (f *Filer) indexTtl(ctx context.Context, entry *Entry) {
+	if entry.TtlSec <= 0 || entry.IsDirectory() || entry.Crtime.Unix() <= 0 {
+		return
+	}
+	expiry := entry.Crtime.Add(time.Duration(entry.TtlSec) * time.Second)
+	if err := f.saveSystemEntry(ctx, NewFullPath(ttlIndexFolder, ttlIndexName(expiry, entry.FullPath)), nil); err != nil {
+		// the entry is still hidden once expired, but kept in the store
+		glog.Errorf("index ttl of %s: %v", entry.FullPath, err)
+	}
+}
+
+func ttlIndexName(expiry time.Time, p FullPath) string {
+	return fmt.Sprintf("%0*d-%s", ttlIndexExpiryWidth, expiry.Unix(), url.PathEscape(string(p)))
+}
+
+// parseTtlIndexName returns the expiry and the path of the file, and false for a malformed name
+func parseTtlIndexName(name string) (expiry int64, p FullPath, ok bool) {
+	if len(name) <= ttlIndexExpiryWidth+1 || name[ttlIndexExpiryWidth] != '-' {
+		return 0, "", false
+	}
+	expiry, err := strconv.ParseInt(name[:ttlIndexExpiryWidth], 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	unescaped, err := url.PathUnescape(name[ttlIndexExpiryWidth+1:])
+	if err != nil {
+		return 0, "", false
+	}
+	return expiry, FullPath(unescaped), true
+}
+
+// ttlSweepLock is leased on the leader master while sweeping, and renewed with the previous token
+type ttlSweepLock struct {
+	filer    *Filer
+	token    int64
+	leasedAt time.Time
+}
+
+func (l *ttlSweepLock) lease(ctx context.Context) error {
+	return l.filer.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err := client.LeaseAdminToken(ctx, &master_pb.LeaseAdminTokenRequest{
+			PreviousToken: l.token,
+			LockName:      ttlSweepLockName,
+			ClientName:    "filer",
+		})
+		if err != nil {
+			return err
+		}
+		l.token, l.leasedAt = resp.Token, time.Now()
+		return nil
+	})
+}
+
+func (l *ttlSweepLock) release(ctx context.Context) {
+	if l.token == 0 {
+		return
+	}
+	err := l.filer.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.ReleaseAdminToken(ctx, &master_pb.ReleaseAdminTokenRequest{
+			PreviousToken: l.token,
+			LockName:      ttlSweepLockName,
+		})
+		return err
+	})
+	if err != nil {
+		glog.V(0).Infof("release lock %s on master: %v", ttlSweepLockName, err)
+	}
+}
+
+func (f *Filer) deleteExpiredEntry(ctx context.Context, p FullPath, now time.Time) (isDeleted bool, err error) {
+
+	unlock := f.LockEntry(p)
+	defer unlock()
+
+	// the file may have been written again since it was indexed
+	entry, err := f.store.FindEntry(ctx, p)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !entry.IsExpired(now) {
+		return false, nil
+	}
+
+	glog.V(3).Infof("deleting expired entry %v", p)
+
+	f.DeleteChunks(p, entry.Chunks)
+
+	f.NotifyUpdateEvent(entry, nil, true)
+
+	if err = f.store.DeleteEntry(ctx, p); err != nil {
+		return false, err
+	}
+	stats.FilerRequestCounter.WithLabelValues("ttlExpired").Inc()
+
+	return true, nil
+}
@@ -3,6 +3,7 @@ package filer2
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
@@ -20,17 +21,35 @@ type FilerStore interface {
 	// err == filer2.ErrNotFound if not found
 	FindEntry(context.Context, FullPath) (entry *Entry, err error)
 	DeleteEntry(context.Context, FullPath) (err error)
-	ListDirectoryEntries(ctx context.Context, dirPath FullPath, startFileName string, includeStartFile bool, limit int) ([]*Entry, error)
+	// ListDirectoryPrefixedEntries visits the entries in name order, only those with names starting with the prefix,
+	// until the limit is reached or eachEntryFunc returns false
+	ListDirectoryPrefixedEntries(ctx context.Context, dirPath FullPath, startFileName string, includeStartFile bool, limit int, prefix string, eachEntryFunc ListEachEntryFunc) error
 
 	BeginTransaction(ctx context.Context) (context.Context, error)
 	CommitTransaction(ctx context.Context) error
 	RollbackTransaction(ctx context.Context) error
 }
 
+// FolderChildrenDeleter is optionally implemented by the stores able to delete a whole folder tree at once
+type FolderChildrenDeleter interface {
+	// DeleteFolderChildren deletes all entries under the folder recursively, but not the folder itself.
+	// Each deleted entry is passed to eachDeletedFunc, if not nil, so the caller can collect exactly the chunks of the deleted files.
+	DeleteFolderChildren(ctx context.Context, fullpath FullPath, eachDeletedFunc EachDeletedEntryFunc) (err error)
+}
+
+type EachDeletedEntryFunc func(entry *Entry)
+
+// ListEachEntryFunc returns false to stop the listing
+type ListEachEntryFunc func(entry *Entry) bool
+
 var ErrNotFound = errors.New("filer: no entry is found in filer store")
+var ErrUnsupportedOperation = errors.New("filer: the operation is not supported by the filer store")
 
 type FilerStoreWrapper struct {
 	actualStore FilerStore
+	// migration is set while the meta data is being moved to another store
+	migration *StoreMigration
+	sync.RWMutex
 }
 
 func NewFilerStoreWrapper(store FilerStore) *FilerStoreWrapper {
@@ -42,44 +61,69 @@ func NewFilerStoreWrapper(store FilerStore) *FilerStoreWrapper {
 	}
 }
 
+func (fsw *FilerStoreWrapper) getStores() (FilerStore, *StoreMigration) {
+	fsw.RLock()
+	defer fsw.RUnlock()
+	return fsw.actualStore, fsw.migration
+}
+
 func (fsw *FilerStoreWrapper) GetName() string {
-	return fsw.actualStore.GetName()
+	store, _ := fsw.getStores()
+	return store.GetName()
 }
 
 func (fsw *FilerStoreWrapper) Initialize(configuration util.Configuration) error {
-	return fsw.actualStore.Initialize(configuration)
+	store, _ := fsw.getStores()
+	return store.Initialize(configuration)
 }
 
 func (fsw *FilerStoreWrapper) InsertEntry(ctx context.Context, entry *Entry) error {
-	stats.FilerStoreCounter.WithLabelValues(fsw.actualStore.GetName(), "insert").Inc()
+	store, migration := fsw.getStores()
+	stats.FilerStoreCounter.WithLabelValues(store.GetName(), "insert").Inc()
 	start := time.Now()
 	defer func() {
-		stats.FilerStoreHistogram.WithLabelValues(fsw.actualStore.GetName(), "insert").Observe(time.Since(start).Seconds())
+		stats.FilerStoreHistogram.WithLabelValues(store.GetName(), "insert").Observe(time.Since(start).Seconds())
 	}()
 
 	filer_pb.BeforeEntrySerialization(entry.Chunks)
-	return fsw.actualStore.InsertEntry(ctx, entry)
+	if migration != nil {
+		return migration.dualWrite(entry.FullPath, func(store FilerStore) error {
+			return store.InsertEntry(ctx, entry)
+		}, func(store FilerStore) error {
+			return insertOrUpdate(ctx, store, entry)
+		})
+	}
+	return store.InsertEntry(ctx, entry)
 }
 
 func (fsw *FilerStoreWrapper) UpdateEntry(ctx context.Context, entry *Entry) error {
-	stats.FilerStoreCounter.WithLabelValues(fsw.actualStore.GetName(), "update").Inc()
+	store, migration := fsw.getStores()
+	stats.FilerStoreCounter.WithLabelValues(store.GetName(), "update").Inc()
 	start := time.Now()
 	defer func() {
-		stats.FilerStoreHistogram.WithLabelValues(fsw.actualStore.GetName(), "update").Observe(time.Since(start).Seconds())
+		stats.FilerStoreHistogram.WithLabelValues(store.GetName(), "update").Observe(time.Since(start).Seconds())
 	}()
 
 	filer_pb.BeforeEntrySerialization(entry.Chunks)
-	return fsw.actualStore.UpdateEntry(ctx, entry)
+	if migration != nil {
+		return migration.dualWrite(entry.FullPath, func(store FilerStore) error {
+			return store.UpdateEntry(ctx, entry)
+		}, func(store FilerStore) error {
+			return insertOrUpdate(ctx, store, entry)
+		})
+	}
+	return store.UpdateEntry(ctx, entry)
 }
 
 func (fsw *FilerStoreWrapper) FindEntry(ctx context.Context, fp FullPath) (entry *Entry, err error) {
-	stats.FilerStoreCounter.WithLabelValues(fsw.actualStore.GetName(), "find").Inc()
+	store, _ := fsw.getStores()
+	stats.FilerStoreCounter.WithLabelValues(store.GetName(), "find").Inc()
 	start := time.Now()
 	defer func() {
-		stats.FilerStoreHistogram.WithLabelValues(fsw.actualStore.GetName(), "find").Observe(time.Since(start).Seconds())
+		stats.FilerStoreHistogram.WithLabelValues(store.GetName(), "find").Observe(time.Since(start).Seconds())
 	}()
 
-	entry, err = fsw.actualStore.FindEntry(ctx, fp)
+	entry, err = store.FindEntry(ctx, fp)
 	if err != nil {
 		return nil, err
 	}
@@ -88,40 +132,83 @@ func (fsw *FilerStoreWrapper) FindEntry(ctx context.Context, fp FullPath) (entry
 }
 
 func (fsw *FilerStoreWrapper) DeleteEntry(ctx context.Context, fp FullPath) (err error) {
-	stats.FilerStoreCounter.WithLabelValues(fsw.actualStore.GetName(), "delete").Inc()
+	store, migration := fsw.getStores()
+	stats.FilerStoreCounter.WithLabelValues(store.GetName(), "delete").Inc()
 	start := time.Now()
 	defer func() {
-		stats.FilerStoreHistogram.WithLabelValues(fsw.actualStore.GetName(), "delete").Observe(time.Since(start).Seconds())
+		stats.FilerStoreHistogram.WithLabelValues(store.GetName(), "delete").Observe(time.Since(start).Seconds())
 	}()
 
-	return fsw.actualStore.DeleteEntry(ctx, fp)
+	if migration != nil {
+		deleteFn := func(store FilerStore) error {
+			return store.DeleteEntry(ctx, fp)
+		}
+		return migration.dualWrite(fp, deleteFn, deleteFn)
+	}
+	return store.DeleteEntry(ctx, fp)
+}
+
+func (fsw *FilerStoreWrapper) CanDeleteFolderChildren() bool {
+	store, _ := fsw.getStores()
+	_, ok := store.(FolderChildrenDeleter)
+	return ok
 }
 
-func (fsw *FilerStoreWrapper) ListDirectoryEntries(ctx context.Context, dirPath FullPath, startFileName string, includeStartFile bool, limit int) ([]*Entry, error) {
-	stats.FilerStoreCounter.WithLabelValues(fsw.actualStore.GetName(), "list").Inc()
+func (fsw *FilerStoreWrapper) DeleteFolderChildren(ctx context.Context, fp FullPath, eachDeletedFunc EachDeletedEntryFunc) (err error) {
+	store, migration := fsw.getStores()
+	deleter, ok := store.(FolderChildrenDeleter)
+	if !ok {
+		return ErrUnsupportedOperation
+	}
+
+	stats.FilerStoreCounter.WithLabelValues(store.GetName(), "deleteFolderChildren").Inc()
 	start := time.Now()
 	defer func() {
-		stats.FilerStoreHistogram.WithLabelValues(fsw.actualStore.GetName(), "list").Observe(time.Since(start).Seconds())
+		stats.FilerStoreHistogram.WithLabelValues(store.GetName(), "deleteFolderChildren").Observe(time.Since(start).Seconds())
 	}()
 
-	entries, err := fsw.actualStore.ListDirectoryEntries(ctx, dirPath, startFileName, includeStartFile, limit)
+	if migration != nil {
+		return migration.deleteFolderChildren(ctx, fp, eachDeletedFunc)
+	}
+	return deleter.DeleteFolderChildren(ctx, fp, eachDeletedFunc)
+}
+
+func (fsw *FilerStoreWrapper) ListDirectoryEntries(ctx context.Context, dirPath FullPath, startFileName string, includeStartFile bool, limit int) (entries []*Entry, err error) {
+	err = fsw.ListDirectoryPrefixedEntries(ctx, dirPath, startFileName, includeStartFile, limit, "", func(entry *Entry) bool {
+		entries = append(entries, entry)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
-	for _, entry := range entries {
+	return entries, nil
+}
+
+func (fsw *FilerStoreWrapper) ListDirectoryPrefixedEntries(ctx context.Context, dirPath FullPath, startFileName string, includeStartFile bool, limit int, prefix string, eachEntryFunc ListEachEntryFunc) error {
+	store, _ := fsw.getStores()
+	stats.FilerStoreCounter.WithLabelValues(store.GetName(), "list").Inc()
+	start := time.Now()
+	defer func() {
+		stats.FilerStoreHistogram.WithLabelValues(store.GetName(), "list").Observe(time.Since(start).Seconds())
+	}()
+
+	return store.ListDirectoryPrefixedEntries(ctx, dirPath, startFileName, includeStartFile, limit, prefix, func(entry *Entry) bool {
 		filer_pb.AfterEntryDeserialization(entry.Chunks)
-	}
-	return entries, err
+		return eachEntryFunc(entry)
+	})
 }
 
 func (fsw *FilerStoreWrapper) BeginTransaction(ctx context.Context) (context.Context, error) {
-	return fsw.actualStore.BeginTransaction(ctx)
+	store, _ := fsw.getStores()
+	return store.BeginTransaction(ctx)
 }
 
 func (fsw *FilerStoreWrapper) CommitTransaction(ctx context.Context) error {
-	return fsw.actualStore.CommitTransaction(ctx)
+	store, _ := fsw.getStores()
+	return store.CommitTransaction(ctx)
 }
 
 func (fsw *FilerStoreWrapper) RollbackTransaction(ctx context.Context) error {
-	return fsw.actualStore.RollbackTransaction(ctx)
+	store, _ := fsw.getStores()
+	return store.RollbackTransaction(ctx)
 }
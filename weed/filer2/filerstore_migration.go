@@ -0,0 +1,507 @@
+package filer2
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	StoreMigrationBackfilling = "backfilling"
+	StoreMigrationBackfilled  = "backfilled"
+	StoreMigrationVerifying   = "verifying"
+	StoreMigrationVerified    = "verified"
+	StoreMigrationMismatched  = "mismatched"
+	StoreMigrationFailed      = "failed"
+	StoreMigrationCutOver     = "cutover"
+
+	storeMigrationPageSize   = 1024
+	storeMigrationLockStripe = 256
+)
+
+// StoreMigrationStatus is a snapshot of the progress of a store migration
+type StoreMigrationStatus struct {
+	SourceStore      string
+	TargetStore      string
+	State            string
+	StartedAt        time.Time
+	DirectoryCount   int64
+	CopiedCount      int64
+	CurrentDirectory string
+	DualWriteErrors  int64
+	SourceEntryCount int64
+	TargetEntryCount int64
+	SourceChecksum   uint64
+	TargetChecksum   uint64
+	MismatchCount    int64
+	Error            string
+}
+
+// StoreMigration moves the filer meta data to another store while the filer keeps serving.
+// All writes go to both stores, the target is backfilled by walking the source,
+// and both are compared entry by entry before the reads are switched over.
+//
+// Changes made by other filers sharing the source store are not seen by the migration.
+// Writes to the target are not part of the source transactions, so a rolled back rename
+// is only detected by the verification.
+type StoreMigration struct {
+	source FilerStore
+	target FilerStore
+
+	// folderLock is held exclusively when deleting a folder tree, and for the cut-over
+	folderLock sync.RWMutex
+	// entryLocks serialize the writes to one entry with copying or checking the same entry
+	entryLocks [storeMigrationLockStripe]sync.Mutex
+
+	cancel     context.CancelFunc
+	backfilled bool // guarded by statusLock
+
+	dualWriteErrors int64
+	directoryCount  int64
+	copiedCount     int64
+
+	statusLock sync.Mutex
+	status     StoreMigrationStatus
+}
+
+func newStoreMigration(source, target FilerStore) *StoreMigration {
+	return &StoreMigration{
+		source: source,
+		target: target,
+		status: StoreMigrationStatus{
+			SourceStore: source.GetName(),
+			TargetStore: target.GetName(),
+			StartedAt:   time.Now(),
+		},
+	}
+}
+
+// Status returns a snapshot of the migration progress
+func (m *StoreMigration) Status() StoreMigrationStatus {
+	m.statusLock.Lock()
+	defer m.statusLock.Unlock()
+	status := m.status
+	status.DirectoryCount = atomic.LoadInt64(&m.directoryCount)
+	status.CopiedCount = atomic.LoadInt64(&m.copiedCount)
+	status.DualWriteErrors = atomic.LoadInt64(&m.dualWriteErrors)
+	return status
+}
+
+func (m *StoreMigration) updateStatus(fn func(status *StoreMigrationStatus)) {
+	m.statusLock.Lock()
+	defer m.statusLock.Unlock()
+	fn(&m.status)
+}
+
+func (m *StoreMigration) lockEntry(fp FullPath) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(fp))
+	lock := &m.entryLocks[h.Sum32()%storeMigrationLockStripe]
+	lock.Lock()
+	return lock
+}
+
+// dualWrite applies one change to the source store, and if it succeeds, also to the target store
+func (m *StoreMigration) dualWrite(fp FullPath, sourceFn, targetFn func(store FilerStore) error) error {
+	m.folderLock.RLock()
+	defer m.folderLock.RUnlock()
+	lock := m.lockEntry(fp)
+	defer lock.Unlock()
+
+	if err := sourceFn(m.source); err != nil {
+		return err
+	}
+	if err := targetFn(m.target); err != nil {
+		atomic.AddInt64(&m.dualWriteErrors, 1)
+		glog.Errorf("store migration: write %s to %s: %v", fp, m.target.GetName(), err)
+	}
+	return nil
+}
+
+func (m *StoreMigration) deleteFolderChildren(ctx context.Context, fp FullPath, eachDeletedFunc EachDeletedEntryFunc) error {
+	m.folderLock.Lock()
+	defer m.folderLock.Unlock()
+
+	deleter, ok := m.source.(FolderChildrenDeleter)
+	if !ok {
+		return ErrUnsupportedOperation
+	}
+	if err := deleter.DeleteFolderChildren(ctx, fp, eachDeletedFunc); err != nil {
+		return err
+	}
+	if err := deleteStoreFolderChildren(ctx, m.target, fp); err != nil {
+		atomic.AddInt64(&m.dualWriteErrors, 1)
+		glog.Errorf("store migration: delete folder children %s from %s: %v", fp, m.target.GetName(), err)
+	}
+	return nil
+}
+
+// insertOrUpdate writes an entry to a store which may or may not have it already
+func insertOrUpdate(ctx context.Context, store FilerStore, entry *Entry) error {
+	if err := store.InsertEntry(ctx, entry); err != nil {
+		if updateErr := store.UpdateEntry(ctx, entry); updateErr != nil {
+			return fmt.Errorf("insert: %v, update: %v", err, updateErr)
+		}
+	}
+	return nil
+}
+
+func deleteStoreFolderChildren(ctx context.Context, store FilerStore, fp FullPath) error {
+	if deleter, ok := store.(FolderChildrenDeleter); ok {
+		return deleter.DeleteFolderChildren(ctx, fp, nil)
+	}
+	return walkStoreDirectory(ctx, store, fp, func(entry *Entry) error {
+		if entry.IsDirectory() {
+			if err := deleteStoreFolderChildren(ctx, store, entry.FullPath); err != nil {
+				return err
+			}
+		}
+		return store.DeleteEntry(ctx, entry.FullPath)
+	})
+}
+
+// walkStoreDirectory visits the direct children of a directory page by page.
+// Each page is read completely before visiting, so the store is free to be written during the visit.
+func walkStoreDirectory(ctx context.Context, store FilerStore, dirPath FullPath, fn func(entry *Entry) error) error {
+	lastFileName := ""
+	for {
+		var entries []*Entry
+		err := store.ListDirectoryPrefixedEntries(ctx, dirPath, lastFileName, false, storeMigrationPageSize, "", func(entry *Entry) bool {
+			entries = append(entries, entry)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("list %s: %v", dirPath, err)
+		}
+		for _, entry := range entries {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(entry); err != nil {
+				return err
+			}
+			lastFileName = entry.Name()
+		}
+		if len(entries) < storeMigrationPageSize {
+			return nil
+		}
+	}
+}
+
+func walkStoreTree(ctx context.Context, store FilerStore, dirPath FullPath, fn func(entry *Entry) error) error {
+	return walkStoreDirectory(ctx, store, dirPath, func(entry *Entry) error {
+		if err := fn(entry); err != nil {
+			return err
+		}
+		if entry.IsDirectory() {
+			return walkStoreTree(ctx, store, entry.FullPath, fn)
+		}
+		return nil
+	})
+}
+
+func (m *StoreMigration) backfill(ctx context.Context) {
+	m.updateStatus(func(status *StoreMigrationStatus) {
+		status.State = StoreMigrationBackfilling
+	})
+
+	err := m.backfillDirectory(ctx, "/")
+
+	m.updateStatus(func(status *StoreMigrationStatus) {
+		status.CurrentDirectory = ""
+		if err != nil {
+			status.State = StoreMigrationFailed
+			status.Error = err.Error()
+			return
+		}
+		status.State = StoreMigrationBackfilled
+		m.backfilled = true
+	})
+	if err != nil {
+		glog.Errorf("store migration to %s: backfill: %v", m.target.GetName(), err)
+	} else {
+		glog.V(0).Infof("store migration to %s: backfilled %d entries", m.target.GetName(), atomic.LoadInt64(&m.copiedCount))
+	}
+}
+
+func (m *StoreMigration) backfillDirectory(ctx context.Context, dirPath FullPath) error {
+	atomic.AddInt64(&m.directoryCount, 1)
+	m.updateStatus(func(status *StoreMigrationStatus) {
+		status.CurrentDirectory = string(dirPath)
+	})
+	return walkStoreDirectory(ctx, m.source, dirPath, func(entry *Entry) error {
+		if err := m.copyEntry(ctx, entry.FullPath); err != nil {
+			return err
+		}
+		if entry.IsDirectory() {
+			return m.backfillDirectory(ctx, entry.FullPath)
+		}
+		return nil
+	})
+}
+
+// copyEntry copies the current version of an entry, in case it is changed after being listed
+func (m *StoreMigration) copyEntry(ctx context.Context, fp FullPath) error {
+	m.folderLock.RLock()
+	defer m.folderLock.RUnlock()
+	lock := m.lockEntry(fp)
+	defer lock.Unlock()
+
+	entry, err := m.source.FindEntry(ctx, fp)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("find %s: %v", fp, err)
+	}
+	if err = insertOrUpdate(ctx, m.target, entry); err != nil {
+		return fmt.Errorf("copy %s: %v", fp, err)
+	}
+	atomic.AddInt64(&m.copiedCount, 1)
+	return nil
+}
+
+func (m *StoreMigration) verify(ctx context.Context) {
+	m.updateStatus(func(status *StoreMigrationStatus) {
+		status.State = StoreMigrationVerifying
+		status.Error = ""
+		status.SourceEntryCount, status.TargetEntryCount = 0, 0
+		status.SourceChecksum, status.TargetChecksum = 0, 0
+		status.MismatchCount = 0
+	})
+	dualWriteErrors := atomic.LoadInt64(&m.dualWriteErrors)
+
+	err := m.verifyEntries(ctx)
+
+	m.updateStatus(func(status *StoreMigrationStatus) {
+		status.CurrentDirectory = ""
+		if err != nil {
+			status.State = StoreMigrationFailed
+			status.Error = err.Error()
+			return
+		}
+		if status.MismatchCount > 0 || status.SourceChecksum != status.TargetChecksum ||
+			atomic.LoadInt64(&m.dualWriteErrors) != dualWriteErrors {
+			status.State = StoreMigrationMismatched
+			return
+		}
+		status.State = StoreMigrationVerified
+	})
+}
+
+// verifyEntries compares both stores entry by entry, while holding the entry lock,
+// so the concurrent writes do not show up as differences.
+// Entries only in the source are found by walking the source, and entries only in the target by walking the target.
+func (m *StoreMigration) verifyEntries(ctx context.Context) error {
+
+	err := walkStoreTree(ctx, m.source, "/", func(entry *Entry) error {
+		if entry.IsDirectory() {
+			m.updateStatus(func(status *StoreMigrationStatus) {
+				status.CurrentDirectory = string(entry.FullPath)
+			})
+		}
+		return m.compareEntry(ctx, entry.FullPath, true)
+	})
+	if err != nil {
+		return err
+	}
+
+	return walkStoreTree(ctx, m.target, "/", func(entry *Entry) error {
+		return m.compareEntry(ctx, entry.FullPath, false)
+	})
+}
+
+func (m *StoreMigration) compareEntry(ctx context.Context, fp FullPath, fromSource bool) error {
+	m.folderLock.RLock()
+	defer m.folderLock.RUnlock()
+	lock := m.lockEntry(fp)
+	defer lock.Unlock()
+
+	sourceEntry, err := m.source.FindEntry(ctx, fp)
+	if err != nil && err != ErrNotFound {
+		return fmt.Errorf("find %s in %s: %v", fp, m.source.GetName(), err)
+	}
+	if !fromSource && sourceEntry != nil {
+		// already counted when walking the source
+		return nil
+	}
+	targetEntry, err := m.target.FindEntry(ctx, fp)
+	if err != nil && err != ErrNotFound {
+		return fmt.Errorf("find %s in %s: %v", fp, m.target.GetName(), err)
+	}
+
+	sourceChecksum, err := entryChecksum(sourceEntry)
+	if err != nil {
+		return err
+	}
+	targetChecksum, err := entryChecksum(targetEntry)
+	if err != nil {
+		return err
+	}
+
+	if sourceChecksum != targetChecksum {
+		glog.V(1).Infof("store migration: %s differs between %s and %s", fp, m.source.GetName(), m.target.GetName())
+	}
+
+	m.updateStatus(func(status *StoreMigrationStatus) {
+		if sourceEntry != nil {
+			status.SourceEntryCount++
+		}
+		if targetEntry != nil {
+			status.TargetEntryCount++
+		}
+		status.SourceChecksum += sourceChecksum
+		status.TargetChecksum += targetChecksum
+		if sourceChecksum != targetChecksum {
+			status.MismatchCount++
+		}
+	})
+	return nil
+}
+
+// entryChecksum hashes the full path and the deterministically encoded meta data, or 0 for a missing entry.
+// The checksums of all entries are summed up, so the walking order does not matter.
+func entryChecksum(entry *Entry) (uint64, error) {
+	if entry == nil {
+		return 0, nil
+	}
+	buf := proto.NewBuffer(nil)
+	buf.SetDeterministic(true)
+	if err := buf.Marshal(entry.ToProtoEntry()); err != nil {
+		return 0, fmt.Errorf("encode %s: %v", entry.FullPath, err)
+	}
+	h := fnv.New64a()
+	h.Write([]byte(entry.FullPath))
+	h.Write([]byte{0})
+	h.Write(buf.Bytes())
+	return h.Sum64(), nil
+}
+
+// StartStoreMigration starts writing to both the current store and the target store,
+// and backfills the target store in the background
+func (f *Filer) StartStoreMigration(target FilerStore) error {
+	return f.store.startMigration(target)
+}
+
+// VerifyStoreMigration compares the current store and the target store in the background
+func (f *Filer) VerifyStoreMigration() error {
+	return f.store.verifyMigration()
+}
+
+// CutOverStoreMigration switches to the target store after a successful verification
+func (f *Filer) CutOverStoreMigration() (StoreMigrationStatus, error) {
+	return f.store.cutOverMigration()
+}
+
+// AbortStoreMigration stops writing to the target store, leaving its content as is
+func (f *Filer) AbortStoreMigration() (StoreMigrationStatus, error) {
+	return f.store.abortMigration()
+}
+
+// StoreMigrationStatus returns the progress of the ongoing migration, or nil if there is none
+func (f *Filer) StoreMigrationStatus() *StoreMigrationStatus {
+	_, migration := f.store.getStores()
+	if migration == nil {
+		return nil
+	}
+	status := migration.Status()
+	return &status
+}
+
+func (fsw *FilerStoreWrapper) startMigration(target FilerStore) error {
+	fsw.Lock()
+	defer fsw.Unlock()
+
+	if fsw.migration != nil {
+		return fmt.Errorf("store migration to %s is already started", fsw.migration.target.GetName())
+	}
+	if _, ok := fsw.actualStore.(FolderChildrenDeleter); ok {
+		if _, ok := target.(FolderChildrenDeleter); !ok {
+			glog.V(0).Infof("store migration: %s deletes folder trees entry by entry", target.GetName())
+		}
+	}
+
+	migration := newStoreMigration(fsw.actualStore, target)
+	ctx, cancel := context.WithCancel(context.Background())
+	migration.cancel = cancel
+	migration.status.State = StoreMigrationBackfilling
+	fsw.migration = migration
+
+	glog.V(0).Infof("store migration from %s to %s started", fsw.actualStore.GetName(), target.GetName())
+	go migration.backfill(ctx)
+	return nil
+}
+
+func (fsw *FilerStoreWrapper) verifyMigration() error {
+	_, migration := fsw.getStores()
+	if migration == nil {
+		return fmt.Errorf("no store migration")
+	}
+
+	migration.statusLock.Lock()
+	defer migration.statusLock.Unlock()
+	if !migration.backfilled {
+		return fmt.Errorf("store migration is %s: %s", migration.status.State, migration.status.Error)
+	}
+	if migration.status.State == StoreMigrationVerifying {
+		return fmt.Errorf("store migration is %s", migration.status.State)
+	}
+	migration.status.State = StoreMigrationVerifying
+
+	ctx, cancel := context.WithCancel(context.Background())
+	migration.cancel = cancel
+	go migration.verify(ctx)
+	return nil
+}
+
+func (fsw *FilerStoreWrapper) cutOverMigration() (StoreMigrationStatus, error) {
+	fsw.Lock()
+	defer fsw.Unlock()
+
+	migration := fsw.migration
+	if migration == nil {
+		return StoreMigrationStatus{}, fmt.Errorf("no store migration")
+	}
+
+	// wait for the in flight writes, and block new ones until the switch is done
+	migration.folderLock.Lock()
+	defer migration.folderLock.Unlock()
+
+	status := migration.Status()
+	if status.State != StoreMigrationVerified {
+		return status, fmt.Errorf("store migration is %s, not %s", status.State, StoreMigrationVerified)
+	}
+	if status.DualWriteErrors > 0 {
+		return status, fmt.Errorf("store migration has %d failed writes to %s", status.DualWriteErrors, status.TargetStore)
+	}
+
+	fsw.actualStore = migration.target
+	fsw.migration = nil
+	migration.updateStatus(func(status *StoreMigrationStatus) {
+		status.State = StoreMigrationCutOver
+	})
+
+	glog.V(0).Infof("filer store is switched from %s to %s", status.SourceStore, status.TargetStore)
+	return migration.Status(), nil
+}
+
+func (fsw *FilerStoreWrapper) abortMigration() (StoreMigrationStatus, error) {
+	fsw.Lock()
+	defer fsw.Unlock()
+
+	migration := fsw.migration
+	if migration == nil {
+		return StoreMigrationStatus{}, fmt.Errorf("no store migration")
+	}
+	migration.cancel()
+	fsw.migration = nil
+
+	glog.V(0).Infof("store migration from %s to %s is aborted", migration.source.GetName(), migration.target.GetName())
+	return migration.Status(), nil
+}
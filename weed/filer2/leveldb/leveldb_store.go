@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -123,12 +124,59 @@ func (store *LevelDBStore) DeleteEntry(ctx context.Context, fullpath filer2.Full
 	return nil
 }
 
-func (store *LevelDBStore) ListDirectoryEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
-	limit int) (entries []*filer2.Entry, err error) {
+func (store *LevelDBStore) DeleteFolderChildren(ctx context.Context, fullpath filer2.FullPath, eachDeletedFunc filer2.EachDeletedEntryFunc) (err error) {
 
-	directoryPrefix := genDirectoryKeyPrefix(fullpath, "")
+	// the keys of the children start with "<dir>\x00", and the deeper ones with "<dir>/"
+	keyPrefixes := [][]byte{genDirectoryKeyPrefix(fullpath, ""), []byte(strings.TrimSuffix(string(fullpath), "/") + "/")}
+	if fullpath == "/" {
+		keyPrefixes = keyPrefixes[1:]
+	}
+
+	for _, keyPrefix := range keyPrefixes {
+		if err = store.deleteKeyRange(keyPrefix, eachDeletedFunc); err != nil {
+			return fmt.Errorf("delete folder children %s : %v", fullpath, err)
+		}
+	}
+
+	return nil
+}
 
-	iter := store.db.NewIterator(&leveldb_util.Range{Start: genDirectoryKeyPrefix(fullpath, startFileName)}, nil)
+func (store *LevelDBStore) deleteKeyRange(keyPrefix []byte, eachDeletedFunc filer2.EachDeletedEntryFunc) error {
+	batch := new(leveldb.Batch)
+	iter := store.db.NewIterator(leveldb_util.BytesPrefix(keyPrefix), nil)
+	for iter.Next() {
+		if eachDeletedFunc != nil {
+			if entry, decodeErr := decodeEntry(iter.Key(), iter.Value()); decodeErr == nil {
+				eachDeletedFunc(entry)
+			}
+		}
+		batch.Delete(iter.Key())
+		if batch.Len() >= 1024 {
+			if err := store.db.Write(batch, nil); err != nil {
+				iter.Release()
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	return store.db.Write(batch, nil)
+}
+
+func (store *LevelDBStore) ListDirectoryPrefixedEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
+	limit int, prefix string, eachEntryFunc filer2.ListEachEntryFunc) (err error) {
+
+	directoryPrefix := genDirectoryKeyPrefix(fullpath, prefix)
+
+	lastFileStart := genDirectoryKeyPrefix(fullpath, startFileName)
+	if startFileName < prefix {
+		lastFileStart = directoryPrefix
+	}
+
+	iter := store.db.NewIterator(&leveldb_util.Range{Start: lastFileStart}, nil)
 	for iter.Next() {
 		key := iter.Key()
 		if !bytes.HasPrefix(key, directoryPrefix) {
@@ -153,11 +201,13 @@ func (store *LevelDBStore) ListDirectoryEntries(ctx context.Context, fullpath fi
 			glog.V(0).Infof("list %s : %v", entry.FullPath, err)
 			break
 		}
-		entries = append(entries, entry)
+		if !eachEntryFunc(entry) {
+			break
+		}
 	}
 	iter.Release()
 
-	return entries, err
+	return err
 }
 
 func genKey(dirPath, fileName string) (key []byte) {
@@ -176,6 +226,21 @@ func genDirectoryKeyPrefix(fullpath filer2.FullPath, startFileName string) (keyP
 	return keyPrefix
 }
 
+// decodeEntry decodes the entry stored under the key "<dir>\x00<name>"
+func decodeEntry(key, value []byte) (*filer2.Entry, error) {
+	sepIndex := bytes.LastIndexByte(key, DIR_FILE_SEPARATOR)
+	if sepIndex < 0 {
+		return nil, fmt.Errorf("invalid key %q", key)
+	}
+	entry := &filer2.Entry{
+		FullPath: filer2.NewFullPath(string(key[:sepIndex]), string(key[sepIndex+1:])),
+	}
+	if err := entry.DecodeAttributesAndChunks(value); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
 func getNameFromKey(key []byte) string {
 
 	sepIndex := len(key) - 1
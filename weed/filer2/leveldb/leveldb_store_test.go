@@ -61,6 +61,19 @@ func TestCreateAndFind(t *testing.T) {
 		return
 	}
 
+	// checking the prefix
+	for prefix, expected := range map[string]int{"file": 1, "file2": 0} {
+		count := 0
+		err = filer.ListDirectoryPrefixedEntries(ctx, filer2.FullPath("/home/chris/this/is/one"), "", false, 100, prefix, func(entry *filer2.Entry) bool {
+			count++
+			return true
+		})
+		if err != nil || count != expected {
+			t.Errorf("list prefix %s entries count: %v %v", prefix, count, err)
+			return
+		}
+	}
+
 }
 
 func TestEmptyRoot(t *testing.T) {
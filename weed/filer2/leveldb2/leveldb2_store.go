@@ -134,11 +134,67 @@ func (store *LevelDB2Store) DeleteEntry(ctx context.Context, fullpath filer2.Ful
 	return nil
 }
 
-func (store *LevelDB2Store) ListDirectoryEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
-	limit int) (entries []*filer2.Entry, err error) {
+func (store *LevelDB2Store) DeleteFolderChildren(ctx context.Context, fullpath filer2.FullPath, eachDeletedFunc filer2.EachDeletedEntryFunc) (err error) {
 
+	// the keys are hashed by the directories, so the sub folders are found by decoding the entries
 	directoryPrefix, partitionId := genDirectoryKeyPrefix(fullpath, "", store.dbCount)
+	db := store.dbs[partitionId]
+
+	var subFolders []filer2.FullPath
+	batch := new(leveldb.Batch)
+	iter := db.NewIterator(leveldb_util.BytesPrefix(directoryPrefix), nil)
+	for iter.Next() {
+		fileName := getNameFromKey(iter.Key())
+		if fileName == "" {
+			continue
+		}
+		entry := &filer2.Entry{
+			FullPath: filer2.NewFullPath(string(fullpath), fileName),
+		}
+		if decodeErr := entry.DecodeAttributesAndChunks(iter.Value()); decodeErr == nil {
+			if entry.IsDirectory() {
+				subFolders = append(subFolders, entry.FullPath)
+			}
+			if eachDeletedFunc != nil {
+				eachDeletedFunc(entry)
+			}
+		}
+		batch.Delete(iter.Key())
+		if batch.Len() >= 1024 {
+			if err = db.Write(batch, nil); err != nil {
+				break
+			}
+			batch.Reset()
+		}
+	}
+	iter.Release()
+	if err == nil {
+		err = iter.Error()
+	}
+	if err == nil {
+		err = db.Write(batch, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("delete folder children %s : %v", fullpath, err)
+	}
+
+	for _, subFolder := range subFolders {
+		if err = store.DeleteFolderChildren(ctx, subFolder, eachDeletedFunc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *LevelDB2Store) ListDirectoryPrefixedEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
+	limit int, prefix string, eachEntryFunc filer2.ListEachEntryFunc) (err error) {
+
+	directoryPrefix, partitionId := genDirectoryKeyPrefix(fullpath, prefix, store.dbCount)
 	lastFileStart, _ := genDirectoryKeyPrefix(fullpath, startFileName, store.dbCount)
+	if startFileName < prefix {
+		lastFileStart = directoryPrefix
+	}
 
 	iter := store.dbs[partitionId].NewIterator(&leveldb_util.Range{Start: lastFileStart}, nil)
 	for iter.Next() {
@@ -168,11 +224,13 @@ func (store *LevelDB2Store) ListDirectoryEntries(ctx context.Context, fullpath f
 			glog.V(0).Infof("list %s : %v", entry.FullPath, err)
 			break
 		}
-		entries = append(entries, entry)
+		if !eachEntryFunc(entry) {
+			break
+		}
 	}
 	iter.Release()
 
-	return entries, err
+	return err
 }
 
 func genKey(dirPath, fileName string, dbCount int) (key []byte, partitionId int) {
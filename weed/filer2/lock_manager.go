@@ -0,0 +1,445 @@
+package filer2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+const (
+	DefaultLockLeaseSec = 30
+
+	// the locks and leases are kept in the filer store, outside of the visible tree,
+	// so all filers on the same store share them, and they survive filer restarts
+	lockFolder      = "/.seaweedfs/locks"
+	lockLeaseFolder = "/.seaweedfs/lock_leases"
+
+	extendedLocks       = "locks"
+	extendedLeaseExpiry = "expiry"
+	extendedLeasePaths  = "paths"
+
+	expireLeasesPageSize = 1024
+
+	// the master locks serialize the changes of the same file locks or lease across the filers
+	masterLockPrefix        = "filer.locks:"
+	masterLockTimeout       = 5 * time.Second
+	masterLockRetryInterval = 20 * time.Millisecond
+)
+
+// LockManager keeps advisory POSIX and flock locks for all clients of the filers.
+// Each client holds a lease, and the locks of a client are ignored and dropped once the lease expires,
+// so a dead mount can not keep files locked forever.
+//
+// The locks of one file, or the lease of one client, are read, checked and written back
+// while holding a lock of the same name on the leader master, so the filers sharing the store
+// change them one at a time. Without masters, only the lock of this filer is used.
+type LockManager struct {
+	filer          *Filer
+	mu             sync.Mutex
+	useMasterLocks bool
+}
+
+func NewLockManager(filer *Filer, useMasterLocks bool) *LockManager {
+	return &LockManager{
+		filer:          filer,
+		useMasterLocks: useMasterLocks,
+	}
+}
+
+// Acquire acquires the lock, or returns the first conflicting lock.
+// Locks of the same owner are replaced on the overlapped range, following POSIX semantics.
+func (lm *LockManager) Acquire(ctx context.Context, p FullPath, lock *filer_pb.FileLock, leaseSec int) (conflict *filer_pb.FileLock, err error) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	err = lm.withMasterLock(ctx, leaseLockName(lock.ClientId), func() error {
+		return lm.renewLease(ctx, lock.ClientId, leaseSec, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = lm.withMasterLock(ctx, locksLockName(p), func() error {
+		locks, err := lm.loadLiveLocks(ctx, p)
+		if err != nil {
+			return err
+		}
+		if conflict = findConflict(locks, lock); conflict != nil {
+			return nil
+		}
+		locks = removeOwnerRange(locks, lock)
+		return lm.saveLocks(ctx, p, append(locks, lock))
+	})
+	return
+}
+
+// Test returns the first lock conflicting with the given lock, without acquiring it.
+func (lm *LockManager) Test(ctx context.Context, p FullPath, lock *filer_pb.FileLock) (*filer_pb.FileLock, error) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	locks, err := lm.loadLiveLocks(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return findConflict(locks, lock), nil
+}
+
+// Release removes the range of the lock held by the same owner.
+func (lm *LockManager) Release(ctx context.Context, p FullPath, lock *filer_pb.FileLock) error {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	return lm.withMasterLock(ctx, locksLockName(p), func() error {
+		locks, err := lm.loadLocks(ctx, p)
+		if err != nil {
+			return err
+		}
+		return lm.saveLocks(ctx, p, removeOwnerRange(locks, lock))
+	})
+}
+
+// KeepLease extends the lease of a client. A non-positive leaseSec releases all locks of the client.
+func (lm *LockManager) KeepLease(ctx context.Context, clientId string, leaseSec int) (lockCount int, err error) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	err = lm.withMasterLock(ctx, leaseLockName(clientId), func() error {
+		if leaseSec <= 0 {
+			return lm.releaseClient(ctx, clientId)
+		}
+		return lm.renewLease(ctx, clientId, leaseSec, "")
+	})
+	if err != nil || leaseSec <= 0 {
+		return 0, err
+	}
+
+	_, paths, err := lm.loadLease(ctx, clientId)
+	if err != nil {
+		return 0, err
+	}
+	for _, p := range paths {
+		locks, err := lm.loadLocks(ctx, p)
+		if err != nil {
+			return 0, err
+		}
+		for _, l := range locks {
+			if l.ClientId == clientId {
+				lockCount++
+			}
+		}
+	}
+	return
+}
+
+func (lm *LockManager) loopExpiringLeases() {
+	ticker := time.NewTicker(5 * time.Second)
+	for now := range ticker.C {
+		if lm.filer.store == nil {
+			continue
+		}
+		if err := lm.expireLeases(context.Background(), now); err != nil {
+			glog.V(0).Infof("expire lock leases: %v", err)
+		}
+	}
+}
+
+func (lm *LockManager) expireLeases(ctx context.Context, now time.Time) error {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	var expired []string
+	for lastClientId := ""; ; {
+		count := 0
+		err := lm.filer.store.ListDirectoryPrefixedEntries(ctx, lockLeaseFolder, lastClientId, false, expireLeasesPageSize, "", func(entry *Entry) bool {
+			count++
+			lastClientId = entry.Name()
+			if leaseExpiry(entry).Before(now) {
+				expired = append(expired, entry.Name())
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if count < expireLeasesPageSize {
+			break
+		}
+	}
+
+	for _, clientId := range expired {
+		err := lm.withMasterLock(ctx, leaseLockName(clientId), func() error {
+			// another filer may have renewed or released the lease meanwhile
+			expiry, _, err := lm.loadLease(ctx, clientId)
+			if err != nil || expiry.IsZero() || !expiry.Before(now) {
+				return err
+			}
+			glog.V(0).Infof("lock lease expired for client %s", clientId)
+			return lm.releaseClient(ctx, clientId)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withMasterLock runs fn while holding the named lock on the leader master.
+// The master lock expires unless released in 10 seconds, which is much longer than fn takes.
+func (lm *LockManager) withMasterLock(ctx context.Context, name string, fn func() error) error {
+	if !lm.useMasterLocks {
+		return fn()
+	}
+
+	var token int64
+	deadline := time.Now().Add(masterLockTimeout)
+	for {
+		err := lm.filer.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+			resp, err := client.LeaseAdminToken(ctx, &master_pb.LeaseAdminTokenRequest{
+				LockName:   masterLockPrefix + name,
+				ClientName: "filer",
+			})
+			if err != nil {
+				return err
+			}
+			token = resp.Token
+			return nil
+		})
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("lock %s on master: %v", name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(masterLockRetryInterval):
+		}
+	}
+
+	defer func() {
+		err := lm.filer.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+			_, err := client.ReleaseAdminToken(ctx, &master_pb.ReleaseAdminTokenRequest{
+				PreviousToken: token,
+				LockName:      masterLockPrefix + name,
+			})
+			return err
+		})
+		if err != nil {
+			glog.V(0).Infof("release lock %s on master: %v", name, err)
+		}
+	}()
+
+	return fn()
+}
+
+// renewLease extends the lease of the client, and records the path it locks.
+// It needs the master lock of the lease.
+func (lm *LockManager) renewLease(ctx context.Context, clientId string, leaseSec int, p FullPath) error {
+	if leaseSec <= 0 {
+		leaseSec = DefaultLockLeaseSec
+	}
+
+	_, paths, err := lm.loadLease(ctx, clientId)
+	if err != nil {
+		return err
+	}
+	if p != "" && !containsPath(paths, p) {
+		paths = append(paths, p)
+	}
+	pathsBlob, err := json.Marshal(paths)
+	if err != nil {
+		return err
+	}
+
+	expiry := time.Now().Add(time.Duration(leaseSec) * time.Second)
+	return lm.filer.saveSystemEntry(ctx, NewFullPath(lockLeaseFolder, clientId), map[string][]byte{
+		extendedLeaseExpiry: []byte(strconv.FormatInt(expiry.UnixNano(), 10)),
+		extendedLeasePaths:  pathsBlob,
+	})
+}
+
+// releaseClient needs the master lock of the lease
+func (lm *LockManager) releaseClient(ctx context.Context, clientId string) error {
+	_, paths, err := lm.loadLease(ctx, clientId)
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		err := lm.withMasterLock(ctx, locksLockName(p), func() error {
+			locks, err := lm.loadLocks(ctx, p)
+			if err != nil {
+				return err
+			}
+			var kept []*filer_pb.FileLock
+			for _, l := range locks {
+				if l.ClientId != clientId {
+					kept = append(kept, l)
+				}
+			}
+			return lm.saveLocks(ctx, p, kept)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return lm.filer.deleteSystemEntry(ctx, NewFullPath(lockLeaseFolder, clientId))
+}
+
+// loadLease returns the lease expiry, which is zero if the client has no lease, and the paths locked by the client
+func (lm *LockManager) loadLease(ctx context.Context, clientId string) (expiry time.Time, paths []FullPath, err error) {
+	entry, err := lm.filer.store.FindEntry(ctx, NewFullPath(lockLeaseFolder, clientId))
+	if err == ErrNotFound {
+		return expiry, nil, nil
+	}
+	if err != nil {
+		return expiry, nil, fmt.Errorf("find lock lease of %s: %v", clientId, err)
+	}
+	if blob := entry.Extended[extendedLeasePaths]; len(blob) > 0 {
+		if err = json.Unmarshal(blob, &paths); err != nil {
+			return expiry, nil, fmt.Errorf("decode lock lease of %s: %v", clientId, err)
+		}
+	}
+	return leaseExpiry(entry), paths, nil
+}
+
+func leaseExpiry(entry *Entry) time.Time {
+	nanos, _ := strconv.ParseInt(string(entry.Extended[extendedLeaseExpiry]), 10, 64)
+	return time.Unix(0, nanos)
+}
+
+func (lm *LockManager) loadLocks(ctx context.Context, p FullPath) (locks []*filer_pb.FileLock, err error) {
+	entry, err := lm.filer.store.FindEntry(ctx, lockEntryPath(p))
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find locks of %s: %v", p, err)
+	}
+	if err = json.Unmarshal(entry.Extended[extendedLocks], &locks); err != nil {
+		return nil, fmt.Errorf("decode locks of %s: %v", p, err)
+	}
+	return
+}
+
+// loadLiveLocks skips the locks of the clients whose lease has expired
+func (lm *LockManager) loadLiveLocks(ctx context.Context, p FullPath) (live []*filer_pb.FileLock, err error) {
+	locks, err := lm.loadLocks(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	isLive := make(map[string]bool)
+	for _, l := range locks {
+		alive, found := isLive[l.ClientId]
+		if !found {
+			expiry, _, err := lm.loadLease(ctx, l.ClientId)
+			if err != nil {
+				return nil, err
+			}
+			alive = expiry.After(now)
+			isLive[l.ClientId] = alive
+		}
+		if alive {
+			live = append(live, l)
+		}
+	}
+	return
+}
+
+func (lm *LockManager) saveLocks(ctx context.Context, p FullPath, locks []*filer_pb.FileLock) error {
+	if len(locks) == 0 {
+		return lm.filer.deleteSystemEntry(ctx, lockEntryPath(p))
+	}
+	blob, err := json.Marshal(locks)
+	if err != nil {
+		return err
+	}
+	return lm.filer.saveSystemEntry(ctx, lockEntryPath(p), map[string][]byte{extendedLocks: blob})
+}
+
+func leaseLockName(clientId string) string {
+	return "lease:" + clientId
+}
+
+func locksLockName(p FullPath) string {
+	return "path:" + string(p)
+}
+
+func lockEntryPath(p FullPath) FullPath {
+	return FullPath(lockFolder + string(p))
+}
+
+func containsPath(paths []FullPath, p FullPath) bool {
+	for _, x := range paths {
+		if x == p {
+			return true
+		}
+	}
+	return false
+}
+
+func findConflict(locks []*filer_pb.FileLock, lock *filer_pb.FileLock) *filer_pb.FileLock {
+	for _, l := range locks {
+		if l.IsFlock != lock.IsFlock {
+			// flock and POSIX locks do not interact, as on Linux
+			continue
+		}
+		if isSameOwner(l, lock) {
+			continue
+		}
+		if !l.IsExclusive && !lock.IsExclusive {
+			continue
+		}
+		if isOverlapped(l, lock) {
+			return l
+		}
+	}
+	return nil
+}
+
+func isSameOwner(a, b *filer_pb.FileLock) bool {
+	return a.ClientId == b.ClientId && a.Owner == b.Owner && a.IsFlock == b.IsFlock
+}
+
+func lockEnd(l *filer_pb.FileLock) uint64 {
+	if l.End == 0 || l.IsFlock {
+		return ^uint64(0)
+	}
+	return l.End
+}
+
+func isOverlapped(a, b *filer_pb.FileLock) bool {
+	return a.Start < lockEnd(b) && b.Start < lockEnd(a)
+}
+
+// removeOwnerRange cuts the range of lock out of the locks held by the same owner,
+// splitting locks that extend beyond the range.
+func removeOwnerRange(locks []*filer_pb.FileLock, lock *filer_pb.FileLock) (kept []*filer_pb.FileLock) {
+	start, stop := lock.Start, lockEnd(lock)
+	for _, l := range locks {
+		if !isSameOwner(l, lock) || !isOverlapped(l, lock) {
+			kept = append(kept, l)
+			continue
+		}
+		if l.Start < start {
+			head := *l
+			head.End = start
+			kept = append(kept, &head)
+		}
+		if lockEnd(l) > stop {
+			tail := *l
+			tail.Start = stop
+			kept = append(kept, &tail)
+		}
+	}
+	return
+}
@@ -80,9 +80,38 @@ func (store *MemDbStore) DeleteEntry(ctx context.Context, fullpath filer2.FullPa
 	return nil
 }
 
-func (store *MemDbStore) ListDirectoryEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool, limit int) (entries []*filer2.Entry, err error) {
+func (store *MemDbStore) DeleteFolderChildren(ctx context.Context, fullpath filer2.FullPath, eachDeletedFunc filer2.EachDeletedEntryFunc) (err error) {
+	subPathPrefix := strings.TrimSuffix(string(fullpath), "/") + "/"
+
+	store.treeLock.Lock()
+	defer store.treeLock.Unlock()
+
+	var items []btree.Item
+	store.tree.AscendGreaterOrEqual(entryItem{&filer2.Entry{FullPath: filer2.FullPath(subPathPrefix)}},
+		func(item btree.Item) bool {
+			if !strings.HasPrefix(string(item.(entryItem).FullPath), subPathPrefix) {
+				return false
+			}
+			items = append(items, item)
+			return true
+		},
+	)
+	for _, item := range items {
+		store.tree.Delete(item)
+		if eachDeletedFunc != nil {
+			eachDeletedFunc(item.(entryItem).Entry)
+		}
+	}
+
+	return nil
+}
+
+func (store *MemDbStore) ListDirectoryPrefixedEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool, limit int, prefix string, eachEntryFunc filer2.ListEachEntryFunc) (err error) {
 
 	startFrom := string(fullpath)
+	if startFileName < prefix {
+		startFileName, inclusive = prefix, true
+	}
 	if startFileName != "" {
 		startFrom = startFrom + "/" + startFileName
 	}
@@ -101,32 +130,37 @@ func (store *MemDbStore) ListDirectoryEntries(ctx context.Context, fullpath file
 				return true
 			}
 
-			dir, name := entry.FullPath.DirAndName()
-			if name == startFileName {
-				if inclusive {
-					limit--
-					entries = append(entries, entry)
-				}
-				return true
-			}
-
 			// only iterate the same prefix
 			if !strings.HasPrefix(string(entry.FullPath), string(fullpath)) {
 				// println("breaking from", entry.FullPath)
 				return false
 			}
 
+			dir, name := entry.FullPath.DirAndName()
 			if dir != string(fullpath) {
 				// this could be items in deeper directories
 				// println("skipping deeper folder", entry.FullPath)
 				return true
 			}
+
+			if name == startFileName {
+				if inclusive {
+					limit--
+					return eachEntryFunc(entry)
+				}
+				return true
+			}
+
+			// the names are visited in order, so no more names can start with the prefix
+			if !strings.HasPrefix(name, prefix) {
+				return false
+			}
+
 			// now process the directory items
 			// println("adding entry", entry.FullPath)
 			limit--
-			entries = append(entries, entry)
-			return true
+			return eachEntryFunc(entry)
 		},
 	)
-	return entries, nil
+	return nil
 }
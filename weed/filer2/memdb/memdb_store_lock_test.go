@@ -0,0 +1,136 @@
+package memdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func newLockTestFiler() *filer2.Filer {
+	filer := filer2.NewFiler(nil, nil)
+	store := &MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	return filer
+}
+
+func TestLockConflicts(t *testing.T) {
+
+	lm := newLockTestFiler().LockManager
+	ctx := context.Background()
+	p := filer2.FullPath("/a/b.db")
+
+	acquire := func(lock *filer_pb.FileLock) *filer_pb.FileLock {
+		conflict, err := lm.Acquire(ctx, p, lock, 0)
+		if err != nil {
+			t.Fatalf("acquire %+v: %v", lock, err)
+		}
+		return conflict
+	}
+	test := func(lock *filer_pb.FileLock) *filer_pb.FileLock {
+		conflict, err := lm.Test(ctx, p, lock)
+		if err != nil {
+			t.Fatalf("test %+v: %v", lock, err)
+		}
+		return conflict
+	}
+
+	shared1 := &filer_pb.FileLock{ClientId: "m1", Owner: 1, Start: 0, End: 100}
+	shared2 := &filer_pb.FileLock{ClientId: "m2", Owner: 1, Start: 50, End: 150}
+	if conflict := acquire(shared1); conflict != nil {
+		t.Fatalf("unexpected conflict %+v", conflict)
+	}
+	if conflict := acquire(shared2); conflict != nil {
+		t.Fatalf("shared locks should not conflict: %+v", conflict)
+	}
+
+	exclusive := &filer_pb.FileLock{ClientId: "m3", Owner: 1, Start: 120, End: 0, IsExclusive: true}
+	if conflict := acquire(exclusive); conflict == nil || conflict.ClientId != "m2" {
+		t.Fatalf("expected conflict with m2, got %+v", conflict)
+	}
+
+	// flock does not interact with POSIX locks
+	flock := &filer_pb.FileLock{ClientId: "m3", Owner: 7, IsExclusive: true, IsFlock: true}
+	if conflict := acquire(flock); conflict != nil {
+		t.Fatalf("unexpected flock conflict %+v", conflict)
+	}
+
+	if err := lm.Release(ctx, p, &filer_pb.FileLock{ClientId: "m2", Owner: 1, Start: 100, End: 0}); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if conflict := acquire(exclusive); conflict != nil {
+		t.Fatalf("unexpected conflict after partial unlock %+v", conflict)
+	}
+	if conflict := test(&filer_pb.FileLock{ClientId: "m4", Start: 60, End: 70, IsExclusive: true}); conflict == nil {
+		t.Fatalf("expected remaining range [50,100) of m2 to be locked")
+	}
+
+	if count, err := lm.KeepLease(ctx, "m3", 30); err != nil || count != 2 {
+		t.Fatalf("expected 2 locks for m3, got %d: %v", count, err)
+	}
+	if _, err := lm.KeepLease(ctx, "m3", 0); err != nil {
+		t.Fatalf("release lease: %v", err)
+	}
+	if conflict := test(&filer_pb.FileLock{ClientId: "m4", Start: 200, IsExclusive: true}); conflict != nil {
+		t.Fatalf("locks of m3 should be released: %+v", conflict)
+	}
+}
+
+func TestLockSplitsOwnRange(t *testing.T) {
+
+	lm := newLockTestFiler().LockManager
+	ctx := context.Background()
+	p := filer2.FullPath("/x")
+
+	lm.Acquire(ctx, p, &filer_pb.FileLock{ClientId: "m1", Owner: 1, Start: 0, End: 100, IsExclusive: true}, 0)
+	lm.Acquire(ctx, p, &filer_pb.FileLock{ClientId: "m1", Owner: 1, Start: 40, End: 60}, 0)
+
+	if conflict, _ := lm.Test(ctx, p, &filer_pb.FileLock{ClientId: "m2", Start: 45, End: 55}); conflict != nil {
+		t.Fatalf("downgraded range should allow shared lock: %+v", conflict)
+	}
+	if conflict, _ := lm.Test(ctx, p, &filer_pb.FileLock{ClientId: "m2", Start: 10, End: 20}); conflict == nil || conflict.End != 40 {
+		t.Fatalf("head range [0,40) should still be exclusive: %+v", conflict)
+	}
+	if conflict, _ := lm.Test(ctx, p, &filer_pb.FileLock{ClientId: "m2", Start: 70, End: 80}); conflict == nil || conflict.Start != 60 {
+		t.Fatalf("tail range [60,100) should still be exclusive: %+v", conflict)
+	}
+}
+
+func TestLocksSurviveFilerRestart(t *testing.T) {
+
+	ctx := context.Background()
+	p := filer2.FullPath("/a/b.db")
+	store := &MemDbStore{}
+	store.Initialize(nil)
+
+	filer1 := filer2.NewFiler(nil, nil)
+	filer1.SetStore(store)
+	lock := &filer_pb.FileLock{ClientId: "m1", Owner: 1, IsExclusive: true}
+	if conflict, err := filer1.LockManager.Acquire(ctx, p, lock, 30); conflict != nil || err != nil {
+		t.Fatalf("acquire: %+v %v", conflict, err)
+	}
+
+	// another filer on the same store sees the lock
+	secondFiler := filer2.NewFiler(nil, nil)
+	secondFiler.SetStore(store)
+	other := &filer_pb.FileLock{ClientId: "m2", Owner: 1, Start: 10, End: 20}
+	if conflict, err := secondFiler.LockManager.Test(ctx, p, other); conflict == nil || err != nil {
+		t.Fatalf("expected conflict with m1, got %+v %v", conflict, err)
+	}
+
+	// the lock is not visible in the file tree
+	entries, err := secondFiler.ListDirectoryEntries(ctx, "/", "", true, 100)
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("unexpected entries %+v %v", entries, err)
+	}
+
+	// the lock is ignored once the lease expires
+	if _, err := secondFiler.LockManager.KeepLease(ctx, "m1", 0); err != nil {
+		t.Fatalf("release lease: %v", err)
+	}
+	if conflict, err := secondFiler.LockManager.Acquire(ctx, p, other, 30); conflict != nil || err != nil {
+		t.Fatalf("expected no conflict after lease release, got %+v %v", conflict, err)
+	}
+}
@@ -0,0 +1,132 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+)
+
+func TestStoreMigration(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	source := &MemDbStore{}
+	source.Initialize(nil)
+	filer.SetStore(source)
+	filer.DisableDirectoryCache()
+
+	ctx := context.Background()
+
+	for i := 0; i < 50; i++ {
+		createFile(t, filer, fmt.Sprintf("/home/chris/dir%d/file%d.jpg", i%5, i))
+	}
+
+	target := &MemDbStore{}
+	target.Initialize(nil)
+	if err := filer.StartStoreMigration(target); err != nil {
+		t.Fatalf("start migration: %v", err)
+	}
+	if err := filer.StartStoreMigration(target); err == nil {
+		t.Errorf("started migration twice")
+	}
+
+	// changes during the migration go to both stores
+	createFile(t, filer, "/home/chris/new/file.txt")
+	if err := filer.DeleteEntryMetaAndData(ctx, "/home/chris/dir0", true, false, false); err != nil {
+		t.Fatalf("delete folder: %v", err)
+	}
+
+	status := waitForStoreMigration(t, filer)
+	if status.State != filer2.StoreMigrationBackfilled {
+		t.Fatalf("backfill: %+v", status)
+	}
+
+	if _, err := filer.CutOverStoreMigration(); err == nil {
+		t.Errorf("cut over before verification")
+	}
+
+	if err := filer.VerifyStoreMigration(); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	status = waitForStoreMigration(t, filer)
+	if status.State != filer2.StoreMigrationVerified {
+		t.Fatalf("verify: %+v", status)
+	}
+	if status.SourceEntryCount != status.TargetEntryCount || status.SourceChecksum != status.TargetChecksum {
+		t.Errorf("verified stores differ: %+v", status)
+	}
+
+	// an entry changed only in the target is detected
+	original, _ := target.FindEntry(ctx, "/home/chris/dir1/file1.jpg")
+	changed := *original
+	changed.Uid = 42
+	target.UpdateEntry(ctx, &changed)
+	if err := filer.VerifyStoreMigration(); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	status = waitForStoreMigration(t, filer)
+	if status.State != filer2.StoreMigrationMismatched || status.MismatchCount != 1 {
+		t.Fatalf("verify changed entry: %+v", status)
+	}
+	target.UpdateEntry(ctx, original)
+	if err := filer.VerifyStoreMigration(); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	waitForStoreMigration(t, filer)
+
+	if _, err := filer.CutOverStoreMigration(); err != nil {
+		t.Fatalf("cut over: %v", err)
+	}
+	if filer.StoreMigrationStatus() != nil {
+		t.Errorf("migration is still running after the cut over")
+	}
+
+	// the filer reads and writes only the target store now
+	createFile(t, filer, "/home/chris/after/cutover.txt")
+	if _, err := target.FindEntry(ctx, "/home/chris/after/cutover.txt"); err != nil {
+		t.Errorf("find in target: %v", err)
+	}
+	if _, err := source.FindEntry(ctx, "/home/chris/after/cutover.txt"); err != filer2.ErrNotFound {
+		t.Errorf("written to the source after cut over: %v", err)
+	}
+	if _, err := filer.FindEntry(ctx, "/home/chris/new/file.txt"); err != nil {
+		t.Errorf("find entry created during migration: %v", err)
+	}
+	if _, err := filer.FindEntry(ctx, "/home/chris/dir0/file0.jpg"); err != filer2.ErrNotFound {
+		t.Errorf("deleted entry is copied: %v", err)
+	}
+	if _, err := filer.FindEntry(ctx, "/home/chris/dir4/file49.jpg"); err != nil {
+		t.Errorf("find copied entry: %v", err)
+	}
+}
+
+func createFile(t *testing.T, filer *filer2.Filer, fullpath string) {
+	entry := &filer2.Entry{
+		FullPath: filer2.FullPath(fullpath),
+		Attr: filer2.Attr{
+			Mode:  0440,
+			Uid:   1234,
+			Gid:   5678,
+			Mtime: time.Now(),
+		},
+	}
+	if err := filer.CreateEntry(context.Background(), entry); err != nil {
+		t.Fatalf("create entry %v: %v", entry.FullPath, err)
+	}
+}
+
+func waitForStoreMigration(t *testing.T, filer *filer2.Filer) filer2.StoreMigrationStatus {
+	for i := 0; i < 500; i++ {
+		status := filer.StoreMigrationStatus()
+		if status == nil {
+			t.Fatalf("no migration")
+		}
+		if status.State != filer2.StoreMigrationBackfilling && status.State != filer2.StoreMigrationVerifying {
+			return *status
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("migration is not done")
+	return filer2.StoreMigrationStatus{}
+}
@@ -105,6 +105,17 @@ func TestCreateFileAndList(t *testing.T) {
 		return
 	}
 
+	// checking the prefix
+	var prefixed []*filer2.Entry
+	err = filer.ListDirectoryPrefixedEntries(ctx, filer2.FullPath("/home/chris/this/is/one/"), "", false, 100, "file2", func(entry *filer2.Entry) bool {
+		prefixed = append(prefixed, entry)
+		return true
+	})
+	if err != nil || len(prefixed) != 1 || prefixed[0].FullPath != entry2.FullPath {
+		t.Errorf("list prefixed entries: %v %v", prefixed, err)
+		return
+	}
+
 	// checking one upper directory
 	entries, _ = filer.ListDirectoryEntries(ctx, filer2.FullPath("/home/chris/this/is"), "", false, 100)
 	if len(entries) != 1 {
@@ -147,3 +158,42 @@ func TestCreateFileAndList(t *testing.T) {
 	}
 
 }
+
+func TestDeleteFolderChildren(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx := context.Background()
+
+	for _, path := range []string{"/home/chris/a/1.jpg", "/home/chris/a/b/2.jpg", "/home/chris/a-b/3.jpg"} {
+		entry := &filer2.Entry{
+			FullPath: filer2.FullPath(path),
+			Attr: filer2.Attr{
+				Mode: 0440,
+			},
+		}
+		if err := filer.CreateEntry(ctx, entry); err != nil {
+			t.Errorf("create entry %v: %v", entry.FullPath, err)
+			return
+		}
+	}
+
+	if err := filer.DeleteEntryMetaAndData(ctx, filer2.FullPath("/home/chris/a"), true, false, false); err != nil {
+		t.Errorf("delete folder: %v", err)
+		return
+	}
+
+	for _, path := range []string{"/home/chris/a", "/home/chris/a/1.jpg", "/home/chris/a/b", "/home/chris/a/b/2.jpg"} {
+		if _, err := filer.FindEntry(ctx, filer2.FullPath(path)); err != filer2.ErrNotFound {
+			t.Errorf("entry %s is not deleted: %v", path, err)
+		}
+	}
+
+	entries, _ := filer.ListDirectoryEntries(ctx, filer2.FullPath("/home/chris"), "", false, 100)
+	if len(entries) != 1 || entries[0].FullPath != "/home/chris/a-b" {
+		t.Errorf("unexpected entries after deletion: %v", entries)
+	}
+}
@@ -0,0 +1,83 @@
+package memdb
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+)
+
+func TestExpiredEntries(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	// the folder ttl is the default for the new files, including those in new sub folders
+	if err := filer.CreateEntry(ctx, &filer2.Entry{
+		FullPath: "/logs",
+		Attr:     filer2.Attr{Mode: os.ModeDir | 0755, Crtime: now.Add(-time.Hour), TtlSec: 60},
+	}); err != nil {
+		t.Fatalf("create folder: %v", err)
+	}
+	for _, p := range []string{"/logs/a.log", "/logs/2020/b.log"} {
+		if err := filer.CreateEntry(ctx, &filer2.Entry{
+			FullPath: filer2.FullPath(p),
+			Attr:     filer2.Attr{Mode: 0644, Crtime: now.Add(-2 * time.Minute)},
+		}); err != nil {
+			t.Fatalf("create %s: %v", p, err)
+		}
+	}
+	if err := filer.CreateEntry(ctx, &filer2.Entry{
+		FullPath: "/logs/c.log",
+		Attr:     filer2.Attr{Mode: 0644, Crtime: now, TtlSec: 3600},
+	}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if ttlSec := filer.DirectoryTtlSec(ctx, "/logs/2020"); ttlSec != 60 {
+		t.Errorf("sub folder ttl %d", ttlSec)
+	}
+
+	// expired entries are not found, nor listed
+	if _, err := filer.FindEntry(ctx, "/logs/a.log"); err != filer2.ErrNotFound {
+		t.Errorf("found expired entry: %v", err)
+	}
+	if entry, err := filer.FindEntry(ctx, "/logs/c.log"); err != nil || entry.TtlSec != 3600 {
+		t.Errorf("find c.log: %+v %v", entry, err)
+	}
+	entries, err := filer.ListDirectoryEntries(ctx, "/logs", "", false, 1)
+	if err != nil || len(entries) != 1 || entries[0].Name() != "2020" {
+		t.Errorf("list: %+v %v", entries, err)
+	}
+	entries, _ = filer.ListDirectoryEntries(ctx, "/logs", "2020", false, 1)
+	if len(entries) != 1 || entries[0].Name() != "c.log" {
+		t.Errorf("list after 2020: %+v", entries)
+	}
+
+	// the sweeper deletes them from the store
+	count, err := filer.DeleteExpiredEntries(ctx)
+	if err != nil || count != 2 {
+		t.Errorf("deleted %d: %v", count, err)
+	}
+	entries, _ = filer.ListDirectoryRawEntries(ctx, "/logs/2020", "", false, 100)
+	if len(entries) != 0 {
+		t.Errorf("expired entries are kept: %+v", entries)
+	}
+	if _, err := filer.FindEntry(ctx, "/logs/c.log"); err != nil {
+		t.Errorf("deleted c.log: %v", err)
+	}
+
+	// only the files not due yet are left in the ttl index
+	entries, _ = filer.ListDirectoryRawEntries(ctx, "/.seaweedfs/ttl", "", false, 100)
+	if len(entries) != 1 || !strings.HasSuffix(entries[0].Name(), url.PathEscape("/logs/c.log")) {
+		t.Errorf("ttl index: %+v", entries)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/go-redis/redis"
-	"sort"
 	"strings"
 	"time"
 )
@@ -36,7 +35,13 @@ func (store *UniversalRedisStore) InsertEntry(ctx context.Context, entry *filer2
 		return fmt.Errorf("encoding %s %+v: %v", entry.FullPath, entry.Attr, err)
 	}
 
-	_, err = store.Client.Set(string(entry.FullPath), value, time.Duration(entry.TtlSec)*time.Second).Result()
+	// the ttl of a folder is only the default for its files
+	expiration := time.Duration(entry.TtlSec) * time.Second
+	if entry.IsDirectory() {
+		expiration = 0
+	}
+
+	_, err = store.Client.Set(string(entry.FullPath), value, expiration).Result()
 
 	if err != nil {
 		return fmt.Errorf("persisting %s : %v", entry.FullPath, err)
@@ -44,7 +49,10 @@ func (store *UniversalRedisStore) InsertEntry(ctx context.Context, entry *filer2
 
 	dir, name := entry.FullPath.DirAndName()
 	if name != "" {
-		_, err = store.Client.SAdd(genDirectoryListKey(dir), name).Result()
+		dirListKey := genDirectoryListKey(dir)
+		err = store.withDirectoryList(dirListKey, func() error {
+			return store.Client.ZAdd(dirListKey, redis.Z{Member: name}).Err()
+		})
 		if err != nil {
 			return fmt.Errorf("persisting %s in parent dir: %v", entry.FullPath, err)
 		}
@@ -90,7 +98,10 @@ func (store *UniversalRedisStore) DeleteEntry(ctx context.Context, fullpath file
 
 	dir, name := fullpath.DirAndName()
 	if name != "" {
-		_, err = store.Client.SRem(genDirectoryListKey(dir), name).Result()
+		dirListKey := genDirectoryListKey(dir)
+		err = store.withDirectoryList(dirListKey, func() error {
+			return store.Client.ZRem(dirListKey, name).Err()
+		})
 		if err != nil {
 			return fmt.Errorf("delete %s in parent dir: %v", fullpath, err)
 		}
@@ -99,53 +110,156 @@ func (store *UniversalRedisStore) DeleteEntry(ctx context.Context, fullpath file
 	return nil
 }
 
-func (store *UniversalRedisStore) ListDirectoryEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
-	limit int) (entries []*filer2.Entry, err error) {
+func (store *UniversalRedisStore) DeleteFolderChildren(ctx context.Context, fullpath filer2.FullPath, eachDeletedFunc filer2.EachDeletedEntryFunc) (err error) {
 
-	members, err := store.Client.SMembers(genDirectoryListKey(string(fullpath))).Result()
-	if err != nil {
-		return nil, fmt.Errorf("list %s : %v", fullpath, err)
-	}
+	// the entries and directory lists under the folder all start with "<dir>/"
+	subPathPrefix := strings.TrimSuffix(string(fullpath), "/") + "/"
+	match := escapeGlobPattern(subPathPrefix) + "*"
 
-	// skip
-	if startFileName != "" {
-		var t []string
-		for _, m := range members {
-			if strings.Compare(m, startFileName) >= 0 {
-				if m == startFileName {
-					if inclusive {
-						t = append(t, m)
+	err = store.forEachMaster(func(client redis.Cmdable) error {
+		var cursor uint64
+		for {
+			keys, nextCursor, err := client.Scan(cursor, match, 1024).Result()
+			if err != nil {
+				return err
+			}
+			// delete one key per command, since the keys can be in different cluster slots
+			if len(keys) > 0 {
+				gets := make(map[string]*redis.StringCmd)
+				_, err = store.Client.Pipelined(func(pipe redis.Pipeliner) error {
+					for _, key := range keys {
+						// read the entry in the same pipeline, so exactly the deleted entries are reported
+						if eachDeletedFunc != nil && !strings.HasSuffix(key, DIR_LIST_MARKER) {
+							gets[key] = pipe.Get(key)
+						}
+						pipe.Del(key)
 					}
-				} else {
-					t = append(t, m)
+					return nil
+				})
+				if err != nil && err != redis.Nil {
+					return err
 				}
+				for key, get := range gets {
+					data, getErr := get.Result()
+					if getErr != nil {
+						continue
+					}
+					entry := &filer2.Entry{
+						FullPath: filer2.FullPath(key),
+					}
+					if decodeErr := entry.DecodeAttributesAndChunks([]byte(data)); decodeErr == nil {
+						eachDeletedFunc(entry)
+					}
+				}
+			}
+			if cursor = nextCursor; cursor == 0 {
+				return nil
 			}
 		}
-		members = t
+	})
+	if err != nil {
+		return fmt.Errorf("delete folder children %s : %v", fullpath, err)
 	}
 
-	// sort
-	sort.Slice(members, func(i, j int) bool {
-		return strings.Compare(members[i], members[j]) < 0
-	})
+	if _, err = store.Client.Del(genDirectoryListKey(string(fullpath))).Result(); err != nil {
+		return fmt.Errorf("delete folder children %s : %v", fullpath, err)
+	}
+
+	return nil
+}
 
-	// limit
-	if limit < len(members) {
-		members = members[:limit]
+// forEachMaster runs fn on every master node of a cluster, since SCAN only covers one node
+func (store *UniversalRedisStore) forEachMaster(fn func(client redis.Cmdable) error) error {
+	if clusterClient, ok := store.Client.(*redis.ClusterClient); ok {
+		return clusterClient.ForEachMaster(func(client *redis.Client) error {
+			return fn(client)
+		})
+	}
+	return fn(store.Client)
+}
+
+func escapeGlobPattern(pattern string) string {
+	return strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`).Replace(pattern)
+}
+
+func (store *UniversalRedisStore) ListDirectoryPrefixedEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
+	limit int, prefix string, eachEntryFunc filer2.ListEachEntryFunc) (err error) {
+
+	if startFileName < prefix {
+		startFileName, inclusive = prefix, true
+	}
+	min := "-"
+	if startFileName != "" {
+		min = "(" + startFileName
+		if inclusive {
+			min = "[" + startFileName
+		}
+	}
+
+	dirListKey := genDirectoryListKey(string(fullpath))
+	var members []string
+	err = store.withDirectoryList(dirListKey, func() (err error) {
+		members, err = store.Client.ZRangeByLex(dirListKey, redis.ZRangeBy{Min: min, Max: "+", Count: int64(limit)}).Result()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("list %s : %v", fullpath, err)
 	}
 
 	// fetch entry meta
 	for _, fileName := range members {
+		// the members are sorted, so no more names can start with the prefix
+		if !strings.HasPrefix(fileName, prefix) {
+			break
+		}
 		path := filer2.NewFullPath(string(fullpath), fileName)
 		entry, err := store.FindEntry(ctx, path)
 		if err != nil {
 			glog.V(0).Infof("list %s : %v", path, err)
-		} else {
-			entries = append(entries, entry)
+			continue
+		}
+		if !eachEntryFunc(entry) {
+			break
 		}
 	}
 
-	return entries, err
+	return nil
+}
+
+// withDirectoryList converts the directory list from the set used by earlier versions
+// to the sorted set, the first time it is accessed
+func (store *UniversalRedisStore) withDirectoryList(dirListKey string, fn func() error) error {
+	err := fn()
+	if err == nil || !strings.HasPrefix(err.Error(), "WRONGTYPE") {
+		return err
+	}
+
+	members, err := store.Client.SMembers(dirListKey).Result()
+	if err != nil {
+		return fmt.Errorf("read directory list %s: %v", dirListKey, err)
+	}
+	glog.V(0).Infof("convert directory list %s with %d members to sorted set", dirListKey, len(members))
+	_, err = store.Client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Del(dirListKey)
+		for len(members) > 0 {
+			batch := members
+			if len(batch) > 1024 {
+				batch = batch[:1024]
+			}
+			members = members[len(batch):]
+			var zs []redis.Z
+			for _, member := range batch {
+				zs = append(zs, redis.Z{Member: member})
+			}
+			pipe.ZAdd(dirListKey, zs...)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("convert directory list %s: %v", dirListKey, err)
+	}
+
+	return fn()
 }
 
 func genDirectoryListKey(dir string) (dirList string) {
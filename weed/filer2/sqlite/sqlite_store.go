@@ -0,0 +1,154 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"path/filepath"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/filer2/abstract_sql"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	weed_util "github.com/chrislusf/seaweedfs/weed/util"
+	_ "modernc.org/sqlite"
+)
+
+const (
+	DB_FILE_NAME = "filer.db"
+)
+
+func init() {
+	filer2.Stores = append(filer2.Stores, &SqliteStore{})
+}
+
+type SqliteStore struct {
+	abstract_sql.AbstractSqlStore
+}
+
+func (store *SqliteStore) GetName() string {
+	return "sqlite"
+}
+
+func (store *SqliteStore) Initialize(configuration weed_util.Configuration) (err error) {
+	dir := configuration.GetString("dir")
+	return store.initialize(dir)
+}
+
+func (store *SqliteStore) initialize(dir string) (err error) {
+	glog.Infof("filer store sqlite dir: %s", dir)
+	if err := weed_util.TestFolderWritable(dir); err != nil {
+		return fmt.Errorf("Check Sqlite Folder %s Writable: %s", dir, err)
+	}
+
+	// sqlite has no default escape character for LIKE
+	store.SqlInsert = "INSERT INTO filemeta (dirhash,name,directory,meta) VALUES(?,?,?,?)"
+	store.SqlUpdate = "UPDATE filemeta SET meta=? WHERE dirhash=? AND name=? AND directory=?"
+	store.SqlFind = "SELECT meta FROM filemeta WHERE dirhash=? AND name=? AND directory=?"
+	store.SqlDelete = "DELETE FROM filemeta WHERE dirhash=? AND name=? AND directory=?"
+	store.SqlDeleteFolderChildren = "DELETE FROM filemeta WHERE dirhash=? AND directory=?"
+	store.SqlListExclusive = "SELECT NAME, meta FROM filemeta WHERE dirhash=? AND name>? AND directory=? AND name LIKE ? ESCAPE '\\' ORDER BY NAME ASC LIMIT ?"
+	store.SqlListInclusive = "SELECT NAME, meta FROM filemeta WHERE dirhash=? AND name>=? AND directory=? AND name LIKE ? ESCAPE '\\' ORDER BY NAME ASC LIMIT ?"
+
+	dbFile := filepath.Join(dir, DB_FILE_NAME)
+	db, err := sql.Open("sqlite", dbFile)
+	if err != nil {
+		return fmt.Errorf("open sqlite %s: %v", dbFile, err)
+	}
+	// the pragmas are set on every connection the pool opens, including the reopened ones
+	store.DB = sql.OpenDB(&sqliteConnector{driver: db.Driver(), dsn: dbFile})
+	db.Close()
+
+	// sqlite allows only one writer, and the driver does not wait for the lock,
+	// so all requests share one connection instead of failing with SQLITE_BUSY
+	store.DB.SetMaxOpenConns(1)
+
+	for _, statement := range []string{
+		"PRAGMA journal_mode=WAL",
+		`CREATE TABLE IF NOT EXISTS filemeta (
+			dirhash     BIGINT,
+			name        VARCHAR(1000),
+			directory   TEXT,
+			meta        BLOB,
+			PRIMARY KEY (dirhash, name)
+		)`,
+	} {
+		if _, err = store.DB.Exec(statement); err != nil {
+			store.DB.Close()
+			return fmt.Errorf("initialize sqlite %s: %v", dbFile, err)
+		}
+	}
+
+	return nil
+}
+
+// BeginTransaction uses the default isolation level, the only one the driver supports,
+// which is serializable in sqlite
+func (store *SqliteStore) BeginTransaction(ctx context.Context) (context.Context, error) {
+	tx, err := store.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return ctx, err
+	}
+
+	return context.WithValue(ctx, "tx", tx), nil
+}
+
+// ListDirectoryPrefixedEntries reads the entries before visiting them,
+// so the only connection is not held while the entries are being processed.
+// The driver reports an empty result set as sql.ErrNoRows.
+func (store *SqliteStore) ListDirectoryPrefixedEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool, limit int, prefix string, eachEntryFunc filer2.ListEachEntryFunc) (err error) {
+	var entries []*filer2.Entry
+	err = store.AbstractSqlStore.ListDirectoryPrefixedEntries(ctx, fullpath, startFileName, inclusive, limit, prefix, func(entry *filer2.Entry) bool {
+		entries = append(entries, entry)
+		return true
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	for _, entry := range entries {
+		if !eachEntryFunc(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// connectionPragmas only apply to the connection running them
+var connectionPragmas = []string{
+	"PRAGMA synchronous=NORMAL",
+	// LIKE is case insensitive by default, which breaks the prefixed listing
+	"PRAGMA case_sensitive_like=ON",
+}
+
+type sqliteConnector struct {
+	driver driver.Driver
+	dsn    string
+}
+
+func (c *sqliteConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.driver.Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+	for _, pragma := range connectionPragmas {
+		if err = execOnConn(conn, pragma); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("%s: %v", pragma, err)
+		}
+	}
+	return conn, nil
+}
+
+func (c *sqliteConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+func execOnConn(conn driver.Conn, query string) error {
+	stmt, err := conn.Prepare(query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(nil)
+	return err
+}
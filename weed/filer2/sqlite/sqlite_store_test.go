@@ -0,0 +1,241 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestCreateAndFind(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test")
+	defer os.RemoveAll(dir)
+	store := &SqliteStore{}
+	store.initialize(dir)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	fullpath := filer2.FullPath("/home/chris/this/is/one/file1.jpg")
+
+	ctx := context.Background()
+
+	entry1 := &filer2.Entry{
+		FullPath: fullpath,
+		Attr: filer2.Attr{
+			Mode: 0440,
+			Uid:  1234,
+			Gid:  5678,
+		},
+	}
+
+	if err := filer.CreateEntry(ctx, entry1); err != nil {
+		t.Errorf("create entry %v: %v", entry1.FullPath, err)
+		return
+	}
+
+	entry, err := filer.FindEntry(ctx, fullpath)
+
+	if err != nil {
+		t.Errorf("find entry: %v", err)
+		return
+	}
+
+	if entry.FullPath != entry1.FullPath {
+		t.Errorf("find wrong entry: %v", entry.FullPath)
+		return
+	}
+
+	// checking one upper directory
+	entries, _ := filer.ListDirectoryEntries(ctx, filer2.FullPath("/home/chris/this/is/one"), "", false, 100)
+	if len(entries) != 1 {
+		t.Errorf("list entries count: %v", len(entries))
+		return
+	}
+
+	// checking one upper directory
+	entries, _ = filer.ListDirectoryEntries(ctx, filer2.FullPath("/"), "", false, 100)
+	if len(entries) != 1 {
+		t.Errorf("list entries count: %v", len(entries))
+		return
+	}
+
+	// checking the prefix
+	for prefix, expected := range map[string]int{"file": 1, "file2": 0} {
+		count := 0
+		err = filer.ListDirectoryPrefixedEntries(ctx, filer2.FullPath("/home/chris/this/is/one"), "", false, 100, prefix, func(entry *filer2.Entry) bool {
+			count++
+			return true
+		})
+		if err != nil || count != expected {
+			t.Errorf("list prefix %s entries count: %v %v", prefix, count, err)
+			return
+		}
+	}
+
+}
+
+func TestEmptyRoot(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test2")
+	defer os.RemoveAll(dir)
+	store := &SqliteStore{}
+	store.initialize(dir)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx := context.Background()
+
+	// checking one upper directory
+	entries, err := filer.ListDirectoryEntries(ctx, filer2.FullPath("/"), "", false, 100)
+	if err != nil {
+		t.Errorf("list entries: %v", err)
+		return
+	}
+	if len(entries) != 0 {
+		t.Errorf("list entries count: %v", len(entries))
+		return
+	}
+
+}
+
+func TestDeleteFolderChildren(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test3")
+	defer os.RemoveAll(dir)
+	store := &SqliteStore{}
+	store.initialize(dir)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx, err := filer.BeginTransaction(context.Background())
+	if err != nil {
+		t.Errorf("begin transaction: %v", err)
+		return
+	}
+
+	for _, path := range []string{"/home/chris/a/1.jpg", "/home/chris/a/b/2.jpg", "/home/chris/a-b/3.jpg"} {
+		entry := &filer2.Entry{
+			FullPath: filer2.FullPath(path),
+			Attr: filer2.Attr{
+				Mode: 0440,
+			},
+		}
+		if err := filer.CreateEntry(ctx, entry); err != nil {
+			t.Errorf("create entry %v: %v", entry.FullPath, err)
+			return
+		}
+	}
+
+	if err := filer.CommitTransaction(ctx); err != nil {
+		t.Errorf("commit transaction: %v", err)
+		return
+	}
+	ctx = context.Background()
+
+	if err := filer.DeleteEntryMetaAndData(ctx, filer2.FullPath("/home/chris/a"), true, false, false); err != nil {
+		t.Errorf("delete folder: %v", err)
+		return
+	}
+
+	for _, path := range []string{"/home/chris/a", "/home/chris/a/1.jpg", "/home/chris/a/b", "/home/chris/a/b/2.jpg"} {
+		if _, err := filer.FindEntry(ctx, filer2.FullPath(path)); err != filer2.ErrNotFound {
+			t.Errorf("entry %s is not deleted: %v", path, err)
+		}
+	}
+
+	entries, _ := filer.ListDirectoryEntries(ctx, filer2.FullPath("/home/chris"), "", false, 100)
+	if len(entries) != 1 || entries[0].FullPath != "/home/chris/a-b" {
+		t.Errorf("unexpected entries after deletion: %v", entries)
+	}
+}
+
+func TestDeleteFolderChildrenReportsDeletedEntries(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test4")
+	defer os.RemoveAll(dir)
+	store := &SqliteStore{}
+	store.initialize(dir)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx, err := filer.BeginTransaction(context.Background())
+	if err != nil {
+		t.Errorf("begin transaction: %v", err)
+		return
+	}
+
+	// more files than one page of the deletion
+	var paths []string
+	for i := 0; i < 1100; i++ {
+		paths = append(paths, fmt.Sprintf("/home/chris/a/b/%d.jpg", i))
+	}
+	paths = append(paths, "/home/chris/a/1.jpg", "/home/chris/a-b/3.jpg")
+	for _, path := range paths {
+		entry := &filer2.Entry{
+			FullPath: filer2.FullPath(path),
+			Attr: filer2.Attr{
+				Mode: 0440,
+			},
+		}
+		if err := filer.CreateEntry(ctx, entry); err != nil {
+			t.Errorf("create entry %v: %v", entry.FullPath, err)
+			return
+		}
+	}
+
+	if err := filer.CommitTransaction(ctx); err != nil {
+		t.Errorf("commit transaction: %v", err)
+		return
+	}
+	ctx = context.Background()
+
+	deleted := make(map[filer2.FullPath]bool)
+	if err := store.DeleteFolderChildren(ctx, filer2.FullPath("/home/chris/a"), func(entry *filer2.Entry) {
+		deleted[entry.FullPath] = true
+	}); err != nil {
+		t.Errorf("delete folder children: %v", err)
+		return
+	}
+
+	// all files, and the sub folder
+	if len(deleted) != 1102 || !deleted["/home/chris/a/b"] || !deleted["/home/chris/a/b/1099.jpg"] || deleted["/home/chris/a-b/3.jpg"] {
+		t.Errorf("unexpected deleted entries: %d", len(deleted))
+	}
+
+	entries, _ := filer.ListDirectoryEntries(ctx, filer2.FullPath("/home/chris/a"), "", false, 100)
+	if len(entries) != 0 {
+		t.Errorf("unexpected entries after deletion: %v", entries)
+	}
+}
+
+func TestPrefixIsCaseSensitiveOnNewConnections(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	dir, _ := ioutil.TempDir("", "seaweedfs_filer_test5")
+	defer os.RemoveAll(dir)
+	store := &SqliteStore{}
+	store.initialize(dir)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	// every query runs on a new connection
+	store.DB.SetMaxIdleConns(0)
+
+	ctx := context.Background()
+	for _, path := range []string{"/home/chris/File1.jpg", "/home/chris/file2.jpg"} {
+		if err := filer.CreateEntry(ctx, &filer2.Entry{FullPath: filer2.FullPath(path), Attr: filer2.Attr{Mode: 0440}}); err != nil {
+			t.Fatalf("create entry %s: %v", path, err)
+		}
+	}
+
+	count := 0
+	err := filer.ListDirectoryPrefixedEntries(ctx, filer2.FullPath("/home/chris"), "", false, 100, "file", func(entry *filer2.Entry) bool {
+		count++
+		return true
+	})
+	if err != nil || count != 1 {
+		t.Errorf("list prefix file entries count: %v %v", count, err)
+	}
+}
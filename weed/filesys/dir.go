@@ -7,10 +7,10 @@ import (
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/fuse"
+	"github.com/chrislusf/seaweedfs/weed/fuse/fs"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
-	"github.com/seaweedfs/fuse"
-	"github.com/seaweedfs/fuse/fs"
 )
 
 type Dir struct {
@@ -225,15 +225,19 @@ func (dir *Dir) ReadDirAll(ctx context.Context) (ret []fuse.Dirent, err error) {
 			}
 
 			glog.V(4).Infof("read directory: %v", request)
-			resp, err := client.ListEntries(ctx, request)
+			var entries []*filer_pb.Entry
+			err := filer_pb.ListEntries(ctx, client, request, func(entry *filer_pb.Entry) error {
+				entries = append(entries, entry)
+				return nil
+			})
 			if err != nil {
 				glog.V(0).Infof("list %s: %v", dir.Path, err)
 				return fuse.EIO
 			}
 
-			cacheTtl := estimatedCacheTtl(len(resp.Entries))
+			cacheTtl := estimatedCacheTtl(len(entries))
 
-			for _, entry := range resp.Entries {
+			for _, entry := range entries {
 				if entry.IsDirectory {
 					dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_Dir}
 					ret = append(ret, dirent)
@@ -245,9 +249,9 @@ func (dir *Dir) ReadDirAll(ctx context.Context) (ret []fuse.Dirent, err error) {
 				lastEntryName = entry.Name
 			}
 
-			remaining -= len(resp.Entries)
+			remaining -= len(entries)
 
-			if len(resp.Entries) < paginationLimit {
+			if len(entries) < paginationLimit {
 				break
 			}
 
@@ -6,10 +6,10 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/chrislusf/seaweedfs/weed/fuse"
+	"github.com/chrislusf/seaweedfs/weed/fuse/fs"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
-	"github.com/seaweedfs/fuse"
-	"github.com/seaweedfs/fuse/fs"
 )
 
 var _ = fs.NodeSymlinker(&Dir{})
@@ -1,215 +1,143 @@
 package filesys
 
 import (
-	"bytes"
 	"context"
-	"fmt"
 	"sync"
-	"sync/atomic"
-	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
-	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
-	"github.com/chrislusf/seaweedfs/weed/security"
 )
 
-type ContinuousDirtyPages struct {
-	hasData bool
-	Offset  int64
-	Size    int64
-	Data    []byte
-	f       *File
-	lock    sync.Mutex
+// DirtyPages buffers the writes of all writers to an open file.
+// The random writes are merged into non-overlapping ranges, and saved as chunks on flush.
+type DirtyPages struct {
+	intervals dirtyIntervals
+	f         *File
+	lock      sync.Mutex
 }
 
-func newDirtyPages(file *File) *ContinuousDirtyPages {
-	return &ContinuousDirtyPages{
-		Data: nil,
-		f:    file,
+func newDirtyPages(file *File) *DirtyPages {
+	return &DirtyPages{
+		intervals: dirtyIntervals{
+			memoryLimit: file.wfs.option.DirtyMemoryLimit,
+			spillDir:    file.wfs.option.CacheDir,
+		},
+		f: file,
 	}
 }
 
-func (pages *ContinuousDirtyPages) releaseResource() {
-	if pages.Data != nil {
-		pages.f.wfs.bufPool.Put(pages.Data)
-		pages.Data = nil
-		atomic.AddInt32(&counter, -1)
-		glog.V(3).Infof("%s/%s releasing resource %d", pages.f.dir.Path, pages.f.Name, counter)
-	}
-}
+func (pages *DirtyPages) releaseResource() {
+	pages.lock.Lock()
+	defer pages.lock.Unlock()
 
-var counter = int32(0)
+	pages.intervals.release()
+}
 
-func (pages *ContinuousDirtyPages) AddPage(ctx context.Context, offset int64, data []byte) (chunks []*filer_pb.FileChunk, err error) {
+func (pages *DirtyPages) AddPage(ctx context.Context, offset int64, data []byte) (chunks []*filer_pb.FileChunk, err error) {
 
 	pages.lock.Lock()
 	defer pages.lock.Unlock()
 
-	var chunk *filer_pb.FileChunk
-
-	if len(data) > int(pages.f.wfs.option.ChunkSizeLimit) {
-		// this is more than what buffer can hold.
-		return pages.flushAndSave(ctx, offset, data)
+	if err = pages.intervals.add(offset, data); err != nil {
+		return nil, err
 	}
 
-	if pages.Data == nil {
-		pages.Data = pages.f.wfs.bufPool.Get().([]byte)
-		atomic.AddInt32(&counter, 1)
-		glog.V(3).Infof("%s/%s acquire resource %d", pages.f.dir.Path, pages.f.Name, counter)
+	if pages.intervals.totalSize > pages.f.wfs.option.DirtyPagesLimit {
+		glog.V(3).Infof("%s/%s dirty pages %d bytes over limit", pages.f.dir.Path, pages.f.Name, pages.intervals.totalSize)
+		return pages.saveAllToStorage(ctx)
 	}
 
-	if offset < pages.Offset || offset >= pages.Offset+int64(len(pages.Data)) ||
-		pages.Offset+int64(len(pages.Data)) < offset+int64(len(data)) {
-		// if the data is out of range,
-		// or buffer is full if adding new data,
-		// flush current buffer and add new data
-
-		// println("offset", offset, "size", len(data), "existing offset", pages.Offset, "size", pages.Size)
-
-		if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
-			if chunk != nil {
-				glog.V(4).Infof("%s/%s add save [%d,%d)", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size))
-				chunks = append(chunks, chunk)
-			}
-		} else {
-			glog.V(0).Infof("%s/%s add save [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
-			return
+	// a sequential write saves the full chunks right away, instead of keeping them until flush
+	chunkSize := pages.f.wfs.option.ChunkSizeLimit
+	stop := offset + int64(len(data))
+	for _, x := range pages.intervals.list {
+		if x.start <= offset && stop == x.stop && x.size() >= chunkSize {
+			return pages.saveRangeToStorage(ctx, x.start, x.start+x.size()/chunkSize*chunkSize)
 		}
-		pages.Offset = offset
-		copy(pages.Data, data)
-		pages.Size = int64(len(data))
-		return
 	}
 
-	if offset != pages.Offset+pages.Size {
-		// when this happens, debug shows the data overlapping with existing data is empty
-		// the data is not just append
-		if offset == pages.Offset && int(pages.Size) < len(data) {
-			// glog.V(2).Infof("pages[%d,%d) pages.Data len=%v, data len=%d, pages.Size=%d", pages.Offset, pages.Offset+pages.Size, len(pages.Data), len(data), pages.Size)
-			copy(pages.Data[pages.Size:], data[pages.Size:])
-		} else {
-			if pages.Size != 0 {
-				glog.V(1).Infof("%s/%s add page: pages [%d, %d) write [%d, %d)", pages.f.dir.Path, pages.f.Name, pages.Offset, pages.Offset+pages.Size, offset, offset+int64(len(data)))
-			}
-			return pages.flushAndSave(ctx, offset, data)
-		}
-	} else {
-		copy(pages.Data[offset-pages.Offset:], data)
-	}
+	return nil, nil
+}
 
-	pages.Size = max(pages.Size, offset+int64(len(data))-pages.Offset)
+func (pages *DirtyPages) FlushToStorage(ctx context.Context) (chunks []*filer_pb.FileChunk, err error) {
 
-	return
-}
+	pages.lock.Lock()
+	defer pages.lock.Unlock()
 
-func (pages *ContinuousDirtyPages) flushAndSave(ctx context.Context, offset int64, data []byte) (chunks []*filer_pb.FileChunk, err error) {
+	return pages.saveAllToStorage(ctx)
+}
 
-	var chunk *filer_pb.FileChunk
+// ReadDirtyData copies the unsaved data overlapping with buf, and returns the stop of the copied data, or 0
+func (pages *DirtyPages) ReadDirtyData(buf []byte, offset int64) (maxStop int64, err error) {
 
-	// flush existing
-	if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
-		if chunk != nil {
-			glog.V(4).Infof("%s/%s flush existing [%d,%d) to %s", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), chunk.FileId)
-			chunks = append(chunks, chunk)
-		}
-	} else {
-		glog.V(0).Infof("%s/%s failed to flush1 [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
-		return
-	}
-	pages.Size = 0
-	pages.Offset = 0
-
-	// flush the new page
-	if chunk, err = pages.saveToStorage(ctx, data, offset); err == nil {
-		if chunk != nil {
-			glog.V(4).Infof("%s/%s flush big request [%d,%d) to %s", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), chunk.FileId)
-			chunks = append(chunks, chunk)
-		}
-	} else {
-		glog.V(0).Infof("%s/%s failed to flush2 [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
-		return
-	}
+	pages.lock.Lock()
+	defer pages.lock.Unlock()
 
-	return
+	return pages.intervals.readAt(buf, offset)
 }
 
-func (pages *ContinuousDirtyPages) FlushToStorage(ctx context.Context) (chunk *filer_pb.FileChunk, err error) {
+// Stop is the file size including the unsaved data, or 0 if there is no unsaved data
+func (pages *DirtyPages) Stop() int64 {
 
 	pages.lock.Lock()
 	defer pages.lock.Unlock()
 
-	if pages.Size == 0 {
-		return nil, nil
-	}
+	return pages.intervals.stop()
+}
 
-	if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
-		pages.Size = 0
-		pages.Offset = 0
-		if chunk != nil {
-			glog.V(4).Infof("%s/%s flush [%d,%d)", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size))
-		}
-	}
-	return
+// Truncate drops the unsaved data beyond the size
+func (pages *DirtyPages) Truncate(size int64) {
+
+	pages.lock.Lock()
+	defer pages.lock.Unlock()
+
+	pages.intervals.truncate(size)
 }
 
-func (pages *ContinuousDirtyPages) saveExistingPagesToStorage(ctx context.Context) (*filer_pb.FileChunk, error) {
+func (pages *DirtyPages) saveAllToStorage(ctx context.Context) (chunks []*filer_pb.FileChunk, err error) {
 
-	if pages.Size == 0 {
-		return nil, nil
+	// the ranges change while being saved
+	var ranges [][2]int64
+	for _, x := range pages.intervals.list {
+		ranges = append(ranges, [2]int64{x.start, x.stop})
 	}
 
-	return pages.saveToStorage(ctx, pages.Data[:pages.Size], pages.Offset)
+	for _, r := range ranges {
+		saved, err := pages.saveRangeToStorage(ctx, r[0], r[1])
+		chunks = append(chunks, saved...)
+		if err != nil {
+			return chunks, err
+		}
+	}
+	return chunks, nil
 }
 
-func (pages *ContinuousDirtyPages) saveToStorage(ctx context.Context, buf []byte, offset int64) (*filer_pb.FileChunk, error) {
+// saveRangeToStorage saves [start, stop) of one range in chunks of at most the chunk size limit.
+// The saved chunks are returned even if a later one fails.
+func (pages *DirtyPages) saveRangeToStorage(ctx context.Context, start, stop int64) (chunks []*filer_pb.FileChunk, err error) {
 
-	var fileId, host string
-	var auth security.EncodedJwt
+	for offset := start; offset < stop; {
+		size := min(stop-offset, pages.f.wfs.option.ChunkSizeLimit)
 
-	if err := pages.f.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
-
-		request := &filer_pb.AssignVolumeRequest{
-			Count:       1,
-			Replication: pages.f.wfs.option.Replication,
-			Collection:  pages.f.wfs.option.Collection,
-			TtlSec:      pages.f.wfs.option.TtlSec,
-			DataCenter:  pages.f.wfs.option.DataCenter,
+		data, err := pages.intervals.readRange(offset, offset+size)
+		if err != nil {
+			return chunks, err
 		}
 
-		resp, err := client.AssignVolume(ctx, request)
+		saved, err := pages.f.wfs.saveDataAsChunk(ctx, pages.f.dir.Path, pages.f.Name, data, offset)
 		if err != nil {
-			glog.V(0).Infof("assign volume failure %v: %v", request, err)
-			return err
+			glog.V(0).Infof("%s/%s save [%d,%d): %v", pages.f.dir.Path, pages.f.Name, offset, offset+size, err)
+			return chunks, err
 		}
+		for _, chunk := range saved {
+			glog.V(4).Infof("%s/%s save [%d,%d) to %s", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), chunk.FileId)
+		}
+		chunks = append(chunks, saved...)
 
-		fileId, host, auth = resp.FileId, resp.Url, security.EncodedJwt(resp.Auth)
-
-		return nil
-	}); err != nil {
-		return nil, fmt.Errorf("filerGrpcAddress assign volume: %v", err)
-	}
-
-	fileUrl := fmt.Sprintf("http://%s/%s", host, fileId)
-	bufReader := bytes.NewReader(buf)
-	uploadResult, err := operation.Upload(fileUrl, pages.f.Name, bufReader, false, "application/octet-stream", nil, auth)
-	if err != nil {
-		glog.V(0).Infof("upload data %v to %s: %v", pages.f.Name, fileUrl, err)
-		return nil, fmt.Errorf("upload data: %v", err)
-	}
-	if uploadResult.Error != "" {
-		glog.V(0).Infof("upload failure %v to %s: %v", pages.f.Name, fileUrl, err)
-		return nil, fmt.Errorf("upload result: %v", uploadResult.Error)
+		pages.intervals.remove(offset, offset+size)
+		offset += size
 	}
-
-	return &filer_pb.FileChunk{
-		FileId: fileId,
-		Offset: offset,
-		Size:   uint64(len(buf)),
-		Mtime:  time.Now().UnixNano(),
-		ETag:   uploadResult.ETag,
-	}, nil
-
+	return chunks, nil
 }
 
 func max(x, y int64) int64 {
@@ -0,0 +1,221 @@
+package filesys
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// dirtyInterval is a written range of the file not saved to the volume servers yet.
+// The data is in memory, or in the spill file at the same offset as in the file.
+type dirtyInterval struct {
+	start int64
+	stop  int64
+	data  []byte
+}
+
+func (x *dirtyInterval) size() int64 {
+	return x.stop - x.start
+}
+
+// dirtyIntervals keeps the written ranges sorted, and neither overlapping nor adjacent,
+// so that random writes are merged into as few chunks as possible.
+// When the ranges outgrow memoryLimit, all data is moved to a local spill file.
+type dirtyIntervals struct {
+	list        []*dirtyInterval
+	totalSize   int64
+	memoryLimit int64
+	spillDir    string
+	spillFile   *os.File
+}
+
+// add writes the data over the existing ranges, merging the overlapping and adjacent ones
+func (d *dirtyIntervals) add(offset int64, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	stop := offset + int64(len(data))
+
+	// d.list[i:j] overlap or touch [offset, stop)
+	i := sort.Search(len(d.list), func(k int) bool { return d.list[k].stop >= offset })
+	j := sort.Search(len(d.list), func(k int) bool { return d.list[k].start > stop })
+
+	merged := &dirtyInterval{start: offset, stop: stop}
+	if i < j {
+		merged.start = min(merged.start, d.list[i].start)
+		merged.stop = max(merged.stop, d.list[j-1].stop)
+	}
+
+	if d.spillFile != nil {
+		if _, err := d.spillFile.WriteAt(data, offset); err != nil {
+			return fmt.Errorf("write spill file %s: %v", d.spillFile.Name(), err)
+		}
+	} else {
+		merged.data = mergeIntervalData(d.list[i:j], merged, offset, data)
+	}
+
+	for _, x := range d.list[i:j] {
+		d.totalSize -= x.size()
+	}
+	d.totalSize += merged.size()
+	d.list = append(d.list[:i], append([]*dirtyInterval{merged}, d.list[j:]...)...)
+
+	if d.spillFile == nil && d.totalSize > d.memoryLimit {
+		if err := d.spill(); err != nil {
+			// keep the data in memory, and try again with the next write
+			glog.V(0).Infof("spill %d bytes of dirty pages: %v", d.totalSize, err)
+		}
+	}
+	return nil
+}
+
+func mergeIntervalData(overlapped []*dirtyInterval, merged *dirtyInterval, offset int64, data []byte) []byte {
+	size := merged.size()
+	var buf []byte
+	if len(overlapped) == 1 && overlapped[0].start == merged.start {
+		// growing one range in place, so that the sequential writes are not copied again and again
+		buf = overlapped[0].data
+		if grow := size - int64(len(buf)); grow > 0 {
+			buf = append(buf, make([]byte, grow)...)
+		}
+	} else {
+		buf = make([]byte, size)
+		for _, x := range overlapped {
+			copy(buf[x.start-merged.start:], x.data)
+		}
+	}
+	copy(buf[offset-merged.start:], data)
+	return buf
+}
+
+// spill moves all data into a sparse local file, at the same offsets as in the file
+func (d *dirtyIntervals) spill() error {
+	f, err := ioutil.TempFile(d.spillDir, "weedmount-dirty-")
+	if err != nil {
+		return err
+	}
+	// only accessed through the opened file, and gone with it
+	os.Remove(f.Name())
+
+	for _, x := range d.list {
+		if _, err := f.WriteAt(x.data, x.start); err != nil {
+			f.Close()
+			return fmt.Errorf("write spill file %s: %v", f.Name(), err)
+		}
+	}
+	for _, x := range d.list {
+		x.data = nil
+	}
+	d.spillFile = f
+	return nil
+}
+
+// readAt copies the dirty data overlapping with buf into buf,
+// and returns the stop of the last copied range, or 0 if nothing is copied
+func (d *dirtyIntervals) readAt(buf []byte, offset int64) (maxStop int64, err error) {
+	stop := offset + int64(len(buf))
+	for _, x := range d.list {
+		start, end := max(x.start, offset), min(x.stop, stop)
+		if start >= end {
+			continue
+		}
+		dst := buf[start-offset : end-offset]
+		if x.data != nil {
+			copy(dst, x.data[start-x.start:end-x.start])
+		} else if _, err = d.spillFile.ReadAt(dst, start); err != nil {
+			return maxStop, fmt.Errorf("read spill file %s: %v", d.spillFile.Name(), err)
+		}
+		maxStop = max(maxStop, end)
+	}
+	return
+}
+
+// readRange returns the data of [start, stop) within one range.
+// The in-memory data is not copied, and is valid until the next change.
+func (d *dirtyIntervals) readRange(start, stop int64) ([]byte, error) {
+	for _, x := range d.list {
+		if x.start <= start && stop <= x.stop {
+			if x.data != nil {
+				return x.data[start-x.start : stop-x.start], nil
+			}
+			buf := make([]byte, stop-start)
+			if _, err := d.spillFile.ReadAt(buf, start); err != nil {
+				return nil, fmt.Errorf("read spill file %s: %v", d.spillFile.Name(), err)
+			}
+			return buf, nil
+		}
+	}
+	return nil, fmt.Errorf("dirty pages [%d,%d) not found", start, stop)
+}
+
+// remove drops [start, stop) from the ranges, after it is saved or truncated
+func (d *dirtyIntervals) remove(start, stop int64) {
+	var list []*dirtyInterval
+	for _, x := range d.list {
+		if x.stop <= start || stop <= x.start {
+			list = append(list, x)
+			continue
+		}
+		d.totalSize -= x.size()
+		if x.start < start {
+			left := &dirtyInterval{start: x.start, stop: start}
+			if x.data != nil {
+				// limit the capacity, so growing the left part does not overwrite the right part
+				left.data = x.data[: start-x.start : start-x.start]
+			}
+			list = append(list, left)
+			d.totalSize += left.size()
+		}
+		if stop < x.stop {
+			right := &dirtyInterval{start: stop, stop: x.stop}
+			if x.data != nil {
+				right.data = x.data[stop-x.start:]
+			}
+			list = append(list, right)
+			d.totalSize += right.size()
+		}
+	}
+	d.list = list
+
+	if len(d.list) == 0 && d.spillFile != nil {
+		d.closeSpillFile()
+	}
+}
+
+func (d *dirtyIntervals) truncate(size int64) {
+	d.remove(size, math.MaxInt64)
+}
+
+// stop is the end of the last range, or 0 if there is no dirty data
+func (d *dirtyIntervals) stop() int64 {
+	if len(d.list) == 0 {
+		return 0
+	}
+	return d.list[len(d.list)-1].stop
+}
+
+func (d *dirtyIntervals) release() {
+	d.list = nil
+	d.totalSize = 0
+	if d.spillFile != nil {
+		d.closeSpillFile()
+	}
+}
+
+func (d *dirtyIntervals) closeSpillFile() {
+	if err := d.spillFile.Close(); err != nil {
+		glog.V(0).Infof("close spill file %s: %v", d.spillFile.Name(), err)
+	}
+	d.spillFile = nil
+}
+
+func min(x, y int64) int64 {
+	if x < y {
+		return x
+	}
+	return y
+}
@@ -0,0 +1,149 @@
+package filesys
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+)
+
+func checkIntervals(t *testing.T, d *dirtyIntervals, expected string, ranges ...int64) {
+	t.Helper()
+	if len(d.list)*2 != len(ranges) {
+		t.Fatalf("ranges %d, expected %d", len(d.list), len(ranges)/2)
+	}
+	var total int64
+	for i, x := range d.list {
+		if x.start != ranges[2*i] || x.stop != ranges[2*i+1] {
+			t.Errorf("range %d is [%d,%d), expected [%d,%d)", i, x.start, x.stop, ranges[2*i], ranges[2*i+1])
+		}
+		total += x.size()
+	}
+	if total != d.totalSize {
+		t.Errorf("total size %d, expected %d", d.totalSize, total)
+	}
+	buf := make([]byte, len(expected))
+	for i := range buf {
+		buf[i] = '.'
+	}
+	if _, err := d.readAt(buf, 0); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(buf) != expected {
+		t.Errorf("data %q, expected %q", buf, expected)
+	}
+}
+
+func TestDirtyIntervalsMerge(t *testing.T) {
+
+	d := &dirtyIntervals{memoryLimit: 1024}
+
+	d.add(2, []byte("ab"))
+	d.add(8, []byte("cd"))
+	checkIntervals(t, d, "..ab....cd", 2, 4, 8, 10)
+
+	// adjacent on both sides
+	d.add(4, []byte("xxxx"))
+	checkIntervals(t, d, "..abxxxxcd", 2, 10)
+
+	// overwrite in the middle
+	d.add(5, []byte("yy"))
+	checkIntervals(t, d, "..abxyyxcd", 2, 10)
+
+	// extend and cover
+	d.add(0, []byte("0123456789AB"))
+	checkIntervals(t, d, "0123456789AB", 0, 12)
+
+	d.add(20, []byte("zz"))
+	d.add(16, []byte("w"))
+	checkIntervals(t, d, "0123456789AB....w...zz", 0, 12, 16, 17, 20, 22)
+
+	d.remove(10, 21)
+	checkIntervals(t, d, "0123456789...........z", 0, 10, 21, 22)
+
+	// growing the left part does not overwrite the right part
+	d.remove(4, 6)
+	d.add(0, []byte("abcde"))
+	checkIntervals(t, d, "abcde.6789...........z", 0, 5, 6, 10, 21, 22)
+
+	d.truncate(7)
+	checkIntervals(t, d, "abcde.6", 0, 5, 6, 7)
+	if d.stop() != 7 {
+		t.Errorf("stop %d", d.stop())
+	}
+
+	d.release()
+	checkIntervals(t, d, "...")
+}
+
+func TestDirtyIntervalsSpill(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "dirty_pages")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	d := &dirtyIntervals{memoryLimit: 64, spillDir: dir}
+	defer d.release()
+
+	expected := make([]byte, 1024)
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		offset := random.Intn(1000)
+		data := make([]byte, random.Intn(24)+1)
+		random.Read(data)
+		if offset+len(data) > len(expected) {
+			data = data[:len(expected)-offset]
+		}
+		if err := d.add(int64(offset), data); err != nil {
+			t.Fatalf("add: %v", err)
+		}
+		copy(expected[offset:], data)
+	}
+	if d.spillFile == nil {
+		t.Fatalf("not spilled with %d bytes", d.totalSize)
+	}
+
+	actual := make([]byte, len(expected))
+	if _, err := d.readAt(actual, 0); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	for _, x := range d.list {
+		if !bytes.Equal(actual[x.start:x.stop], expected[x.start:x.stop]) {
+			t.Errorf("range [%d,%d) is different", x.start, x.stop)
+		}
+		data, err := d.readRange(x.start, x.stop)
+		if err != nil || !bytes.Equal(data, expected[x.start:x.stop]) {
+			t.Errorf("read range [%d,%d): %v", x.start, x.stop, err)
+		}
+	}
+
+	// back to memory once everything is saved
+	d.remove(0, d.stop())
+	if d.spillFile != nil || d.totalSize != 0 {
+		t.Errorf("spill file %v, total size %d", d.spillFile, d.totalSize)
+	}
+}
+
+func TestPlanCompaction(t *testing.T) {
+
+	views := []*filer2.ChunkView{
+		{LogicOffset: 0, Size: 3},
+		{LogicOffset: 3, Size: 4},
+		{LogicOffset: 7, Size: 1},
+		{LogicOffset: 20, Size: 5},
+	}
+	pieces := planCompaction(views, 5)
+	expected := []compactionPiece{{0, 5}, {5, 8}, {20, 25}}
+	if len(pieces) != len(expected) {
+		t.Fatalf("pieces %+v", pieces)
+	}
+	for i, piece := range pieces {
+		if piece != expected[i] {
+			t.Errorf("piece %d %+v, expected %+v", i, piece, expected[i])
+		}
+	}
+}
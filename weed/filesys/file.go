@@ -8,10 +8,10 @@ import (
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/fuse"
+	"github.com/chrislusf/seaweedfs/weed/fuse/fs"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
-	"github.com/seaweedfs/fuse"
-	"github.com/seaweedfs/fuse/fs"
 )
 
 const blockSize = 512
@@ -42,6 +42,14 @@ func (file *File) Attr(ctx context.Context, attr *fuse.Attr) error {
 
 	attr.Mode = os.FileMode(file.entry.Attributes.FileMode)
 	attr.Size = filer2.TotalSize(file.entry.Chunks)
+	if file.isOpen {
+		// including the unsaved writes
+		if fh := file.wfs.findHandle(file.fullpath()); fh != nil {
+			if stop := uint64(fh.dirtyPages.Stop()); stop > attr.Size {
+				attr.Size = stop
+			}
+		}
+	}
 	attr.Mtime = time.Unix(file.entry.Attributes.Mtime, 0)
 	attr.Gid = file.entry.Attributes.Gid
 	attr.Uid = file.entry.Attributes.Uid
@@ -83,6 +91,9 @@ func (file *File) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *f
 			file.entry.Chunks = nil
 			file.entryViewCache = nil
 		}
+		if fh := file.wfs.findHandle(file.fullpath()); fh != nil {
+			fh.dirtyPages.Truncate(int64(req.Size))
+		}
 		file.entry.Attributes.FileSize = req.Size
 	}
 	if req.Valid.Mode() {
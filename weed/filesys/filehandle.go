@@ -5,19 +5,20 @@ import (
 	"fmt"
 	"mime"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/fuse"
+	"github.com/chrislusf/seaweedfs/weed/fuse/fs"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/gabriel-vasile/mimetype"
-	"github.com/seaweedfs/fuse"
-	"github.com/seaweedfs/fuse/fs"
 )
 
 type FileHandle struct {
 	// cache file has been written to
-	dirtyPages    *ContinuousDirtyPages
+	dirtyPages    *DirtyPages
 	contentType   string
 	dirtyMetadata bool
 	handle        uint64
@@ -27,6 +28,10 @@ type FileHandle struct {
 	NodeId    fuse.NodeID    // file or directory the request is about
 	Uid       uint32         // user ID of process making request
 	Gid       uint32         // group ID of process making request
+
+	// owners of the locks acquired through this handle
+	lockOwners     map[uint64]bool
+	lockOwnersLock sync.Mutex
 }
 
 func newFileHandle(file *File, uid, gid uint32) *FileHandle {
@@ -50,21 +55,33 @@ func (fh *FileHandle) Read(ctx context.Context, req *fuse.ReadRequest, resp *fus
 
 	glog.V(4).Infof("%s read fh %d: [%d,%d)", fh.f.fullpath(), fh.handle, req.Offset, req.Offset+int64(req.Size))
 
-	// this value should come from the filer instead of the old f
-	if len(fh.f.entry.Chunks) == 0 {
-		glog.V(1).Infof("empty fh %v/%v", fh.f.dir.Path, fh.f.Name)
-		return nil
-	}
-
 	buff := make([]byte, req.Size)
 
-	if fh.f.entryViewCache == nil {
-		fh.f.entryViewCache = filer2.NonOverlappingVisibleIntervals(fh.f.entry.Chunks)
-	}
+	var totalRead int64
+	var err error
+	if len(fh.f.entry.Chunks) > 0 {
+		if fh.f.wfs.option.FilerProxy {
+			// the chunks may not have been saved to the filer yet
+			totalRead, err = filer2.ReadThroughFiler(ctx, fh.f.wfs, fh.f.fullpath(), buff, fh.f.entry.Chunks, req.Offset)
+		} else {
+			if fh.f.entryViewCache == nil {
+				fh.f.entryViewCache = filer2.NonOverlappingVisibleIntervals(fh.f.entry.Chunks)
+			}
 
-	chunkViews := filer2.ViewFromVisibleIntervals(fh.f.entryViewCache, req.Offset, req.Size)
+			chunkViews := filer2.ViewFromVisibleIntervals(fh.f.entryViewCache, req.Offset, req.Size)
 
-	totalRead, err := filer2.ReadIntoBuffer(ctx, fh.f.wfs, fh.f.fullpath(), buff, chunkViews, req.Offset)
+			totalRead, err = filer2.ReadIntoBuffer(ctx, fh.f.wfs, fh.f.fullpath(), buff, chunkViews, req.Offset, fh.f.wfs.option.DataCenter)
+		}
+	}
+
+	// the unsaved writes are newer than the chunks
+	if err == nil {
+		var maxStop int64
+		maxStop, err = fh.dirtyPages.ReadDirtyData(buff, req.Offset)
+		if maxStop-req.Offset > totalRead {
+			totalRead = maxStop - req.Offset
+		}
+	}
 
 	resp.Data = buff[:totalRead]
 
@@ -116,6 +133,10 @@ func (fh *FileHandle) Release(ctx context.Context, req *fuse.ReleaseRequest) err
 
 	fh.dirtyPages.releaseResource()
 
+	if req.ReleaseFlags&fuse.ReleaseFlockUnlock != 0 {
+		fh.releaseFlockOf(ctx, req.LockOwner)
+	}
+
 	fh.f.wfs.ReleaseHandle(fh.f.fullpath(), fuse.HandleID(fh.handle))
 
 	fh.f.isOpen = false
@@ -128,14 +149,19 @@ func (fh *FileHandle) Flush(ctx context.Context, req *fuse.FlushRequest) error {
 	// send the data to the OS
 	glog.V(4).Infof("%s fh %d flush %v", fh.f.fullpath(), fh.handle, req)
 
-	chunk, err := fh.dirtyPages.FlushToStorage(ctx)
+	// closing any descriptor releases the POSIX locks of the process, even if the flush fails
+	defer fh.releasePosixLocksOf(ctx, req.LockOwner)
+
+	chunks, err := fh.dirtyPages.FlushToStorage(ctx)
+	fh.f.addChunks(chunks)
+	if len(chunks) > 0 {
+		fh.dirtyMetadata = true
+	}
 	if err != nil {
 		glog.Errorf("flush %s/%s: %v", fh.f.dir.Path, fh.f.Name, err)
 		return fmt.Errorf("flush %s/%s: %v", fh.f.dir.Path, fh.f.Name, err)
 	}
 
-	fh.f.addChunk(chunk)
-
 	if !fh.dirtyMetadata {
 		return nil
 	}
@@ -175,6 +201,8 @@ func (fh *FileHandle) Flush(ctx context.Context, req *fuse.FlushRequest) error {
 			glog.V(3).Infof("garbage %s/%s chunks %d: %v [%d,%d)", fh.f.dir.Path, fh.f.Name, i, chunk.FileId, chunk.Offset, chunk.Offset+int64(chunk.Size))
 		}
 
+		fh.f.wfs.maybeCompactFile(fh.f.fullpath(), len(chunks))
+
 		return nil
 	})
 }
@@ -0,0 +1,225 @@
+package filesys
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/fuse"
+	"github.com/chrislusf/seaweedfs/weed/fuse/fs"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+const lockRetryInterval = 100 * time.Millisecond
+
+var _ = fs.HandleLocker(&FileHandle{})
+
+// lockClient holds the lease of all locks taken by this mount on the filer.
+type lockClient struct {
+	clientId  string
+	leaseOnce sync.Once
+}
+
+func newLockClient() *lockClient {
+	hostname, _ := os.Hostname()
+	return &lockClient{
+		clientId: fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+	}
+}
+
+func (wfs *WFS) keepLockLease() {
+	ticker := time.NewTicker(time.Duration(filer2.DefaultLockLeaseSec) * time.Second / 3)
+	for range ticker.C {
+		err := wfs.WithFilerClient(context.Background(), func(client filer_pb.SeaweedFilerClient) error {
+			_, err := client.KeepLockLease(context.Background(), &filer_pb.KeepLockLeaseRequest{
+				ClientId: wfs.locks.clientId,
+				LeaseSec: filer2.DefaultLockLeaseSec,
+			})
+			return err
+		})
+		if err != nil {
+			glog.V(0).Infof("keep lock lease %s: %v", wfs.locks.clientId, err)
+		}
+	}
+}
+
+// ReleaseAllLocks drops every lock this mount holds, e.g., when unmounting.
+func (wfs *WFS) ReleaseAllLocks() error {
+	return wfs.WithFilerClient(context.Background(), func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.KeepLockLease(context.Background(), &filer_pb.KeepLockLeaseRequest{
+			ClientId: wfs.locks.clientId,
+		})
+		return err
+	})
+}
+
+func (fh *FileHandle) newFileLock(owner uint64, start, end uint64, isExclusive, isFlock bool, pid uint32) *filer_pb.FileLock {
+	return &filer_pb.FileLock{
+		ClientId:    fh.f.wfs.locks.clientId,
+		Owner:       owner,
+		Start:       start,
+		End:         end,
+		IsExclusive: isExclusive,
+		IsFlock:     isFlock,
+		Pid:         pid,
+	}
+}
+
+func (fh *FileHandle) Lock(ctx context.Context, req *fuse.LockRequest) error {
+	glog.V(4).Infof("%s lock %v", fh.f.fullpath(), req)
+	return fh.acquireLock(ctx, fh.toFileLock(req.LockOwner, req.Lock, req.LockFlags), false)
+}
+
+func (fh *FileHandle) LockWait(ctx context.Context, req *fuse.LockWaitRequest) error {
+	glog.V(4).Infof("%s lock wait %v", fh.f.fullpath(), req)
+	return fh.acquireLock(ctx, fh.toFileLock(req.LockOwner, req.Lock, req.LockFlags), true)
+}
+
+func (fh *FileHandle) Unlock(ctx context.Context, req *fuse.UnlockRequest) error {
+	glog.V(4).Infof("%s unlock %v", fh.f.fullpath(), req)
+	return fh.releaseLock(ctx, fh.toFileLock(req.LockOwner, req.Lock, req.LockFlags))
+}
+
+func (fh *FileHandle) QueryLock(ctx context.Context, req *fuse.QueryLockRequest, resp *fuse.QueryLockResponse) error {
+	conflict, err := fh.testLock(ctx, fh.toFileLock(req.LockOwner, req.Lock, req.LockFlags))
+	if err != nil || conflict == nil {
+		return err
+	}
+	resp.Lock = fuse.FileLock{
+		Start: conflict.Start,
+		End:   math.MaxInt64,
+		Type:  fuse.LockRead,
+	}
+	if conflict.End != 0 && !conflict.IsFlock {
+		resp.Lock.End = conflict.End - 1
+	}
+	if conflict.IsExclusive {
+		resp.Lock.Type = fuse.LockWrite
+	}
+	// the kernel can only find the processes of this mount
+	if conflict.ClientId == fh.f.wfs.locks.clientId {
+		resp.Lock.PID = int32(conflict.Pid)
+	}
+	return nil
+}
+
+// toFileLock converts the kernel lock, whose end is inclusive with math.MaxInt64 to the end of file
+func (fh *FileHandle) toFileLock(owner uint64, lock fuse.FileLock, flags fuse.LockFlags) *filer_pb.FileLock {
+	var end uint64
+	if lock.End < math.MaxInt64 {
+		end = lock.End + 1
+	}
+	return fh.newFileLock(owner, lock.Start, end, lock.Type == fuse.LockWrite, flags&fuse.LockFlock != 0, uint32(lock.PID))
+}
+
+// acquireLock acquires a POSIX record lock or a flock on the file, shared by all mounts of the filer.
+// If wait is true, it blocks until the lock is acquired or the request is interrupted.
+func (fh *FileHandle) acquireLock(ctx context.Context, lock *filer_pb.FileLock, wait bool) error {
+
+	fh.f.wfs.locks.leaseOnce.Do(func() {
+		go fh.f.wfs.keepLockLease()
+	})
+
+	for {
+		var resp *filer_pb.LockResponse
+		err := fh.f.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) (err error) {
+			resp, err = client.Lock(ctx, &filer_pb.LockRequest{
+				Directory: fh.f.dir.Path,
+				Name:      fh.f.Name,
+				Lock:      lock,
+				LeaseSec:  filer2.DefaultLockLeaseSec,
+			})
+			return err
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return fuse.EINTR
+			}
+			glog.Errorf("lock %s: %v", fh.f.fullpath(), err)
+			return fuse.EIO
+		}
+		if resp.Acquired {
+			fh.rememberLockOwner(lock.Owner)
+			return nil
+		}
+		if !wait {
+			return fuse.Errno(syscall.EAGAIN)
+		}
+		select {
+		case <-ctx.Done():
+			return fuse.EINTR
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
+
+// releaseLock releases the range of the lock held by the same owner.
+func (fh *FileHandle) releaseLock(ctx context.Context, lock *filer_pb.FileLock) error {
+	err := fh.f.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.Unlock(ctx, &filer_pb.UnlockRequest{
+			Directory: fh.f.dir.Path,
+			Name:      fh.f.Name,
+			Lock:      lock,
+		})
+		return err
+	})
+	if err != nil {
+		glog.Errorf("unlock %s: %v", fh.f.fullpath(), err)
+		return fuse.EIO
+	}
+	return nil
+}
+
+// testLock returns the lock that would block the given lock, or nil if it could be placed.
+func (fh *FileHandle) testLock(ctx context.Context, lock *filer_pb.FileLock) (conflict *filer_pb.FileLock, err error) {
+	err = fh.f.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.TestLock(ctx, &filer_pb.LockRequest{
+			Directory: fh.f.dir.Path,
+			Name:      fh.f.Name,
+			Lock:      lock,
+		})
+		if err != nil {
+			return err
+		}
+		conflict = resp.Conflict
+		return nil
+	})
+	if err != nil {
+		glog.Errorf("test lock %s: %v", fh.f.fullpath(), err)
+		return nil, fuse.EIO
+	}
+	return
+}
+
+func (fh *FileHandle) rememberLockOwner(owner uint64) {
+	fh.lockOwnersLock.Lock()
+	defer fh.lockOwnersLock.Unlock()
+	if fh.lockOwners == nil {
+		fh.lockOwners = make(map[uint64]bool)
+	}
+	fh.lockOwners[owner] = true
+}
+
+// releasePosixLocksOf drops the POSIX locks of the owner, since closing any
+// descriptor of a file releases all its POSIX locks held by the process.
+func (fh *FileHandle) releasePosixLocksOf(ctx context.Context, owner uint64) {
+	fh.lockOwnersLock.Lock()
+	found := fh.lockOwners[owner]
+	delete(fh.lockOwners, owner)
+	fh.lockOwnersLock.Unlock()
+	if !found {
+		return
+	}
+	fh.releaseLock(ctx, fh.newFileLock(owner, 0, 0, false, false, 0))
+}
+
+// releaseFlockOf drops the flock of the owner, when its last descriptor is closed.
+func (fh *FileHandle) releaseFlockOf(ctx context.Context, owner uint64) {
+	fh.releaseLock(ctx, fh.newFileLock(owner, 0, 0, false, true, 0))
+}
@@ -0,0 +1,164 @@
+package filesys
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/filer2/memdb"
+	"github.com/chrislusf/seaweedfs/weed/fuse"
+	"github.com/chrislusf/seaweedfs/weed/fuse/fs"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// lockTestFilerServer serves one file and the lock requests of the filer.
+// Other requests are not expected, and fail on the nil embedded server.
+type lockTestFilerServer struct {
+	filer_pb.SeaweedFilerServer
+	lm *filer2.LockManager
+}
+
+func (s *lockTestFilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.LookupDirectoryEntryRequest) (*filer_pb.LookupDirectoryEntryResponse, error) {
+	if req.Directory != "/" || req.Name != "data.db" {
+		return nil, filer2.ErrNotFound
+	}
+	return &filer_pb.LookupDirectoryEntryResponse{
+		Entry: &filer_pb.Entry{
+			Name: req.Name,
+			Attributes: &filer_pb.FuseAttributes{
+				FileMode: 0644,
+				Mtime:    time.Now().Unix(),
+				Crtime:   time.Now().Unix(),
+			},
+		},
+	}, nil
+}
+
+func (s *lockTestFilerServer) Lock(ctx context.Context, req *filer_pb.LockRequest) (*filer_pb.LockResponse, error) {
+	conflict, err := s.lm.Acquire(ctx, filer2.NewFullPath(req.Directory, req.Name), req.Lock, int(req.LeaseSec))
+	if err != nil {
+		return nil, err
+	}
+	return &filer_pb.LockResponse{Acquired: conflict == nil, Conflict: conflict}, nil
+}
+
+func (s *lockTestFilerServer) Unlock(ctx context.Context, req *filer_pb.UnlockRequest) (*filer_pb.UnlockResponse, error) {
+	return &filer_pb.UnlockResponse{}, s.lm.Release(ctx, filer2.NewFullPath(req.Directory, req.Name), req.Lock)
+}
+
+func (s *lockTestFilerServer) TestLock(ctx context.Context, req *filer_pb.LockRequest) (*filer_pb.LockResponse, error) {
+	conflict, err := s.lm.Test(ctx, filer2.NewFullPath(req.Directory, req.Name), req.Lock)
+	if err != nil {
+		return nil, err
+	}
+	return &filer_pb.LockResponse{Acquired: conflict == nil, Conflict: conflict}, nil
+}
+
+func (s *lockTestFilerServer) KeepLockLease(ctx context.Context, req *filer_pb.KeepLockLeaseRequest) (*filer_pb.KeepLockLeaseResponse, error) {
+	lockCount, err := s.lm.KeepLease(ctx, req.ClientId, int(req.LeaseSec))
+	return &filer_pb.KeepLockLeaseResponse{LockCount: int32(lockCount)}, err
+}
+
+func TestFlockOnMountedFile(t *testing.T) {
+
+	if _, err := os.Stat("/dev/fuse"); err != nil {
+		t.Skipf("no fuse device: %v", err)
+	}
+	if _, err := exec.LookPath("fusermount"); err != nil {
+		t.Skipf("no fusermount: %v", err)
+	}
+
+	filer := filer2.NewFiler(nil, nil)
+	store := &memdb.MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	lm := filer.LockManager
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	filer_pb.RegisterSeaweedFilerServer(grpcServer, &lockTestFilerServer{lm: lm})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	dir, err := ioutil.TempDir("", "seaweedfs_mount")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := fuse.Mount(dir, fuse.LockingPOSIX(), fuse.LockingFlock())
+	if err != nil {
+		t.Skipf("mount: %v", err)
+	}
+	defer c.Close()
+	defer fuse.Unmount(dir)
+
+	wfs := NewSeaweedFileSystem(&Option{
+		FilerGrpcAddress:   listener.Addr().String(),
+		GrpcDialOption:     grpc.WithInsecure(),
+		FilerMountRootPath: "/",
+		ChunkSizeLimit:     1024 * 1024,
+		DirListingLimit:    100,
+		MountMode:          os.ModeDir | 0755,
+	})
+	go fs.Serve(c, wfs)
+	<-c.Ready
+	if c.MountError != nil {
+		t.Skipf("mount: %v", c.MountError)
+	}
+
+	ctx := context.Background()
+	p := filer2.FullPath("/data.db")
+	other := &filer_pb.FileLock{ClientId: "another-mount", Owner: 1, IsExclusive: true, IsFlock: true}
+	if conflict, err := lm.Acquire(ctx, p, other, 30); conflict != nil || err != nil {
+		t.Fatalf("lock from another mount: %+v %v", conflict, err)
+	}
+
+	// os.Open would register the file with the runtime poller, which blocks on a poll request to this process
+	fd, err := syscall.Open(filepath.Join(dir, "data.db"), syscall.O_RDONLY, 0)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	if err := syscall.Flock(fd, syscall.LOCK_EX|syscall.LOCK_NB); err != syscall.EWOULDBLOCK {
+		t.Fatalf("expected the flock held by another mount to block, got %v", err)
+	}
+
+	if _, err := lm.KeepLease(ctx, other.ClientId, 0); err != nil {
+		t.Fatalf("release lock of another mount: %v", err)
+	}
+	if err := syscall.Flock(fd, syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		t.Fatalf("flock: %v", err)
+	}
+	if conflict, err := lm.Test(ctx, p, other); conflict == nil || err != nil {
+		t.Fatalf("expected the flock to be visible on the filer, got %+v %v", conflict, err)
+	}
+
+	// closing the file releases the flock
+	syscall.Close(fd)
+	for i := 0; ; i++ {
+		conflict, err := lm.Test(ctx, p, other)
+		if err != nil {
+			t.Fatalf("test lock: %v", err)
+		}
+		if conflict == nil {
+			break
+		}
+		if i > 50 {
+			t.Fatalf("flock is not released after close: %+v", conflict)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -8,12 +8,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/chrislusf/seaweedfs/weed/fuse"
+	"github.com/chrislusf/seaweedfs/weed/fuse/fs"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/karlseguin/ccache"
-	"github.com/seaweedfs/fuse"
-	"github.com/seaweedfs/fuse/fs"
 	"google.golang.org/grpc"
 )
 
@@ -29,6 +30,16 @@ type Option struct {
 	DirListingLimit    int
 	EntryCacheTtl      time.Duration
 	Umask              os.FileMode
+	FileIdBatchSize    int
+	FilerProxy         bool
+
+	// DirtyMemoryLimit is the unsaved data of a file kept in memory, before spilling to a file in CacheDir
+	DirtyMemoryLimit int64
+	// DirtyPagesLimit is the unsaved data of a file kept locally, before saving it as chunks
+	DirtyPagesLimit int64
+	CacheDir        string
+	// CompactionChunkThreshold rewrites the closed files fragmented into more chunks, 0 to disable
+	CompactionChunkThreshold int
 
 	MountUid   uint32
 	MountGid   uint32
@@ -48,9 +59,14 @@ type WFS struct {
 	handles           []*FileHandle
 	pathToHandleIndex map[string]int
 	pathToHandleLock  sync.Mutex
-	bufPool           sync.Pool
 
 	stats statsCache
+
+	locks *lockClient
+
+	fileIdPool *operation.FileIdPool
+
+	compaction *compactionQueue
 }
 type statsCache struct {
 	filer_pb.StatisticsResponse
@@ -62,11 +78,19 @@ func NewSeaweedFileSystem(option *Option) *WFS {
 		option:                    option,
 		listDirectoryEntriesCache: ccache.New(ccache.Configure().MaxSize(1024 * 8).ItemsToPrune(100)),
 		pathToHandleIndex:         make(map[string]int),
-		bufPool: sync.Pool{
-			New: func() interface{} {
-				return make([]byte, option.ChunkSizeLimit)
-			},
-		},
+		locks:                     newLockClient(),
+	}
+	// with the filer proxy, the filer assigns the file ids, and the masters may not be reachable
+	if option.FileIdBatchSize > 1 && !option.FilerProxy {
+		wfs.fileIdPool = operation.NewFileIdPool(uint64(option.FileIdBatchSize), operation.DefaultFileIdLeaseDuration)
+		go wfs.keepDiscardingUnwritableVolumes()
+	}
+	if option.DirtyPagesLimit < option.ChunkSizeLimit {
+		option.DirtyPagesLimit = option.ChunkSizeLimit
+	}
+	if option.CompactionChunkThreshold > 0 {
+		wfs.compaction = newCompactionQueue()
+		go wfs.loopCompactFiles()
 	}
 
 	return wfs
@@ -116,6 +140,17 @@ func (wfs *WFS) AcquireHandle(file *File, uid, gid uint32) (fileHandle *FileHand
 	return
 }
 
+// findHandle returns the open handle of the file, or nil
+func (wfs *WFS) findHandle(fullpath string) *FileHandle {
+	wfs.pathToHandleLock.Lock()
+	defer wfs.pathToHandleLock.Unlock()
+
+	if index, found := wfs.pathToHandleIndex[fullpath]; found && index < len(wfs.handles) {
+		return wfs.handles[index]
+	}
+	return nil
+}
+
 func (wfs *WFS) ReleaseHandle(fullpath string, handleId fuse.HandleID) {
 	wfs.pathToHandleLock.Lock()
 	defer wfs.pathToHandleLock.Unlock()
@@ -0,0 +1,96 @@
+package filesys
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/wdclient"
+)
+
+// assignFileId hands out the file ids leased from the filer in batches.
+// The filer decides the ttl and disk type by the parent folder, so the leases are kept per folder.
+func (wfs *WFS) assignFileId(ctx context.Context, parentPath string) (*operation.AssignResult, error) {
+
+	assignFn := func(count uint64) (*operation.AssignResult, error) {
+		var assignResult *operation.AssignResult
+		err := wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+			request := &filer_pb.AssignVolumeRequest{
+				Count:       int32(count),
+				Replication: wfs.option.Replication,
+				Collection:  wfs.option.Collection,
+				TtlSec:      wfs.option.TtlSec,
+				DataCenter:  wfs.option.DataCenter,
+				ParentPath:  parentPath,
+			}
+
+			resp, err := client.AssignVolume(ctx, request)
+			if err != nil {
+				glog.V(0).Infof("assign volume failure %v: %v", request, err)
+				return err
+			}
+
+			assignResult = &operation.AssignResult{
+				Fid:       resp.FileId,
+				Url:       resp.Url,
+				PublicUrl: resp.PublicUrl,
+				Count:     uint64(resp.Count),
+				Auth:      security.EncodedJwt(resp.Auth),
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("filerGrpcAddress assign volume: %v", err)
+		}
+		return assignResult, nil
+	}
+
+	if wfs.fileIdPool == nil {
+		return assignFn(1)
+	}
+	return wfs.fileIdPool.Assign(parentPath, assignFn)
+}
+
+// discardFileIds stops handing out the leased file ids of the volume, after an upload to it failed
+func (wfs *WFS) discardFileIds(fileId string) {
+	if wfs.fileIdPool == nil {
+		return
+	}
+	if fid, err := needle.ParseFileIdFromString(fileId); err == nil {
+		wfs.fileIdPool.DiscardVolume(fid.VolumeId)
+	}
+}
+
+// keepDiscardingUnwritableVolumes follows the masters of the filer, and stops handing out
+// the leased file ids of the volumes once they become full or read only
+func (wfs *WFS) keepDiscardingUnwritableVolumes() {
+	ctx := context.Background()
+	var masters []string
+	for {
+		err := wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.GetFilerConfiguration(ctx, &filer_pb.GetFilerConfigurationRequest{})
+			if err != nil {
+				return err
+			}
+			masters = resp.Masters
+			return nil
+		})
+		if err == nil && len(masters) > 0 {
+			break
+		}
+		glog.V(0).Infof("get masters from filer %s: %v", wfs.option.FilerGrpcAddress, err)
+		time.Sleep(5 * time.Second)
+	}
+
+	masterClient := wdclient.NewMasterClient(ctx, wfs.option.GrpcDialOption, "mount", masters)
+	masterClient.OnVolumeUnwritable = func(vid uint32) {
+		wfs.fileIdPool.DiscardVolume(needle.VolumeId(vid))
+	}
+	masterClient.KeepConnectedToMaster()
+}
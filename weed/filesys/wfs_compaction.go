@@ -0,0 +1,155 @@
+package filesys
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+const compactionInterval = time.Minute
+
+// compactionQueue holds the fragmented files, to be rewritten into fewer chunks after they are closed
+type compactionQueue struct {
+	paths map[string]bool
+	lock  sync.Mutex
+}
+
+func newCompactionQueue() *compactionQueue {
+	return &compactionQueue{
+		paths: make(map[string]bool),
+	}
+}
+
+// maybeCompactFile queues the file if it has more chunks than the compaction threshold
+func (wfs *WFS) maybeCompactFile(fullpath string, chunkCount int) {
+	if wfs.compaction == nil || chunkCount <= wfs.option.CompactionChunkThreshold {
+		return
+	}
+
+	wfs.compaction.lock.Lock()
+	defer wfs.compaction.lock.Unlock()
+
+	wfs.compaction.paths[fullpath] = true
+}
+
+// takeClosedFiles removes the closed files from the queue, and keeps the open ones for later
+func (wfs *WFS) takeClosedFiles() (fullpaths []string) {
+	wfs.compaction.lock.Lock()
+	defer wfs.compaction.lock.Unlock()
+
+	for fullpath := range wfs.compaction.paths {
+		if wfs.findHandle(fullpath) == nil {
+			fullpaths = append(fullpaths, fullpath)
+			delete(wfs.compaction.paths, fullpath)
+		}
+	}
+	return
+}
+
+func (wfs *WFS) loopCompactFiles() {
+	for range time.Tick(compactionInterval) {
+		for _, fullpath := range wfs.takeClosedFiles() {
+			if err := wfs.compactFile(context.Background(), fullpath); err != nil {
+				glog.V(0).Infof("compact %s: %v", fullpath, err)
+			}
+		}
+	}
+}
+
+// compactFile rewrites the visible data of a fragmented file into chunks of the chunk size limit.
+// The filer only replaces the chunks if they are not changed while the data is copied,
+// and the rewrite is also dropped if the file is opened meanwhile.
+func (wfs *WFS) compactFile(ctx context.Context, fullpath string) error {
+
+	entry, err := filer2.GetEntry(ctx, wfs, fullpath)
+	if err != nil {
+		return err
+	}
+	if entry == nil || entry.IsDirectory {
+		return nil
+	}
+
+	compacted, _ := filer2.CompactFileChunks(entry.Chunks)
+	visibles := filer2.NonOverlappingVisibleIntervals(compacted)
+	pieces := planCompaction(filer2.ViewFromVisibleIntervals(visibles, 0, int(filer2.TotalSize(compacted))), wfs.option.ChunkSizeLimit)
+	if len(compacted) <= wfs.option.CompactionChunkThreshold || len(pieces)*2 > len(compacted) {
+		return nil
+	}
+
+	dir, name := filer2.FullPath(fullpath).DirAndName()
+	var chunks []*filer_pb.FileChunk
+	for _, piece := range pieces {
+		buf := make([]byte, piece.stop-piece.start)
+		if wfs.option.FilerProxy {
+			_, err = filer2.ReadThroughFiler(ctx, wfs, fullpath, buf, compacted, piece.start)
+		} else {
+			chunkViews := filer2.ViewFromVisibleIntervals(visibles, piece.start, len(buf))
+			_, err = filer2.ReadIntoBuffer(ctx, wfs, fullpath, buf, chunkViews, piece.start, wfs.option.DataCenter)
+		}
+		if err != nil {
+			wfs.deleteFileChunks(ctx, chunks)
+			return fmt.Errorf("read [%d,%d): %v", piece.start, piece.stop, err)
+		}
+		saved, err := wfs.saveDataAsChunk(ctx, dir, name, buf, piece.start)
+		if err != nil {
+			wfs.deleteFileChunks(ctx, chunks)
+			return fmt.Errorf("save [%d,%d): %v", piece.start, piece.stop, err)
+		}
+		chunks = append(chunks, saved...)
+	}
+
+	latest, err := filer2.GetEntry(ctx, wfs, fullpath)
+	if err != nil || latest == nil || !filer2.SameChunks(latest.Chunks, entry.Chunks) || wfs.findHandle(fullpath) != nil {
+		glog.V(1).Infof("compact %s: changed while compacting", fullpath)
+		wfs.deleteFileChunks(ctx, chunks)
+		return err
+	}
+
+	// the replaced chunks are deleted by the filer
+	err = wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.UpdateEntry(ctx, &filer_pb.UpdateEntryRequest{
+			Directory: dir,
+			Entry: &filer_pb.Entry{
+				Name:       latest.Name,
+				Attributes: latest.Attributes,
+				Chunks:     chunks,
+				Extended:   latest.Extended,
+			},
+			ExpectedChunks: entry.Chunks,
+		})
+		return err
+	})
+	if err != nil {
+		wfs.deleteFileChunks(ctx, chunks)
+		return fmt.Errorf("update entry: %v", err)
+	}
+	wfs.listDirectoryEntriesCache.Delete(fullpath)
+
+	glog.V(1).Infof("compacted %s from %d into %d chunks", fullpath, len(entry.Chunks), len(chunks))
+	return nil
+}
+
+type compactionPiece struct {
+	start int64
+	stop  int64
+}
+
+// planCompaction joins the adjacent chunk views of the whole file, and splits them by the chunk size.
+// The holes between the chunk views are kept.
+func planCompaction(views []*filer2.ChunkView, chunkSize int64) (pieces []compactionPiece) {
+	for i := 0; i < len(views); {
+		start, stop := views[i].LogicOffset, views[i].LogicOffset+int64(views[i].Size)
+		for i++; i < len(views) && views[i].LogicOffset == stop; i++ {
+			stop += int64(views[i].Size)
+		}
+		for ; start < stop; start += chunkSize {
+			pieces = append(pieces, compactionPiece{start: start, stop: min(start+chunkSize, stop)})
+		}
+	}
+	return
+}
@@ -0,0 +1,65 @@
+package filesys
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// saveDataAsChunk uploads the data of the file at the offset, directly or through the filer
+func (wfs *WFS) saveDataAsChunk(ctx context.Context, dir, name string, buf []byte, offset int64) ([]*filer_pb.FileChunk, error) {
+
+	if wfs.option.FilerProxy {
+		return filer2.WriteThroughFiler(ctx, wfs, &filer_pb.WriteFileRequest{
+			Directory:   dir,
+			Name:        name,
+			Offset:      offset,
+			Collection:  wfs.option.Collection,
+			Replication: wfs.option.Replication,
+			TtlSec:      wfs.option.TtlSec,
+			DataCenter:  wfs.option.DataCenter,
+		}, buf)
+	}
+
+	assignResult, err := wfs.assignFileId(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	fileId := assignResult.Fid
+
+	fileUrl := fmt.Sprintf("http://%s/%s", assignResult.Url, fileId)
+	uploadResult, err := operation.Upload(fileUrl, name, bytes.NewReader(buf), false, "application/octet-stream", nil, assignResult.Auth)
+	if err != nil {
+		// the volume may have become full or read only since the file id was leased, retry once with another one
+		wfs.discardFileIds(fileId)
+		if assignResult, err = wfs.assignFileId(ctx, dir); err != nil {
+			return nil, err
+		}
+		fileId = assignResult.Fid
+		fileUrl = fmt.Sprintf("http://%s/%s", assignResult.Url, fileId)
+		uploadResult, err = operation.Upload(fileUrl, name, bytes.NewReader(buf), false, "application/octet-stream", nil, assignResult.Auth)
+	}
+	if err != nil {
+		glog.V(0).Infof("upload data %v to %s: %v", name, fileUrl, err)
+		return nil, fmt.Errorf("upload data: %v", err)
+	}
+	if uploadResult.Error != "" {
+		glog.V(0).Infof("upload failure %v to %s: %v", name, fileUrl, err)
+		return nil, fmt.Errorf("upload result: %v", uploadResult.Error)
+	}
+
+	return []*filer_pb.FileChunk{{
+		FileId: fileId,
+		Offset: offset,
+		Size:   uint64(len(buf)),
+		Mtime:  time.Now().UnixNano(),
+		ETag:   uploadResult.ETag,
+	}}, nil
+
+}
@@ -0,0 +1,162 @@
+package images
+
+import (
+	"container/list"
+	"crypto/sha1"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// Cache keeps the derived images on disk, and removes the least recently used ones beyond the capacity.
+// The files are kept across restarts.
+type Cache struct {
+	dir      string
+	capacity int64
+
+	sync.Mutex
+	size    int64
+	lru     *list.List // of *cacheEntry, the most recently used at the front
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	name string
+	size int64
+}
+
+func NewCache(dir string, capacityBytes int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	c := &Cache{
+		dir:      dir,
+		capacity: capacityBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(fileInfos, func(i, j int) bool {
+		return fileInfos[i].ModTime().Before(fileInfos[j].ModTime())
+	})
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(fileInfo.Name(), ".tmp") {
+			os.Remove(filepath.Join(dir, fileInfo.Name()))
+			continue
+		}
+		c.entries[fileInfo.Name()] = c.lru.PushFront(&cacheEntry{name: fileInfo.Name(), size: fileInfo.Size()})
+		c.size += fileInfo.Size()
+	}
+	c.Lock()
+	c.evict()
+	c.Unlock()
+
+	return c, nil
+}
+
+func (c *Cache) Get(key string) (data []byte, found bool) {
+	name := cacheFileName(key)
+
+	c.Lock()
+	elem, found := c.entries[name]
+	if found {
+		c.lru.MoveToFront(elem)
+	}
+	c.Unlock()
+	if !found {
+		return nil, false
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(c.dir, name))
+	if err != nil {
+		glog.V(0).Infof("read cached image %s: %v", name, err)
+		c.remove(name)
+		return nil, false
+	}
+	return data, true
+}
+
+func (c *Cache) Put(key string, data []byte) {
+	if int64(len(data)) > c.capacity {
+		return
+	}
+	name := cacheFileName(key)
+
+	// write to a temporary file first, so a file in the cache is always complete
+	tmpFile, err := ioutil.TempFile(c.dir, name+".*.tmp")
+	if err != nil {
+		glog.V(0).Infof("create cached image %s: %v", name, err)
+		return
+	}
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), filepath.Join(c.dir, name))
+	}
+	if err != nil {
+		glog.V(0).Infof("write cached image %s: %v", name, err)
+		os.Remove(tmpFile.Name())
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if elem, found := c.entries[name]; found {
+		entry := elem.Value.(*cacheEntry)
+		c.size += int64(len(data)) - entry.size
+		entry.size = int64(len(data))
+		c.lru.MoveToFront(elem)
+	} else {
+		c.entries[name] = c.lru.PushFront(&cacheEntry{name: name, size: int64(len(data))})
+		c.size += int64(len(data))
+	}
+	c.evict()
+}
+
+func (c *Cache) remove(name string) {
+	c.Lock()
+	defer c.Unlock()
+	if elem, found := c.entries[name]; found {
+		c.size -= elem.Value.(*cacheEntry).size
+		c.lru.Remove(elem)
+		delete(c.entries, name)
+	}
+	os.Remove(filepath.Join(c.dir, name))
+}
+
+// evict removes the least recently used files till the size fits in the capacity. The lock should be held.
+func (c *Cache) evict() {
+	for c.size > c.capacity {
+		elem := c.lru.Back()
+		if elem == nil {
+			return
+		}
+		entry := elem.Value.(*cacheEntry)
+		c.size -= entry.size
+		c.lru.Remove(elem)
+		delete(c.entries, entry.name)
+		if err := os.Remove(filepath.Join(c.dir, entry.name)); err != nil && !os.IsNotExist(err) {
+			glog.V(0).Infof("evict cached image %s: %v", entry.name, err)
+		}
+	}
+}
+
+func cacheFileName(key string) string {
+	hash := sha1.Sum([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
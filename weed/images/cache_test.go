@@ -0,0 +1,52 @@
+package images
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "image_cache")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := NewCache(dir, 25)
+	if err != nil {
+		t.Fatalf("new cache: %v", err)
+	}
+
+	c.Put("a", bytes.Repeat([]byte("a"), 10))
+	c.Put("b", bytes.Repeat([]byte("b"), 10))
+	if data, found := c.Get("a"); !found || string(data) != "aaaaaaaaaa" {
+		t.Errorf("get a: %q %v", data, found)
+	}
+	// b is the least recently used
+	c.Put("c", bytes.Repeat([]byte("c"), 10))
+	if _, found := c.Get("b"); found {
+		t.Errorf("b is not evicted")
+	}
+	// too large to cache
+	c.Put("d", bytes.Repeat([]byte("d"), 26))
+	if _, found := c.Get("d"); found {
+		t.Errorf("d is cached")
+	}
+
+	// the files are kept across restarts
+	c, err = NewCache(dir, 25)
+	if err != nil {
+		t.Fatalf("reopen cache: %v", err)
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, found := c.Get(key); !found {
+			t.Errorf("%s is lost after reopening", key)
+		}
+	}
+	if fileInfos, _ := ioutil.ReadDir(dir); len(fileInfos) != 2 {
+		t.Errorf("%d files in the cache dir", len(fileInfos))
+	}
+}
@@ -0,0 +1,185 @@
+package images
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/images/nativewebp"
+	"github.com/disintegration/imaging"
+	_ "golang.org/x/image/webp"
+)
+
+// ProcessOptions describes how to derive an image from the original one.
+// The steps are applied in the order of cropping, rotating, resizing, and encoding.
+type ProcessOptions struct {
+	// the rectangle to crop, with the top left and bottom right corners, ignored if empty
+	CropX1, CropY1, CropX2, CropY2 int
+	// degrees to rotate clockwise, one of 90, 180, 270
+	Rotate int
+	// the same as Resized
+	Width, Height int
+	Mode          string
+	// the output format, one of jpg, png, gif, webp, the same as the original if empty
+	Format string
+	// the jpeg quality, from 1 to 100. The webp output is lossless, so the quality has no effect on it.
+	Quality int
+}
+
+// IsProcessable tells whether the file extension is a supported image format
+func IsProcessable(ext string) bool {
+	switch strings.ToLower(ext) {
+	case ".png", ".jpg", ".jpeg", ".gif", ".webp":
+		return true
+	}
+	return false
+}
+
+// NeedProcessing tells whether any processing is asked for
+func (option ProcessOptions) NeedProcessing() bool {
+	return option.hasCrop() || option.Rotate != 0 || option.Width != 0 || option.Height != 0 || option.Format != "" || option.Quality != 0
+}
+
+// String is the canonical form of the options, to identify the derived image
+func (option ProcessOptions) String() string {
+	return fmt.Sprintf("crop=%d,%d,%d,%d&rotate=%d&width=%d&height=%d&mode=%s&format=%s&quality=%d",
+		option.CropX1, option.CropY1, option.CropX2, option.CropY2, option.Rotate,
+		option.Width, option.Height, option.Mode, option.Format, option.Quality)
+}
+
+// OutputExt is the format of the image derived from the original format ext
+func (option ProcessOptions) OutputExt(ext string) string {
+	if option.Format != "" {
+		return "." + option.Format
+	}
+	return ext
+}
+
+func (option ProcessOptions) hasCrop() bool {
+	return option.CropX2 > option.CropX1 && option.CropY2 > option.CropY1
+}
+
+// Validate checks the options, and normalizes the format
+func (option *ProcessOptions) Validate() error {
+	if option.CropX1 < 0 || option.CropY1 < 0 || option.CropX2 < 0 || option.CropY2 < 0 {
+		return fmt.Errorf("invalid crop rectangle %d,%d,%d,%d", option.CropX1, option.CropY1, option.CropX2, option.CropY2)
+	}
+	switch option.Rotate {
+	case 0, 90, 180, 270:
+	default:
+		return fmt.Errorf("invalid rotation %d, should be 90, 180 or 270", option.Rotate)
+	}
+	if option.Width < 0 || option.Height < 0 {
+		return fmt.Errorf("invalid size %dx%d", option.Width, option.Height)
+	}
+	option.Format = strings.TrimPrefix(strings.ToLower(option.Format), ".")
+	switch option.Format {
+	case "", "png", "gif", "webp":
+	case "jpg", "jpeg":
+		option.Format = "jpg"
+	default:
+		return fmt.Errorf("unsupported image format %s", option.Format)
+	}
+	if option.Quality < 0 || option.Quality > 100 {
+		return fmt.Errorf("invalid quality %d, should be from 1 to 100", option.Quality)
+	}
+	return nil
+}
+
+// Process derives the image by the options. The ext is the original format, and the returned newExt is the derived one.
+// The processed data is nil if the image does not need to change, e.g., when it is already smaller than the size.
+func Process(ext string, read io.Reader, option ProcessOptions) (processed []byte, newExt string, err error) {
+
+	ext = strings.ToLower(ext)
+	newExt = option.OutputExt(ext)
+
+	srcImage, _, err := image.Decode(read)
+	if err != nil {
+		return nil, "", fmt.Errorf("decode image: %v", err)
+	}
+
+	dstImage, isChanged := srcImage, false
+	if option.hasCrop() {
+		bounds := srcImage.Bounds()
+		rect := image.Rect(option.CropX1, option.CropY1, option.CropX2, option.CropY2).Add(bounds.Min).Intersect(bounds)
+		if rect.Empty() {
+			return nil, "", fmt.Errorf("crop rectangle %v is outside of the image %v", rect, bounds)
+		}
+		dstImage, isChanged = imaging.Crop(dstImage, rect), true
+	}
+
+	switch option.Rotate {
+	case 90:
+		dstImage, isChanged = imaging.Rotate270(dstImage), true
+	case 180:
+		dstImage, isChanged = imaging.Rotate180(dstImage), true
+	case 270:
+		dstImage, isChanged = imaging.Rotate90(dstImage), true
+	}
+
+	if option.Width != 0 || option.Height != 0 {
+		if resized := resize(dstImage, option.Width, option.Height, option.Mode); resized != dstImage {
+			dstImage, isChanged = resized, true
+		}
+	}
+
+	if !isChanged && option.Quality == 0 && (option.Format == "" || normalizeExt(newExt) == normalizeExt(ext)) {
+		return nil, ext, nil
+	}
+
+	var buf bytes.Buffer
+	if err = encode(&buf, dstImage, newExt, option.Quality); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), newExt, nil
+}
+
+// resize works the same as Resized, and does not enlarge the image
+func resize(srcImage image.Image, width, height int, mode string) image.Image {
+	bounds := srcImage.Bounds()
+	if !(bounds.Dx() > width && width != 0 || bounds.Dy() > height && height != 0) {
+		return srcImage
+	}
+	// fit and fill need both the width and the height
+	switch {
+	case mode == "fit" && width != 0 && height != 0:
+		return imaging.Fit(srcImage, width, height, imaging.Lanczos)
+	case mode == "fill" && width != 0 && height != 0:
+		return imaging.Fill(srcImage, width, height, imaging.Center, imaging.Lanczos)
+	default:
+		if width == height && bounds.Dx() != bounds.Dy() {
+			return imaging.Thumbnail(srcImage, width, height, imaging.Lanczos)
+		}
+		return imaging.Resize(srcImage, width, height, imaging.Lanczos)
+	}
+}
+
+func normalizeExt(ext string) string {
+	if ext == ".jpeg" {
+		return ".jpg"
+	}
+	return ext
+}
+
+func encode(w io.Writer, m image.Image, ext string, quality int) error {
+	switch ext {
+	case ".png":
+		return png.Encode(w, m)
+	case ".jpg", ".jpeg":
+		var options *jpeg.Options
+		if quality > 0 {
+			options = &jpeg.Options{Quality: quality}
+		}
+		return jpeg.Encode(w, m, options)
+	case ".gif":
+		return gif.Encode(w, m, nil)
+	case ".webp":
+		return nativewebp.Encode(w, m, nil)
+	}
+	return fmt.Errorf("unsupported image format %s", ext)
+}
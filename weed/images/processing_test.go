@@ -0,0 +1,112 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"testing"
+
+	"golang.org/x/image/webp"
+)
+
+func TestProcess(t *testing.T) {
+
+	var original bytes.Buffer
+	png.Encode(&original, image.NewNRGBA(image.Rect(0, 0, 300, 200)))
+
+	option := ProcessOptions{CropX1: 10, CropY1: 20, CropX2: 110, CropY2: 70, Rotate: 90, Format: "PNG"}
+	if err := option.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	processed, newExt, err := Process(".png", bytes.NewReader(original.Bytes()), option)
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	if newExt != ".png" {
+		t.Errorf("new ext %s", newExt)
+	}
+	m, err := png.Decode(bytes.NewReader(processed))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if m.Bounds().Dx() != 50 || m.Bounds().Dy() != 100 {
+		t.Errorf("cropped and rotated to %v", m.Bounds())
+	}
+
+	option = ProcessOptions{Width: 30, Mode: "fit", Quality: 50}
+	processed, newExt, err = Process(".jpg", bytes.NewReader(mustReadFile(t, "sample1.jpg")), option)
+	if err != nil || newExt != ".jpg" {
+		t.Fatalf("resize: %s %v", newExt, err)
+	}
+	m, _, err = image.Decode(bytes.NewReader(processed))
+	if err != nil || m.Bounds().Dx() != 30 {
+		t.Errorf("resized to %v: %v", m.Bounds(), err)
+	}
+
+	// already small enough
+	processed, newExt, err = Process(".png", bytes.NewReader(original.Bytes()), ProcessOptions{Width: 400, Format: "png"})
+	if err != nil || processed != nil || newExt != ".png" {
+		t.Errorf("processed an unchanged image: %d bytes %s %v", len(processed), newExt, err)
+	}
+
+	for _, invalid := range []ProcessOptions{{Rotate: 45}, {Format: "bmp"}, {Quality: 101}, {CropX1: -1}} {
+		if err := invalid.Validate(); err == nil {
+			t.Errorf("%+v is valid", invalid)
+		}
+	}
+}
+
+func TestProcessWebp(t *testing.T) {
+
+	gradient := image.NewNRGBA(image.Rect(0, 0, 67, 33))
+	for y := 0; y < 33; y++ {
+		for x := 0; x < 67; x++ {
+			gradient.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 3), G: uint8(y * 7), B: uint8(x * y), A: 255})
+		}
+	}
+	var original bytes.Buffer
+	png.Encode(&original, gradient)
+
+	// the webp output is lossless
+	option := ProcessOptions{Format: "webp"}
+	if err := option.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	processed, newExt, err := Process(".png", bytes.NewReader(original.Bytes()), option)
+	if err != nil || newExt != ".webp" {
+		t.Fatalf("convert: %s %v", newExt, err)
+	}
+	m, err := webp.Decode(bytes.NewReader(processed))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if m.Bounds() != gradient.Bounds() {
+		t.Fatalf("converted to %v", m.Bounds())
+	}
+	for y := 0; y < 33; y++ {
+		for x := 0; x < 67; x++ {
+			if actual := color.NRGBAModel.Convert(m.At(x, y)); actual != gradient.At(x, y) {
+				t.Fatalf("pixel (%d,%d) is %v, expected %v", x, y, actual, gradient.At(x, y))
+			}
+		}
+	}
+
+	// the images derived from webp stay webp
+	processed, newExt, err = Process(".webp", bytes.NewReader(processed), ProcessOptions{Width: 30})
+	if err != nil || newExt != ".webp" {
+		t.Fatalf("resize: %s %v", newExt, err)
+	}
+	if m, err = webp.Decode(bytes.NewReader(processed)); err != nil || m.Bounds().Dx() != 30 {
+		t.Errorf("resized to %v: %v", m.Bounds(), err)
+	}
+}
+
+func mustReadFile(t *testing.T, name string) []byte {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		t.Fatalf("read %s: %v", name, err)
+	}
+	return data
+}
@@ -3,13 +3,12 @@ package images
 import (
 	"bytes"
 	"image"
-	"image/gif"
-	"image/jpeg"
-	"image/png"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
-	"github.com/disintegration/imaging"
-	"io"
 )
 
 func Resized(ext string, read io.ReadSeeker, width, height int, mode string) (resized io.ReadSeeker, w int, h int) {
@@ -19,34 +18,14 @@ func Resized(ext string, read io.ReadSeeker, width, height int, mode string) (re
 	srcImage, _, err := image.Decode(read)
 	if err == nil {
 		bounds := srcImage.Bounds()
-		var dstImage *image.NRGBA
 		if bounds.Dx() > width && width != 0 || bounds.Dy() > height && height != 0 {
-			switch mode {
-			case "fit":
-				dstImage = imaging.Fit(srcImage, width, height, imaging.Lanczos)
-			case "fill":
-				dstImage = imaging.Fill(srcImage, width, height, imaging.Center, imaging.Lanczos)
-			default:
-				if width == height && bounds.Dx() != bounds.Dy() {
-					dstImage = imaging.Thumbnail(srcImage, width, height, imaging.Lanczos)
-					w, h = width, height
-				} else {
-					dstImage = imaging.Resize(srcImage, width, height, imaging.Lanczos)
-				}
-			}
+			dstImage := resize(srcImage, width, height, mode)
+			var buf bytes.Buffer
+			encode(&buf, dstImage, ext, 0)
+			return bytes.NewReader(buf.Bytes()), dstImage.Bounds().Dx(), dstImage.Bounds().Dy()
 		} else {
 			return read, bounds.Dx(), bounds.Dy()
 		}
-		var buf bytes.Buffer
-		switch ext {
-		case ".png":
-			png.Encode(&buf, dstImage)
-		case ".jpg", ".jpeg":
-			jpeg.Encode(&buf, dstImage, nil)
-		case ".gif":
-			gif.Encode(&buf, dstImage, nil)
-		}
-		return bytes.NewReader(buf.Bytes()), dstImage.Bounds().Dx(), dstImage.Bounds().Dy()
 	} else {
 		glog.Error(err)
 	}
@@ -18,6 +18,7 @@ type VolumeAssignRequest struct {
 	DataCenter  string
 	Rack        string
 	DataNode    string
+	DiskType    string
 }
 
 type AssignResult struct {
@@ -46,13 +47,14 @@ func Assign(server string, grpcDialOption grpc.DialOption, primaryRequest *Volum
 		lastError = WithMasterServerClient(server, grpcDialOption, func(masterClient master_pb.SeaweedClient) error {
 
 			req := &master_pb.AssignRequest{
-				Count:       primaryRequest.Count,
-				Replication: primaryRequest.Replication,
-				Collection:  primaryRequest.Collection,
-				Ttl:         primaryRequest.Ttl,
-				DataCenter:  primaryRequest.DataCenter,
-				Rack:        primaryRequest.Rack,
-				DataNode:    primaryRequest.DataNode,
+				Count:       request.Count,
+				Replication: request.Replication,
+				Collection:  request.Collection,
+				Ttl:         request.Ttl,
+				DataCenter:  request.DataCenter,
+				Rack:        request.Rack,
+				DataNode:    request.DataNode,
+				DiskType:    request.DiskType,
 			}
 			resp, grpcErr := masterClient.Assign(context.Background(), req)
 			if grpcErr != nil {
@@ -79,6 +81,7 @@ func Assign(server string, grpcDialOption grpc.DialOption, primaryRequest *Volum
 			continue
 		}
 
+		break
 	}
 
 	return ret, lastError
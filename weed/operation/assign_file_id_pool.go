@@ -0,0 +1,180 @@
+package operation
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+const (
+	DefaultFileIdBatchSize     = 32
+	DefaultFileIdLeaseDuration = time.Minute
+)
+
+// FileIdPool leases file ids in batches with the assign count, and hands them out locally,
+// so most uploads do not wait for a round trip to the master.
+// A lease is refilled in the background when it runs low, and is dropped when it expires,
+// or when its volume is reported full or read only.
+type FileIdPool struct {
+	batchSize     uint64
+	leaseDuration time.Duration
+
+	sync.Mutex
+	groups map[string]*fileIdGroup
+}
+
+// the leases of the same assign parameters
+type fileIdGroup struct {
+	leases       []*fileIdLease
+	isRefilling  bool
+	isNotPooling bool // the file ids are signed one by one
+}
+
+type fileIdLease struct {
+	volumeId  needle.VolumeId
+	key       uint64
+	cookie    uint32
+	next      uint64
+	count     uint64
+	url       string
+	publicUrl string
+	expireAt  time.Time
+}
+
+func NewFileIdPool(batchSize uint64, leaseDuration time.Duration) *FileIdPool {
+	return &FileIdPool{
+		batchSize:     batchSize,
+		leaseDuration: leaseDuration,
+		groups:        make(map[string]*fileIdGroup),
+	}
+}
+
+// Assign returns one file id. The key identifies the assign parameters, and assignFn leases count file ids.
+func (p *FileIdPool) Assign(key string, assignFn func(count uint64) (*AssignResult, error)) (*AssignResult, error) {
+
+	now := time.Now()
+
+	p.Lock()
+	group, found := p.groups[key]
+	if !found {
+		group = &fileIdGroup{}
+		p.groups[key] = group
+	}
+	if group.isNotPooling {
+		p.Unlock()
+		return assignFn(1)
+	}
+	ret := group.take(now)
+	if ret != nil && !group.isRefilling && group.remaining() <= p.batchSize/2 {
+		group.isRefilling = true
+		go p.refill(group, assignFn)
+	}
+	p.Unlock()
+	if ret != nil {
+		return ret, nil
+	}
+
+	// nothing left, lease synchronously
+	assignResult, err := assignFn(p.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if !p.addLease(group, assignResult, now) {
+		return assignResult, nil
+	}
+	return group.take(now), nil
+}
+
+func (p *FileIdPool) refill(group *fileIdGroup, assignFn func(count uint64) (*AssignResult, error)) {
+
+	assignResult, err := assignFn(p.batchSize)
+
+	p.Lock()
+	defer p.Unlock()
+	group.isRefilling = false
+	if err != nil {
+		glog.V(1).Infof("refill file ids: %v", err)
+		return
+	}
+	p.addLease(group, assignResult, time.Now())
+}
+
+// addLease returns false if the assigned file ids can not be pooled
+func (p *FileIdPool) addLease(group *fileIdGroup, assignResult *AssignResult, now time.Time) bool {
+	if assignResult.Auth != "" {
+		group.isNotPooling = true
+		return false
+	}
+	fid, err := needle.ParseFileIdFromString(assignResult.Fid)
+	if err != nil || assignResult.Count == 0 {
+		glog.V(0).Infof("unexpected assign result %+v: %v", assignResult, err)
+		return false
+	}
+	group.leases = append(group.leases, &fileIdLease{
+		volumeId:  fid.VolumeId,
+		key:       uint64(fid.Key),
+		cookie:    uint32(fid.Cookie),
+		count:     assignResult.Count,
+		url:       assignResult.Url,
+		publicUrl: assignResult.PublicUrl,
+		expireAt:  now.Add(p.leaseDuration),
+	})
+	return true
+}
+
+// DiscardVolume drops the leased file ids on the volume, which can not be written any more
+func (p *FileIdPool) DiscardVolume(vid needle.VolumeId) {
+	p.Lock()
+	defer p.Unlock()
+
+	for _, group := range p.groups {
+		var leases []*fileIdLease
+		for _, lease := range group.leases {
+			if lease.volumeId != vid {
+				leases = append(leases, lease)
+			}
+		}
+		if len(leases) != len(group.leases) {
+			glog.V(1).Infof("discard leased file ids on volume %d", vid)
+		}
+		group.leases = leases
+	}
+}
+
+func (group *fileIdGroup) take(now time.Time) *AssignResult {
+	for len(group.leases) > 0 {
+		lease := group.leases[0]
+		if lease.next >= lease.count || now.After(lease.expireAt) {
+			group.leases = group.leases[1:]
+			continue
+		}
+		fid := needle.NewFileId(lease.volumeId, lease.key+lease.next, lease.cookie)
+		lease.next++
+		return &AssignResult{
+			Fid:       fid.String(),
+			Url:       lease.url,
+			PublicUrl: lease.publicUrl,
+			Count:     1,
+		}
+	}
+	return nil
+}
+
+func (group *fileIdGroup) remaining() (count uint64) {
+	for _, lease := range group.leases {
+		count += lease.count - lease.next
+	}
+	return
+}
+
+// AssignKey identifies the assign parameters of the request
+func AssignKey(request *VolumeAssignRequest) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s", request.Collection, request.Replication, request.Ttl,
+		request.DataCenter, request.Rack, request.DataNode, request.DiskType)
+}
@@ -0,0 +1,95 @@
+package operation
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+type fakeAssigner struct {
+	sync.Mutex
+	nextVid  uint32
+	requests []uint64
+	auth     string
+}
+
+func (a *fakeAssigner) assign(count uint64) (*AssignResult, error) {
+	a.Lock()
+	defer a.Unlock()
+	a.nextVid++
+	a.requests = append(a.requests, count)
+	fid := needle.NewFileId(needle.VolumeId(a.nextVid), 100, 0x12345678)
+	return &AssignResult{Fid: fid.String(), Url: "localhost:8080", Count: count, Auth: security.EncodedJwt(a.auth)}, nil
+}
+
+func (a *fakeAssigner) requestCount() int {
+	a.Lock()
+	defer a.Unlock()
+	return len(a.requests)
+}
+
+func TestFileIdPoolBatches(t *testing.T) {
+	pool := NewFileIdPool(4, time.Minute)
+	assigner := &fakeAssigner{}
+
+	// the first two come from the same lease, with consecutive keys
+	for i, expected := range []string{"1,6412345678", "1,6512345678"} {
+		ret, err := pool.Assign("c1", assigner.assign)
+		if err != nil {
+			t.Fatalf("assign %d: %v", i, err)
+		}
+		if ret.Fid != expected || ret.Count != 1 {
+			t.Errorf("assign %d: %+v, expected %s", i, ret, expected)
+		}
+	}
+	// 2 left, a refill has started in the background
+	for i := 0; i < 100 && assigner.requestCount() < 2; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if assigner.requestCount() != 2 {
+		t.Fatalf("requests %v", assigner.requests)
+	}
+
+	// the volume is full, its leased file ids are dropped
+	pool.DiscardVolume(1)
+	ret, _ := pool.Assign("c1", assigner.assign)
+	if fid, _ := needle.ParseFileIdFromString(ret.Fid); fid.VolumeId != 2 {
+		t.Errorf("assigned %s after discarding volume 1", ret.Fid)
+	}
+
+	// another key leases separately
+	ret, _ = pool.Assign("c2", assigner.assign)
+	if fid, _ := needle.ParseFileIdFromString(ret.Fid); fid.VolumeId == 2 {
+		t.Errorf("assigned %s from another key", ret.Fid)
+	}
+}
+
+func TestFileIdPoolExpiredLease(t *testing.T) {
+	pool := NewFileIdPool(4, time.Millisecond)
+	assigner := &fakeAssigner{}
+
+	pool.Assign("c1", assigner.assign)
+	time.Sleep(10 * time.Millisecond)
+	ret, _ := pool.Assign("c1", assigner.assign)
+	if fid, _ := needle.ParseFileIdFromString(ret.Fid); fid.VolumeId == 1 {
+		t.Errorf("assigned %s from an expired lease", ret.Fid)
+	}
+}
+
+func TestFileIdPoolSignedFileIds(t *testing.T) {
+	pool := NewFileIdPool(4, time.Minute)
+	assigner := &fakeAssigner{auth: "jwt"}
+
+	for i := 0; i < 3; i++ {
+		ret, err := pool.Assign("c1", assigner.assign)
+		if err != nil || ret.Auth != "jwt" {
+			t.Fatalf("assign %d: %+v %v", i, ret, err)
+		}
+	}
+	if len(assigner.requests) != 3 || assigner.requests[1] != 1 || assigner.requests[2] != 1 {
+		t.Errorf("signed file ids are pooled: %v", assigner.requests)
+	}
+}
@@ -0,0 +1,202 @@
+package operation
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+)
+
+const grpcNeedleBufferSize = 1024 * 1024
+
+// GrpcUpload writes the content to the volume server with the WriteNeedle rpc, instead of a http multipart upload.
+// The volumeServer is the http address, as in the assign result, and the content is gzipped the same way as Upload.
+func GrpcUpload(volumeServer string, grpcDialOption grpc.DialOption, fileId string, filename string, reader io.Reader, isGzipped bool, mtype string, pairMap map[string]string, jwt security.EncodedJwt) (*UploadResult, error) {
+
+	if !isGzipped {
+		if shouldBeZipped, iAmSure := util.IsGzippableFileType(filepath.Base(filename), mtype); iAmSure && shouldBeZipped {
+			gzipped := gzipReader(reader)
+			defer gzipped.Close()
+			reader, isGzipped = gzipped, true
+		}
+	}
+
+	// the pairs are passed as the http headers, with the prefix, for Upload
+	pairs := make(map[string]string)
+	for k, v := range pairMap {
+		pairs[strings.TrimPrefix(k, needle.PairNamePrefix)] = v
+	}
+
+	var ret *UploadResult
+	err := WithVolumeServerClient(volumeServer, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := client.WriteNeedle(ctx)
+		if err != nil {
+			return err
+		}
+
+		req := &volume_server_pb.WriteNeedleRequest{
+			FileId:    fileId,
+			Name:      filename,
+			Mime:      mtype,
+			Pairs:     pairs,
+			IsGzipped: isGzipped,
+			Jwt:       string(jwt),
+		}
+		buf := make([]byte, grpcNeedleBufferSize)
+		for {
+			n, readErr := io.ReadFull(reader, buf)
+			if n > 0 || req.FileId != "" {
+				req.Data = buf[:n]
+				if sendErr := stream.Send(req); sendErr != nil {
+					return fmt.Errorf("send %s: %v", fileId, sendErr)
+				}
+				req = &volume_server_pb.WriteNeedleRequest{}
+			}
+			if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+				break
+			}
+			if readErr != nil {
+				return fmt.Errorf("read content of %s: %v", fileId, readErr)
+			}
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return fmt.Errorf("write %s: %v", fileId, err)
+		}
+		ret = &UploadResult{
+			Name: resp.Name,
+			Size: resp.Size,
+			ETag: resp.Etag,
+		}
+		return nil
+	})
+
+	return ret, err
+}
+
+// GrpcReadNeedle streams the needle data in [offset, offset+size) to the writer, with size 0 to read till the end.
+// The data is as stored: it is gzipped if the returned metadata says IsGzipped.
+func GrpcReadNeedle(volumeServer string, grpcDialOption grpc.DialOption, fileId string, offset, size int64, jwt security.EncodedJwt, w io.Writer) (meta *volume_server_pb.ReadNeedleResponse, err error) {
+
+	err = WithVolumeServerClient(volumeServer, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := client.ReadNeedle(ctx, &volume_server_pb.ReadNeedleRequest{
+			FileId: fileId,
+			Offset: offset,
+			Size:   size,
+			Jwt:    string(jwt),
+		})
+		if err != nil {
+			return err
+		}
+
+		for {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				break
+			}
+			if recvErr != nil {
+				return fmt.Errorf("read %s: %v", fileId, recvErr)
+			}
+			if _, writeErr := w.Write(resp.Data); writeErr != nil {
+				return writeErr
+			}
+			if meta == nil {
+				meta = resp
+				meta.Data = nil
+			}
+		}
+		if meta == nil {
+			return fmt.Errorf("read %s: no response", fileId)
+		}
+		return nil
+	})
+
+	return
+}
+
+// UploadData writes the data with the WriteNeedle rpc of the volume server in the upload url,
+// and falls back to the http upload if the rpc fails, e.g. on the volume servers without it.
+// A retried upload of the same data to the same file id leaves the needle unchanged.
+func UploadData(uploadUrl string, grpcDialOption grpc.DialOption, filename string, data []byte, mtype string, jwt security.EncodedJwt) (*UploadResult, error) {
+	u, err := url.Parse(uploadUrl)
+	if err == nil {
+		var ret *UploadResult
+		if ret, err = GrpcUpload(u.Host, grpcDialOption, strings.TrimPrefix(u.Path, "/"), filename, bytes.NewReader(data), false, mtype, nil, jwt); err == nil {
+			return ret, nil
+		}
+		glog.V(1).Infof("grpc upload %s: %v, retry with http", uploadUrl, err)
+	}
+	return Upload(uploadUrl, filename, bytes.NewReader(data), false, mtype, nil, jwt)
+}
+
+// GrpcReadNeedleContent fills buf with the needle content from the offset, with the ReadNeedle rpc.
+// The range of a gzipped needle applies to the gzipped data, so the whole needle is read and unzipped.
+func GrpcReadNeedleContent(volumeServer string, grpcDialOption grpc.DialOption, fileId string, offset int64, buf []byte) error {
+
+	var data bytes.Buffer
+	meta, err := GrpcReadNeedle(volumeServer, grpcDialOption, fileId, offset, int64(len(buf)), "", &data)
+	if err != nil {
+		return err
+	}
+	if meta.IsChunkManifest {
+		return fmt.Errorf("read %s: chunk manifest", fileId)
+	}
+
+	content := data.Bytes()
+	if meta.IsGzipped {
+		if offset != 0 || uint64(len(content)) != meta.TotalSize {
+			data.Reset()
+			if _, err = GrpcReadNeedle(volumeServer, grpcDialOption, fileId, 0, 0, "", &data); err != nil {
+				return err
+			}
+		}
+		if content, err = util.UnGzipData(data.Bytes()); err != nil {
+			return fmt.Errorf("unzip %s: %v", fileId, err)
+		}
+		if offset > int64(len(content)) {
+			return fmt.Errorf("read %s: offset %d beyond %d bytes", fileId, offset, len(content))
+		}
+		content = content[offset:]
+	}
+
+	if len(content) < len(buf) {
+		return fmt.Errorf("read %d bytes of %s, expected %d", len(content), fileId, len(buf))
+	}
+	copy(buf, content)
+	return nil
+}
+
+// gzipReader compresses the reader in the background, till the returned reader is closed
+func gzipReader(reader io.Reader) *io.PipeReader {
+	pr, pw := io.Pipe()
+	go func() {
+		gzWriter, _ := gzip.NewWriterLevel(pw, flate.BestSpeed)
+		_, err := io.Copy(gzWriter, reader)
+		if closeErr := gzWriter.Close(); err == nil {
+			err = closeErr
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
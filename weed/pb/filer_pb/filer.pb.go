@@ -27,6 +27,10 @@ It has these top-level messages:
 	DeleteEntryResponse
 	AtomicRenameEntryRequest
 	AtomicRenameEntryResponse
+	ReadFileRequest
+	ReadFileResponse
+	WriteFileRequest
+	WriteFileResponse
 	AssignVolumeRequest
 	AssignVolumeResponse
 	LookupVolumeRequest
@@ -39,6 +43,15 @@ It has these top-level messages:
 	StatisticsResponse
 	GetFilerConfigurationRequest
 	GetFilerConfigurationResponse
+	FileLock
+	LockRequest
+	LockResponse
+	UnlockRequest
+	UnlockResponse
+	KeepLockLeaseRequest
+	KeepLockLeaseResponse
+	MigrateStoreRequest
+	MigrateStoreResponse
 */
 package filer_pb
 
@@ -151,7 +164,7 @@ func (m *ListEntriesRequest) GetLimit() uint32 {
 }
 
 type ListEntriesResponse struct {
-	Entries []*Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	Entry *Entry `protobuf:"bytes,1,opt,name=entry" json:"entry,omitempty"`
 }
 
 func (m *ListEntriesResponse) Reset()                    { *m = ListEntriesResponse{} }
@@ -159,9 +172,9 @@ func (m *ListEntriesResponse) String() string            { return proto.CompactT
 func (*ListEntriesResponse) ProtoMessage()               {}
 func (*ListEntriesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
-func (m *ListEntriesResponse) GetEntries() []*Entry {
+func (m *ListEntriesResponse) GetEntry() *Entry {
 	if m != nil {
-		return m.Entries
+		return m.Entry
 	}
 	return nil
 }
@@ -497,6 +510,8 @@ func (m *FuseAttributes) GetSymlinkTarget() string {
 type CreateEntryRequest struct {
 	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
 	Entry     *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+	// the extended attributes of an existing entry are kept unless set_extended is true
+	SetExtended bool `protobuf:"varint,3,opt,name=set_extended,json=setExtended" json:"set_extended,omitempty"`
 }
 
 func (m *CreateEntryRequest) Reset()                    { *m = CreateEntryRequest{} }
@@ -518,6 +533,13 @@ func (m *CreateEntryRequest) GetEntry() *Entry {
 	return nil
 }
 
+func (m *CreateEntryRequest) GetSetExtended() bool {
+	if m != nil {
+		return m.SetExtended
+	}
+	return false
+}
+
 type CreateEntryResponse struct {
 }
 
@@ -529,6 +551,11 @@ func (*CreateEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor
 type UpdateEntryRequest struct {
 	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
 	Entry     *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+	// the extended attributes are kept unless set_extended is true
+	SetExtended bool `protobuf:"varint,3,opt,name=set_extended,json=setExtended" json:"set_extended,omitempty"`
+	// if set, the entry is only updated if it still has these chunks,
+	// checked together with the other writes through the same filer
+	ExpectedChunks []*FileChunk `protobuf:"bytes,4,rep,name=expected_chunks,json=expectedChunks" json:"expected_chunks,omitempty"`
 }
 
 func (m *UpdateEntryRequest) Reset()                    { *m = UpdateEntryRequest{} }
@@ -550,6 +577,20 @@ func (m *UpdateEntryRequest) GetEntry() *Entry {
 	return nil
 }
 
+func (m *UpdateEntryRequest) GetSetExtended() bool {
+	if m != nil {
+		return m.SetExtended
+	}
+	return false
+}
+
+func (m *UpdateEntryRequest) GetExpectedChunks() []*FileChunk {
+	if m != nil {
+		return m.ExpectedChunks
+	}
+	return nil
+}
+
 type UpdateEntryResponse struct {
 }
 
@@ -663,18 +704,186 @@ func (m *AtomicRenameEntryResponse) String() string            { return proto.Co
 func (*AtomicRenameEntryResponse) ProtoMessage()               {}
 func (*AtomicRenameEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
+// read the file content through the filer, for the clients that can not reach the volume servers
+type ReadFileRequest struct {
+	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Offset    int64  `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	Size      int64  `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
+	// read these chunks instead of the saved ones, e.g., when a mount has not flushed the new chunks yet.
+	// Each chunk must be saved in the file, or be written to the same file through WriteFile.
+	Chunks []*FileChunk `protobuf:"bytes,5,rep,name=chunks" json:"chunks,omitempty"`
+}
+
+func (m *ReadFileRequest) Reset()                    { *m = ReadFileRequest{} }
+func (m *ReadFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadFileRequest) ProtoMessage()               {}
+func (*ReadFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *ReadFileRequest) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *ReadFileRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReadFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ReadFileRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ReadFileRequest) GetChunks() []*FileChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+type ReadFileResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ReadFileResponse) Reset()                    { *m = ReadFileResponse{} }
+func (m *ReadFileResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadFileResponse) ProtoMessage()               {}
+func (*ReadFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *ReadFileResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// write the data as new chunks through the filer, starting at the offset in the file.
+// The first request carries the metadata, and all requests carry the data in sequence.
+// The entry is not changed, the returned chunks should be saved with CreateEntry or UpdateEntry.
+type WriteFileRequest struct {
+	Directory   string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Offset      int64  `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	Collection  string `protobuf:"bytes,4,opt,name=collection" json:"collection,omitempty"`
+	Replication string `protobuf:"bytes,5,opt,name=replication" json:"replication,omitempty"`
+	TtlSec      int32  `protobuf:"varint,6,opt,name=ttl_sec,json=ttlSec" json:"ttl_sec,omitempty"`
+	DataCenter  string `protobuf:"bytes,7,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	DiskType    string `protobuf:"bytes,8,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
+	Data        []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *WriteFileRequest) Reset()                    { *m = WriteFileRequest{} }
+func (m *WriteFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteFileRequest) ProtoMessage()               {}
+func (*WriteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *WriteFileRequest) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *WriteFileRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WriteFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *WriteFileRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *WriteFileRequest) GetReplication() string {
+	if m != nil {
+		return m.Replication
+	}
+	return ""
+}
+
+func (m *WriteFileRequest) GetTtlSec() int32 {
+	if m != nil {
+		return m.TtlSec
+	}
+	return 0
+}
+
+func (m *WriteFileRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *WriteFileRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
+func (m *WriteFileRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type WriteFileResponse struct {
+	Chunks []*FileChunk `protobuf:"bytes,1,rep,name=chunks" json:"chunks,omitempty"`
+}
+
+func (m *WriteFileResponse) Reset()                    { *m = WriteFileResponse{} }
+func (m *WriteFileResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteFileResponse) ProtoMessage()               {}
+func (*WriteFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *WriteFileResponse) GetChunks() []*FileChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
 type AssignVolumeRequest struct {
 	Count       int32  `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
 	Collection  string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
 	Replication string `protobuf:"bytes,3,opt,name=replication" json:"replication,omitempty"`
 	TtlSec      int32  `protobuf:"varint,4,opt,name=ttl_sec,json=ttlSec" json:"ttl_sec,omitempty"`
 	DataCenter  string `protobuf:"bytes,5,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	ParentPath  string `protobuf:"bytes,6,opt,name=parent_path,json=parentPath" json:"parent_path,omitempty"`
+	DiskType    string `protobuf:"bytes,7,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *AssignVolumeRequest) Reset()                    { *m = AssignVolumeRequest{} }
 func (m *AssignVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*AssignVolumeRequest) ProtoMessage()               {}
-func (*AssignVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*AssignVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *AssignVolumeRequest) GetCount() int32 {
 	if m != nil {
@@ -711,6 +920,20 @@ func (m *AssignVolumeRequest) GetDataCenter() string {
 	return ""
 }
 
+func (m *AssignVolumeRequest) GetParentPath() string {
+	if m != nil {
+		return m.ParentPath
+	}
+	return ""
+}
+
+func (m *AssignVolumeRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type AssignVolumeResponse struct {
 	FileId    string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
 	Url       string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
@@ -722,7 +945,7 @@ type AssignVolumeResponse struct {
 func (m *AssignVolumeResponse) Reset()                    { *m = AssignVolumeResponse{} }
 func (m *AssignVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*AssignVolumeResponse) ProtoMessage()               {}
-func (*AssignVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*AssignVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *AssignVolumeResponse) GetFileId() string {
 	if m != nil {
@@ -766,7 +989,7 @@ type LookupVolumeRequest struct {
 func (m *LookupVolumeRequest) Reset()                    { *m = LookupVolumeRequest{} }
 func (m *LookupVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*LookupVolumeRequest) ProtoMessage()               {}
-func (*LookupVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*LookupVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *LookupVolumeRequest) GetVolumeIds() []string {
 	if m != nil {
@@ -782,7 +1005,7 @@ type Locations struct {
 func (m *Locations) Reset()                    { *m = Locations{} }
 func (m *Locations) String() string            { return proto.CompactTextString(m) }
 func (*Locations) ProtoMessage()               {}
-func (*Locations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*Locations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *Locations) GetLocations() []*Location {
 	if m != nil {
@@ -792,14 +1015,15 @@ func (m *Locations) GetLocations() []*Location {
 }
 
 type Location struct {
-	Url       string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	PublicUrl string `protobuf:"bytes,2,opt,name=public_url,json=publicUrl" json:"public_url,omitempty"`
+	Url        string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	PublicUrl  string `protobuf:"bytes,2,opt,name=public_url,json=publicUrl" json:"public_url,omitempty"`
+	DataCenter string `protobuf:"bytes,3,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
 }
 
 func (m *Location) Reset()                    { *m = Location{} }
 func (m *Location) String() string            { return proto.CompactTextString(m) }
 func (*Location) ProtoMessage()               {}
-func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *Location) GetUrl() string {
 	if m != nil {
@@ -815,6 +1039,13 @@ func (m *Location) GetPublicUrl() string {
 	return ""
 }
 
+func (m *Location) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
 type LookupVolumeResponse struct {
 	LocationsMap map[string]*Locations `protobuf:"bytes,1,rep,name=locations_map,json=locationsMap" json:"locations_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -822,7 +1053,7 @@ type LookupVolumeResponse struct {
 func (m *LookupVolumeResponse) Reset()                    { *m = LookupVolumeResponse{} }
 func (m *LookupVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*LookupVolumeResponse) ProtoMessage()               {}
-func (*LookupVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*LookupVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *LookupVolumeResponse) GetLocationsMap() map[string]*Locations {
 	if m != nil {
@@ -838,7 +1069,7 @@ type DeleteCollectionRequest struct {
 func (m *DeleteCollectionRequest) Reset()                    { *m = DeleteCollectionRequest{} }
 func (m *DeleteCollectionRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCollectionRequest) ProtoMessage()               {}
-func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *DeleteCollectionRequest) GetCollection() string {
 	if m != nil {
@@ -853,7 +1084,7 @@ type DeleteCollectionResponse struct {
 func (m *DeleteCollectionResponse) Reset()                    { *m = DeleteCollectionResponse{} }
 func (m *DeleteCollectionResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCollectionResponse) ProtoMessage()               {}
-func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 type StatisticsRequest struct {
 	Replication string `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
@@ -864,7 +1095,7 @@ type StatisticsRequest struct {
 func (m *StatisticsRequest) Reset()                    { *m = StatisticsRequest{} }
 func (m *StatisticsRequest) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsRequest) ProtoMessage()               {}
-func (*StatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*StatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *StatisticsRequest) GetReplication() string {
 	if m != nil {
@@ -899,7 +1130,7 @@ type StatisticsResponse struct {
 func (m *StatisticsResponse) Reset()                    { *m = StatisticsResponse{} }
 func (m *StatisticsResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsResponse) ProtoMessage()               {}
-func (*StatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*StatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *StatisticsResponse) GetReplication() string {
 	if m != nil {
@@ -949,7 +1180,7 @@ type GetFilerConfigurationRequest struct {
 func (m *GetFilerConfigurationRequest) Reset()                    { *m = GetFilerConfigurationRequest{} }
 func (m *GetFilerConfigurationRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetFilerConfigurationRequest) ProtoMessage()               {}
-func (*GetFilerConfigurationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*GetFilerConfigurationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 type GetFilerConfigurationResponse struct {
 	Masters     []string `protobuf:"bytes,1,rep,name=masters" json:"masters,omitempty"`
@@ -961,7 +1192,7 @@ type GetFilerConfigurationResponse struct {
 func (m *GetFilerConfigurationResponse) Reset()                    { *m = GetFilerConfigurationResponse{} }
 func (m *GetFilerConfigurationResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetFilerConfigurationResponse) ProtoMessage()               {}
-func (*GetFilerConfigurationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*GetFilerConfigurationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *GetFilerConfigurationResponse) GetMasters() []string {
 	if m != nil {
@@ -991,127 +1222,522 @@ func (m *GetFilerConfigurationResponse) GetMaxMb() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*LookupDirectoryEntryRequest)(nil), "filer_pb.LookupDirectoryEntryRequest")
-	proto.RegisterType((*LookupDirectoryEntryResponse)(nil), "filer_pb.LookupDirectoryEntryResponse")
-	proto.RegisterType((*ListEntriesRequest)(nil), "filer_pb.ListEntriesRequest")
-	proto.RegisterType((*ListEntriesResponse)(nil), "filer_pb.ListEntriesResponse")
-	proto.RegisterType((*Entry)(nil), "filer_pb.Entry")
-	proto.RegisterType((*FullEntry)(nil), "filer_pb.FullEntry")
-	proto.RegisterType((*EventNotification)(nil), "filer_pb.EventNotification")
-	proto.RegisterType((*FileChunk)(nil), "filer_pb.FileChunk")
-	proto.RegisterType((*FileId)(nil), "filer_pb.FileId")
-	proto.RegisterType((*FuseAttributes)(nil), "filer_pb.FuseAttributes")
-	proto.RegisterType((*CreateEntryRequest)(nil), "filer_pb.CreateEntryRequest")
-	proto.RegisterType((*CreateEntryResponse)(nil), "filer_pb.CreateEntryResponse")
-	proto.RegisterType((*UpdateEntryRequest)(nil), "filer_pb.UpdateEntryRequest")
-	proto.RegisterType((*UpdateEntryResponse)(nil), "filer_pb.UpdateEntryResponse")
-	proto.RegisterType((*DeleteEntryRequest)(nil), "filer_pb.DeleteEntryRequest")
-	proto.RegisterType((*DeleteEntryResponse)(nil), "filer_pb.DeleteEntryResponse")
-	proto.RegisterType((*AtomicRenameEntryRequest)(nil), "filer_pb.AtomicRenameEntryRequest")
-	proto.RegisterType((*AtomicRenameEntryResponse)(nil), "filer_pb.AtomicRenameEntryResponse")
-	proto.RegisterType((*AssignVolumeRequest)(nil), "filer_pb.AssignVolumeRequest")
-	proto.RegisterType((*AssignVolumeResponse)(nil), "filer_pb.AssignVolumeResponse")
-	proto.RegisterType((*LookupVolumeRequest)(nil), "filer_pb.LookupVolumeRequest")
-	proto.RegisterType((*Locations)(nil), "filer_pb.Locations")
-	proto.RegisterType((*Location)(nil), "filer_pb.Location")
-	proto.RegisterType((*LookupVolumeResponse)(nil), "filer_pb.LookupVolumeResponse")
-	proto.RegisterType((*DeleteCollectionRequest)(nil), "filer_pb.DeleteCollectionRequest")
-	proto.RegisterType((*DeleteCollectionResponse)(nil), "filer_pb.DeleteCollectionResponse")
-	proto.RegisterType((*StatisticsRequest)(nil), "filer_pb.StatisticsRequest")
-	proto.RegisterType((*StatisticsResponse)(nil), "filer_pb.StatisticsResponse")
-	proto.RegisterType((*GetFilerConfigurationRequest)(nil), "filer_pb.GetFilerConfigurationRequest")
-	proto.RegisterType((*GetFilerConfigurationResponse)(nil), "filer_pb.GetFilerConfigurationResponse")
+type FileLock struct {
+	ClientId    string `protobuf:"bytes,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	Owner       uint64 `protobuf:"varint,2,opt,name=owner" json:"owner,omitempty"`
+	Start       uint64 `protobuf:"varint,3,opt,name=start" json:"start,omitempty"`
+	End         uint64 `protobuf:"varint,4,opt,name=end" json:"end,omitempty"`
+	IsExclusive bool   `protobuf:"varint,5,opt,name=is_exclusive,json=isExclusive" json:"is_exclusive,omitempty"`
+	IsFlock     bool   `protobuf:"varint,6,opt,name=is_flock,json=isFlock" json:"is_flock,omitempty"`
+	Pid         uint32 `protobuf:"varint,7,opt,name=pid" json:"pid,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for SeaweedFiler service
+func (m *FileLock) Reset()                    { *m = FileLock{} }
+func (m *FileLock) String() string            { return proto.CompactTextString(m) }
+func (*FileLock) ProtoMessage()               {}
+func (*FileLock) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
-type SeaweedFilerClient interface {
-	LookupDirectoryEntry(ctx context.Context, in *LookupDirectoryEntryRequest, opts ...grpc.CallOption) (*LookupDirectoryEntryResponse, error)
-	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
-	CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error)
-	UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error)
-	DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*DeleteEntryResponse, error)
-	AtomicRenameEntry(ctx context.Context, in *AtomicRenameEntryRequest, opts ...grpc.CallOption) (*AtomicRenameEntryResponse, error)
-	AssignVolume(ctx context.Context, in *AssignVolumeRequest, opts ...grpc.CallOption) (*AssignVolumeResponse, error)
-	LookupVolume(ctx context.Context, in *LookupVolumeRequest, opts ...grpc.CallOption) (*LookupVolumeResponse, error)
-	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
-	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
-	GetFilerConfiguration(ctx context.Context, in *GetFilerConfigurationRequest, opts ...grpc.CallOption) (*GetFilerConfigurationResponse, error)
+func (m *FileLock) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-type seaweedFilerClient struct {
-	cc *grpc.ClientConn
+func (m *FileLock) GetOwner() uint64 {
+	if m != nil {
+		return m.Owner
+	}
+	return 0
 }
 
-func NewSeaweedFilerClient(cc *grpc.ClientConn) SeaweedFilerClient {
-	return &seaweedFilerClient{cc}
+func (m *FileLock) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
 }
 
-func (c *seaweedFilerClient) LookupDirectoryEntry(ctx context.Context, in *LookupDirectoryEntryRequest, opts ...grpc.CallOption) (*LookupDirectoryEntryResponse, error) {
-	out := new(LookupDirectoryEntryResponse)
-	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/LookupDirectoryEntry", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLock) GetEnd() uint64 {
+	if m != nil {
+		return m.End
 	}
-	return out, nil
+	return 0
 }
 
-func (c *seaweedFilerClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
-	out := new(ListEntriesResponse)
-	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/ListEntries", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLock) GetIsExclusive() bool {
+	if m != nil {
+		return m.IsExclusive
 	}
-	return out, nil
+	return false
 }
 
-func (c *seaweedFilerClient) CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error) {
-	out := new(CreateEntryResponse)
-	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/CreateEntry", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLock) GetIsFlock() bool {
+	if m != nil {
+		return m.IsFlock
 	}
-	return out, nil
+	return false
 }
 
-func (c *seaweedFilerClient) UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error) {
-	out := new(UpdateEntryResponse)
-	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/UpdateEntry", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLock) GetPid() uint32 {
+	if m != nil {
+		return m.Pid
 	}
-	return out, nil
+	return 0
 }
 
-func (c *seaweedFilerClient) DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*DeleteEntryResponse, error) {
-	out := new(DeleteEntryResponse)
-	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/DeleteEntry", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type LockRequest struct {
+	Directory string    `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	Name      string    `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Lock      *FileLock `protobuf:"bytes,3,opt,name=lock" json:"lock,omitempty"`
+	LeaseSec  int32     `protobuf:"varint,4,opt,name=lease_sec,json=leaseSec" json:"lease_sec,omitempty"`
+}
+
+func (m *LockRequest) Reset()                    { *m = LockRequest{} }
+func (m *LockRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()               {}
+func (*LockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *LockRequest) GetDirectory() string {
+	if m != nil {
+		return m.Directory
 	}
-	return out, nil
+	return ""
 }
 
-func (c *seaweedFilerClient) AtomicRenameEntry(ctx context.Context, in *AtomicRenameEntryRequest, opts ...grpc.CallOption) (*AtomicRenameEntryResponse, error) {
-	out := new(AtomicRenameEntryResponse)
-	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/AtomicRenameEntry", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *LockRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *seaweedFilerClient) AssignVolume(ctx context.Context, in *AssignVolumeRequest, opts ...grpc.CallOption) (*AssignVolumeResponse, error) {
-	out := new(AssignVolumeResponse)
+func (m *LockRequest) GetLock() *FileLock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+func (m *LockRequest) GetLeaseSec() int32 {
+	if m != nil {
+		return m.LeaseSec
+	}
+	return 0
+}
+
+type LockResponse struct {
+	Acquired bool      `protobuf:"varint,1,opt,name=acquired" json:"acquired,omitempty"`
+	Conflict *FileLock `protobuf:"bytes,2,opt,name=conflict" json:"conflict,omitempty"`
+}
+
+func (m *LockResponse) Reset()                    { *m = LockResponse{} }
+func (m *LockResponse) String() string            { return proto.CompactTextString(m) }
+func (*LockResponse) ProtoMessage()               {}
+func (*LockResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *LockResponse) GetAcquired() bool {
+	if m != nil {
+		return m.Acquired
+	}
+	return false
+}
+
+func (m *LockResponse) GetConflict() *FileLock {
+	if m != nil {
+		return m.Conflict
+	}
+	return nil
+}
+
+type UnlockRequest struct {
+	Directory string    `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	Name      string    `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Lock      *FileLock `protobuf:"bytes,3,opt,name=lock" json:"lock,omitempty"`
+}
+
+func (m *UnlockRequest) Reset()                    { *m = UnlockRequest{} }
+func (m *UnlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnlockRequest) ProtoMessage()               {}
+func (*UnlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *UnlockRequest) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *UnlockRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UnlockRequest) GetLock() *FileLock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+type UnlockResponse struct {
+}
+
+func (m *UnlockResponse) Reset()                    { *m = UnlockResponse{} }
+func (m *UnlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnlockResponse) ProtoMessage()               {}
+func (*UnlockResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+type KeepLockLeaseRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	LeaseSec int32  `protobuf:"varint,2,opt,name=lease_sec,json=leaseSec" json:"lease_sec,omitempty"`
+}
+
+func (m *KeepLockLeaseRequest) Reset()                    { *m = KeepLockLeaseRequest{} }
+func (m *KeepLockLeaseRequest) String() string            { return proto.CompactTextString(m) }
+func (*KeepLockLeaseRequest) ProtoMessage()               {}
+func (*KeepLockLeaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *KeepLockLeaseRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *KeepLockLeaseRequest) GetLeaseSec() int32 {
+	if m != nil {
+		return m.LeaseSec
+	}
+	return 0
+}
+
+type KeepLockLeaseResponse struct {
+	LockCount int32 `protobuf:"varint,1,opt,name=lock_count,json=lockCount" json:"lock_count,omitempty"`
+}
+
+func (m *KeepLockLeaseResponse) Reset()                    { *m = KeepLockLeaseResponse{} }
+func (m *KeepLockLeaseResponse) String() string            { return proto.CompactTextString(m) }
+func (*KeepLockLeaseResponse) ProtoMessage()               {}
+func (*KeepLockLeaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *KeepLockLeaseResponse) GetLockCount() int32 {
+	if m != nil {
+		return m.LockCount
+	}
+	return 0
+}
+
+type MigrateStoreRequest struct {
+	Action string `protobuf:"bytes,1,opt,name=action" json:"action,omitempty"`
+	Store  string `protobuf:"bytes,2,opt,name=store" json:"store,omitempty"`
+}
+
+func (m *MigrateStoreRequest) Reset()                    { *m = MigrateStoreRequest{} }
+func (m *MigrateStoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*MigrateStoreRequest) ProtoMessage()               {}
+func (*MigrateStoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *MigrateStoreRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *MigrateStoreRequest) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+type MigrateStoreResponse struct {
+	SourceStore      string `protobuf:"bytes,1,opt,name=source_store,json=sourceStore" json:"source_store,omitempty"`
+	TargetStore      string `protobuf:"bytes,2,opt,name=target_store,json=targetStore" json:"target_store,omitempty"`
+	State            string `protobuf:"bytes,3,opt,name=state" json:"state,omitempty"`
+	StartedAtNs      int64  `protobuf:"varint,4,opt,name=started_at_ns,json=startedAtNs" json:"started_at_ns,omitempty"`
+	DirectoryCount   int64  `protobuf:"varint,5,opt,name=directory_count,json=directoryCount" json:"directory_count,omitempty"`
+	CopiedCount      int64  `protobuf:"varint,6,opt,name=copied_count,json=copiedCount" json:"copied_count,omitempty"`
+	CurrentDirectory string `protobuf:"bytes,7,opt,name=current_directory,json=currentDirectory" json:"current_directory,omitempty"`
+	DualWriteErrors  int64  `protobuf:"varint,8,opt,name=dual_write_errors,json=dualWriteErrors" json:"dual_write_errors,omitempty"`
+	SourceEntryCount int64  `protobuf:"varint,9,opt,name=source_entry_count,json=sourceEntryCount" json:"source_entry_count,omitempty"`
+	TargetEntryCount int64  `protobuf:"varint,10,opt,name=target_entry_count,json=targetEntryCount" json:"target_entry_count,omitempty"`
+	SourceChecksum   uint64 `protobuf:"varint,11,opt,name=source_checksum,json=sourceChecksum" json:"source_checksum,omitempty"`
+	TargetChecksum   uint64 `protobuf:"varint,12,opt,name=target_checksum,json=targetChecksum" json:"target_checksum,omitempty"`
+	MismatchCount    int64  `protobuf:"varint,13,opt,name=mismatch_count,json=mismatchCount" json:"mismatch_count,omitempty"`
+	Error            string `protobuf:"bytes,14,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *MigrateStoreResponse) Reset()                    { *m = MigrateStoreResponse{} }
+func (m *MigrateStoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*MigrateStoreResponse) ProtoMessage()               {}
+func (*MigrateStoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *MigrateStoreResponse) GetSourceStore() string {
+	if m != nil {
+		return m.SourceStore
+	}
+	return ""
+}
+
+func (m *MigrateStoreResponse) GetTargetStore() string {
+	if m != nil {
+		return m.TargetStore
+	}
+	return ""
+}
+
+func (m *MigrateStoreResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *MigrateStoreResponse) GetStartedAtNs() int64 {
+	if m != nil {
+		return m.StartedAtNs
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetDirectoryCount() int64 {
+	if m != nil {
+		return m.DirectoryCount
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetCopiedCount() int64 {
+	if m != nil {
+		return m.CopiedCount
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetCurrentDirectory() string {
+	if m != nil {
+		return m.CurrentDirectory
+	}
+	return ""
+}
+
+func (m *MigrateStoreResponse) GetDualWriteErrors() int64 {
+	if m != nil {
+		return m.DualWriteErrors
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetSourceEntryCount() int64 {
+	if m != nil {
+		return m.SourceEntryCount
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetTargetEntryCount() int64 {
+	if m != nil {
+		return m.TargetEntryCount
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetSourceChecksum() uint64 {
+	if m != nil {
+		return m.SourceChecksum
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetTargetChecksum() uint64 {
+	if m != nil {
+		return m.TargetChecksum
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetMismatchCount() int64 {
+	if m != nil {
+		return m.MismatchCount
+	}
+	return 0
+}
+
+func (m *MigrateStoreResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LookupDirectoryEntryRequest)(nil), "filer_pb.LookupDirectoryEntryRequest")
+	proto.RegisterType((*LookupDirectoryEntryResponse)(nil), "filer_pb.LookupDirectoryEntryResponse")
+	proto.RegisterType((*ListEntriesRequest)(nil), "filer_pb.ListEntriesRequest")
+	proto.RegisterType((*ListEntriesResponse)(nil), "filer_pb.ListEntriesResponse")
+	proto.RegisterType((*Entry)(nil), "filer_pb.Entry")
+	proto.RegisterType((*FullEntry)(nil), "filer_pb.FullEntry")
+	proto.RegisterType((*EventNotification)(nil), "filer_pb.EventNotification")
+	proto.RegisterType((*FileChunk)(nil), "filer_pb.FileChunk")
+	proto.RegisterType((*FileId)(nil), "filer_pb.FileId")
+	proto.RegisterType((*FuseAttributes)(nil), "filer_pb.FuseAttributes")
+	proto.RegisterType((*CreateEntryRequest)(nil), "filer_pb.CreateEntryRequest")
+	proto.RegisterType((*CreateEntryResponse)(nil), "filer_pb.CreateEntryResponse")
+	proto.RegisterType((*UpdateEntryRequest)(nil), "filer_pb.UpdateEntryRequest")
+	proto.RegisterType((*UpdateEntryResponse)(nil), "filer_pb.UpdateEntryResponse")
+	proto.RegisterType((*DeleteEntryRequest)(nil), "filer_pb.DeleteEntryRequest")
+	proto.RegisterType((*DeleteEntryResponse)(nil), "filer_pb.DeleteEntryResponse")
+	proto.RegisterType((*AtomicRenameEntryRequest)(nil), "filer_pb.AtomicRenameEntryRequest")
+	proto.RegisterType((*AtomicRenameEntryResponse)(nil), "filer_pb.AtomicRenameEntryResponse")
+	proto.RegisterType((*ReadFileRequest)(nil), "filer_pb.ReadFileRequest")
+	proto.RegisterType((*ReadFileResponse)(nil), "filer_pb.ReadFileResponse")
+	proto.RegisterType((*WriteFileRequest)(nil), "filer_pb.WriteFileRequest")
+	proto.RegisterType((*WriteFileResponse)(nil), "filer_pb.WriteFileResponse")
+	proto.RegisterType((*AssignVolumeRequest)(nil), "filer_pb.AssignVolumeRequest")
+	proto.RegisterType((*AssignVolumeResponse)(nil), "filer_pb.AssignVolumeResponse")
+	proto.RegisterType((*LookupVolumeRequest)(nil), "filer_pb.LookupVolumeRequest")
+	proto.RegisterType((*Locations)(nil), "filer_pb.Locations")
+	proto.RegisterType((*Location)(nil), "filer_pb.Location")
+	proto.RegisterType((*LookupVolumeResponse)(nil), "filer_pb.LookupVolumeResponse")
+	proto.RegisterType((*DeleteCollectionRequest)(nil), "filer_pb.DeleteCollectionRequest")
+	proto.RegisterType((*DeleteCollectionResponse)(nil), "filer_pb.DeleteCollectionResponse")
+	proto.RegisterType((*StatisticsRequest)(nil), "filer_pb.StatisticsRequest")
+	proto.RegisterType((*StatisticsResponse)(nil), "filer_pb.StatisticsResponse")
+	proto.RegisterType((*GetFilerConfigurationRequest)(nil), "filer_pb.GetFilerConfigurationRequest")
+	proto.RegisterType((*GetFilerConfigurationResponse)(nil), "filer_pb.GetFilerConfigurationResponse")
+	proto.RegisterType((*FileLock)(nil), "filer_pb.FileLock")
+	proto.RegisterType((*LockRequest)(nil), "filer_pb.LockRequest")
+	proto.RegisterType((*LockResponse)(nil), "filer_pb.LockResponse")
+	proto.RegisterType((*UnlockRequest)(nil), "filer_pb.UnlockRequest")
+	proto.RegisterType((*UnlockResponse)(nil), "filer_pb.UnlockResponse")
+	proto.RegisterType((*KeepLockLeaseRequest)(nil), "filer_pb.KeepLockLeaseRequest")
+	proto.RegisterType((*KeepLockLeaseResponse)(nil), "filer_pb.KeepLockLeaseResponse")
+	proto.RegisterType((*MigrateStoreRequest)(nil), "filer_pb.MigrateStoreRequest")
+	proto.RegisterType((*MigrateStoreResponse)(nil), "filer_pb.MigrateStoreResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for SeaweedFiler service
+
+type SeaweedFilerClient interface {
+	LookupDirectoryEntry(ctx context.Context, in *LookupDirectoryEntryRequest, opts ...grpc.CallOption) (*LookupDirectoryEntryResponse, error)
+	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (SeaweedFiler_ListEntriesClient, error)
+	CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error)
+	UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error)
+	DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*DeleteEntryResponse, error)
+	AtomicRenameEntry(ctx context.Context, in *AtomicRenameEntryRequest, opts ...grpc.CallOption) (*AtomicRenameEntryResponse, error)
+	AssignVolume(ctx context.Context, in *AssignVolumeRequest, opts ...grpc.CallOption) (*AssignVolumeResponse, error)
+	LookupVolume(ctx context.Context, in *LookupVolumeRequest, opts ...grpc.CallOption) (*LookupVolumeResponse, error)
+	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (SeaweedFiler_ReadFileClient, error)
+	WriteFile(ctx context.Context, opts ...grpc.CallOption) (SeaweedFiler_WriteFileClient, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
+	GetFilerConfiguration(ctx context.Context, in *GetFilerConfigurationRequest, opts ...grpc.CallOption) (*GetFilerConfigurationResponse, error)
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	TestLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	KeepLockLease(ctx context.Context, in *KeepLockLeaseRequest, opts ...grpc.CallOption) (*KeepLockLeaseResponse, error)
+	MigrateStore(ctx context.Context, in *MigrateStoreRequest, opts ...grpc.CallOption) (*MigrateStoreResponse, error)
+}
+
+type seaweedFilerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSeaweedFilerClient(cc *grpc.ClientConn) SeaweedFilerClient {
+	return &seaweedFilerClient{cc}
+}
+
+func (c *seaweedFilerClient) LookupDirectoryEntry(ctx context.Context, in *LookupDirectoryEntryRequest, opts ...grpc.CallOption) (*LookupDirectoryEntryResponse, error) {
+	out := new(LookupDirectoryEntryResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/LookupDirectoryEntry", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (SeaweedFiler_ListEntriesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SeaweedFiler_serviceDesc.Streams[0], c.cc, "/filer_pb.SeaweedFiler/ListEntries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedFilerListEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SeaweedFiler_ListEntriesClient interface {
+	Recv() (*ListEntriesResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedFilerListEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedFilerListEntriesClient) Recv() (*ListEntriesResponse, error) {
+	m := new(ListEntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *seaweedFilerClient) CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error) {
+	out := new(CreateEntryResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/CreateEntry", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error) {
+	out := new(UpdateEntryResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/UpdateEntry", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*DeleteEntryResponse, error) {
+	out := new(DeleteEntryResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/DeleteEntry", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) AtomicRenameEntry(ctx context.Context, in *AtomicRenameEntryRequest, opts ...grpc.CallOption) (*AtomicRenameEntryResponse, error) {
+	out := new(AtomicRenameEntryResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/AtomicRenameEntry", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) AssignVolume(ctx context.Context, in *AssignVolumeRequest, opts ...grpc.CallOption) (*AssignVolumeResponse, error) {
+	out := new(AssignVolumeResponse)
 	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/AssignVolume", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -1128,6 +1754,72 @@ func (c *seaweedFilerClient) LookupVolume(ctx context.Context, in *LookupVolumeR
 	return out, nil
 }
 
+func (c *seaweedFilerClient) ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (SeaweedFiler_ReadFileClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SeaweedFiler_serviceDesc.Streams[1], c.cc, "/filer_pb.SeaweedFiler/ReadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedFilerReadFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SeaweedFiler_ReadFileClient interface {
+	Recv() (*ReadFileResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedFilerReadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedFilerReadFileClient) Recv() (*ReadFileResponse, error) {
+	m := new(ReadFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *seaweedFilerClient) WriteFile(ctx context.Context, opts ...grpc.CallOption) (SeaweedFiler_WriteFileClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SeaweedFiler_serviceDesc.Streams[2], c.cc, "/filer_pb.SeaweedFiler/WriteFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedFilerWriteFileClient{stream}
+	return x, nil
+}
+
+type SeaweedFiler_WriteFileClient interface {
+	Send(*WriteFileRequest) error
+	CloseAndRecv() (*WriteFileResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedFilerWriteFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedFilerWriteFileClient) Send(m *WriteFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *seaweedFilerWriteFileClient) CloseAndRecv() (*WriteFileResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(WriteFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *seaweedFilerClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
 	out := new(DeleteCollectionResponse)
 	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/DeleteCollection", in, out, c.cc, opts...)
@@ -1155,20 +1847,72 @@ func (c *seaweedFilerClient) GetFilerConfiguration(ctx context.Context, in *GetF
 	return out, nil
 }
 
+func (c *seaweedFilerClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/Lock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/Unlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) TestLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/TestLock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) KeepLockLease(ctx context.Context, in *KeepLockLeaseRequest, opts ...grpc.CallOption) (*KeepLockLeaseResponse, error) {
+	out := new(KeepLockLeaseResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/KeepLockLease", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) MigrateStore(ctx context.Context, in *MigrateStoreRequest, opts ...grpc.CallOption) (*MigrateStoreResponse, error) {
+	out := new(MigrateStoreResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/MigrateStore", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SeaweedFiler service
 
 type SeaweedFilerServer interface {
 	LookupDirectoryEntry(context.Context, *LookupDirectoryEntryRequest) (*LookupDirectoryEntryResponse, error)
-	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	ListEntries(*ListEntriesRequest, SeaweedFiler_ListEntriesServer) error
 	CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error)
 	UpdateEntry(context.Context, *UpdateEntryRequest) (*UpdateEntryResponse, error)
 	DeleteEntry(context.Context, *DeleteEntryRequest) (*DeleteEntryResponse, error)
 	AtomicRenameEntry(context.Context, *AtomicRenameEntryRequest) (*AtomicRenameEntryResponse, error)
 	AssignVolume(context.Context, *AssignVolumeRequest) (*AssignVolumeResponse, error)
 	LookupVolume(context.Context, *LookupVolumeRequest) (*LookupVolumeResponse, error)
+	ReadFile(*ReadFileRequest, SeaweedFiler_ReadFileServer) error
+	WriteFile(SeaweedFiler_WriteFileServer) error
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
 	Statistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error)
 	GetFilerConfiguration(context.Context, *GetFilerConfigurationRequest) (*GetFilerConfigurationResponse, error)
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	TestLock(context.Context, *LockRequest) (*LockResponse, error)
+	KeepLockLease(context.Context, *KeepLockLeaseRequest) (*KeepLockLeaseResponse, error)
+	MigrateStore(context.Context, *MigrateStoreRequest) (*MigrateStoreResponse, error)
 }
 
 func RegisterSeaweedFilerServer(s *grpc.Server, srv SeaweedFilerServer) {
@@ -1193,22 +1937,25 @@ func _SeaweedFiler_LookupDirectoryEntry_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SeaweedFiler_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEntriesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func _SeaweedFiler_ListEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if interceptor == nil {
-		return srv.(SeaweedFilerServer).ListEntries(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filer_pb.SeaweedFiler/ListEntries",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SeaweedFilerServer).ListEntries(ctx, req.(*ListEntriesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(SeaweedFilerServer).ListEntries(m, &seaweedFilerListEntriesServer{stream})
+}
+
+type SeaweedFiler_ListEntriesServer interface {
+	Send(*ListEntriesResponse) error
+	grpc.ServerStream
+}
+
+type seaweedFilerListEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedFilerListEntriesServer) Send(m *ListEntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
 func _SeaweedFiler_CreateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -1319,6 +2066,53 @@ func _SeaweedFiler_LookupVolume_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeaweedFiler_ReadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SeaweedFilerServer).ReadFile(m, &seaweedFilerReadFileServer{stream})
+}
+
+type SeaweedFiler_ReadFileServer interface {
+	Send(*ReadFileResponse) error
+	grpc.ServerStream
+}
+
+type seaweedFilerReadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedFilerReadFileServer) Send(m *ReadFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SeaweedFiler_WriteFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SeaweedFilerServer).WriteFile(&seaweedFilerWriteFileServer{stream})
+}
+
+type SeaweedFiler_WriteFileServer interface {
+	SendAndClose(*WriteFileResponse) error
+	Recv() (*WriteFileRequest, error)
+	grpc.ServerStream
+}
+
+type seaweedFilerWriteFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedFilerWriteFileServer) SendAndClose(m *WriteFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *seaweedFilerWriteFileServer) Recv() (*WriteFileRequest, error) {
+	m := new(WriteFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _SeaweedFiler_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCollectionRequest)
 	if err := dec(in); err != nil {
@@ -1373,6 +2167,96 @@ func _SeaweedFiler_GetFilerConfiguration_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeaweedFiler_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_TestLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).TestLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/TestLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).TestLock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_KeepLockLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeepLockLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).KeepLockLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/KeepLockLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).KeepLockLease(ctx, req.(*KeepLockLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_MigrateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).MigrateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/MigrateStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).MigrateStore(ctx, req.(*MigrateStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "filer_pb.SeaweedFiler",
 	HandlerType: (*SeaweedFilerServer)(nil),
@@ -1381,10 +2265,6 @@ var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupDirectoryEntry",
 			Handler:    _SeaweedFiler_LookupDirectoryEntry_Handler,
 		},
-		{
-			MethodName: "ListEntries",
-			Handler:    _SeaweedFiler_ListEntries_Handler,
-		},
 		{
 			MethodName: "CreateEntry",
 			Handler:    _SeaweedFiler_CreateEntry_Handler,
@@ -1421,114 +2301,196 @@ var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFilerConfiguration",
 			Handler:    _SeaweedFiler_GetFilerConfiguration_Handler,
 		},
+		{
+			MethodName: "Lock",
+			Handler:    _SeaweedFiler_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _SeaweedFiler_Unlock_Handler,
+		},
+		{
+			MethodName: "TestLock",
+			Handler:    _SeaweedFiler_TestLock_Handler,
+		},
+		{
+			MethodName: "KeepLockLease",
+			Handler:    _SeaweedFiler_KeepLockLease_Handler,
+		},
+		{
+			MethodName: "MigrateStore",
+			Handler:    _SeaweedFiler_MigrateStore_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListEntries",
+			Handler:       _SeaweedFiler_ListEntries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadFile",
+			Handler:       _SeaweedFiler_ReadFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WriteFile",
+			Handler:       _SeaweedFiler_WriteFile_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "filer.proto",
 }
 
 func init() { proto.RegisterFile("filer.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x58, 0x49, 0x6f, 0xdc, 0x46,
-	0x16, 0x36, 0x7b, 0xe7, 0xeb, 0x6e, 0x5b, 0x2a, 0xc9, 0x36, 0xdd, 0x5a, 0x46, 0xa6, 0xc6, 0x1e,
-	0x19, 0x63, 0x68, 0x0c, 0x8f, 0x0f, 0xf6, 0x18, 0x03, 0xc4, 0xd6, 0x12, 0x08, 0x91, 0x17, 0x50,
-	0x76, 0x90, 0x20, 0x40, 0x08, 0x8a, 0xac, 0x6e, 0x55, 0x44, 0xb2, 0x3a, 0xc5, 0xa2, 0x24, 0xe7,
-	0x27, 0xe4, 0x98, 0x63, 0x80, 0x9c, 0xf3, 0x27, 0x82, 0x5c, 0x02, 0xff, 0x9d, 0x1c, 0x73, 0x0e,
-	0xaa, 0x8a, 0x64, 0x17, 0x9b, 0x2d, 0xc9, 0x41, 0xe0, 0x1b, 0xeb, 0x2d, 0xdf, 0x5b, 0xea, 0x2d,
-	0xd5, 0x0d, 0xdd, 0x21, 0x09, 0x31, 0xdb, 0x1c, 0x33, 0xca, 0x29, 0xea, 0xc8, 0x83, 0x3b, 0x3e,
-	0xb4, 0x5f, 0xc1, 0xd2, 0x3e, 0xa5, 0xc7, 0xe9, 0x78, 0x9b, 0x30, 0xec, 0x73, 0xca, 0xde, 0xed,
-	0xc4, 0x9c, 0xbd, 0x73, 0xf0, 0xb7, 0x29, 0x4e, 0x38, 0x5a, 0x06, 0x33, 0xc8, 0x19, 0x96, 0xb1,
-	0x66, 0x6c, 0x98, 0xce, 0x84, 0x80, 0x10, 0x34, 0x62, 0x2f, 0xc2, 0x56, 0x4d, 0x32, 0xe4, 0xb7,
-	0xbd, 0x03, 0xcb, 0xb3, 0x01, 0x93, 0x31, 0x8d, 0x13, 0x8c, 0xee, 0x40, 0x13, 0x0b, 0x82, 0x44,
-	0xeb, 0x3e, 0xbc, 0xb6, 0x99, 0xbb, 0xb2, 0xa9, 0xe4, 0x14, 0xd7, 0xfe, 0xd5, 0x00, 0xb4, 0x4f,
-	0x12, 0x2e, 0x88, 0x04, 0x27, 0x1f, 0xe6, 0xcf, 0x0d, 0x68, 0x8d, 0x19, 0x1e, 0x92, 0xb3, 0xcc,
-	0xa3, 0xec, 0x84, 0xee, 0xc3, 0x7c, 0xc2, 0x3d, 0xc6, 0x77, 0x19, 0x8d, 0x76, 0x49, 0x88, 0x5f,
-	0x0a, 0xa7, 0xeb, 0x52, 0xa4, 0xca, 0x40, 0x9b, 0x80, 0x48, 0xec, 0x87, 0x69, 0x42, 0x4e, 0xf0,
-	0x41, 0xce, 0xb5, 0x1a, 0x6b, 0xc6, 0x46, 0xc7, 0x99, 0xc1, 0x41, 0x8b, 0xd0, 0x0c, 0x49, 0x44,
-	0xb8, 0xd5, 0x5c, 0x33, 0x36, 0xfa, 0x8e, 0x3a, 0xd8, 0x9f, 0xc0, 0x42, 0xc9, 0xff, 0x2c, 0xfc,
-	0x7b, 0xd0, 0xc6, 0x8a, 0x64, 0x19, 0x6b, 0xf5, 0x59, 0x09, 0xc8, 0xf9, 0xf6, 0x4f, 0x35, 0x68,
-	0x4a, 0x52, 0x91, 0x67, 0x63, 0x92, 0x67, 0x74, 0x1b, 0x7a, 0x24, 0x71, 0x27, 0xc9, 0xa8, 0x49,
-	0xff, 0xba, 0x24, 0x29, 0xf2, 0x8e, 0xfe, 0x0d, 0x2d, 0xff, 0x28, 0x8d, 0x8f, 0x13, 0xab, 0x2e,
-	0x4d, 0x2d, 0x4c, 0x4c, 0x89, 0x60, 0xb7, 0x04, 0xcf, 0xc9, 0x44, 0xd0, 0x63, 0x00, 0x8f, 0x73,
-	0x46, 0x0e, 0x53, 0x8e, 0x13, 0x19, 0x6d, 0xf7, 0xa1, 0xa5, 0x29, 0xa4, 0x09, 0x7e, 0x56, 0xf0,
-	0x1d, 0x4d, 0x16, 0x3d, 0x81, 0x0e, 0x3e, 0xe3, 0x38, 0x0e, 0x70, 0x60, 0x35, 0xa5, 0xa1, 0x95,
-	0xa9, 0x98, 0x36, 0x77, 0x32, 0xbe, 0x8a, 0xb0, 0x10, 0x1f, 0x3c, 0x85, 0x7e, 0x89, 0x85, 0xe6,
-	0xa0, 0x7e, 0x8c, 0xf3, 0x9b, 0x15, 0x9f, 0x22, 0xbb, 0x27, 0x5e, 0x98, 0xaa, 0x22, 0xeb, 0x39,
-	0xea, 0xf0, 0xbf, 0xda, 0x63, 0xc3, 0xde, 0x06, 0x73, 0x37, 0x0d, 0xc3, 0x42, 0x31, 0x20, 0x2c,
-	0x57, 0x0c, 0x08, 0x9b, 0x14, 0x5a, 0xed, 0xc2, 0x42, 0xfb, 0xc5, 0x80, 0xf9, 0x9d, 0x13, 0x1c,
-	0xf3, 0x97, 0x94, 0x93, 0x21, 0xf1, 0x3d, 0x4e, 0x68, 0x8c, 0xee, 0x83, 0x49, 0xc3, 0xc0, 0xbd,
-	0xb0, 0x52, 0x3b, 0x34, 0xcc, 0xbc, 0xbe, 0x0f, 0x66, 0x8c, 0x4f, 0xdd, 0x0b, 0xcd, 0x75, 0x62,
-	0x7c, 0xaa, 0xa4, 0xd7, 0xa1, 0x1f, 0xe0, 0x10, 0x73, 0xec, 0x16, 0xb7, 0x23, 0xae, 0xae, 0xa7,
-	0x88, 0x5b, 0xea, 0x3a, 0xee, 0xc2, 0x35, 0x01, 0x39, 0xf6, 0x18, 0x8e, 0xb9, 0x3b, 0xf6, 0xf8,
-	0x91, 0xbc, 0x13, 0xd3, 0xe9, 0xc7, 0xf8, 0xf4, 0xb5, 0xa4, 0xbe, 0xf6, 0xf8, 0x91, 0xfd, 0x87,
-	0x01, 0x66, 0x71, 0x99, 0xe8, 0x26, 0xb4, 0x85, 0x59, 0x97, 0x04, 0x59, 0x26, 0x5a, 0xe2, 0xb8,
-	0x17, 0x88, 0xce, 0xa0, 0xc3, 0x61, 0x82, 0xb9, 0x74, 0xaf, 0xee, 0x64, 0x27, 0x51, 0x59, 0x09,
-	0xf9, 0x4e, 0x35, 0x43, 0xc3, 0x91, 0xdf, 0x22, 0xe3, 0x11, 0x27, 0x11, 0x96, 0x06, 0xeb, 0x8e,
-	0x3a, 0xa0, 0x05, 0x68, 0x62, 0x97, 0x7b, 0x23, 0x59, 0xe5, 0xa6, 0xd3, 0xc0, 0x6f, 0xbc, 0x11,
-	0xfa, 0x27, 0x5c, 0x4d, 0x68, 0xca, 0x7c, 0xec, 0xe6, 0x66, 0x5b, 0x92, 0xdb, 0x53, 0xd4, 0x5d,
-	0x65, 0xdc, 0x86, 0xfa, 0x90, 0x04, 0x56, 0x5b, 0x26, 0x66, 0xae, 0x5c, 0x84, 0x7b, 0x81, 0x23,
-	0x98, 0xe8, 0x3f, 0x00, 0x05, 0x52, 0x60, 0x75, 0xce, 0x11, 0x35, 0x73, 0xdc, 0xc0, 0xfe, 0x02,
-	0x5a, 0x19, 0xfc, 0x12, 0x98, 0x27, 0x34, 0x4c, 0xa3, 0x22, 0xec, 0xbe, 0xd3, 0x51, 0x84, 0xbd,
-	0x00, 0xdd, 0x02, 0x39, 0xeb, 0x5c, 0x51, 0x55, 0x35, 0x19, 0xa4, 0xcc, 0xd0, 0x67, 0x58, 0x4e,
-	0x0b, 0x9f, 0xd2, 0x63, 0xa2, 0xa2, 0x6f, 0x3b, 0xd9, 0xc9, 0xfe, 0xbd, 0x06, 0x57, 0xcb, 0xe5,
-	0x2e, 0x4c, 0x48, 0x14, 0x99, 0x2b, 0x43, 0xc2, 0x48, 0xd8, 0x83, 0x52, 0xbe, 0x6a, 0x7a, 0xbe,
-	0x72, 0x95, 0x88, 0x06, 0xca, 0x40, 0x5f, 0xa9, 0xbc, 0xa0, 0x01, 0x16, 0xd5, 0x9a, 0x92, 0x40,
-	0x26, 0xb8, 0xef, 0x88, 0x4f, 0x41, 0x19, 0x91, 0x20, 0x1b, 0x21, 0xe2, 0x53, 0xba, 0xc7, 0x24,
-	0x6e, 0x4b, 0x5d, 0x99, 0x3a, 0x89, 0x2b, 0x8b, 0x04, 0xb5, 0xad, 0xee, 0x41, 0x7c, 0xa3, 0x35,
-	0xe8, 0x32, 0x3c, 0x0e, 0xb3, 0xea, 0x95, 0xe9, 0x33, 0x1d, 0x9d, 0x84, 0x56, 0x01, 0x7c, 0x1a,
-	0x86, 0xd8, 0x97, 0x02, 0xa6, 0x14, 0xd0, 0x28, 0xa2, 0x72, 0x38, 0x0f, 0xdd, 0x04, 0xfb, 0x16,
-	0xac, 0x19, 0x1b, 0x4d, 0xa7, 0xc5, 0x79, 0x78, 0x80, 0x7d, 0x11, 0x47, 0x9a, 0x60, 0xe6, 0xca,
-	0x01, 0xd4, 0x95, 0x7a, 0x1d, 0x41, 0x90, 0xa3, 0x72, 0x05, 0x60, 0xc4, 0x68, 0x3a, 0x56, 0xdc,
-	0xde, 0x5a, 0x5d, 0xcc, 0x63, 0x49, 0x91, 0xec, 0x3b, 0x70, 0x35, 0x79, 0x17, 0x85, 0x24, 0x3e,
-	0x76, 0xb9, 0xc7, 0x46, 0x98, 0x5b, 0x7d, 0x55, 0xc3, 0x19, 0xf5, 0x8d, 0x24, 0xda, 0x5f, 0x02,
-	0xda, 0x62, 0xd8, 0xe3, 0xf8, 0x2f, 0xac, 0x9e, 0x0f, 0xec, 0xee, 0xeb, 0xb0, 0x50, 0x82, 0x56,
-	0x53, 0x58, 0x58, 0x7c, 0x3b, 0x0e, 0x3e, 0x96, 0xc5, 0x12, 0x74, 0x66, 0xf1, 0xbd, 0x01, 0x68,
-	0x5b, 0x36, 0xf8, 0xdf, 0xdb, 0xaf, 0xa2, 0xe5, 0xc4, 0xdc, 0x57, 0x03, 0x24, 0xf0, 0xb8, 0x97,
-	0x6d, 0xa6, 0x1e, 0x49, 0x14, 0xfe, 0xb6, 0xc7, 0xbd, 0x6c, 0x3b, 0x30, 0xec, 0xa7, 0x4c, 0x2c,
-	0x2b, 0x59, 0x57, 0x72, 0x3b, 0x38, 0x39, 0x09, 0x3d, 0x82, 0x1b, 0x64, 0x14, 0x53, 0x86, 0x27,
-	0x62, 0x2e, 0x66, 0x8c, 0x32, 0x59, 0x6f, 0x1d, 0x67, 0x51, 0x71, 0x0b, 0x85, 0x1d, 0xc1, 0x13,
-	0xe1, 0x95, 0xc2, 0xc8, 0xc2, 0xfb, 0xd1, 0x00, 0xeb, 0x19, 0xa7, 0x11, 0xf1, 0x1d, 0x2c, 0xdc,
-	0x2c, 0x05, 0xb9, 0x0e, 0x7d, 0x31, 0x4c, 0xa7, 0x03, 0xed, 0xd1, 0x30, 0x98, 0x2c, 0xab, 0x5b,
-	0x20, 0xe6, 0xa9, 0xab, 0xc5, 0xdb, 0xa6, 0x61, 0x20, 0xcb, 0x68, 0x1d, 0xc4, 0xd0, 0xd3, 0xf4,
-	0xd5, 0xea, 0xee, 0xc5, 0xf8, 0xb4, 0xa4, 0x2f, 0x84, 0xa4, 0xbe, 0x9a, 0x94, 0xed, 0x18, 0x9f,
-	0x0a, 0x7d, 0x7b, 0x09, 0x6e, 0xcd, 0xf0, 0x2d, 0xf3, 0xfc, 0x67, 0x03, 0x16, 0x9e, 0x25, 0x09,
-	0x19, 0xc5, 0x9f, 0xcb, 0x99, 0x91, 0x3b, 0xbd, 0x08, 0x4d, 0x9f, 0xa6, 0x31, 0x97, 0xce, 0x36,
-	0x1d, 0x75, 0x98, 0x6a, 0xa3, 0x5a, 0xa5, 0x8d, 0xa6, 0x1a, 0xb1, 0x5e, 0x6d, 0x44, 0xad, 0xd1,
-	0x1a, 0xa5, 0x46, 0xfb, 0x07, 0x74, 0xc5, 0x75, 0xba, 0x3e, 0x8e, 0x39, 0x66, 0xd9, 0x98, 0x05,
-	0x41, 0xda, 0x92, 0x14, 0xfb, 0x7b, 0x03, 0x16, 0xcb, 0x9e, 0x66, 0x6f, 0x8a, 0x73, 0xa7, 0xbe,
-	0x18, 0x33, 0x2c, 0xcc, 0xdc, 0x14, 0x9f, 0xa2, 0x61, 0xc7, 0xe9, 0x61, 0x48, 0x7c, 0x57, 0x30,
-	0x94, 0x7b, 0xa6, 0xa2, 0xbc, 0x65, 0xe1, 0x24, 0xe8, 0x86, 0x1e, 0x34, 0x82, 0x86, 0x97, 0xf2,
-	0xa3, 0x7c, 0xf2, 0x8b, 0x6f, 0xfb, 0x11, 0x2c, 0xa8, 0x67, 0x5e, 0x39, 0x6b, 0x2b, 0x00, 0xc5,
-	0x2c, 0x56, 0x2f, 0x1c, 0xd3, 0x31, 0xf3, 0x61, 0x9c, 0xd8, 0xff, 0x07, 0x73, 0x9f, 0xaa, 0x44,
-	0x24, 0xe8, 0x01, 0x98, 0x61, 0x7e, 0xc8, 0x1e, 0x43, 0x68, 0xd2, 0x54, 0xb9, 0x9c, 0x33, 0x11,
-	0xb2, 0x9f, 0x42, 0x27, 0x27, 0xe7, 0xb1, 0x19, 0xe7, 0xc5, 0x56, 0x9b, 0x8a, 0xcd, 0xfe, 0xcd,
-	0x80, 0xc5, 0xb2, 0xcb, 0x59, 0xfa, 0xde, 0x42, 0xbf, 0x30, 0xe1, 0x46, 0xde, 0x38, 0xf3, 0xe5,
-	0x81, 0xee, 0x4b, 0x55, 0xad, 0x70, 0x30, 0x79, 0xe1, 0x8d, 0x55, 0x49, 0xf5, 0x42, 0x8d, 0x34,
-	0x78, 0x03, 0xf3, 0x15, 0x91, 0x19, 0xef, 0x9b, 0x7b, 0xfa, 0xfb, 0xa6, 0xf4, 0x46, 0x2b, 0xb4,
-	0xf5, 0x47, 0xcf, 0x13, 0xb8, 0xa9, 0xfa, 0x6f, 0xab, 0x28, 0xba, 0x3c, 0xf7, 0xe5, 0xda, 0x34,
-	0xa6, 0x6b, 0xd3, 0x1e, 0x80, 0x55, 0x55, 0xcd, 0xba, 0x60, 0x04, 0xf3, 0x07, 0xdc, 0xe3, 0x24,
-	0xe1, 0xc4, 0x2f, 0x1e, 0xdb, 0x53, 0xc5, 0x6c, 0x5c, 0xb6, 0x55, 0xaa, 0xed, 0x30, 0x07, 0x75,
-	0xce, 0xf3, 0x3a, 0x13, 0x9f, 0xe2, 0x16, 0x90, 0x6e, 0x29, 0xbb, 0x83, 0x8f, 0x60, 0x4a, 0xd4,
-	0x03, 0xa7, 0xdc, 0x0b, 0xd5, 0xd6, 0x6e, 0xc8, 0xad, 0x6d, 0x4a, 0x8a, 0x5c, 0xdb, 0x6a, 0xb1,
-	0x05, 0x8a, 0xdb, 0x54, 0x3b, 0x5d, 0x10, 0x24, 0x73, 0x05, 0x40, 0xb6, 0x94, 0xea, 0x86, 0x96,
-	0xd2, 0x15, 0x94, 0x2d, 0x41, 0xb0, 0x57, 0x61, 0xf9, 0x53, 0xcc, 0xc5, 0xfb, 0x83, 0x6d, 0xd1,
-	0x78, 0x48, 0x46, 0x29, 0xf3, 0xb4, 0xab, 0xb0, 0x7f, 0x30, 0x60, 0xe5, 0x1c, 0x81, 0x2c, 0x60,
-	0x0b, 0xda, 0x91, 0x97, 0x70, 0xcc, 0xf2, 0x2e, 0xc9, 0x8f, 0xd3, 0xa9, 0xa8, 0x5d, 0x96, 0x8a,
-	0x7a, 0x25, 0x15, 0xd7, 0xa1, 0x15, 0x79, 0x67, 0x6e, 0x74, 0x98, 0x3d, 0x30, 0x9a, 0x91, 0x77,
-	0xf6, 0xe2, 0xf0, 0xe1, 0xfb, 0x36, 0xf4, 0x0e, 0xb0, 0x77, 0x8a, 0x71, 0x20, 0x1d, 0x43, 0xa3,
-	0xbc, 0x21, 0xca, 0x3f, 0xd5, 0xd0, 0x9d, 0xe9, 0xca, 0x9f, 0xf9, 0xdb, 0x70, 0x70, 0xf7, 0x32,
-	0xb1, 0xac, 0xb6, 0xae, 0xa0, 0x7d, 0xe8, 0x6a, 0xbf, 0x85, 0xd0, 0xb2, 0xa6, 0x58, 0xf9, 0x89,
-	0x37, 0x58, 0x39, 0x87, 0xab, 0xa3, 0x69, 0x3b, 0x5d, 0x47, 0xab, 0xbe, 0x22, 0x74, 0xb4, 0x59,
-	0x0f, 0x01, 0x89, 0xa6, 0xed, 0x6b, 0x1d, 0xad, 0xfa, 0x42, 0xd0, 0xd1, 0x66, 0x2d, 0x79, 0x89,
-	0xa6, 0xad, 0x47, 0x1d, 0xad, 0xba, 0xfc, 0x75, 0xb4, 0x59, 0x3b, 0xf5, 0x0a, 0xfa, 0x1a, 0xe6,
-	0x2b, 0x8b, 0x0b, 0xd9, 0x13, 0xad, 0xf3, 0x36, 0xee, 0x60, 0xfd, 0x42, 0x99, 0x02, 0xff, 0x15,
-	0xf4, 0xf4, 0x85, 0x82, 0x34, 0x87, 0x66, 0xac, 0xc4, 0xc1, 0xea, 0x79, 0x6c, 0x1d, 0x50, 0x9f,
-	0x95, 0x3a, 0xe0, 0x8c, 0x6d, 0xa1, 0x03, 0xce, 0x1a, 0xb1, 0xf6, 0x15, 0xf4, 0x15, 0xcc, 0x4d,
-	0xcf, 0x2c, 0x74, 0x7b, 0x3a, 0x6d, 0x95, 0x51, 0x38, 0xb0, 0x2f, 0x12, 0x29, 0xc0, 0xf7, 0x00,
-	0x26, 0xa3, 0x08, 0x2d, 0x4d, 0x74, 0x2a, 0xa3, 0x70, 0xb0, 0x3c, 0x9b, 0x59, 0x40, 0x7d, 0x03,
-	0xd7, 0x67, 0xf6, 0x3b, 0xd2, 0x9a, 0xe4, 0xa2, 0x89, 0x31, 0xf8, 0xd7, 0xa5, 0x72, 0xb9, 0xad,
-	0xe7, 0xab, 0x30, 0x97, 0xa8, 0x36, 0x1e, 0x26, 0x9b, 0x7e, 0x48, 0x70, 0xcc, 0x9f, 0x83, 0xd4,
-	0x78, 0xcd, 0x28, 0xa7, 0x87, 0x2d, 0xf9, 0x1f, 0xcf, 0x7f, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x0e, 0xa9, 0xb5, 0x68, 0xf2, 0x11, 0x00, 0x00,
+	// 2348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x73, 0xdc, 0x48,
+	0x11, 0x47, 0xfb, 0xe5, 0x55, 0xef, 0xae, 0x3f, 0xc6, 0x4e, 0x6e, 0xa3, 0xc4, 0x8e, 0x4f, 0x21,
+	0x89, 0xe1, 0x52, 0x26, 0x15, 0xae, 0xe0, 0x8e, 0xbb, 0xab, 0x22, 0xe7, 0x38, 0x90, 0x3a, 0x27,
+	0x97, 0x92, 0x13, 0xa0, 0x80, 0x42, 0x25, 0x4b, 0xb3, 0xeb, 0x61, 0xb5, 0x92, 0x6e, 0x66, 0x14,
+	0x3b, 0x14, 0x8f, 0xbc, 0xc0, 0x23, 0x8f, 0x14, 0xbc, 0xf2, 0x07, 0x50, 0xc5, 0x0b, 0x45, 0xf1,
+	0x42, 0xf1, 0xa7, 0xf0, 0xca, 0x23, 0xcf, 0xd4, 0x7c, 0x48, 0x1a, 0xed, 0x87, 0x7d, 0x17, 0xea,
+	0xe0, 0x4d, 0xd3, 0xdd, 0xd3, 0xd3, 0xfd, 0xeb, 0x9e, 0x9e, 0xee, 0x5d, 0xe8, 0x8d, 0x48, 0x8c,
+	0xe9, 0x7e, 0x46, 0x53, 0x9e, 0xa2, 0xae, 0x5c, 0xf8, 0xd9, 0x89, 0xfb, 0x29, 0x5c, 0x3f, 0x4a,
+	0xd3, 0x49, 0x9e, 0x3d, 0x22, 0x14, 0x87, 0x3c, 0xa5, 0xaf, 0x0f, 0x13, 0x4e, 0x5f, 0x7b, 0xf8,
+	0xb3, 0x1c, 0x33, 0x8e, 0x6e, 0x80, 0x1d, 0x15, 0x8c, 0xa1, 0xb5, 0x6b, 0xed, 0xd9, 0x5e, 0x45,
+	0x40, 0x08, 0x5a, 0x49, 0x30, 0xc5, 0xc3, 0x86, 0x64, 0xc8, 0x6f, 0xf7, 0x10, 0x6e, 0x2c, 0x56,
+	0xc8, 0xb2, 0x34, 0x61, 0x18, 0xdd, 0x86, 0x36, 0x4e, 0xb8, 0xd6, 0xd6, 0x7b, 0xb0, 0xb6, 0x5f,
+	0x98, 0xb2, 0xaf, 0xe4, 0x14, 0xd7, 0xfd, 0x9b, 0x05, 0xe8, 0x88, 0x30, 0x2e, 0x88, 0x04, 0xb3,
+	0xcf, 0x67, 0xcf, 0x55, 0xe8, 0x64, 0x14, 0x8f, 0xc8, 0xb9, 0xb6, 0x48, 0xaf, 0xd0, 0x3d, 0xd8,
+	0x60, 0x3c, 0xa0, 0xfc, 0x31, 0x4d, 0xa7, 0x8f, 0x49, 0x8c, 0x9f, 0x09, 0xa3, 0x9b, 0x52, 0x64,
+	0x9e, 0x81, 0xf6, 0x01, 0x91, 0x24, 0x8c, 0x73, 0x46, 0x5e, 0xe1, 0xe3, 0x82, 0x3b, 0x6c, 0xed,
+	0x5a, 0x7b, 0x5d, 0x6f, 0x01, 0x07, 0x6d, 0x41, 0x3b, 0x26, 0x53, 0xc2, 0x87, 0xed, 0x5d, 0x6b,
+	0x6f, 0xe0, 0xa9, 0x85, 0xfb, 0x21, 0x6c, 0xd6, 0xec, 0xff, 0x62, 0xee, 0xff, 0xa1, 0x01, 0x6d,
+	0x49, 0x28, 0x31, 0xb6, 0x2a, 0x8c, 0xd1, 0xdb, 0xd0, 0x27, 0xcc, 0xaf, 0x80, 0x68, 0x48, 0xdb,
+	0x7a, 0x84, 0x95, 0x98, 0xa3, 0x77, 0xa0, 0x13, 0x9e, 0xe6, 0xc9, 0x84, 0x0d, 0x9b, 0xbb, 0xcd,
+	0xbd, 0xde, 0x83, 0xcd, 0xea, 0x20, 0xe1, 0xe8, 0x81, 0xe0, 0x79, 0x5a, 0x04, 0xbd, 0x07, 0x10,
+	0x70, 0x4e, 0xc9, 0x49, 0xce, 0x31, 0x93, 0x9e, 0xf6, 0x1e, 0x0c, 0x8d, 0x0d, 0x39, 0xc3, 0x0f,
+	0x4b, 0xbe, 0x67, 0xc8, 0xa2, 0xf7, 0xa1, 0x8b, 0xcf, 0x39, 0x4e, 0x22, 0x1c, 0x0d, 0xdb, 0xf2,
+	0xa0, 0xed, 0x19, 0x8f, 0xf6, 0x0f, 0x35, 0x5f, 0xf9, 0x57, 0x8a, 0x3b, 0x1f, 0xc0, 0xa0, 0xc6,
+	0x42, 0xeb, 0xd0, 0x9c, 0xe0, 0x22, 0xaa, 0xe2, 0x53, 0x20, 0xfb, 0x2a, 0x88, 0x73, 0x95, 0x60,
+	0x7d, 0x4f, 0x2d, 0xbe, 0xd3, 0x78, 0xcf, 0x72, 0x1f, 0x81, 0xfd, 0x38, 0x8f, 0xe3, 0x72, 0x63,
+	0x44, 0x68, 0xb1, 0x31, 0x22, 0xb4, 0x42, 0xb9, 0x71, 0x21, 0xca, 0x7f, 0xb5, 0x60, 0xe3, 0xf0,
+	0x15, 0x4e, 0xf8, 0xb3, 0x94, 0x93, 0x11, 0x09, 0x03, 0x4e, 0xd2, 0x04, 0xdd, 0x03, 0x3b, 0x8d,
+	0x23, 0xff, 0xc2, 0x30, 0x75, 0xd3, 0x58, 0x5b, 0x7d, 0x0f, 0xec, 0x04, 0x9f, 0xf9, 0x17, 0x1e,
+	0xd7, 0x4d, 0xf0, 0x99, 0x92, 0xbe, 0x05, 0x83, 0x08, 0xc7, 0x98, 0x63, 0xbf, 0x8c, 0x8e, 0x08,
+	0x5d, 0x5f, 0x11, 0x0f, 0x54, 0x38, 0xee, 0xc0, 0x9a, 0x50, 0x99, 0x05, 0x14, 0x27, 0xdc, 0xcf,
+	0x02, 0x7e, 0x2a, 0x63, 0x62, 0x7b, 0x83, 0x04, 0x9f, 0x3d, 0x97, 0xd4, 0xe7, 0x01, 0x3f, 0x75,
+	0xff, 0x6d, 0x81, 0x5d, 0x06, 0x13, 0xbd, 0x05, 0x2b, 0xe2, 0x58, 0x9f, 0x44, 0x1a, 0x89, 0x8e,
+	0x58, 0x3e, 0x89, 0xc4, 0xad, 0x48, 0x47, 0x23, 0x86, 0xb9, 0x34, 0xaf, 0xe9, 0xe9, 0x95, 0xc8,
+	0x2c, 0x46, 0x7e, 0xa1, 0x2e, 0x42, 0xcb, 0x93, 0xdf, 0x02, 0xf1, 0x29, 0x27, 0x53, 0x2c, 0x0f,
+	0x6c, 0x7a, 0x6a, 0x81, 0x36, 0xa1, 0x8d, 0x7d, 0x1e, 0x8c, 0x65, 0x86, 0xdb, 0x5e, 0x0b, 0xbf,
+	0x08, 0xc6, 0xe8, 0xab, 0xb0, 0xca, 0xd2, 0x9c, 0x86, 0xd8, 0x2f, 0x8e, 0xed, 0x48, 0x6e, 0x5f,
+	0x51, 0x1f, 0xab, 0xc3, 0x5d, 0x68, 0x8e, 0x48, 0x34, 0x5c, 0x91, 0xc0, 0xac, 0xd7, 0x93, 0xf0,
+	0x49, 0xe4, 0x09, 0x26, 0xfa, 0x06, 0x40, 0xa9, 0x29, 0x1a, 0x76, 0x97, 0x88, 0xda, 0x85, 0xde,
+	0xc8, 0xfd, 0x11, 0x74, 0xb4, 0xfa, 0xeb, 0x60, 0xbf, 0x4a, 0xe3, 0x7c, 0x5a, 0xba, 0x3d, 0xf0,
+	0xba, 0x8a, 0xf0, 0x24, 0x42, 0xd7, 0x40, 0xd6, 0x39, 0x5f, 0x64, 0x55, 0x43, 0x3a, 0x29, 0x11,
+	0xfa, 0x04, 0xcb, 0x4a, 0x11, 0xa6, 0xe9, 0x84, 0x28, 0xef, 0x57, 0x3c, 0xbd, 0x72, 0xff, 0xd5,
+	0x80, 0xd5, 0x7a, 0xba, 0x8b, 0x23, 0xa4, 0x16, 0x89, 0x95, 0x25, 0xd5, 0x48, 0xb5, 0xc7, 0x35,
+	0xbc, 0x1a, 0x26, 0x5e, 0xc5, 0x96, 0x69, 0x1a, 0xa9, 0x03, 0x06, 0x6a, 0xcb, 0xd3, 0x34, 0xc2,
+	0x22, 0x5b, 0x73, 0x12, 0x49, 0x80, 0x07, 0x9e, 0xf8, 0x14, 0x94, 0x31, 0x89, 0x74, 0xf9, 0x10,
+	0x9f, 0xd2, 0x3c, 0x2a, 0xf5, 0x76, 0x54, 0xc8, 0xd4, 0x4a, 0x84, 0x6c, 0x2a, 0xa8, 0x2b, 0x2a,
+	0x0e, 0xe2, 0x1b, 0xed, 0x42, 0x8f, 0xe2, 0x2c, 0xd6, 0xd9, 0x2b, 0xe1, 0xb3, 0x3d, 0x93, 0x84,
+	0x76, 0x00, 0xc2, 0x34, 0x8e, 0x71, 0x28, 0x05, 0x6c, 0x29, 0x60, 0x50, 0x44, 0xe6, 0x70, 0x1e,
+	0xfb, 0x0c, 0x87, 0x43, 0xd8, 0xb5, 0xf6, 0xda, 0x5e, 0x87, 0xf3, 0xf8, 0x18, 0x87, 0xc2, 0x8f,
+	0x9c, 0x61, 0xea, 0xcb, 0x02, 0xd4, 0x93, 0xfb, 0xba, 0x82, 0x20, 0xcb, 0xe4, 0x36, 0xc0, 0x98,
+	0xa6, 0x79, 0xa6, 0xb8, 0xfd, 0xdd, 0xa6, 0xa8, 0xc5, 0x92, 0x22, 0xd9, 0xb7, 0x61, 0x95, 0xbd,
+	0x9e, 0xc6, 0x24, 0x99, 0xf8, 0x3c, 0xa0, 0x63, 0xcc, 0x87, 0x03, 0x95, 0xc3, 0x9a, 0xfa, 0x42,
+	0x12, 0xdd, 0x5f, 0x02, 0x3a, 0xa0, 0x38, 0xe0, 0xf8, 0x0b, 0x3c, 0x3b, 0x9f, 0xef, 0x76, 0x8b,
+	0x2a, 0xc9, 0x30, 0xf7, 0xcb, 0xfa, 0xa4, 0xae, 0x5a, 0x8f, 0x61, 0x5e, 0xd4, 0x1d, 0xf7, 0x0a,
+	0x6c, 0xd6, 0x4e, 0x57, 0x45, 0xda, 0xfd, 0x8b, 0x05, 0xe8, 0x65, 0x16, 0xfd, 0x9f, 0xac, 0x42,
+	0x1f, 0xc2, 0x1a, 0x3e, 0xcf, 0x70, 0xc8, 0x71, 0x54, 0x94, 0x89, 0xd6, 0xf2, 0x22, 0xbe, 0x5a,
+	0xc8, 0xca, 0x25, 0x13, 0x3e, 0xd5, 0x6c, 0xd7, 0x3e, 0xfd, 0xc3, 0x02, 0xf4, 0x48, 0x56, 0x99,
+	0xff, 0xee, 0x81, 0x17, 0xf7, 0x5e, 0x3c, 0x3e, 0xaa, 0x8a, 0x45, 0x01, 0x0f, 0xf4, 0xd3, 0xd8,
+	0x27, 0x4c, 0xe9, 0x7f, 0x14, 0xf0, 0x40, 0x3f, 0x51, 0x14, 0x87, 0x39, 0x15, 0xaf, 0xe5, 0xb0,
+	0x5d, 0x3c, 0x51, 0x5e, 0x41, 0x42, 0xef, 0xc2, 0x55, 0x32, 0x4e, 0x52, 0x8a, 0x2b, 0x31, 0x1f,
+	0x53, 0x9a, 0x52, 0x99, 0xf4, 0x5d, 0x6f, 0x4b, 0x71, 0xcb, 0x0d, 0x87, 0x82, 0x27, 0xdc, 0xab,
+	0xb9, 0xa1, 0xdd, 0xfb, 0x9d, 0x05, 0xc3, 0x87, 0x3c, 0x9d, 0x92, 0xd0, 0xc3, 0xc2, 0xcc, 0x9a,
+	0x93, 0xb7, 0x60, 0x20, 0x2a, 0xfa, 0xac, 0xa3, 0xfd, 0x34, 0x8e, 0xaa, 0x17, 0xf3, 0x1a, 0x88,
+	0xa2, 0xee, 0x1b, 0xfe, 0xae, 0xa4, 0x71, 0x24, 0x73, 0xf9, 0x16, 0x88, 0xca, 0x6b, 0xec, 0x57,
+	0xbd, 0x43, 0x3f, 0xc1, 0x67, 0xb5, 0xfd, 0x42, 0x48, 0xee, 0x57, 0xe5, 0x7a, 0x25, 0xc1, 0x67,
+	0x62, 0xbf, 0x7b, 0x1d, 0xae, 0x2d, 0xb0, 0x4d, 0x5b, 0xfe, 0x7b, 0x0b, 0xd6, 0x3c, 0x1c, 0x44,
+	0x22, 0xa2, 0x6f, 0x1e, 0x95, 0xaa, 0xc8, 0x37, 0x17, 0x16, 0x79, 0x55, 0xcf, 0xe5, 0xb7, 0xd1,
+	0x1b, 0xb4, 0x2f, 0xed, 0x0d, 0xdc, 0x3b, 0xb0, 0x5e, 0x59, 0xa7, 0x9b, 0x18, 0x04, 0x2d, 0x19,
+	0x78, 0x4b, 0x3e, 0xcb, 0xf2, 0xdb, 0xfd, 0x75, 0x03, 0xd6, 0x7f, 0x48, 0x09, 0xc7, 0x5f, 0x8e,
+	0x1f, 0xf5, 0x1a, 0xd6, 0x9a, 0xab, 0x61, 0x33, 0x55, 0xb0, 0x3d, 0x5f, 0x05, 0x8d, 0x2a, 0xd7,
+	0xa9, 0x55, 0xb9, 0x9b, 0xd0, 0x13, 0x1e, 0xf8, 0x21, 0x4e, 0x38, 0xa6, 0xba, 0xb6, 0x82, 0x20,
+	0x1d, 0x48, 0x8a, 0x28, 0x83, 0x11, 0x61, 0x13, 0x9f, 0xbf, 0xce, 0xb0, 0xae, 0xaf, 0x5d, 0x41,
+	0x78, 0xf1, 0x3a, 0xab, 0xb0, 0xb0, 0x0d, 0x2c, 0xbe, 0x0b, 0x1b, 0x06, 0x14, 0x1a, 0xb4, 0x0a,
+	0x75, 0xeb, 0x72, 0xd4, 0xff, 0x69, 0xc1, 0xe6, 0x43, 0xc6, 0xc8, 0x38, 0xf9, 0x81, 0x7c, 0xcd,
+	0x0a, 0x40, 0xb7, 0xa0, 0x1d, 0xa6, 0x79, 0xc2, 0x25, 0x98, 0x6d, 0x4f, 0x2d, 0x66, 0xc0, 0x69,
+	0x5c, 0x06, 0x4e, 0xf3, 0x42, 0x70, 0x5a, 0x17, 0x81, 0xd3, 0x9e, 0x03, 0xe7, 0x26, 0xf4, 0xcc,
+	0x46, 0x45, 0xf5, 0x00, 0x90, 0x95, 0x5d, 0x4a, 0x1d, 0xbd, 0x95, 0x3a, 0x7a, 0xee, 0x6f, 0x2c,
+	0xd8, 0xaa, 0xfb, 0xa9, 0xd1, 0x5a, 0xda, 0xcd, 0x88, 0xe7, 0x93, 0xc6, 0xda, 0x49, 0xf1, 0x29,
+	0x1e, 0xa2, 0x2c, 0x3f, 0x89, 0x49, 0xe8, 0x0b, 0x86, 0x72, 0xce, 0x56, 0x94, 0x97, 0x34, 0xae,
+	0x20, 0x6b, 0x99, 0x90, 0x21, 0x68, 0x05, 0x39, 0x3f, 0x2d, 0x3a, 0x1a, 0xf1, 0xed, 0xbe, 0x0b,
+	0x9b, 0x6a, 0x74, 0xa9, 0x63, 0xbe, 0x0d, 0x50, 0xf6, 0x18, 0x2a, 0x78, 0xb6, 0x67, 0x17, 0x4d,
+	0x06, 0x73, 0x3f, 0x02, 0xfb, 0x28, 0x55, 0x30, 0x32, 0x74, 0x1f, 0xec, 0xb8, 0x58, 0xe8, 0x38,
+	0xa3, 0x2a, 0xce, 0x85, 0x9c, 0x57, 0x09, 0xb9, 0x3f, 0x85, 0x6e, 0x41, 0x2e, 0x7c, 0xb3, 0x96,
+	0xf9, 0xd6, 0x98, 0xf5, 0x6d, 0x26, 0x3a, 0xcd, 0xd9, 0xe8, 0xb8, 0x7f, 0xb7, 0x60, 0xab, 0xee,
+	0x93, 0xc6, 0xf7, 0x25, 0x0c, 0x4a, 0x1b, 0xfc, 0x69, 0x90, 0x69, 0x63, 0xef, 0x9b, 0xc6, 0xce,
+	0x6f, 0x2b, 0x3d, 0x60, 0x4f, 0x83, 0x4c, 0x95, 0xb1, 0x7e, 0x6c, 0x90, 0x9c, 0x17, 0xb0, 0x31,
+	0x27, 0xb2, 0xa0, 0xb1, 0xff, 0x9a, 0xd9, 0xd8, 0xd7, 0xae, 0x42, 0xb9, 0xdb, 0xec, 0xf6, 0xdf,
+	0x87, 0xb7, 0x54, 0xcd, 0x3f, 0x28, 0x73, 0xba, 0x08, 0x4e, 0x3d, 0xf5, 0xad, 0xd9, 0xd4, 0x77,
+	0x1d, 0x18, 0xce, 0x6f, 0xd5, 0x95, 0x77, 0x0c, 0x1b, 0xc7, 0x3c, 0xe0, 0x84, 0x71, 0x12, 0x96,
+	0x13, 0xe6, 0xcc, 0x5d, 0xb1, 0x2e, 0x6b, 0xa7, 0xe6, 0x6f, 0xdb, 0x3a, 0x34, 0x39, 0x2f, 0x12,
+	0x51, 0x7c, 0x8a, 0x28, 0x20, 0xf3, 0x24, 0x1d, 0x83, 0x2f, 0xe1, 0x28, 0x91, 0x30, 0x3c, 0xe5,
+	0x41, 0xec, 0x97, 0x55, 0xbf, 0xe5, 0xd9, 0x92, 0x22, 0xfb, 0x55, 0xd5, 0xd1, 0x45, 0x8a, 0xdb,
+	0x96, 0x5c, 0xd1, 0xd1, 0x45, 0x92, 0xb9, 0x0d, 0x20, 0xef, 0x9c, 0xba, 0x2e, 0x1d, 0xb5, 0x57,
+	0x50, 0x0e, 0x04, 0xc1, 0xdd, 0x81, 0x1b, 0xdf, 0xc3, 0x5c, 0xd4, 0x2a, 0x7a, 0x90, 0x26, 0x23,
+	0x32, 0xce, 0x69, 0x60, 0x84, 0xc2, 0xfd, 0xad, 0x05, 0xdb, 0x4b, 0x04, 0xb4, 0xc3, 0x43, 0x58,
+	0x99, 0x06, 0x8c, 0x63, 0x5a, 0x5c, 0xa3, 0x62, 0x39, 0x0b, 0x45, 0xe3, 0x32, 0x28, 0x9a, 0x73,
+	0x50, 0x5c, 0x81, 0xce, 0x34, 0x38, 0xf7, 0xa7, 0x27, 0xba, 0xb3, 0x6e, 0x4f, 0x83, 0xf3, 0xa7,
+	0x27, 0xee, 0x9f, 0x2d, 0xe8, 0x0a, 0x8b, 0x8e, 0xd2, 0x70, 0x22, 0xbc, 0x0f, 0x63, 0x22, 0x6a,
+	0x55, 0x59, 0x56, 0xba, 0x8a, 0xf0, 0x24, 0x12, 0x75, 0x22, 0x3d, 0x4b, 0x30, 0xd5, 0xa3, 0x82,
+	0x5a, 0x08, 0xaa, 0xfc, 0x85, 0x40, 0x4f, 0x49, 0x6a, 0x21, 0x70, 0xc7, 0x49, 0xa4, 0xe1, 0x15,
+	0x9f, 0xba, 0xdf, 0xc1, 0xe7, 0xfa, 0xd7, 0x81, 0xaa, 0xdf, 0x39, 0x2c, 0x48, 0xa2, 0x41, 0x20,
+	0xcc, 0x1f, 0xc5, 0x69, 0x38, 0xd1, 0x1d, 0xce, 0x0a, 0x61, 0x8f, 0xc5, 0x52, 0xe8, 0xcb, 0xf4,
+	0x94, 0x34, 0xf0, 0xc4, 0xa7, 0xfb, 0x2b, 0x0b, 0x7a, 0xc2, 0xe6, 0x37, 0x7f, 0x49, 0xef, 0x40,
+	0x4b, 0x1e, 0xd5, 0xdc, 0xb5, 0xea, 0x55, 0xa8, 0x80, 0xc3, 0x6b, 0xc5, 0x1a, 0x94, 0x18, 0x07,
+	0x0c, 0x1b, 0xc5, 0xbf, 0x2b, 0x09, 0xc7, 0x38, 0x74, 0x7f, 0x0c, 0x7d, 0x65, 0x85, 0x8e, 0xa0,
+	0x03, 0xdd, 0x20, 0xfc, 0x2c, 0x27, 0x14, 0x2b, 0x00, 0xbb, 0x5e, 0xb9, 0x46, 0xfb, 0xd0, 0x0d,
+	0xd3, 0x64, 0x14, 0x93, 0x90, 0x0f, 0x1b, 0x4b, 0x0f, 0x2d, 0x65, 0x5c, 0x02, 0x83, 0x97, 0x49,
+	0xfc, 0xbf, 0xf0, 0xd1, 0x5d, 0x87, 0xd5, 0xe2, 0x28, 0x7d, 0xf7, 0x9f, 0xc3, 0xd6, 0x27, 0x18,
+	0x67, 0x42, 0xe6, 0x48, 0x38, 0x5b, 0xd8, 0x70, 0x61, 0x8a, 0xd4, 0xa0, 0x6a, 0xcc, 0x40, 0xf5,
+	0x2d, 0xb8, 0x32, 0xa3, 0x51, 0x63, 0xb6, 0x0d, 0x20, 0x8e, 0xf6, 0xcd, 0x87, 0x5b, 0x3c, 0x00,
+	0x13, 0x75, 0xad, 0x0e, 0x60, 0xf3, 0x29, 0x19, 0xd3, 0x80, 0xe3, 0x63, 0x9e, 0xd2, 0xd2, 0x90,
+	0xab, 0xd0, 0x09, 0xcc, 0xa2, 0xa6, 0x57, 0x2a, 0x21, 0x53, 0x5a, 0xe0, 0xa0, 0x16, 0xee, 0x1f,
+	0x5b, 0xb0, 0x55, 0xd7, 0xa2, 0x0f, 0x17, 0xe3, 0x86, 0x9a, 0xad, 0xd5, 0x2e, 0x5d, 0x64, 0x14,
+	0x4d, 0x8a, 0x0a, 0x11, 0x35, 0xa1, 0xf9, 0xa6, 0xe2, 0x9e, 0xa2, 0x29, 0x11, 0x75, 0x0b, 0x78,
+	0xf1, 0xa3, 0x99, 0x5a, 0x20, 0x17, 0x06, 0xf2, 0x3a, 0xe0, 0xc8, 0x0f, 0xb8, 0x9f, 0x30, 0xdd,
+	0x64, 0xf6, 0x34, 0xf1, 0x21, 0x7f, 0xc6, 0xd0, 0x5d, 0x58, 0x2b, 0x43, 0xa8, 0x11, 0x68, 0x4b,
+	0xa9, 0xd5, 0x92, 0x2c, 0x61, 0x10, 0x56, 0x84, 0x69, 0x46, 0xc4, 0xc8, 0x53, 0x96, 0x9f, 0xa6,
+	0xd7, 0x53, 0x34, 0x25, 0xf2, 0x0e, 0x6c, 0x84, 0x39, 0x95, 0xbd, 0x46, 0x95, 0x27, 0xaa, 0xa3,
+	0x58, 0xd7, 0x8c, 0xaa, 0x1d, 0xff, 0x3a, 0x6c, 0x44, 0x79, 0x10, 0xfb, 0x67, 0x94, 0x70, 0x3d,
+	0x57, 0x30, 0xd9, 0xbc, 0x35, 0xbd, 0x35, 0xc1, 0x90, 0x0d, 0x9a, 0x1c, 0x29, 0x18, 0xba, 0x07,
+	0x48, 0x83, 0x24, 0x67, 0x34, 0x6d, 0x81, 0x2d, 0x85, 0xd7, 0x15, 0x47, 0xbe, 0x64, 0xca, 0x8c,
+	0x7b, 0x80, 0x34, 0x5e, 0xa6, 0x34, 0x28, 0x69, 0xc5, 0x31, 0xa4, 0xef, 0xc2, 0x9a, 0xd6, 0x1d,
+	0x9e, 0xe2, 0x70, 0xc2, 0xf2, 0xa9, 0x9c, 0xa4, 0x5b, 0x9e, 0xfe, 0xf5, 0xe4, 0x40, 0x53, 0x85,
+	0xa0, 0x56, 0x5b, 0x0a, 0xf6, 0x95, 0xa0, 0x22, 0x97, 0x82, 0xb7, 0x61, 0x75, 0x4a, 0xd8, 0x34,
+	0xe0, 0xe1, 0xa9, 0x3e, 0x7b, 0x20, 0xcf, 0x1e, 0x14, 0x54, 0x75, 0xf0, 0x16, 0xb4, 0xd5, 0x34,
+	0xb5, 0xaa, 0x62, 0x26, 0x17, 0x0f, 0xfe, 0xd4, 0x83, 0xfe, 0x31, 0x0e, 0xce, 0x30, 0x96, 0x2d,
+	0x3d, 0x45, 0xe3, 0xa2, 0x41, 0xa8, 0xff, 0x5e, 0x8b, 0x6e, 0xcf, 0x76, 0x02, 0x0b, 0x7f, 0x20,
+	0x76, 0xee, 0x5c, 0x26, 0xa6, 0xef, 0xdb, 0x57, 0xd0, 0x33, 0xe8, 0x19, 0x3f, 0x88, 0xa2, 0x1b,
+	0xc6, 0xc6, 0xb9, 0xdf, 0x79, 0x9d, 0xed, 0x25, 0xdc, 0x42, 0xdb, 0x7d, 0x0b, 0x1d, 0x41, 0xcf,
+	0x98, 0xdd, 0x4d, 0x7d, 0xf3, 0x3f, 0x28, 0x38, 0xdb, 0x4b, 0xb8, 0xa5, 0x75, 0x47, 0xd0, 0x33,
+	0xa6, 0x66, 0x53, 0xdb, 0xfc, 0x0f, 0x01, 0xce, 0xf6, 0x12, 0xae, 0xa9, 0xcd, 0x18, 0x52, 0x4d,
+	0x6d, 0xf3, 0x23, 0xb8, 0xb3, 0xbd, 0x84, 0x5b, 0x6a, 0xfb, 0x19, 0x6c, 0xcc, 0x8d, 0x8f, 0xc8,
+	0xad, 0x76, 0x2d, 0x9b, 0x7b, 0x9d, 0x5b, 0x17, 0xca, 0x94, 0xfa, 0x3f, 0x85, 0xbe, 0xd9, 0x83,
+	0x23, 0xc3, 0xa0, 0x05, 0x33, 0x88, 0xb3, 0xb3, 0x8c, 0x6d, 0x2a, 0x34, 0xbb, 0x47, 0x53, 0xe1,
+	0x82, 0x06, 0xdb, 0xd9, 0x59, 0xc6, 0x2e, 0x15, 0x1e, 0x42, 0xb7, 0x18, 0x42, 0xd1, 0xb5, 0x4a,
+	0x7a, 0x66, 0x6c, 0x76, 0x9c, 0x45, 0x2c, 0x23, 0x65, 0xbe, 0x0f, 0x76, 0x39, 0x97, 0x21, 0x43,
+	0x78, 0x76, 0x6e, 0x75, 0xae, 0x2f, 0xe4, 0x15, 0x9a, 0xf6, 0x2c, 0xf4, 0x13, 0x58, 0x9f, 0x6d,
+	0x2b, 0xd1, 0xdb, 0xb3, 0x71, 0x9c, 0xeb, 0x56, 0x1d, 0xf7, 0x22, 0x91, 0xd2, 0xdb, 0x27, 0x00,
+	0x55, 0xb7, 0x88, 0x0c, 0x5b, 0xe6, 0xba, 0x55, 0xe7, 0xc6, 0x62, 0x66, 0xa9, 0xea, 0xe7, 0x70,
+	0x65, 0x61, 0x4b, 0x86, 0x8c, 0x7b, 0x7b, 0x51, 0x53, 0xe7, 0xdc, 0xbd, 0x54, 0xae, 0x3c, 0xeb,
+	0xdb, 0xd0, 0x92, 0x5d, 0xd6, 0x95, 0x5a, 0x37, 0x5f, 0xbc, 0xee, 0xce, 0xd5, 0x59, 0x72, 0xb9,
+	0xf1, 0x23, 0xe8, 0xa8, 0xd7, 0x19, 0xbd, 0x65, 0x5c, 0x2c, 0xb3, 0x35, 0x70, 0x86, 0xf3, 0x8c,
+	0x72, 0xfb, 0x07, 0xd0, 0x7d, 0x81, 0x19, 0x7f, 0xb3, 0xb3, 0x3d, 0x18, 0xd4, 0x5e, 0x6d, 0x64,
+	0x24, 0xe3, 0xa2, 0x06, 0xc1, 0xb9, 0xb9, 0x94, 0x6f, 0xa6, 0xbf, 0xf9, 0x16, 0x9b, 0xe9, 0xbf,
+	0xe0, 0xa5, 0x77, 0x76, 0x96, 0xb1, 0x0b, 0x85, 0x1f, 0xef, 0xc0, 0x3a, 0x53, 0x35, 0x7b, 0xc4,
+	0xf6, 0x55, 0x37, 0xf2, 0x31, 0xc8, 0x58, 0x3c, 0xa7, 0x29, 0x4f, 0x4f, 0x3a, 0xf2, 0x5f, 0xbd,
+	0x6f, 0xfe, 0x67, 0x00, 0xc4, 0x89, 0x31, 0x50, 0xe4, 0x1b, 0x00, 0x00,
 }
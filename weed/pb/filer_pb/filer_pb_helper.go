@@ -1,6 +1,9 @@
 package filer_pb
 
 import (
+	"context"
+	"io"
+
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
@@ -67,3 +70,26 @@ func AfterEntryDeserialization(chunks []*FileChunk) {
 
 	}
 }
+
+// ListEntries streams the directory entries from the filer, stopping at the first error returned by fn
+func ListEntries(ctx context.Context, client SeaweedFilerClient, request *ListEntriesRequest, fn func(entry *Entry) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.ListEntries(ctx, request)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, recvErr := stream.Recv()
+		if recvErr == io.EOF {
+			return nil
+		}
+		if recvErr != nil {
+			return recvErr
+		}
+		if err := fn(resp.Entry); err != nil {
+			return err
+		}
+	}
+}
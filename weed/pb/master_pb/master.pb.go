@@ -41,6 +41,18 @@ It has these top-level messages:
 	LookupEcVolumeResponse
 	GetMasterConfigurationRequest
 	GetMasterConfigurationResponse
+	RaftListClusterServersRequest
+	RaftListClusterServersResponse
+	RaftAddServerRequest
+	RaftAddServerResponse
+	RaftRemoveServerRequest
+	RaftRemoveServerResponse
+	LeaseAdminTokenRequest
+	LeaseAdminTokenResponse
+	ReleaseAdminTokenRequest
+	ReleaseAdminTokenResponse
+	VolumeServerDrainRequest
+	VolumeServerDrainResponse
 */
 package master_pb
 
@@ -87,6 +99,7 @@ type Heartbeat struct {
 	NewEcShards     []*VolumeEcShardInformationMessage `protobuf:"bytes,17,rep,name=new_ec_shards,json=newEcShards" json:"new_ec_shards,omitempty"`
 	DeletedEcShards []*VolumeEcShardInformationMessage `protobuf:"bytes,18,rep,name=deleted_ec_shards,json=deletedEcShards" json:"deleted_ec_shards,omitempty"`
 	HasNoEcShards   bool                               `protobuf:"varint,19,opt,name=has_no_ec_shards,json=hasNoEcShards" json:"has_no_ec_shards,omitempty"`
+	MaxVolumeCounts map[string]uint32                  `protobuf:"bytes,20,rep,name=max_volume_counts,json=maxVolumeCounts" json:"max_volume_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 }
 
 func (m *Heartbeat) Reset()                    { *m = Heartbeat{} }
@@ -206,6 +219,13 @@ func (m *Heartbeat) GetHasNoEcShards() bool {
 	return false
 }
 
+func (m *Heartbeat) GetMaxVolumeCounts() map[string]uint32 {
+	if m != nil {
+		return m.MaxVolumeCounts
+	}
+	return nil
+}
+
 type HeartbeatResponse struct {
 	VolumeSizeLimit        uint64 `protobuf:"varint,1,opt,name=volume_size_limit,json=volumeSizeLimit" json:"volume_size_limit,omitempty"`
 	Leader                 string `protobuf:"bytes,2,opt,name=leader" json:"leader,omitempty"`
@@ -259,6 +279,7 @@ type VolumeInformationMessage struct {
 	Ttl              uint32 `protobuf:"varint,10,opt,name=ttl" json:"ttl,omitempty"`
 	CompactRevision  uint32 `protobuf:"varint,11,opt,name=compact_revision,json=compactRevision" json:"compact_revision,omitempty"`
 	ModifiedAtSecond int64  `protobuf:"varint,12,opt,name=modified_at_second,json=modifiedAtSecond" json:"modified_at_second,omitempty"`
+	DiskType         string `protobuf:"bytes,13,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *VolumeInformationMessage) Reset()                    { *m = VolumeInformationMessage{} }
@@ -350,12 +371,20 @@ func (m *VolumeInformationMessage) GetModifiedAtSecond() int64 {
 	return 0
 }
 
+func (m *VolumeInformationMessage) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type VolumeShortInformationMessage struct {
 	Id               uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	Collection       string `protobuf:"bytes,3,opt,name=collection" json:"collection,omitempty"`
 	ReplicaPlacement uint32 `protobuf:"varint,8,opt,name=replica_placement,json=replicaPlacement" json:"replica_placement,omitempty"`
 	Version          uint32 `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
 	Ttl              uint32 `protobuf:"varint,10,opt,name=ttl" json:"ttl,omitempty"`
+	DiskType         string `protobuf:"bytes,15,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *VolumeShortInformationMessage) Reset()                    { *m = VolumeShortInformationMessage{} }
@@ -398,6 +427,13 @@ func (m *VolumeShortInformationMessage) GetTtl() uint32 {
 	return 0
 }
 
+func (m *VolumeShortInformationMessage) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type VolumeEcShardInformationMessage struct {
 	Id          uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	Collection  string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
@@ -505,11 +541,13 @@ func (m *KeepConnectedRequest) GetName() string {
 }
 
 type VolumeLocation struct {
-	Url         string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	PublicUrl   string   `protobuf:"bytes,2,opt,name=public_url,json=publicUrl" json:"public_url,omitempty"`
-	NewVids     []uint32 `protobuf:"varint,3,rep,packed,name=new_vids,json=newVids" json:"new_vids,omitempty"`
-	DeletedVids []uint32 `protobuf:"varint,4,rep,packed,name=deleted_vids,json=deletedVids" json:"deleted_vids,omitempty"`
-	Leader      string   `protobuf:"bytes,5,opt,name=leader" json:"leader,omitempty"`
+	Url            string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	PublicUrl      string   `protobuf:"bytes,2,opt,name=public_url,json=publicUrl" json:"public_url,omitempty"`
+	NewVids        []uint32 `protobuf:"varint,3,rep,packed,name=new_vids,json=newVids" json:"new_vids,omitempty"`
+	DeletedVids    []uint32 `protobuf:"varint,4,rep,packed,name=deleted_vids,json=deletedVids" json:"deleted_vids,omitempty"`
+	Leader         string   `protobuf:"bytes,5,opt,name=leader" json:"leader,omitempty"`
+	DataCenter     string   `protobuf:"bytes,6,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	UnwritableVids []uint32 `protobuf:"varint,7,rep,packed,name=unwritable_vids,json=unwritableVids" json:"unwritable_vids,omitempty"`
 }
 
 func (m *VolumeLocation) Reset()                    { *m = VolumeLocation{} }
@@ -552,6 +590,20 @@ func (m *VolumeLocation) GetLeader() string {
 	return ""
 }
 
+func (m *VolumeLocation) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *VolumeLocation) GetUnwritableVids() []uint32 {
+	if m != nil {
+		return m.UnwritableVids
+	}
+	return nil
+}
+
 type LookupVolumeRequest struct {
 	VolumeIds  []string `protobuf:"bytes,1,rep,name=volume_ids,json=volumeIds" json:"volume_ids,omitempty"`
 	Collection string   `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
@@ -659,6 +711,7 @@ type AssignRequest struct {
 	Rack               string `protobuf:"bytes,6,opt,name=rack" json:"rack,omitempty"`
 	DataNode           string `protobuf:"bytes,7,opt,name=data_node,json=dataNode" json:"data_node,omitempty"`
 	MemoryMapMaxSizeMB uint32 `protobuf:"varint,8,opt,name=memorymapmaxsizemb" json:"memorymapmaxsizemb,omitempty"`
+	DiskType           string `protobuf:"bytes,9,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *AssignRequest) Reset()                    { *m = AssignRequest{} }
@@ -722,6 +775,13 @@ func (m *AssignRequest) GetMemoryMapMaxSizeMB() uint32 {
 	return 0
 }
 
+func (m *AssignRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type AssignResponse struct {
 	Fid       string `protobuf:"bytes,1,opt,name=fid" json:"fid,omitempty"`
 	Url       string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
@@ -981,6 +1041,8 @@ type DataNodeInfo struct {
 	ActiveVolumeCount uint64                             `protobuf:"varint,5,opt,name=active_volume_count,json=activeVolumeCount" json:"active_volume_count,omitempty"`
 	VolumeInfos       []*VolumeInformationMessage        `protobuf:"bytes,6,rep,name=volume_infos,json=volumeInfos" json:"volume_infos,omitempty"`
 	EcShardInfos      []*VolumeEcShardInformationMessage `protobuf:"bytes,7,rep,name=ec_shard_infos,json=ecShardInfos" json:"ec_shard_infos,omitempty"`
+	MaxVolumeCounts   map[string]uint64                  `protobuf:"bytes,8,rep,name=max_volume_counts,json=maxVolumeCounts" json:"max_volume_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	IsDraining        bool                               `protobuf:"varint,9,opt,name=is_draining,json=isDraining" json:"is_draining,omitempty"`
 }
 
 func (m *DataNodeInfo) Reset()                    { *m = DataNodeInfo{} }
@@ -1037,6 +1099,20 @@ func (m *DataNodeInfo) GetEcShardInfos() []*VolumeEcShardInformationMessage {
 	return nil
 }
 
+func (m *DataNodeInfo) GetMaxVolumeCounts() map[string]uint64 {
+	if m != nil {
+		return m.MaxVolumeCounts
+	}
+	return nil
+}
+
+func (m *DataNodeInfo) GetIsDraining() bool {
+	if m != nil {
+		return m.IsDraining
+	}
+	return false
+}
+
 type RackInfo struct {
 	Id                string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	VolumeCount       uint64          `protobuf:"varint,2,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
@@ -1337,6 +1413,252 @@ func (m *GetMasterConfigurationResponse) GetMetricsIntervalSeconds() uint32 {
 	return 0
 }
 
+type RaftListClusterServersRequest struct {
+}
+
+func (m *RaftListClusterServersRequest) Reset()                    { *m = RaftListClusterServersRequest{} }
+func (m *RaftListClusterServersRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftListClusterServersRequest) ProtoMessage()               {}
+func (*RaftListClusterServersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+type RaftListClusterServersResponse struct {
+	ClusterServers []*RaftListClusterServersResponse_ClusterServer `protobuf:"bytes,1,rep,name=cluster_servers,json=clusterServers" json:"cluster_servers,omitempty"`
+}
+
+func (m *RaftListClusterServersResponse) Reset()                    { *m = RaftListClusterServersResponse{} }
+func (m *RaftListClusterServersResponse) String() string            { return proto.CompactTextString(m) }
+func (*RaftListClusterServersResponse) ProtoMessage()               {}
+func (*RaftListClusterServersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *RaftListClusterServersResponse) GetClusterServers() []*RaftListClusterServersResponse_ClusterServer {
+	if m != nil {
+		return m.ClusterServers
+	}
+	return nil
+}
+
+type RaftListClusterServersResponse_ClusterServer struct {
+	Address        string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	IsLeader       bool   `protobuf:"varint,2,opt,name=is_leader,json=isLeader" json:"is_leader,omitempty"`
+	IsAlive        bool   `protobuf:"varint,3,opt,name=is_alive,json=isAlive" json:"is_alive,omitempty"`
+	LastActivityNs int64  `protobuf:"varint,4,opt,name=last_activity_ns,json=lastActivityNs" json:"last_activity_ns,omitempty"`
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) Reset() {
+	*m = RaftListClusterServersResponse_ClusterServer{}
+}
+func (m *RaftListClusterServersResponse_ClusterServer) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RaftListClusterServersResponse_ClusterServer) ProtoMessage() {}
+func (*RaftListClusterServersResponse_ClusterServer) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{33, 0}
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
+	}
+	return false
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) GetIsAlive() bool {
+	if m != nil {
+		return m.IsAlive
+	}
+	return false
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) GetLastActivityNs() int64 {
+	if m != nil {
+		return m.LastActivityNs
+	}
+	return 0
+}
+
+type RaftAddServerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *RaftAddServerRequest) Reset()                    { *m = RaftAddServerRequest{} }
+func (m *RaftAddServerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftAddServerRequest) ProtoMessage()               {}
+func (*RaftAddServerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *RaftAddServerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type RaftAddServerResponse struct {
+}
+
+func (m *RaftAddServerResponse) Reset()                    { *m = RaftAddServerResponse{} }
+func (m *RaftAddServerResponse) String() string            { return proto.CompactTextString(m) }
+func (*RaftAddServerResponse) ProtoMessage()               {}
+func (*RaftAddServerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+type RaftRemoveServerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *RaftRemoveServerRequest) Reset()                    { *m = RaftRemoveServerRequest{} }
+func (m *RaftRemoveServerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftRemoveServerRequest) ProtoMessage()               {}
+func (*RaftRemoveServerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *RaftRemoveServerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type RaftRemoveServerResponse struct {
+}
+
+func (m *RaftRemoveServerResponse) Reset()                    { *m = RaftRemoveServerResponse{} }
+func (m *RaftRemoveServerResponse) String() string            { return proto.CompactTextString(m) }
+func (*RaftRemoveServerResponse) ProtoMessage()               {}
+func (*RaftRemoveServerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+type LeaseAdminTokenRequest struct {
+	PreviousToken int64  `protobuf:"varint,1,opt,name=previous_token,json=previousToken" json:"previous_token,omitempty"`
+	LockName      string `protobuf:"bytes,2,opt,name=lock_name,json=lockName" json:"lock_name,omitempty"`
+	ClientName    string `protobuf:"bytes,3,opt,name=client_name,json=clientName" json:"client_name,omitempty"`
+}
+
+func (m *LeaseAdminTokenRequest) Reset()                    { *m = LeaseAdminTokenRequest{} }
+func (m *LeaseAdminTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*LeaseAdminTokenRequest) ProtoMessage()               {}
+func (*LeaseAdminTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *LeaseAdminTokenRequest) GetPreviousToken() int64 {
+	if m != nil {
+		return m.PreviousToken
+	}
+	return 0
+}
+
+func (m *LeaseAdminTokenRequest) GetLockName() string {
+	if m != nil {
+		return m.LockName
+	}
+	return ""
+}
+
+func (m *LeaseAdminTokenRequest) GetClientName() string {
+	if m != nil {
+		return m.ClientName
+	}
+	return ""
+}
+
+type LeaseAdminTokenResponse struct {
+	Token    int64 `protobuf:"varint,1,opt,name=token" json:"token,omitempty"`
+	LockTsNs int64 `protobuf:"varint,2,opt,name=lock_ts_ns,json=lockTsNs" json:"lock_ts_ns,omitempty"`
+}
+
+func (m *LeaseAdminTokenResponse) Reset()                    { *m = LeaseAdminTokenResponse{} }
+func (m *LeaseAdminTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*LeaseAdminTokenResponse) ProtoMessage()               {}
+func (*LeaseAdminTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *LeaseAdminTokenResponse) GetToken() int64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *LeaseAdminTokenResponse) GetLockTsNs() int64 {
+	if m != nil {
+		return m.LockTsNs
+	}
+	return 0
+}
+
+type ReleaseAdminTokenRequest struct {
+	PreviousToken int64  `protobuf:"varint,1,opt,name=previous_token,json=previousToken" json:"previous_token,omitempty"`
+	LockName      string `protobuf:"bytes,2,opt,name=lock_name,json=lockName" json:"lock_name,omitempty"`
+}
+
+func (m *ReleaseAdminTokenRequest) Reset()                    { *m = ReleaseAdminTokenRequest{} }
+func (m *ReleaseAdminTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseAdminTokenRequest) ProtoMessage()               {}
+func (*ReleaseAdminTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *ReleaseAdminTokenRequest) GetPreviousToken() int64 {
+	if m != nil {
+		return m.PreviousToken
+	}
+	return 0
+}
+
+func (m *ReleaseAdminTokenRequest) GetLockName() string {
+	if m != nil {
+		return m.LockName
+	}
+	return ""
+}
+
+type ReleaseAdminTokenResponse struct {
+}
+
+func (m *ReleaseAdminTokenResponse) Reset()                    { *m = ReleaseAdminTokenResponse{} }
+func (m *ReleaseAdminTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseAdminTokenResponse) ProtoMessage()               {}
+func (*ReleaseAdminTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+type VolumeServerDrainRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	IsDraining bool   `protobuf:"varint,2,opt,name=is_draining,json=isDraining" json:"is_draining,omitempty"`
+}
+
+func (m *VolumeServerDrainRequest) Reset()                    { *m = VolumeServerDrainRequest{} }
+func (m *VolumeServerDrainRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerDrainRequest) ProtoMessage()               {}
+func (*VolumeServerDrainRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *VolumeServerDrainRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VolumeServerDrainRequest) GetIsDraining() bool {
+	if m != nil {
+		return m.IsDraining
+	}
+	return false
+}
+
+type VolumeServerDrainResponse struct {
+	IsConnected bool `protobuf:"varint,1,opt,name=is_connected,json=isConnected" json:"is_connected,omitempty"`
+}
+
+func (m *VolumeServerDrainResponse) Reset()                    { *m = VolumeServerDrainResponse{} }
+func (m *VolumeServerDrainResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerDrainResponse) ProtoMessage()               {}
+func (*VolumeServerDrainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *VolumeServerDrainResponse) GetIsConnected() bool {
+	if m != nil {
+		return m.IsConnected
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Heartbeat)(nil), "master_pb.Heartbeat")
 	proto.RegisterType((*HeartbeatResponse)(nil), "master_pb.HeartbeatResponse")
@@ -1373,6 +1695,19 @@ func init() {
 	proto.RegisterType((*LookupEcVolumeResponse_EcShardIdLocation)(nil), "master_pb.LookupEcVolumeResponse.EcShardIdLocation")
 	proto.RegisterType((*GetMasterConfigurationRequest)(nil), "master_pb.GetMasterConfigurationRequest")
 	proto.RegisterType((*GetMasterConfigurationResponse)(nil), "master_pb.GetMasterConfigurationResponse")
+	proto.RegisterType((*RaftListClusterServersRequest)(nil), "master_pb.RaftListClusterServersRequest")
+	proto.RegisterType((*RaftListClusterServersResponse)(nil), "master_pb.RaftListClusterServersResponse")
+	proto.RegisterType((*RaftListClusterServersResponse_ClusterServer)(nil), "master_pb.RaftListClusterServersResponse.ClusterServer")
+	proto.RegisterType((*RaftAddServerRequest)(nil), "master_pb.RaftAddServerRequest")
+	proto.RegisterType((*RaftAddServerResponse)(nil), "master_pb.RaftAddServerResponse")
+	proto.RegisterType((*RaftRemoveServerRequest)(nil), "master_pb.RaftRemoveServerRequest")
+	proto.RegisterType((*RaftRemoveServerResponse)(nil), "master_pb.RaftRemoveServerResponse")
+	proto.RegisterType((*LeaseAdminTokenRequest)(nil), "master_pb.LeaseAdminTokenRequest")
+	proto.RegisterType((*LeaseAdminTokenResponse)(nil), "master_pb.LeaseAdminTokenResponse")
+	proto.RegisterType((*ReleaseAdminTokenRequest)(nil), "master_pb.ReleaseAdminTokenRequest")
+	proto.RegisterType((*ReleaseAdminTokenResponse)(nil), "master_pb.ReleaseAdminTokenResponse")
+	proto.RegisterType((*VolumeServerDrainRequest)(nil), "master_pb.VolumeServerDrainRequest")
+	proto.RegisterType((*VolumeServerDrainResponse)(nil), "master_pb.VolumeServerDrainResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1396,6 +1731,12 @@ type SeaweedClient interface {
 	VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error)
 	LookupEcVolume(ctx context.Context, in *LookupEcVolumeRequest, opts ...grpc.CallOption) (*LookupEcVolumeResponse, error)
 	GetMasterConfiguration(ctx context.Context, in *GetMasterConfigurationRequest, opts ...grpc.CallOption) (*GetMasterConfigurationResponse, error)
+	RaftListClusterServers(ctx context.Context, in *RaftListClusterServersRequest, opts ...grpc.CallOption) (*RaftListClusterServersResponse, error)
+	RaftAddServer(ctx context.Context, in *RaftAddServerRequest, opts ...grpc.CallOption) (*RaftAddServerResponse, error)
+	RaftRemoveServer(ctx context.Context, in *RaftRemoveServerRequest, opts ...grpc.CallOption) (*RaftRemoveServerResponse, error)
+	LeaseAdminToken(ctx context.Context, in *LeaseAdminTokenRequest, opts ...grpc.CallOption) (*LeaseAdminTokenResponse, error)
+	ReleaseAdminToken(ctx context.Context, in *ReleaseAdminTokenRequest, opts ...grpc.CallOption) (*ReleaseAdminTokenResponse, error)
+	VolumeServerDrain(ctx context.Context, in *VolumeServerDrainRequest, opts ...grpc.CallOption) (*VolumeServerDrainResponse, error)
 }
 
 type seaweedClient struct {
@@ -1540,6 +1881,60 @@ func (c *seaweedClient) GetMasterConfiguration(ctx context.Context, in *GetMaste
 	return out, nil
 }
 
+func (c *seaweedClient) RaftListClusterServers(ctx context.Context, in *RaftListClusterServersRequest, opts ...grpc.CallOption) (*RaftListClusterServersResponse, error) {
+	out := new(RaftListClusterServersResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftListClusterServers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) RaftAddServer(ctx context.Context, in *RaftAddServerRequest, opts ...grpc.CallOption) (*RaftAddServerResponse, error) {
+	out := new(RaftAddServerResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftAddServer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) RaftRemoveServer(ctx context.Context, in *RaftRemoveServerRequest, opts ...grpc.CallOption) (*RaftRemoveServerResponse, error) {
+	out := new(RaftRemoveServerResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftRemoveServer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) LeaseAdminToken(ctx context.Context, in *LeaseAdminTokenRequest, opts ...grpc.CallOption) (*LeaseAdminTokenResponse, error) {
+	out := new(LeaseAdminTokenResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/LeaseAdminToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) ReleaseAdminToken(ctx context.Context, in *ReleaseAdminTokenRequest, opts ...grpc.CallOption) (*ReleaseAdminTokenResponse, error) {
+	out := new(ReleaseAdminTokenResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/ReleaseAdminToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) VolumeServerDrain(ctx context.Context, in *VolumeServerDrainRequest, opts ...grpc.CallOption) (*VolumeServerDrainResponse, error) {
+	out := new(VolumeServerDrainResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/VolumeServerDrain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Seaweed service
 
 type SeaweedServer interface {
@@ -1553,6 +1948,12 @@ type SeaweedServer interface {
 	VolumeList(context.Context, *VolumeListRequest) (*VolumeListResponse, error)
 	LookupEcVolume(context.Context, *LookupEcVolumeRequest) (*LookupEcVolumeResponse, error)
 	GetMasterConfiguration(context.Context, *GetMasterConfigurationRequest) (*GetMasterConfigurationResponse, error)
+	RaftListClusterServers(context.Context, *RaftListClusterServersRequest) (*RaftListClusterServersResponse, error)
+	RaftAddServer(context.Context, *RaftAddServerRequest) (*RaftAddServerResponse, error)
+	RaftRemoveServer(context.Context, *RaftRemoveServerRequest) (*RaftRemoveServerResponse, error)
+	LeaseAdminToken(context.Context, *LeaseAdminTokenRequest) (*LeaseAdminTokenResponse, error)
+	ReleaseAdminToken(context.Context, *ReleaseAdminTokenRequest) (*ReleaseAdminTokenResponse, error)
+	VolumeServerDrain(context.Context, *VolumeServerDrainRequest) (*VolumeServerDrainResponse, error)
 }
 
 func RegisterSeaweedServer(s *grpc.Server, srv SeaweedServer) {
@@ -1755,6 +2156,114 @@ func _Seaweed_GetMasterConfiguration_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Seaweed_RaftListClusterServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftListClusterServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftListClusterServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftListClusterServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftListClusterServers(ctx, req.(*RaftListClusterServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_RaftAddServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftAddServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftAddServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftAddServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftAddServer(ctx, req.(*RaftAddServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_RaftRemoveServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftRemoveServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftRemoveServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftRemoveServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftRemoveServer(ctx, req.(*RaftRemoveServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_LeaseAdminToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseAdminTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).LeaseAdminToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/LeaseAdminToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).LeaseAdminToken(ctx, req.(*LeaseAdminTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_ReleaseAdminToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAdminTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).ReleaseAdminToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/ReleaseAdminToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).ReleaseAdminToken(ctx, req.(*ReleaseAdminTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_VolumeServerDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeServerDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).VolumeServerDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/VolumeServerDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).VolumeServerDrain(ctx, req.(*VolumeServerDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Seaweed_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "master_pb.Seaweed",
 	HandlerType: (*SeaweedServer)(nil),
@@ -1791,6 +2300,30 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMasterConfiguration",
 			Handler:    _Seaweed_GetMasterConfiguration_Handler,
 		},
+		{
+			MethodName: "RaftListClusterServers",
+			Handler:    _Seaweed_RaftListClusterServers_Handler,
+		},
+		{
+			MethodName: "RaftAddServer",
+			Handler:    _Seaweed_RaftAddServer_Handler,
+		},
+		{
+			MethodName: "RaftRemoveServer",
+			Handler:    _Seaweed_RaftRemoveServer_Handler,
+		},
+		{
+			MethodName: "LeaseAdminToken",
+			Handler:    _Seaweed_LeaseAdminToken_Handler,
+		},
+		{
+			MethodName: "ReleaseAdminToken",
+			Handler:    _Seaweed_ReleaseAdminToken_Handler,
+		},
+		{
+			MethodName: "VolumeServerDrain",
+			Handler:    _Seaweed_VolumeServerDrain_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1812,124 +2345,159 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("master.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0x23, 0x49,
-	0x19, 0x9f, 0xb6, 0x1d, 0xc7, 0xfe, 0xfc, 0x88, 0x5d, 0xc9, 0x64, 0x3d, 0x5e, 0x66, 0xe2, 0xe9,
-	0x45, 0xda, 0xec, 0xb0, 0x84, 0x65, 0x76, 0x25, 0x90, 0x00, 0xad, 0x66, 0x32, 0xd9, 0x25, 0x9a,
-	0xc7, 0xce, 0xb4, 0x87, 0x41, 0x42, 0x42, 0x4d, 0xb9, 0xbb, 0x92, 0x94, 0xd2, 0xee, 0x6e, 0xba,
-	0xca, 0x99, 0x78, 0x39, 0xc2, 0x0d, 0x89, 0x0b, 0x07, 0x4e, 0xdc, 0xf9, 0x1b, 0x38, 0x70, 0xe1,
-	0xc8, 0x1f, 0xc0, 0x99, 0x7f, 0x81, 0x2b, 0x42, 0x5a, 0xd5, 0xab, 0xbb, 0xda, 0x76, 0x92, 0xc9,
-	0x4a, 0x73, 0x98, 0x5b, 0xf5, 0xf7, 0xaa, 0xaf, 0x7e, 0x5f, 0x7d, 0x8f, 0xb2, 0xa1, 0x3d, 0xc5,
-	0x8c, 0x93, 0x6c, 0x2f, 0xcd, 0x12, 0x9e, 0xa0, 0xa6, 0xfa, 0xf2, 0xd3, 0x89, 0xfb, 0xc7, 0x3a,
-	0x34, 0x7f, 0x4e, 0x70, 0xc6, 0x27, 0x04, 0x73, 0xd4, 0x85, 0x0a, 0x4d, 0x07, 0xce, 0xc8, 0xd9,
-	0x6d, 0x7a, 0x15, 0x9a, 0x22, 0x04, 0xb5, 0x34, 0xc9, 0xf8, 0xa0, 0x32, 0x72, 0x76, 0x3b, 0x9e,
-	0x5c, 0xa3, 0xdb, 0x00, 0xe9, 0x6c, 0x12, 0xd1, 0xc0, 0x9f, 0x65, 0xd1, 0xa0, 0x2a, 0x65, 0x9b,
-	0x8a, 0xf2, 0x8b, 0x2c, 0x42, 0xbb, 0xd0, 0x9b, 0xe2, 0x73, 0xff, 0x2c, 0x89, 0x66, 0x53, 0xe2,
-	0x07, 0xc9, 0x2c, 0xe6, 0x83, 0x9a, 0x54, 0xef, 0x4e, 0xf1, 0xf9, 0x2b, 0x49, 0xde, 0x17, 0x54,
-	0x34, 0x12, 0x5e, 0x9d, 0xfb, 0x47, 0x34, 0x22, 0xfe, 0x29, 0x99, 0x0f, 0xd6, 0x46, 0xce, 0x6e,
-	0xcd, 0x83, 0x29, 0x3e, 0xff, 0x82, 0x46, 0xe4, 0x31, 0x99, 0xa3, 0x1d, 0x68, 0x85, 0x98, 0x63,
-	0x3f, 0x20, 0x31, 0x27, 0xd9, 0xa0, 0x2e, 0xf7, 0x02, 0x41, 0xda, 0x97, 0x14, 0xe1, 0x5f, 0x86,
-	0x83, 0xd3, 0xc1, 0xba, 0xe4, 0xc8, 0xb5, 0xf0, 0x0f, 0x87, 0x53, 0x1a, 0xfb, 0xd2, 0xf3, 0x86,
-	0xdc, 0xba, 0x29, 0x29, 0xcf, 0x85, 0xfb, 0x3f, 0x83, 0x75, 0xe5, 0x1b, 0x1b, 0x34, 0x47, 0xd5,
-	0xdd, 0xd6, 0xfd, 0x0f, 0xf6, 0x72, 0x34, 0xf6, 0x94, 0x7b, 0x87, 0xf1, 0x51, 0x92, 0x4d, 0x31,
-	0xa7, 0x49, 0xfc, 0x94, 0x30, 0x86, 0x8f, 0x89, 0x67, 0x74, 0xd0, 0x21, 0xb4, 0x62, 0xf2, 0xda,
-	0x37, 0x26, 0x40, 0x9a, 0xd8, 0x5d, 0x32, 0x31, 0x3e, 0x49, 0x32, 0xbe, 0xc2, 0x0e, 0xc4, 0xe4,
-	0xf5, 0x2b, 0x6d, 0xea, 0x05, 0x6c, 0x84, 0x24, 0x22, 0x9c, 0x84, 0xb9, 0xb9, 0xd6, 0x35, 0xcd,
-	0x75, 0xb5, 0x01, 0x63, 0xf2, 0xbb, 0xd0, 0x3d, 0xc1, 0xcc, 0x8f, 0x93, 0xdc, 0x62, 0x7b, 0xe4,
-	0xec, 0x36, 0xbc, 0xf6, 0x09, 0x66, 0xcf, 0x12, 0x23, 0xf5, 0x25, 0x34, 0x49, 0xe0, 0xb3, 0x13,
-	0x9c, 0x85, 0x6c, 0xd0, 0x93, 0x5b, 0xde, 0x5b, 0xda, 0xf2, 0x20, 0x18, 0x0b, 0x81, 0x15, 0x9b,
-	0x36, 0x88, 0x62, 0x31, 0xf4, 0x0c, 0x3a, 0x02, 0x8c, 0xc2, 0x58, 0xff, 0xda, 0xc6, 0x04, 0x9a,
-	0x07, 0xc6, 0xde, 0x2b, 0xe8, 0x1b, 0x44, 0x0a, 0x9b, 0xe8, 0xda, 0x36, 0x0d, 0xac, 0xb9, 0xdd,
-	0x0f, 0xa1, 0xa7, 0x61, 0x29, 0xcc, 0x6e, 0x4a, 0x60, 0x3a, 0x12, 0x18, 0x23, 0xe8, 0xfe, 0xdd,
-	0x81, 0x7e, 0x9e, 0x0d, 0x1e, 0x61, 0x69, 0x12, 0x33, 0x82, 0xee, 0x41, 0x5f, 0x5f, 0x67, 0x46,
-	0xbf, 0x26, 0x7e, 0x44, 0xa7, 0x94, 0xcb, 0x24, 0xa9, 0x79, 0x1b, 0x8a, 0x31, 0xa6, 0x5f, 0x93,
-	0x27, 0x82, 0x8c, 0xb6, 0xa1, 0x1e, 0x11, 0x1c, 0x92, 0x4c, 0xe6, 0x4c, 0xd3, 0xd3, 0x5f, 0xe8,
-	0x43, 0xd8, 0x98, 0x12, 0x9e, 0xd1, 0x80, 0xf9, 0x38, 0x0c, 0x33, 0xc2, 0x98, 0x4e, 0x9d, 0xae,
-	0x26, 0x3f, 0x50, 0x54, 0xf4, 0x63, 0x18, 0x18, 0x41, 0x2a, 0xee, 0xf8, 0x19, 0x8e, 0x7c, 0x46,
-	0x82, 0x24, 0x0e, 0x99, 0xce, 0xa3, 0x6d, 0xcd, 0x3f, 0xd4, 0xec, 0xb1, 0xe2, 0xba, 0x7f, 0xad,
-	0xc2, 0xe0, 0xa2, 0x0b, 0x2c, 0x33, 0x3b, 0x94, 0x4e, 0x77, 0xbc, 0x0a, 0x0d, 0x45, 0xe6, 0x88,
-	0xc3, 0x48, 0x2f, 0x6b, 0x9e, 0x5c, 0xa3, 0x3b, 0x00, 0x41, 0x12, 0x45, 0x24, 0x10, 0x8a, 0xda,
-	0x3d, 0x8b, 0x22, 0x32, 0x4b, 0x26, 0x6b, 0x91, 0xd4, 0x35, 0xaf, 0x29, 0x28, 0x2a, 0x9f, 0xef,
-	0x42, 0x5b, 0x01, 0xaf, 0x05, 0x54, 0x3e, 0xb7, 0x14, 0x4d, 0x89, 0x7c, 0x0c, 0xc8, 0x04, 0x78,
-	0x32, 0xcf, 0x05, 0xeb, 0x52, 0xb0, 0xa7, 0x39, 0x0f, 0xe7, 0x46, 0xfa, 0x7d, 0x68, 0x66, 0x04,
-	0x87, 0x7e, 0x12, 0x47, 0x73, 0x99, 0xe2, 0x0d, 0xaf, 0x21, 0x08, 0x5f, 0xc5, 0xd1, 0x1c, 0x7d,
-	0x0f, 0xfa, 0x19, 0x49, 0x23, 0x1a, 0x60, 0x3f, 0x8d, 0x70, 0x40, 0xa6, 0x24, 0x36, 0xd9, 0xde,
-	0xd3, 0x8c, 0xe7, 0x86, 0x8e, 0x06, 0xb0, 0x7e, 0x46, 0x32, 0x26, 0x8e, 0xd5, 0x94, 0x22, 0xe6,
-	0x13, 0xf5, 0xa0, 0xca, 0x79, 0x34, 0x00, 0x49, 0x15, 0x4b, 0xf4, 0x11, 0xf4, 0x82, 0x64, 0x9a,
-	0xe2, 0x80, 0xfb, 0x19, 0x39, 0xa3, 0x52, 0xa9, 0x25, 0xd9, 0x1b, 0x9a, 0xee, 0x69, 0xb2, 0x38,
-	0xce, 0x34, 0x09, 0xe9, 0x11, 0x25, 0xa1, 0x8f, 0xb9, 0x0e, 0x93, 0x4c, 0xb9, 0xaa, 0xd7, 0x33,
-	0x9c, 0x07, 0x5c, 0x05, 0xc8, 0xfd, 0x9b, 0x03, 0xb7, 0x2f, 0x4d, 0xe7, 0xa5, 0x20, 0x5d, 0x15,
-	0x90, 0xb7, 0x85, 0x81, 0x3b, 0x83, 0x9d, 0x2b, 0x92, 0xec, 0x0a, 0x5f, 0x2b, 0x4b, 0xbe, 0xba,
-	0xd0, 0x21, 0x81, 0x4f, 0xe3, 0x90, 0x9c, 0xfb, 0x13, 0xca, 0xd5, 0xf5, 0xef, 0x78, 0x2d, 0x12,
-	0x1c, 0x0a, 0xda, 0x43, 0xca, 0x99, 0xbb, 0x0e, 0x6b, 0x07, 0xd3, 0x94, 0xcf, 0xdd, 0x7f, 0x38,
-	0xb0, 0x31, 0x9e, 0xa5, 0x24, 0x7b, 0x18, 0x25, 0xc1, 0xe9, 0xc1, 0x39, 0xcf, 0x30, 0xfa, 0x0a,
-	0xba, 0x24, 0xc3, 0x6c, 0x96, 0x89, 0x6b, 0x13, 0xd2, 0xf8, 0x58, 0x6e, 0x5e, 0xae, 0x96, 0x0b,
-	0x3a, 0x7b, 0x07, 0x4a, 0x61, 0x5f, 0xca, 0x7b, 0x1d, 0x62, 0x7f, 0x0e, 0x7f, 0x05, 0x9d, 0x12,
-	0x5f, 0xe4, 0x84, 0xe8, 0x2d, 0xfa, 0x50, 0x72, 0x2d, 0xf2, 0x39, 0xc5, 0x19, 0xe5, 0x73, 0xdd,
-	0x03, 0xf5, 0x97, 0xc8, 0x05, 0x5d, 0x13, 0x68, 0x28, 0xce, 0x52, 0x15, 0x5d, 0x46, 0x51, 0x0e,
-	0x43, 0xe6, 0xde, 0x83, 0xad, 0xc7, 0x84, 0xa4, 0xfb, 0x49, 0x1c, 0x93, 0x80, 0x93, 0xd0, 0x23,
-	0xbf, 0x9d, 0x11, 0xc6, 0xc5, 0x16, 0x31, 0x9e, 0x12, 0xdd, 0x62, 0xe5, 0xda, 0xfd, 0x8b, 0x03,
-	0x5d, 0x85, 0xf6, 0x93, 0x24, 0x90, 0x18, 0x8b, 0x88, 0x88, 0xe6, 0xaa, 0xa4, 0xc4, 0x72, 0xa1,
-	0xeb, 0x56, 0x16, 0xbb, 0xee, 0x2d, 0x68, 0xc8, 0xb6, 0x54, 0x38, 0xb3, 0x2e, 0x3a, 0x0d, 0x0d,
-	0x59, 0x91, 0x96, 0xa1, 0x62, 0xd7, 0x24, 0xbb, 0x65, 0x3a, 0x87, 0x10, 0x29, 0x8a, 0xd6, 0x9a,
-	0x5d, 0xb4, 0xdc, 0x97, 0xb0, 0xf9, 0x24, 0x49, 0x4e, 0x67, 0xa9, 0x72, 0xcf, 0x1c, 0xa2, 0x7c,
-	0x76, 0x67, 0x54, 0x15, 0xbe, 0xe4, 0x67, 0xbf, 0xea, 0x26, 0xb8, 0xff, 0x75, 0x60, 0xab, 0x6c,
-	0x56, 0xd7, 0xd9, 0xdf, 0xc0, 0x66, 0x6e, 0xd7, 0x8f, 0x34, 0x16, 0x6a, 0x83, 0xd6, 0xfd, 0x4f,
-	0xac, 0x30, 0xaf, 0xd2, 0x36, 0xbd, 0x3b, 0x34, 0x20, 0x7a, 0xfd, 0xb3, 0x05, 0x0a, 0x1b, 0x9e,
-	0x43, 0x6f, 0x51, 0x4c, 0x54, 0x99, 0x7c, 0x57, 0x8d, 0x78, 0xc3, 0x68, 0xa2, 0x1f, 0x42, 0xb3,
-	0x70, 0xa4, 0x22, 0x1d, 0xd9, 0x2c, 0x39, 0xa2, 0xf7, 0x2a, 0xa4, 0xd0, 0x16, 0xac, 0x91, 0x2c,
-	0x4b, 0x32, 0x9d, 0xaf, 0xea, 0xc3, 0xfd, 0x09, 0x34, 0xbe, 0x75, 0x74, 0xdd, 0x7f, 0x39, 0xd0,
-	0x79, 0xc0, 0x18, 0x3d, 0x8e, 0x4d, 0x08, 0xb6, 0x60, 0x4d, 0xd5, 0x4e, 0xd5, 0x86, 0xd4, 0x07,
-	0x1a, 0x41, 0x4b, 0xa7, 0xbd, 0x05, 0xbd, 0x4d, 0xba, 0xb2, 0xa2, 0xe8, 0x52, 0x50, 0x53, 0xae,
-	0x89, 0x72, 0xb8, 0x30, 0x83, 0xad, 0x5d, 0x38, 0x83, 0xd5, 0xad, 0x19, 0xec, 0x7d, 0x68, 0x4a,
-	0xa5, 0x38, 0x09, 0x89, 0x1e, 0xce, 0x1a, 0x82, 0xf0, 0x2c, 0x09, 0x89, 0xfb, 0x67, 0x07, 0xba,
-	0xe6, 0x34, 0x3a, 0xf2, 0x3d, 0xa8, 0x1e, 0xe5, 0xe8, 0x8b, 0xa5, 0xc1, 0xa8, 0x72, 0x11, 0x46,
-	0x4b, 0x73, 0x67, 0x8e, 0x48, 0xcd, 0x46, 0x24, 0x0f, 0xc6, 0x9a, 0x15, 0x0c, 0xe1, 0x32, 0x9e,
-	0xf1, 0x13, 0xe3, 0xb2, 0x58, 0xbb, 0xc7, 0xd0, 0x1f, 0x73, 0xcc, 0x29, 0xe3, 0x34, 0x60, 0x06,
-	0xe6, 0x05, 0x40, 0x9d, 0xab, 0x00, 0xad, 0x5c, 0x04, 0x68, 0x35, 0x07, 0xd4, 0xfd, 0xa7, 0x03,
-	0xc8, 0xde, 0x49, 0x43, 0xf0, 0x16, 0xb6, 0x12, 0x90, 0xf1, 0x84, 0x8b, 0x01, 0x42, 0xb4, 0x7a,
-	0xdd, 0xb0, 0x25, 0x45, 0x0c, 0x2c, 0x22, 0x4a, 0x33, 0x46, 0x42, 0xc5, 0x55, 0xdd, 0xba, 0x21,
-	0x08, 0x92, 0x59, 0x6e, 0xf6, 0xf5, 0x85, 0x66, 0xef, 0x3e, 0x80, 0xd6, 0x98, 0x27, 0x19, 0x3e,
-	0x26, 0x2f, 0xe7, 0xe9, 0x9b, 0x78, 0xaf, 0xbd, 0xab, 0x14, 0x40, 0x8c, 0x00, 0xf6, 0x0b, 0xef,
-	0x57, 0x55, 0xc6, 0xdf, 0xc1, 0xcd, 0x42, 0xe2, 0x09, 0x65, 0xdc, 0xc4, 0xe5, 0x33, 0xd8, 0xa6,
-	0x71, 0x10, 0xcd, 0x42, 0xe2, 0xc7, 0xa2, 0x31, 0x45, 0xf9, 0xbc, 0xeb, 0xc8, 0x31, 0x61, 0x4b,
-	0x73, 0x9f, 0x49, 0xa6, 0x99, 0x7b, 0x3f, 0x06, 0x64, 0xb4, 0x48, 0x90, 0x6b, 0x54, 0xa4, 0x46,
-	0x4f, 0x73, 0x0e, 0x02, 0x2d, 0xed, 0xbe, 0x80, 0xed, 0xc5, 0xcd, 0x75, 0xa8, 0x7e, 0x04, 0xad,
-	0x02, 0x76, 0x53, 0x9f, 0x6e, 0x5a, 0x65, 0xa1, 0xd0, 0xf3, 0x6c, 0x49, 0xf7, 0xfb, 0xf0, 0x5e,
-	0xc1, 0x7a, 0x24, 0x0b, 0xf0, 0x65, 0x8d, 0x61, 0x08, 0x83, 0x65, 0x71, 0xe5, 0x83, 0xfb, 0x9f,
-	0x0a, 0xb4, 0x1f, 0xe9, 0x8c, 0x12, 0xdd, 0xd9, 0xea, 0xc7, 0x4d, 0xd9, 0x8f, 0xef, 0x42, 0xbb,
-	0xf4, 0x06, 0x53, 0x83, 0x5e, 0xeb, 0xcc, 0x7a, 0x80, 0xad, 0x7a, 0xaa, 0x55, 0xa5, 0xd8, 0xe2,
-	0x53, 0xed, 0x1e, 0xf4, 0x8f, 0x32, 0x42, 0x96, 0x5f, 0x75, 0x35, 0x6f, 0x43, 0x30, 0x6c, 0xd9,
-	0x3d, 0xd8, 0xc4, 0x01, 0xa7, 0x67, 0x0b, 0xd2, 0xea, 0x7e, 0xf5, 0x15, 0xcb, 0x96, 0xff, 0x22,
-	0x77, 0x94, 0xc6, 0x47, 0x09, 0x1b, 0xd4, 0xdf, 0xfc, 0x55, 0xa6, 0x4f, 0x23, 0x38, 0x0c, 0x3d,
-	0x87, 0xae, 0x99, 0xee, 0xb5, 0xa5, 0xf5, 0x6b, 0xbf, 0x1c, 0xda, 0xa4, 0x60, 0x31, 0xf7, 0x0f,
-	0x15, 0x68, 0x78, 0x38, 0x38, 0x7d, 0xb7, 0xf1, 0xfd, 0x1c, 0x36, 0xf2, 0x5a, 0x5c, 0x82, 0xf8,
-	0x3d, 0x0b, 0x18, 0xfb, 0x2a, 0x79, 0x9d, 0xd0, 0xfa, 0x62, 0xee, 0xff, 0x1d, 0xe8, 0x3e, 0xca,
-	0xeb, 0xfd, 0xbb, 0x0d, 0xc6, 0x7d, 0x00, 0xd1, 0xa0, 0x4a, 0x38, 0xd8, 0x0d, 0xdd, 0x84, 0xdb,
-	0x6b, 0x66, 0x7a, 0xc5, 0xdc, 0x3f, 0x55, 0xa0, 0xfd, 0x32, 0x49, 0x93, 0x28, 0x39, 0x9e, 0xbf,
-	0xdb, 0xa7, 0x3f, 0x80, 0xbe, 0xd5, 0xcb, 0x4b, 0x20, 0xdc, 0x5a, 0xb8, 0x0c, 0x45, 0xb0, 0xbd,
-	0x8d, 0xb0, 0xf4, 0xcd, 0xdc, 0x4d, 0xe8, 0xeb, 0x79, 0xb5, 0x28, 0xc9, 0xee, 0xef, 0x1d, 0x40,
-	0x36, 0x55, 0xd7, 0xca, 0x9f, 0x42, 0x87, 0x6b, 0xec, 0xe4, 0x7e, 0x7a, 0x68, 0xb7, 0xef, 0x9e,
-	0x8d, 0xad, 0xd7, 0xe6, 0x36, 0xd2, 0x3f, 0x80, 0xad, 0xa5, 0x97, 0xb7, 0x3f, 0x9d, 0x68, 0x84,
-	0xfb, 0x0b, 0x8f, 0xef, 0xa7, 0x13, 0xf7, 0x33, 0xb8, 0xa9, 0x86, 0x43, 0x53, 0xc7, 0x4d, 0x7d,
-	0x5d, 0x9a, 0xf2, 0x3a, 0xc5, 0x94, 0xe7, 0xfe, 0xcf, 0x81, 0xed, 0x45, 0x35, 0xed, 0xff, 0x65,
-	0x7a, 0x08, 0x03, 0xd2, 0xf5, 0xc6, 0x9e, 0x57, 0xd5, 0x98, 0xf8, 0xe9, 0xd2, 0xbc, 0xba, 0x68,
-	0x7b, 0xcf, 0xd4, 0xa1, 0x62, 0x64, 0xed, 0xb1, 0x32, 0x81, 0x0d, 0x31, 0xf4, 0x97, 0xc4, 0xc4,
-	0xb4, 0x6f, 0xf6, 0xd5, 0x3e, 0xad, 0x6b, 0xc5, 0x6f, 0x31, 0xb0, 0xba, 0x3b, 0x70, 0xfb, 0x4b,
-	0xc2, 0x9f, 0x4a, 0x99, 0xfd, 0x24, 0x3e, 0xa2, 0xc7, 0xb3, 0x4c, 0x09, 0x15, 0xa1, 0xbd, 0x73,
-	0x91, 0x84, 0x86, 0x69, 0xc5, 0xcf, 0x1b, 0xce, 0xb5, 0x7f, 0xde, 0xa8, 0x5c, 0xf6, 0xf3, 0xc6,
-	0xfd, 0x7f, 0xd7, 0x61, 0x7d, 0x4c, 0xf0, 0x6b, 0x42, 0x42, 0x74, 0x08, 0x9d, 0x31, 0x89, 0xc3,
-	0xe2, 0x87, 0xcb, 0x2d, 0xeb, 0x8c, 0x39, 0x75, 0xf8, 0x9d, 0x55, 0xd4, 0xbc, 0x85, 0xde, 0xd8,
-	0x75, 0x3e, 0x71, 0xd0, 0x0b, 0xe8, 0x94, 0x5e, 0x6a, 0x68, 0xc7, 0x52, 0x5a, 0xf5, 0x86, 0x1b,
-	0xde, 0x5a, 0x6a, 0x28, 0x06, 0xd5, 0xdc, 0x64, 0xdb, 0x7e, 0xa1, 0xa0, 0x3b, 0x17, 0x3e, 0x5d,
-	0x94, 0xc1, 0x9d, 0x2b, 0x9e, 0x36, 0xee, 0x0d, 0xf4, 0x39, 0xd4, 0xd5, 0xc8, 0x8c, 0x06, 0x96,
-	0x70, 0xe9, 0x4d, 0x50, 0xf2, 0xab, 0x3c, 0x5f, 0xbb, 0x37, 0xd0, 0x63, 0x80, 0x62, 0xe8, 0x44,
-	0x36, 0x30, 0x4b, 0x53, 0xef, 0xf0, 0xf6, 0x05, 0xdc, 0xdc, 0xd8, 0x2f, 0xa1, 0x5b, 0x1e, 0x8d,
-	0xd0, 0x68, 0xe5, 0xf4, 0x63, 0xd5, 0x87, 0xe1, 0xdd, 0x4b, 0x24, 0x72, 0xc3, 0xbf, 0x86, 0xde,
-	0xe2, 0xc4, 0x83, 0xdc, 0x95, 0x8a, 0xa5, 0xe9, 0x69, 0xf8, 0xc1, 0xa5, 0x32, 0x36, 0x08, 0x45,
-	0x89, 0x2a, 0x81, 0xb0, 0x54, 0xcf, 0x4a, 0x20, 0x2c, 0xd7, 0x35, 0x05, 0x42, 0x39, 0xaf, 0x4b,
-	0x20, 0xac, 0xac, 0x42, 0x25, 0x10, 0x56, 0x17, 0x05, 0xf7, 0x06, 0x4a, 0x60, 0x7b, 0x75, 0xb6,
-	0x21, 0xfb, 0xa7, 0x8e, 0x4b, 0x53, 0x76, 0xf8, 0xd1, 0x1b, 0x48, 0x9a, 0x0d, 0x27, 0x75, 0xf9,
-	0xaf, 0xc0, 0xa7, 0xdf, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xab, 0xab, 0x3b, 0x25, 0x18, 0x00,
-	0x00,
+	// 2453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0xcb, 0x6e, 0x1b, 0xc9,
+	0x71, 0x87, 0xa4, 0x24, 0xb2, 0x28, 0xbe, 0x5a, 0xb2, 0x4c, 0x71, 0x57, 0x96, 0x3c, 0x76, 0x60,
+	0xd9, 0x71, 0x14, 0xc7, 0x5e, 0x60, 0x17, 0x79, 0x2d, 0x64, 0x59, 0xbb, 0x11, 0x2c, 0x69, 0xed,
+	0x91, 0xec, 0x04, 0x0b, 0x24, 0xb3, 0xad, 0x99, 0x96, 0xdc, 0xd0, 0x70, 0x86, 0x99, 0x6e, 0xd2,
+	0xe2, 0x06, 0x39, 0x25, 0xb7, 0x00, 0x41, 0x80, 0x5c, 0x72, 0x09, 0x90, 0x53, 0x3e, 0x62, 0x0f,
+	0xb9, 0x04, 0xf9, 0x90, 0xfc, 0x42, 0xae, 0x41, 0x80, 0xa0, 0x1f, 0xf3, 0xe4, 0x90, 0x92, 0x0d,
+	0xf8, 0xe0, 0xdb, 0x74, 0x55, 0x75, 0x55, 0x75, 0x55, 0x75, 0x3d, 0x9a, 0x84, 0xc5, 0x3e, 0x66,
+	0x9c, 0x84, 0x5b, 0x83, 0x30, 0xe0, 0x01, 0xaa, 0xa9, 0x95, 0x3d, 0x38, 0x31, 0xff, 0xbe, 0x00,
+	0xb5, 0x9f, 0x11, 0x1c, 0xf2, 0x13, 0x82, 0x39, 0x6a, 0x42, 0x89, 0x0e, 0xba, 0xc6, 0x86, 0xb1,
+	0x59, 0xb3, 0x4a, 0x74, 0x80, 0x10, 0x54, 0x06, 0x41, 0xc8, 0xbb, 0xa5, 0x0d, 0x63, 0xb3, 0x61,
+	0xc9, 0x6f, 0xb4, 0x06, 0x30, 0x18, 0x9e, 0x78, 0xd4, 0xb1, 0x87, 0xa1, 0xd7, 0x2d, 0x4b, 0xda,
+	0x9a, 0x82, 0xbc, 0x08, 0x3d, 0xb4, 0x09, 0xed, 0x3e, 0xbe, 0xb0, 0x47, 0x81, 0x37, 0xec, 0x13,
+	0xdb, 0x09, 0x86, 0x3e, 0xef, 0x56, 0xe4, 0xf6, 0x66, 0x1f, 0x5f, 0xbc, 0x94, 0xe0, 0x1d, 0x01,
+	0x45, 0x1b, 0x42, 0xab, 0x0b, 0xfb, 0x94, 0x7a, 0xc4, 0x3e, 0x27, 0xe3, 0xee, 0xdc, 0x86, 0xb1,
+	0x59, 0xb1, 0xa0, 0x8f, 0x2f, 0x3e, 0xa7, 0x1e, 0x79, 0x4a, 0xc6, 0x68, 0x1d, 0xea, 0x2e, 0xe6,
+	0xd8, 0x76, 0x88, 0xcf, 0x49, 0xd8, 0x9d, 0x97, 0xb2, 0x40, 0x80, 0x76, 0x24, 0x44, 0xe8, 0x17,
+	0x62, 0xe7, 0xbc, 0xbb, 0x20, 0x31, 0xf2, 0x5b, 0xe8, 0x87, 0xdd, 0x3e, 0xf5, 0x6d, 0xa9, 0x79,
+	0x55, 0x8a, 0xae, 0x49, 0xc8, 0x33, 0xa1, 0xfe, 0x4f, 0x60, 0x41, 0xe9, 0xc6, 0xba, 0xb5, 0x8d,
+	0xf2, 0x66, 0xfd, 0xe1, 0xad, 0xad, 0xd8, 0x1a, 0x5b, 0x4a, 0xbd, 0x3d, 0xff, 0x34, 0x08, 0xfb,
+	0x98, 0xd3, 0xc0, 0x3f, 0x20, 0x8c, 0xe1, 0x33, 0x62, 0x45, 0x7b, 0xd0, 0x1e, 0xd4, 0x7d, 0xf2,
+	0xda, 0x8e, 0x58, 0x80, 0x64, 0xb1, 0x39, 0xc1, 0xe2, 0xe8, 0x55, 0x10, 0xf2, 0x02, 0x3e, 0xe0,
+	0x93, 0xd7, 0x2f, 0x35, 0xab, 0xe7, 0xd0, 0x72, 0x89, 0x47, 0x38, 0x71, 0x63, 0x76, 0xf5, 0x37,
+	0x64, 0xd7, 0xd4, 0x0c, 0x22, 0x96, 0xb7, 0xa1, 0xf9, 0x0a, 0x33, 0xdb, 0x0f, 0x62, 0x8e, 0x8b,
+	0x1b, 0xc6, 0x66, 0xd5, 0x5a, 0x7c, 0x85, 0xd9, 0x61, 0x10, 0x51, 0x7d, 0x01, 0x35, 0xe2, 0xd8,
+	0xec, 0x15, 0x0e, 0x5d, 0xd6, 0x6d, 0x4b, 0x91, 0xf7, 0x26, 0x44, 0xee, 0x3a, 0x47, 0x82, 0xa0,
+	0x40, 0x68, 0x95, 0x28, 0x14, 0x43, 0x87, 0xd0, 0x10, 0xc6, 0x48, 0x98, 0x75, 0xde, 0x98, 0x99,
+	0xb0, 0xe6, 0x6e, 0xc4, 0xef, 0x25, 0x74, 0x22, 0x8b, 0x24, 0x3c, 0xd1, 0x1b, 0xf3, 0x8c, 0xcc,
+	0x1a, 0xf3, 0xbd, 0x03, 0x6d, 0x6d, 0x96, 0x84, 0xed, 0x92, 0x34, 0x4c, 0x43, 0x1a, 0x26, 0x26,
+	0x7c, 0x01, 0x9d, 0x7c, 0xf0, 0xb2, 0xee, 0xb2, 0x54, 0xe0, 0x6e, 0x4a, 0x81, 0xf8, 0xc2, 0x6c,
+	0x1d, 0x64, 0x42, 0x9a, 0xed, 0xfa, 0x3c, 0x1c, 0x5b, 0xad, 0x6c, 0xa0, 0xb3, 0xde, 0x63, 0x58,
+	0x2e, 0x22, 0x44, 0x6d, 0x28, 0x8b, 0xc0, 0x57, 0xf7, 0x4d, 0x7c, 0xa2, 0x65, 0x98, 0x1b, 0x61,
+	0x6f, 0x48, 0xf4, 0x8d, 0x53, 0x8b, 0x1f, 0x96, 0x3e, 0x35, 0xcc, 0x6f, 0x0d, 0xe8, 0xc4, 0x72,
+	0x2d, 0xc2, 0x06, 0x81, 0xcf, 0x08, 0xba, 0x07, 0x1d, 0xad, 0x2c, 0xa3, 0xdf, 0x10, 0xdb, 0xa3,
+	0x7d, 0xca, 0x25, 0xbf, 0x8a, 0xd5, 0x52, 0x88, 0x23, 0xfa, 0x0d, 0xd9, 0x17, 0x60, 0xb4, 0x02,
+	0xf3, 0x1e, 0xc1, 0x2e, 0x09, 0x25, 0xf3, 0x9a, 0xa5, 0x57, 0xe8, 0x0e, 0xb4, 0xfa, 0x84, 0x87,
+	0xd4, 0x61, 0x36, 0x76, 0xdd, 0x90, 0x30, 0xa6, 0x6f, 0x75, 0x53, 0x83, 0xb7, 0x15, 0x14, 0x7d,
+	0x0a, 0xdd, 0x88, 0x90, 0x8a, 0xeb, 0x37, 0xc2, 0x9e, 0xcd, 0x88, 0x13, 0xf8, 0x2e, 0xd3, 0x57,
+	0x7c, 0x45, 0xe3, 0xf7, 0x34, 0xfa, 0x48, 0x61, 0xcd, 0x6f, 0xcb, 0xd0, 0x9d, 0x76, 0xb7, 0x64,
+	0xd2, 0x71, 0xa5, 0xd2, 0x0d, 0xab, 0x44, 0x5d, 0x71, 0xa9, 0xc5, 0x61, 0xa4, 0x96, 0x15, 0x4b,
+	0x7e, 0xa3, 0x1b, 0x00, 0x4e, 0xe0, 0x79, 0xc4, 0x11, 0x1b, 0xb5, 0x7a, 0x29, 0x88, 0xb8, 0xf4,
+	0x32, 0x8f, 0x24, 0xf9, 0xa6, 0x62, 0xd5, 0x04, 0x44, 0xa5, 0x9a, 0x9b, 0xb0, 0xa8, 0x62, 0x42,
+	0x13, 0xa8, 0x54, 0x53, 0x57, 0x30, 0x45, 0x72, 0x1f, 0x50, 0x14, 0x7b, 0x27, 0xe3, 0x98, 0x70,
+	0x5e, 0x12, 0xb6, 0x35, 0xe6, 0xf1, 0x38, 0xa2, 0xfe, 0x10, 0x6a, 0x21, 0xc1, 0xae, 0x1d, 0xf8,
+	0xde, 0x58, 0x66, 0x9f, 0xaa, 0x55, 0x15, 0x80, 0x2f, 0x7d, 0x6f, 0x8c, 0xbe, 0x0b, 0x9d, 0x90,
+	0x0c, 0x3c, 0xea, 0x60, 0x7b, 0xe0, 0x61, 0x87, 0xf4, 0x89, 0x1f, 0x25, 0xa2, 0xb6, 0x46, 0x3c,
+	0x8b, 0xe0, 0xa8, 0x0b, 0x0b, 0x23, 0x12, 0x32, 0x71, 0xac, 0x9a, 0x24, 0x89, 0x96, 0x22, 0x3a,
+	0x38, 0xf7, 0xba, 0x20, 0xa1, 0xe2, 0x13, 0xdd, 0x85, 0xb6, 0x13, 0xf4, 0x07, 0xd8, 0xe1, 0x76,
+	0x48, 0x46, 0x54, 0x6e, 0xaa, 0x4b, 0x74, 0x4b, 0xc3, 0x2d, 0x0d, 0x16, 0xc7, 0xe9, 0x07, 0x2e,
+	0x3d, 0xa5, 0xc4, 0xb5, 0x31, 0xd7, 0x6e, 0x92, 0xd9, 0xa0, 0x6c, 0xb5, 0x23, 0xcc, 0x36, 0x57,
+	0x0e, 0x12, 0xc7, 0x71, 0x29, 0x3b, 0xb7, 0xf9, 0x78, 0x40, 0xba, 0x0d, 0x69, 0xdd, 0xaa, 0x00,
+	0x1c, 0x8f, 0x07, 0xc4, 0xfc, 0x97, 0x01, 0x6b, 0x33, 0xd3, 0xd0, 0x84, 0x07, 0x2f, 0xf3, 0xd6,
+	0x3b, 0x33, 0x50, 0xe6, 0x1c, 0xad, 0xdc, 0x39, 0x86, 0xb0, 0x7e, 0x49, 0xe6, 0xb8, 0xe4, 0x20,
+	0xa5, 0x89, 0x83, 0x98, 0xd0, 0x20, 0x8e, 0x4d, 0x7d, 0x97, 0x5c, 0xd8, 0x27, 0x94, 0xab, 0x8b,
+	0xd3, 0xb0, 0xea, 0xc4, 0xd9, 0x13, 0xb0, 0xc7, 0x94, 0x33, 0x73, 0x01, 0xe6, 0x76, 0xfb, 0x03,
+	0x3e, 0x36, 0xff, 0x61, 0x40, 0xeb, 0x68, 0x38, 0x20, 0xe1, 0x63, 0x2f, 0x70, 0xce, 0x77, 0x2f,
+	0x78, 0x88, 0xd1, 0x97, 0xd0, 0x24, 0x21, 0x66, 0xc3, 0x50, 0x04, 0x9c, 0x4b, 0xfd, 0x33, 0x29,
+	0x3c, 0x5b, 0x02, 0x72, 0x7b, 0xb6, 0x76, 0xd5, 0x86, 0x1d, 0x49, 0x6f, 0x35, 0x48, 0x7a, 0xd9,
+	0xfb, 0x0a, 0x1a, 0x19, 0xbc, 0xb8, 0x4d, 0xa2, 0x60, 0xea, 0x43, 0xc9, 0x6f, 0x91, 0x09, 0x06,
+	0x38, 0xa4, 0x7c, 0xac, 0xd3, 0x8c, 0x5e, 0x89, 0x5b, 0xa4, 0xb3, 0x09, 0x75, 0xc5, 0x59, 0xca,
+	0xa2, 0x74, 0x2a, 0xc8, 0x9e, 0xcb, 0xcc, 0x7b, 0xb0, 0xfc, 0x94, 0x90, 0xc1, 0x4e, 0xe0, 0xfb,
+	0xc4, 0xe1, 0xc4, 0xb5, 0xc8, 0xaf, 0x87, 0x84, 0x71, 0x21, 0xc2, 0xc7, 0x7d, 0xa2, 0xf3, 0x98,
+	0xfc, 0x36, 0xff, 0x6d, 0x40, 0x53, 0x59, 0x7b, 0x3f, 0x70, 0x30, 0xd7, 0xee, 0x12, 0x1d, 0x83,
+	0xce, 0x76, 0xc3, 0xd0, 0xcb, 0xb5, 0x12, 0xa5, 0x7c, 0x2b, 0xb1, 0x0a, 0x55, 0x59, 0x6b, 0x13,
+	0x65, 0x16, 0x44, 0xf9, 0xa4, 0x2e, 0x4b, 0x2e, 0xb4, 0xab, 0xd0, 0x15, 0x89, 0xae, 0x47, 0xe5,
+	0x50, 0x90, 0x24, 0xe9, 0x6e, 0x2e, 0x93, 0xee, 0x2e, 0x6d, 0x2a, 0xee, 0x40, 0x6b, 0xe8, 0xbf,
+	0x0e, 0x29, 0xc7, 0x27, 0x1e, 0x51, 0xec, 0x17, 0x24, 0xfb, 0x66, 0x02, 0x16, 0x12, 0xcc, 0x63,
+	0x58, 0xda, 0x0f, 0x82, 0xf3, 0xe1, 0x40, 0x1d, 0x34, 0x32, 0x47, 0xd6, 0x8a, 0xc6, 0x46, 0x59,
+	0x9c, 0x2a, 0xb6, 0xe2, 0x65, 0x31, 0x65, 0xfe, 0xc7, 0x80, 0xe5, 0x2c, 0x5b, 0x9d, 0xeb, 0xbf,
+	0x86, 0xa5, 0x98, 0xaf, 0xed, 0x69, 0xab, 0x2a, 0x01, 0xf5, 0x87, 0x0f, 0x52, 0x01, 0x53, 0xb4,
+	0x3b, 0x6a, 0x6d, 0xdc, 0xc8, 0x1d, 0x56, 0x67, 0x94, 0x83, 0xb0, 0xde, 0x05, 0xb4, 0xf3, 0x64,
+	0xe2, 0x4a, 0xc5, 0x52, 0xb5, 0xef, 0xaa, 0xd1, 0x4e, 0xf4, 0x03, 0xa8, 0x25, 0x8a, 0x94, 0xa4,
+	0x22, 0x4b, 0x19, 0x45, 0xb4, 0xac, 0x84, 0x4a, 0x54, 0x38, 0x12, 0x86, 0x41, 0xa8, 0xd3, 0x82,
+	0x5a, 0x98, 0x3f, 0x82, 0xea, 0x5b, 0xc7, 0x89, 0xf9, 0x97, 0x12, 0x34, 0xb6, 0x19, 0xa3, 0x67,
+	0x7e, 0xe4, 0x82, 0x65, 0x98, 0x53, 0xf9, 0x5b, 0x95, 0x42, 0xb5, 0x40, 0x1b, 0x50, 0xd7, 0xd9,
+	0x25, 0x65, 0xfa, 0x34, 0xe8, 0xd2, 0xc4, 0xa5, 0x33, 0x4e, 0x45, 0xa9, 0x26, 0x32, 0x4e, 0x2e,
+	0x9a, 0xe6, 0xa6, 0xb6, 0xa8, 0xf3, 0xa9, 0x16, 0x55, 0xa4, 0x29, 0xb1, 0xc9, 0x0f, 0x5c, 0xa2,
+	0x7b, 0xd7, 0xaa, 0x00, 0x1c, 0x06, 0x2e, 0x41, 0x5b, 0x80, 0x0e, 0x48, 0x3f, 0x08, 0xc7, 0x07,
+	0x78, 0x70, 0x80, 0x2f, 0x44, 0xfd, 0x3e, 0x78, 0xac, 0xb3, 0x63, 0x01, 0x26, 0x9b, 0xf3, 0x6a,
+	0xb9, 0x9c, 0xf7, 0x67, 0x03, 0x9a, 0x91, 0x69, 0x74, 0x18, 0xb5, 0xa1, 0x7c, 0x1a, 0xbb, 0x52,
+	0x7c, 0x46, 0x06, 0x2f, 0x4d, 0x33, 0xf8, 0x44, 0x8f, 0x1f, 0x9b, 0xb7, 0x92, 0x36, 0x6f, 0xec,
+	0xd9, 0xb9, 0x94, 0x67, 0xc5, 0xf9, 0xf1, 0x90, 0xbf, 0x8a, 0xce, 0x2f, 0xbe, 0xcd, 0x33, 0xe8,
+	0x1c, 0x71, 0xcc, 0x29, 0xe3, 0xd4, 0x61, 0x91, 0xcf, 0x72, 0xde, 0x31, 0x2e, 0xf3, 0x4e, 0x69,
+	0x9a, 0x77, 0xca, 0xb1, 0x77, 0xcc, 0x7f, 0x1a, 0x80, 0xd2, 0x92, 0xb4, 0x09, 0xde, 0x81, 0x28,
+	0x61, 0x32, 0x1e, 0x70, 0xd1, 0x11, 0x89, 0xde, 0x45, 0x77, 0x20, 0x12, 0x22, 0xfc, 0x24, 0xbc,
+	0x34, 0x64, 0xc4, 0x55, 0x58, 0xd5, 0x7e, 0x54, 0x05, 0x40, 0x22, 0xb3, 0xdd, 0xcb, 0x7c, 0xae,
+	0x7b, 0x31, 0xb7, 0xa1, 0x7e, 0xc4, 0x83, 0x10, 0x9f, 0x11, 0xe1, 0xd3, 0x2b, 0x68, 0xaf, 0xb5,
+	0x2b, 0x25, 0x86, 0xd8, 0x00, 0xd8, 0x49, 0xb4, 0x2f, 0x4a, 0xd8, 0xbf, 0x81, 0x6b, 0x09, 0xc5,
+	0x3e, 0x65, 0x3c, 0xf2, 0xcb, 0xc7, 0xb0, 0x42, 0x7d, 0xc7, 0x1b, 0xba, 0xc4, 0xf6, 0x45, 0xbd,
+	0xf4, 0xe2, 0xd9, 0xc2, 0x90, 0x7d, 0xcf, 0xb2, 0xc6, 0x1e, 0x4a, 0x64, 0x34, 0x63, 0xdc, 0x07,
+	0x14, 0xed, 0x22, 0x4e, 0xbc, 0xa3, 0x24, 0x77, 0xb4, 0x35, 0x66, 0xd7, 0xd1, 0xd4, 0xe6, 0x73,
+	0x58, 0xc9, 0x0b, 0xd7, 0xae, 0xfa, 0x04, 0xea, 0x89, 0xd9, 0xa3, 0x64, 0x77, 0x2d, 0x95, 0x63,
+	0x92, 0x7d, 0x56, 0x9a, 0xd2, 0xfc, 0x1e, 0x5c, 0x4f, 0x50, 0x4f, 0x64, 0x5d, 0x98, 0x55, 0xaf,
+	0x7a, 0xd0, 0x9d, 0x24, 0x57, 0x3a, 0x98, 0x7f, 0xab, 0xc0, 0xe2, 0x13, 0x7d, 0x3d, 0x45, 0xd3,
+	0x90, 0x6a, 0x13, 0x6a, 0xb2, 0x4d, 0xb8, 0x09, 0x8b, 0x99, 0x79, 0x57, 0x75, 0xae, 0xf5, 0x51,
+	0x6a, 0xd8, 0x2d, 0x1a, 0x8b, 0xcb, 0x92, 0x2c, 0x3f, 0x16, 0xdf, 0x83, 0xce, 0x69, 0x48, 0xc8,
+	0xe4, 0x04, 0x5d, 0xb1, 0x5a, 0x02, 0x91, 0xa6, 0xdd, 0x82, 0x25, 0xec, 0x70, 0x3a, 0xca, 0x51,
+	0xab, 0xf8, 0xea, 0x28, 0x54, 0x9a, 0xfe, 0xf3, 0x58, 0x51, 0xea, 0x9f, 0x06, 0xac, 0x3b, 0x7f,
+	0xf5, 0x09, 0xb8, 0x3e, 0x8a, 0x31, 0x0c, 0x3d, 0x83, 0x66, 0x34, 0x49, 0x69, 0x4e, 0x0b, 0x6f,
+	0x3c, 0xa5, 0x2d, 0x92, 0x04, 0xc5, 0xd0, 0x2f, 0x8a, 0x26, 0xaf, 0xaa, 0x64, 0x7a, 0x3f, 0xc5,
+	0x34, 0xed, 0x86, 0xab, 0x0d, 0x5f, 0x22, 0x43, 0x53, 0x66, 0xbb, 0x21, 0xa6, 0xbe, 0xe8, 0xaf,
+	0x6a, 0x32, 0x04, 0x81, 0xb2, 0x27, 0x1a, 0xf2, 0xb6, 0xd3, 0x59, 0x25, 0x3d, 0x9d, 0xfd, 0xbe,
+	0x04, 0x55, 0x0b, 0x3b, 0xe7, 0xef, 0x77, 0x78, 0x7c, 0x06, 0xad, 0xb8, 0x2e, 0x65, 0x22, 0xe4,
+	0xfa, 0x14, 0x17, 0x58, 0x0d, 0x37, 0xb5, 0x62, 0xe6, 0xff, 0x0c, 0x68, 0x3e, 0x89, 0x6b, 0xdf,
+	0xfb, 0x6d, 0x8c, 0x87, 0x00, 0xa2, 0x58, 0x67, 0xec, 0x90, 0x6e, 0x6e, 0x22, 0x77, 0x5b, 0xb5,
+	0x50, 0x7f, 0x31, 0xf3, 0x8f, 0x25, 0x58, 0x3c, 0x0e, 0x06, 0x81, 0x17, 0x9c, 0x8d, 0xdf, 0xef,
+	0xd3, 0xef, 0x42, 0x27, 0xd5, 0xd7, 0x64, 0x8c, 0xb0, 0x9a, 0x0b, 0x86, 0xc4, 0xd9, 0x56, 0xcb,
+	0xcd, 0xac, 0x99, 0xb9, 0x04, 0x1d, 0x3d, 0x05, 0x24, 0x15, 0xc5, 0xfc, 0x9d, 0x01, 0x28, 0x0d,
+	0xd5, 0xa9, 0xfe, 0xc7, 0xd0, 0xe0, 0xda, 0x76, 0x52, 0x9e, 0x1e, 0x85, 0xd2, 0xb1, 0x97, 0xb6,
+	0xad, 0xb5, 0xc8, 0x53, 0x2b, 0xf4, 0x7d, 0x58, 0x9e, 0x78, 0x09, 0xb1, 0xfb, 0x27, 0xda, 0xc2,
+	0x9d, 0xdc, 0x63, 0xc8, 0xc1, 0x89, 0xf9, 0x31, 0x5c, 0x53, 0x8d, 0x72, 0x54, 0x86, 0xa2, 0xf2,
+	0x30, 0xd1, 0xf1, 0x36, 0x92, 0x8e, 0xd7, 0xfc, 0xaf, 0x01, 0x2b, 0xf9, 0x6d, 0x5a, 0xff, 0x59,
+	0xfb, 0x10, 0x06, 0xa4, 0xd3, 0xa5, 0x6b, 0xe7, 0x5b, 0xe6, 0x47, 0x13, 0xbd, 0x7b, 0x9e, 0xf7,
+	0x56, 0x94, 0x46, 0x93, 0xf6, 0xbd, 0xcd, 0xb2, 0x00, 0xd6, 0xc3, 0xd0, 0x99, 0x20, 0x13, 0x33,
+	0x54, 0x24, 0x57, 0xeb, 0xb4, 0xa0, 0x37, 0xbe, 0x45, 0xf3, 0x6e, 0xae, 0xc3, 0xda, 0x17, 0x84,
+	0x1f, 0x48, 0x9a, 0x9d, 0xc0, 0x3f, 0xa5, 0x67, 0xc3, 0x50, 0x11, 0x25, 0xae, 0xbd, 0x31, 0x8d,
+	0x42, 0x9b, 0xa9, 0xe0, 0xb9, 0xc9, 0x78, 0xe3, 0xe7, 0xa6, 0xd2, 0xcc, 0xe7, 0xa6, 0x75, 0x58,
+	0xb3, 0xf0, 0x29, 0x17, 0xd1, 0xb5, 0xe3, 0x0d, 0x85, 0x2a, 0x47, 0x24, 0x1c, 0x91, 0x30, 0xea,
+	0x35, 0xcd, 0x3f, 0x95, 0xe0, 0xc6, 0x34, 0x8a, 0x78, 0xda, 0x6a, 0x39, 0x0a, 0x63, 0x33, 0x85,
+	0xd2, 0xcd, 0xc7, 0x27, 0x99, 0x1c, 0x30, 0x8b, 0xc7, 0x56, 0x06, 0x6c, 0x35, 0x9d, 0x0c, 0x55,
+	0xef, 0x0f, 0x06, 0x34, 0x32, 0x14, 0xe2, 0xa9, 0x23, 0x6b, 0x92, 0x68, 0x29, 0x62, 0x8b, 0x32,
+	0x3b, 0xf5, 0x7c, 0x57, 0xb5, 0xaa, 0x94, 0xed, 0xcb, 0xb5, 0xf0, 0x31, 0x65, 0x36, 0xf6, 0xe8,
+	0x88, 0xc8, 0x4c, 0x51, 0xb5, 0x16, 0x28, 0xdb, 0x16, 0x4b, 0x91, 0x4c, 0x3c, 0xcc, 0xb8, 0x2d,
+	0x2f, 0x38, 0xe5, 0x63, 0xdb, 0x57, 0x4f, 0x75, 0x65, 0xab, 0x29, 0xe0, 0xdb, 0x1a, 0x7c, 0xc8,
+	0xcc, 0x07, 0xb0, 0x2c, 0x4e, 0xb3, 0xed, 0xba, 0x5a, 0x5d, 0x7d, 0x1b, 0xa6, 0xea, 0x64, 0x5e,
+	0x87, 0x6b, 0xb9, 0x1d, 0xba, 0x5f, 0x7a, 0x04, 0xd7, 0x05, 0xc2, 0x22, 0xfd, 0x60, 0x44, 0xae,
+	0xca, 0xad, 0x07, 0xdd, 0xc9, 0x4d, 0x9a, 0xe1, 0x6f, 0x61, 0x65, 0x9f, 0x60, 0x46, 0xb6, 0xc5,
+	0x2b, 0xfe, 0x71, 0x70, 0x4e, 0xe2, 0x41, 0xef, 0x3b, 0xd0, 0x1c, 0x88, 0xa7, 0xb0, 0x60, 0xc8,
+	0x6c, 0x2e, 0x10, 0x92, 0x6d, 0xd9, 0x6a, 0x44, 0x50, 0x49, 0x2d, 0xcc, 0x27, 0xde, 0x4f, 0x6c,
+	0xd9, 0xf6, 0xa9, 0xb6, 0xb8, 0x2a, 0x00, 0x87, 0xb8, 0x4f, 0x44, 0x83, 0xe0, 0x78, 0x94, 0xf8,
+	0x5c, 0xa1, 0xa3, 0xa9, 0x4f, 0x82, 0x04, 0x81, 0x79, 0x00, 0xd7, 0x27, 0xc4, 0xeb, 0x28, 0x59,
+	0x86, 0xb9, 0xb4, 0x58, 0xb5, 0x40, 0x1f, 0x01, 0x48, 0x71, 0x9c, 0xd9, 0xbe, 0x8a, 0xd5, 0xb2,
+	0x92, 0x77, 0xcc, 0x0e, 0x99, 0xf9, 0x2b, 0xe8, 0x5a, 0xc4, 0x7b, 0x67, 0xe7, 0x31, 0x3f, 0x84,
+	0xd5, 0x02, 0xfe, 0xda, 0x94, 0x2f, 0xa2, 0x87, 0x58, 0x65, 0x62, 0xd9, 0x04, 0x5d, 0xea, 0x9c,
+	0x7c, 0x0f, 0x55, 0xca, 0xf7, 0x50, 0xe6, 0x4f, 0x61, 0xb5, 0x80, 0xad, 0x36, 0xd2, 0x4d, 0x58,
+	0xa4, 0xcc, 0x76, 0xa2, 0x67, 0x23, 0x3d, 0x37, 0xd4, 0x29, 0x8b, 0x5f, 0x92, 0x1e, 0xfe, 0xb5,
+	0x0e, 0x0b, 0x47, 0x04, 0xbf, 0x26, 0xc4, 0x45, 0x7b, 0xd0, 0x38, 0x22, 0xbe, 0x9b, 0xfc, 0x2a,
+	0xb5, 0x5c, 0xf4, 0xf4, 0xde, 0xfb, 0xa8, 0x08, 0x1a, 0x9f, 0xf3, 0x83, 0x4d, 0xe3, 0x81, 0x81,
+	0x9e, 0x43, 0x23, 0xf3, 0x62, 0x85, 0xd6, 0x53, 0x9b, 0x8a, 0xde, 0xb2, 0x7a, 0xab, 0x13, 0x1d,
+	0x6c, 0x94, 0x07, 0x63, 0x96, 0x8b, 0xe9, 0xf7, 0x15, 0x74, 0x63, 0xea, 0xc3, 0x8b, 0x62, 0xb8,
+	0x7e, 0xc9, 0xc3, 0x8c, 0xf9, 0x01, 0xfa, 0x0c, 0xe6, 0xd5, 0x8c, 0x8e, 0xba, 0x29, 0xe2, 0xcc,
+	0x8b, 0x46, 0x6f, 0xb5, 0x00, 0x13, 0x33, 0x78, 0x0a, 0x90, 0x4c, 0xb9, 0x28, 0x6d, 0x98, 0x89,
+	0x31, 0xbb, 0xb7, 0x36, 0x05, 0x1b, 0x33, 0xfb, 0x39, 0x34, 0xb3, 0xb3, 0x18, 0xda, 0x28, 0x1c,
+	0xb7, 0x52, 0x15, 0xbd, 0x77, 0x73, 0x06, 0x45, 0xcc, 0xf8, 0x97, 0xd0, 0xce, 0x8f, 0x58, 0xc8,
+	0x2c, 0xdc, 0x98, 0x19, 0xd7, 0x7a, 0xb7, 0x66, 0xd2, 0xa4, 0x8d, 0x90, 0x34, 0x15, 0x19, 0x23,
+	0x4c, 0x74, 0x20, 0xbd, 0xb5, 0x29, 0xd8, 0xb4, 0x11, 0xb2, 0x95, 0x38, 0x63, 0x84, 0xc2, 0xbe,
+	0xa1, 0x77, 0x73, 0x06, 0x45, 0xcc, 0x38, 0x80, 0x95, 0xe2, 0xfa, 0x88, 0xd2, 0x4f, 0xbe, 0x33,
+	0x8b, 0x6c, 0xef, 0xee, 0x15, 0x28, 0xd3, 0x02, 0x8b, 0xab, 0x54, 0x46, 0xe0, 0xcc, 0x72, 0xd9,
+	0xbb, 0x7b, 0x05, 0xca, 0x58, 0xe0, 0x31, 0x34, 0x32, 0x65, 0x21, 0x73, 0xe7, 0x8a, 0x4a, 0x4c,
+	0x6f, 0x63, 0x3a, 0x41, 0x3a, 0x78, 0xf2, 0xe5, 0x21, 0x13, 0x3c, 0x53, 0x0a, 0x4e, 0xef, 0xd6,
+	0x4c, 0x9a, 0x98, 0xfd, 0x57, 0xd0, 0xca, 0xa5, 0x78, 0x94, 0x71, 0x67, 0x61, 0xb6, 0xee, 0x99,
+	0xb3, 0x48, 0x62, 0xde, 0x5f, 0x43, 0x67, 0x22, 0x1f, 0xa3, 0x8c, 0x5e, 0x53, 0xaa, 0x41, 0xef,
+	0xf6, 0x6c, 0xa2, 0xb4, 0x84, 0x89, 0xec, 0x8b, 0x26, 0xa7, 0xfa, 0xc9, 0x94, 0xdf, 0xbb, 0x3d,
+	0x9b, 0x28, 0x92, 0x70, 0x32, 0x2f, 0xff, 0x38, 0xf0, 0xe8, 0xff, 0x03, 0x00, 0xf3, 0x40, 0x03,
+	0x5e, 0x48, 0x20, 0x00, 0x00,
 }
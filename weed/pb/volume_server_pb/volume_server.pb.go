@@ -63,6 +63,10 @@ It has these top-level messages:
 	VolumeEcBlobDeleteResponse
 	ReadVolumeFileStatusRequest
 	ReadVolumeFileStatusResponse
+	WriteNeedleRequest
+	WriteNeedleResponse
+	ReadNeedleRequest
+	ReadNeedleResponse
 	DiskStatus
 	MemStatus
 	QueryRequest
@@ -324,6 +328,7 @@ type AllocateVolumeRequest struct {
 	Replication        string `protobuf:"bytes,4,opt,name=replication" json:"replication,omitempty"`
 	Ttl                string `protobuf:"bytes,5,opt,name=ttl" json:"ttl,omitempty"`
 	MemoryMapMaxSizeMB uint32 `protobuf:"varint,6,opt,name=memorymapmaxsizemb" json:"memorymapmaxsizemb,omitempty"`
+	DiskType           string `protobuf:"bytes,7,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *AllocateVolumeRequest) Reset()                    { *m = AllocateVolumeRequest{} }
@@ -373,6 +378,13 @@ func (m *AllocateVolumeRequest) GetMemoryMapMaxSizeMB() uint32 {
 	return 0
 }
 
+func (m *AllocateVolumeRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type AllocateVolumeResponse struct {
 }
 
@@ -603,6 +615,7 @@ type VolumeCopyRequest struct {
 	Replication    string `protobuf:"bytes,3,opt,name=replication" json:"replication,omitempty"`
 	Ttl            string `protobuf:"bytes,4,opt,name=ttl" json:"ttl,omitempty"`
 	SourceDataNode string `protobuf:"bytes,5,opt,name=source_data_node,json=sourceDataNode" json:"source_data_node,omitempty"`
+	DiskType       string `protobuf:"bytes,6,opt,name=disk_type,json=diskType" json:"disk_type,omitempty"`
 }
 
 func (m *VolumeCopyRequest) Reset()                    { *m = VolumeCopyRequest{} }
@@ -645,6 +658,13 @@ func (m *VolumeCopyRequest) GetSourceDataNode() string {
 	return ""
 }
 
+func (m *VolumeCopyRequest) GetDiskType() string {
+	if m != nil {
+		return m.DiskType
+	}
+	return ""
+}
+
 type VolumeCopyResponse struct {
 	LastAppendAtNs uint64 `protobuf:"varint,1,opt,name=last_append_at_ns,json=lastAppendAtNs" json:"last_append_at_ns,omitempty"`
 }
@@ -1293,6 +1313,264 @@ func (m *ReadVolumeFileStatusResponse) GetCollection() string {
 	return ""
 }
 
+// the first request carries the needle metadata, and all requests carry the data in sequence
+type WriteNeedleRequest struct {
+	FileId          string            `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	Name            string            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Mime            string            `protobuf:"bytes,3,opt,name=mime" json:"mime,omitempty"`
+	Pairs           map[string]string `protobuf:"bytes,4,rep,name=pairs" json:"pairs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ttl             string            `protobuf:"bytes,5,opt,name=ttl" json:"ttl,omitempty"`
+	IsGzipped       bool              `protobuf:"varint,6,opt,name=is_gzipped,json=isGzipped" json:"is_gzipped,omitempty"`
+	LastModified    uint64            `protobuf:"varint,7,opt,name=last_modified,json=lastModified" json:"last_modified,omitempty"`
+	IsChunkManifest bool              `protobuf:"varint,8,opt,name=is_chunk_manifest,json=isChunkManifest" json:"is_chunk_manifest,omitempty"`
+	Jwt             string            `protobuf:"bytes,9,opt,name=jwt" json:"jwt,omitempty"`
+	IsReplicate     bool              `protobuf:"varint,10,opt,name=is_replicate,json=isReplicate" json:"is_replicate,omitempty"`
+	Data            []byte            `protobuf:"bytes,11,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *WriteNeedleRequest) Reset()                    { *m = WriteNeedleRequest{} }
+func (m *WriteNeedleRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteNeedleRequest) ProtoMessage()               {}
+func (*WriteNeedleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *WriteNeedleRequest) GetFileId() string {
+	if m != nil {
+		return m.FileId
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetMime() string {
+	if m != nil {
+		return m.Mime
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetPairs() map[string]string {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *WriteNeedleRequest) GetTtl() string {
+	if m != nil {
+		return m.Ttl
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetIsGzipped() bool {
+	if m != nil {
+		return m.IsGzipped
+	}
+	return false
+}
+
+func (m *WriteNeedleRequest) GetLastModified() uint64 {
+	if m != nil {
+		return m.LastModified
+	}
+	return 0
+}
+
+func (m *WriteNeedleRequest) GetIsChunkManifest() bool {
+	if m != nil {
+		return m.IsChunkManifest
+	}
+	return false
+}
+
+func (m *WriteNeedleRequest) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetIsReplicate() bool {
+	if m != nil {
+		return m.IsReplicate
+	}
+	return false
+}
+
+func (m *WriteNeedleRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type WriteNeedleResponse struct {
+	Name        string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Size        uint32 `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	Etag        string `protobuf:"bytes,3,opt,name=etag" json:"etag,omitempty"`
+	IsUnchanged bool   `protobuf:"varint,4,opt,name=is_unchanged,json=isUnchanged" json:"is_unchanged,omitempty"`
+}
+
+func (m *WriteNeedleResponse) Reset()                    { *m = WriteNeedleResponse{} }
+func (m *WriteNeedleResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteNeedleResponse) ProtoMessage()               {}
+func (*WriteNeedleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *WriteNeedleResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WriteNeedleResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *WriteNeedleResponse) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *WriteNeedleResponse) GetIsUnchanged() bool {
+	if m != nil {
+		return m.IsUnchanged
+	}
+	return false
+}
+
+type ReadNeedleRequest struct {
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	Size   int64  `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	Jwt    string `protobuf:"bytes,4,opt,name=jwt" json:"jwt,omitempty"`
+}
+
+func (m *ReadNeedleRequest) Reset()                    { *m = ReadNeedleRequest{} }
+func (m *ReadNeedleRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadNeedleRequest) ProtoMessage()               {}
+func (*ReadNeedleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *ReadNeedleRequest) GetFileId() string {
+	if m != nil {
+		return m.FileId
+	}
+	return ""
+}
+
+func (m *ReadNeedleRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ReadNeedleRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ReadNeedleRequest) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+// the first response carries the needle metadata, and all responses carry the data in sequence
+type ReadNeedleResponse struct {
+	Name            string            `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Mime            string            `protobuf:"bytes,2,opt,name=mime" json:"mime,omitempty"`
+	Pairs           map[string]string `protobuf:"bytes,3,rep,name=pairs" json:"pairs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IsGzipped       bool              `protobuf:"varint,4,opt,name=is_gzipped,json=isGzipped" json:"is_gzipped,omitempty"`
+	LastModified    uint64            `protobuf:"varint,5,opt,name=last_modified,json=lastModified" json:"last_modified,omitempty"`
+	IsChunkManifest bool              `protobuf:"varint,6,opt,name=is_chunk_manifest,json=isChunkManifest" json:"is_chunk_manifest,omitempty"`
+	Etag            string            `protobuf:"bytes,7,opt,name=etag" json:"etag,omitempty"`
+	TotalSize       uint64            `protobuf:"varint,8,opt,name=total_size,json=totalSize" json:"total_size,omitempty"`
+	Data            []byte            `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ReadNeedleResponse) Reset()                    { *m = ReadNeedleResponse{} }
+func (m *ReadNeedleResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadNeedleResponse) ProtoMessage()               {}
+func (*ReadNeedleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *ReadNeedleResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReadNeedleResponse) GetMime() string {
+	if m != nil {
+		return m.Mime
+	}
+	return ""
+}
+
+func (m *ReadNeedleResponse) GetPairs() map[string]string {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *ReadNeedleResponse) GetIsGzipped() bool {
+	if m != nil {
+		return m.IsGzipped
+	}
+	return false
+}
+
+func (m *ReadNeedleResponse) GetLastModified() uint64 {
+	if m != nil {
+		return m.LastModified
+	}
+	return 0
+}
+
+func (m *ReadNeedleResponse) GetIsChunkManifest() bool {
+	if m != nil {
+		return m.IsChunkManifest
+	}
+	return false
+}
+
+func (m *ReadNeedleResponse) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *ReadNeedleResponse) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ReadNeedleResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type DiskStatus struct {
 	Dir  string `protobuf:"bytes,1,opt,name=dir" json:"dir,omitempty"`
 	All  uint64 `protobuf:"varint,2,opt,name=all" json:"all,omitempty"`
@@ -1303,7 +1581,7 @@ type DiskStatus struct {
 func (m *DiskStatus) Reset()                    { *m = DiskStatus{} }
 func (m *DiskStatus) String() string            { return proto.CompactTextString(m) }
 func (*DiskStatus) ProtoMessage()               {}
-func (*DiskStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*DiskStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *DiskStatus) GetDir() string {
 	if m != nil {
@@ -1346,7 +1624,7 @@ type MemStatus struct {
 func (m *MemStatus) Reset()                    { *m = MemStatus{} }
 func (m *MemStatus) String() string            { return proto.CompactTextString(m) }
 func (*MemStatus) ProtoMessage()               {}
-func (*MemStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*MemStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 func (m *MemStatus) GetGoroutines() int32 {
 	if m != nil {
@@ -1408,7 +1686,7 @@ type QueryRequest struct {
 func (m *QueryRequest) Reset()                    { *m = QueryRequest{} }
 func (m *QueryRequest) String() string            { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()               {}
-func (*QueryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*QueryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 func (m *QueryRequest) GetSelections() []string {
 	if m != nil {
@@ -1454,7 +1732,7 @@ type QueryRequest_Filter struct {
 func (m *QueryRequest_Filter) Reset()                    { *m = QueryRequest_Filter{} }
 func (m *QueryRequest_Filter) String() string            { return proto.CompactTextString(m) }
 func (*QueryRequest_Filter) ProtoMessage()               {}
-func (*QueryRequest_Filter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56, 0} }
+func (*QueryRequest_Filter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60, 0} }
 
 func (m *QueryRequest_Filter) GetField() string {
 	if m != nil {
@@ -1489,7 +1767,7 @@ func (m *QueryRequest_InputSerialization) Reset()         { *m = QueryRequest_In
 func (m *QueryRequest_InputSerialization) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest_InputSerialization) ProtoMessage()    {}
 func (*QueryRequest_InputSerialization) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 1}
+	return fileDescriptor0, []int{60, 1}
 }
 
 func (m *QueryRequest_InputSerialization) GetCompressionType() string {
@@ -1529,6 +1807,8 @@ type QueryRequest_InputSerialization_CSVInput struct {
 	Comments             string `protobuf:"bytes,6,opt,name=comments" json:"comments,omitempty"`
 	// If true, records might contain record delimiters within quote characters
 	AllowQuotedRecordDelimiter bool `protobuf:"varint,7,opt,name=allow_quoted_record_delimiter,json=allowQuotedRecordDelimiter" json:"allow_quoted_record_delimiter,omitempty"`
+	// column names read from the file header, used to resolve the selections and filter
+	ColumnNames []string `protobuf:"bytes,8,rep,name=column_names,json=columnNames" json:"column_names,omitempty"`
 }
 
 func (m *QueryRequest_InputSerialization_CSVInput) Reset() {
@@ -1537,7 +1817,7 @@ func (m *QueryRequest_InputSerialization_CSVInput) Reset() {
 func (m *QueryRequest_InputSerialization_CSVInput) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest_InputSerialization_CSVInput) ProtoMessage()    {}
 func (*QueryRequest_InputSerialization_CSVInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 1, 0}
+	return fileDescriptor0, []int{60, 1, 0}
 }
 
 func (m *QueryRequest_InputSerialization_CSVInput) GetFileHeaderInfo() string {
@@ -1589,6 +1869,13 @@ func (m *QueryRequest_InputSerialization_CSVInput) GetAllowQuotedRecordDelimiter
 	return false
 }
 
+func (m *QueryRequest_InputSerialization_CSVInput) GetColumnNames() []string {
+	if m != nil {
+		return m.ColumnNames
+	}
+	return nil
+}
+
 type QueryRequest_InputSerialization_JSONInput struct {
 	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
 }
@@ -1599,7 +1886,7 @@ func (m *QueryRequest_InputSerialization_JSONInput) Reset() {
 func (m *QueryRequest_InputSerialization_JSONInput) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest_InputSerialization_JSONInput) ProtoMessage()    {}
 func (*QueryRequest_InputSerialization_JSONInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 1, 1}
+	return fileDescriptor0, []int{60, 1, 1}
 }
 
 func (m *QueryRequest_InputSerialization_JSONInput) GetType() string {
@@ -1620,7 +1907,7 @@ func (m *QueryRequest_InputSerialization_ParquetInput) String() string {
 }
 func (*QueryRequest_InputSerialization_ParquetInput) ProtoMessage() {}
 func (*QueryRequest_InputSerialization_ParquetInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 1, 2}
+	return fileDescriptor0, []int{60, 1, 2}
 }
 
 type QueryRequest_OutputSerialization struct {
@@ -1632,7 +1919,7 @@ func (m *QueryRequest_OutputSerialization) Reset()         { *m = QueryRequest_O
 func (m *QueryRequest_OutputSerialization) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest_OutputSerialization) ProtoMessage()    {}
 func (*QueryRequest_OutputSerialization) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 2}
+	return fileDescriptor0, []int{60, 2}
 }
 
 func (m *QueryRequest_OutputSerialization) GetCsvOutput() *QueryRequest_OutputSerialization_CSVOutput {
@@ -1665,7 +1952,7 @@ func (m *QueryRequest_OutputSerialization_CSVOutput) String() string {
 }
 func (*QueryRequest_OutputSerialization_CSVOutput) ProtoMessage() {}
 func (*QueryRequest_OutputSerialization_CSVOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 2, 0}
+	return fileDescriptor0, []int{60, 2, 0}
 }
 
 func (m *QueryRequest_OutputSerialization_CSVOutput) GetQuoteFields() string {
@@ -1715,7 +2002,7 @@ func (m *QueryRequest_OutputSerialization_JSONOutput) String() string {
 }
 func (*QueryRequest_OutputSerialization_JSONOutput) ProtoMessage() {}
 func (*QueryRequest_OutputSerialization_JSONOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 2, 1}
+	return fileDescriptor0, []int{60, 2, 1}
 }
 
 func (m *QueryRequest_OutputSerialization_JSONOutput) GetRecordDelimiter() string {
@@ -1727,12 +2014,18 @@ func (m *QueryRequest_OutputSerialization_JSONOutput) GetRecordDelimiter() strin
 
 type QueriedStripe struct {
 	Records []byte `protobuf:"bytes,1,opt,name=records,proto3" json:"records,omitempty"`
+	// a needle may start or end in the middle of a record,
+	// so the bytes before the first and after the last record delimiter are returned as is.
+	Head []byte `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	Tail []byte `protobuf:"bytes,3,opt,name=tail,proto3" json:"tail,omitempty"`
+	// if false, the whole needle is in head
+	HasRecordDelimiter bool `protobuf:"varint,4,opt,name=has_record_delimiter,json=hasRecordDelimiter" json:"has_record_delimiter,omitempty"`
 }
 
 func (m *QueriedStripe) Reset()                    { *m = QueriedStripe{} }
 func (m *QueriedStripe) String() string            { return proto.CompactTextString(m) }
 func (*QueriedStripe) ProtoMessage()               {}
-func (*QueriedStripe) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*QueriedStripe) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
 func (m *QueriedStripe) GetRecords() []byte {
 	if m != nil {
@@ -1741,6 +2034,27 @@ func (m *QueriedStripe) GetRecords() []byte {
 	return nil
 }
 
+func (m *QueriedStripe) GetHead() []byte {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *QueriedStripe) GetTail() []byte {
+	if m != nil {
+		return m.Tail
+	}
+	return nil
+}
+
+func (m *QueriedStripe) GetHasRecordDelimiter() bool {
+	if m != nil {
+		return m.HasRecordDelimiter
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*BatchDeleteRequest)(nil), "volume_server_pb.BatchDeleteRequest")
 	proto.RegisterType((*BatchDeleteResponse)(nil), "volume_server_pb.BatchDeleteResponse")
@@ -1796,6 +2110,10 @@ func init() {
 	proto.RegisterType((*VolumeEcBlobDeleteResponse)(nil), "volume_server_pb.VolumeEcBlobDeleteResponse")
 	proto.RegisterType((*ReadVolumeFileStatusRequest)(nil), "volume_server_pb.ReadVolumeFileStatusRequest")
 	proto.RegisterType((*ReadVolumeFileStatusResponse)(nil), "volume_server_pb.ReadVolumeFileStatusResponse")
+	proto.RegisterType((*WriteNeedleRequest)(nil), "volume_server_pb.WriteNeedleRequest")
+	proto.RegisterType((*WriteNeedleResponse)(nil), "volume_server_pb.WriteNeedleResponse")
+	proto.RegisterType((*ReadNeedleRequest)(nil), "volume_server_pb.ReadNeedleRequest")
+	proto.RegisterType((*ReadNeedleResponse)(nil), "volume_server_pb.ReadNeedleResponse")
 	proto.RegisterType((*DiskStatus)(nil), "volume_server_pb.DiskStatus")
 	proto.RegisterType((*MemStatus)(nil), "volume_server_pb.MemStatus")
 	proto.RegisterType((*QueryRequest)(nil), "volume_server_pb.QueryRequest")
@@ -1850,6 +2168,9 @@ type VolumeServerClient interface {
 	VolumeEcShardsUnmount(ctx context.Context, in *VolumeEcShardsUnmountRequest, opts ...grpc.CallOption) (*VolumeEcShardsUnmountResponse, error)
 	VolumeEcShardRead(ctx context.Context, in *VolumeEcShardReadRequest, opts ...grpc.CallOption) (VolumeServer_VolumeEcShardReadClient, error)
 	VolumeEcBlobDelete(ctx context.Context, in *VolumeEcBlobDeleteRequest, opts ...grpc.CallOption) (*VolumeEcBlobDeleteResponse, error)
+	// needle data, an alternative to the http upload and download
+	WriteNeedle(ctx context.Context, opts ...grpc.CallOption) (VolumeServer_WriteNeedleClient, error)
+	ReadNeedle(ctx context.Context, in *ReadNeedleRequest, opts ...grpc.CallOption) (VolumeServer_ReadNeedleClient, error)
 	// query
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (VolumeServer_QueryClient, error)
 }
@@ -2188,8 +2509,74 @@ func (c *volumeServerClient) VolumeEcBlobDelete(ctx context.Context, in *VolumeE
 	return out, nil
 }
 
+func (c *volumeServerClient) WriteNeedle(ctx context.Context, opts ...grpc.CallOption) (VolumeServer_WriteNeedleClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[4], c.cc, "/volume_server_pb.VolumeServer/WriteNeedle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumeServerWriteNeedleClient{stream}
+	return x, nil
+}
+
+type VolumeServer_WriteNeedleClient interface {
+	Send(*WriteNeedleRequest) error
+	CloseAndRecv() (*WriteNeedleResponse, error)
+	grpc.ClientStream
+}
+
+type volumeServerWriteNeedleClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumeServerWriteNeedleClient) Send(m *WriteNeedleRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *volumeServerWriteNeedleClient) CloseAndRecv() (*WriteNeedleResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(WriteNeedleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *volumeServerClient) ReadNeedle(ctx context.Context, in *ReadNeedleRequest, opts ...grpc.CallOption) (VolumeServer_ReadNeedleClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[5], c.cc, "/volume_server_pb.VolumeServer/ReadNeedle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumeServerReadNeedleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VolumeServer_ReadNeedleClient interface {
+	Recv() (*ReadNeedleResponse, error)
+	grpc.ClientStream
+}
+
+type volumeServerReadNeedleClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumeServerReadNeedleClient) Recv() (*ReadNeedleResponse, error) {
+	m := new(ReadNeedleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *volumeServerClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (VolumeServer_QueryClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[4], c.cc, "/volume_server_pb.VolumeServer/Query", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[6], c.cc, "/volume_server_pb.VolumeServer/Query", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2252,6 +2639,9 @@ type VolumeServerServer interface {
 	VolumeEcShardsUnmount(context.Context, *VolumeEcShardsUnmountRequest) (*VolumeEcShardsUnmountResponse, error)
 	VolumeEcShardRead(*VolumeEcShardReadRequest, VolumeServer_VolumeEcShardReadServer) error
 	VolumeEcBlobDelete(context.Context, *VolumeEcBlobDeleteRequest) (*VolumeEcBlobDeleteResponse, error)
+	// needle data, an alternative to the http upload and download
+	WriteNeedle(VolumeServer_WriteNeedleServer) error
+	ReadNeedle(*ReadNeedleRequest, VolumeServer_ReadNeedleServer) error
 	// query
 	Query(*QueryRequest, VolumeServer_QueryServer) error
 }
@@ -2370,7 +2760,6 @@ func _VolumeServer_DeleteCollection_Handler(srv interface{}, ctx context.Context
 
 func _VolumeServer_AllocateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AllocateVolumeRequest)
-
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2741,6 +3130,53 @@ func _VolumeServer_VolumeEcBlobDelete_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeServer_WriteNeedle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VolumeServerServer).WriteNeedle(&volumeServerWriteNeedleServer{stream})
+}
+
+type VolumeServer_WriteNeedleServer interface {
+	SendAndClose(*WriteNeedleResponse) error
+	Recv() (*WriteNeedleRequest, error)
+	grpc.ServerStream
+}
+
+type volumeServerWriteNeedleServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumeServerWriteNeedleServer) SendAndClose(m *WriteNeedleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *volumeServerWriteNeedleServer) Recv() (*WriteNeedleRequest, error) {
+	m := new(WriteNeedleRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _VolumeServer_ReadNeedle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadNeedleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VolumeServerServer).ReadNeedle(m, &volumeServerReadNeedleServer{stream})
+}
+
+type VolumeServer_ReadNeedleServer interface {
+	Send(*ReadNeedleResponse) error
+	grpc.ServerStream
+}
+
+type volumeServerReadNeedleServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumeServerReadNeedleServer) Send(m *ReadNeedleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _VolumeServer_Query_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(QueryRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2876,6 +3312,16 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 			Handler:       _VolumeServer_VolumeEcShardRead_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WriteNeedle",
+			Handler:       _VolumeServer_WriteNeedle_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadNeedle",
+			Handler:       _VolumeServer_ReadNeedle_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Query",
 			Handler:       _VolumeServer_Query_Handler,
@@ -2888,160 +3334,186 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("volume_server.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x3a, 0x4f, 0x77, 0x1c, 0x47,
-	0xf1, 0x5a, 0xef, 0x4a, 0xda, 0xad, 0x5d, 0x59, 0x72, 0x4b, 0xb1, 0x36, 0x63, 0x4b, 0x56, 0x26,
-	0x7f, 0x2c, 0xdb, 0x89, 0xec, 0x28, 0xbf, 0x1f, 0x09, 0x09, 0x01, 0x6c, 0xd9, 0x06, 0x93, 0x44,
-	0x26, 0x23, 0xc7, 0x04, 0x9c, 0xc7, 0xbc, 0xd6, 0x4c, 0xcb, 0x1a, 0x34, 0x3b, 0x3d, 0x9e, 0xee,
-	0x91, 0xbd, 0x7e, 0x70, 0x0a, 0x57, 0x3e, 0x00, 0x67, 0x4e, 0x70, 0xe0, 0xca, 0x07, 0xe0, 0xc2,
-	0x07, 0x80, 0x4f, 0xc0, 0x99, 0x03, 0x37, 0xde, 0xe3, 0xc2, 0xeb, 0x3f, 0x33, 0x3b, 0x7f, 0xb5,
-	0xa3, 0xd8, 0xef, 0xf1, 0xb8, 0xf5, 0x54, 0xd7, 0x9f, 0xae, 0xea, 0xaa, 0xea, 0xea, 0xea, 0x81,
-	0xe5, 0x63, 0xea, 0xc7, 0x23, 0x62, 0x33, 0x12, 0x1d, 0x93, 0x68, 0x2b, 0x8c, 0x28, 0xa7, 0x68,
-	0x29, 0x07, 0xb4, 0xc3, 0x7d, 0xf3, 0x3a, 0xa0, 0x5b, 0x98, 0x3b, 0x87, 0xb7, 0x89, 0x4f, 0x38,
-	0xb1, 0xc8, 0x93, 0x98, 0x30, 0x8e, 0x5e, 0x85, 0xee, 0x81, 0xe7, 0x13, 0xdb, 0x73, 0xd9, 0xb0,
-	0xb5, 0xd1, 0xde, 0xec, 0x59, 0xf3, 0xe2, 0xfb, 0x9e, 0xcb, 0xcc, 0xfb, 0xb0, 0x9c, 0x23, 0x60,
-	0x21, 0x0d, 0x18, 0x41, 0x1f, 0xc0, 0x7c, 0x44, 0x58, 0xec, 0x73, 0x45, 0xd0, 0xdf, 0x5e, 0xdf,
-	0x2a, 0xca, 0xda, 0x4a, 0x49, 0x62, 0x9f, 0x5b, 0x09, 0xba, 0xf9, 0x75, 0x0b, 0x06, 0xd9, 0x19,
-	0xb4, 0x0a, 0xf3, 0x5a, 0xf8, 0xb0, 0xb5, 0xd1, 0xda, 0xec, 0x59, 0x73, 0x4a, 0x36, 0x3a, 0x0f,
-	0x73, 0x8c, 0x63, 0x1e, 0xb3, 0xe1, 0x99, 0x8d, 0xd6, 0xe6, 0xac, 0xa5, 0xbf, 0xd0, 0x0a, 0xcc,
-	0x92, 0x28, 0xa2, 0xd1, 0xb0, 0x2d, 0xd1, 0xd5, 0x07, 0x42, 0xd0, 0x61, 0xde, 0x73, 0x32, 0xec,
-	0x6c, 0xb4, 0x36, 0x17, 0x2c, 0x39, 0x46, 0x43, 0x98, 0x3f, 0x26, 0x11, 0xf3, 0x68, 0x30, 0x9c,
-	0x95, 0xe0, 0xe4, 0xd3, 0x9c, 0x87, 0xd9, 0x3b, 0xa3, 0x90, 0x8f, 0xcd, 0xf7, 0x61, 0xf8, 0x10,
-	0x3b, 0x71, 0x3c, 0x7a, 0x28, 0x97, 0xbf, 0x73, 0x48, 0x9c, 0xa3, 0xc4, 0x2c, 0x17, 0xa0, 0xa7,
-	0x95, 0xd2, 0x6b, 0x5b, 0xb0, 0xba, 0x0a, 0x70, 0xcf, 0x35, 0xbf, 0x0f, 0xaf, 0x56, 0x10, 0x6a,
-	0xf3, 0xbc, 0x0e, 0x0b, 0x8f, 0x71, 0xb4, 0x8f, 0x1f, 0x13, 0x3b, 0xc2, 0xdc, 0xa3, 0x92, 0xba,
-	0x65, 0x0d, 0x34, 0xd0, 0x12, 0x30, 0xf3, 0x11, 0x18, 0x39, 0x0e, 0x74, 0x14, 0x62, 0x87, 0x37,
-	0x11, 0x8e, 0x36, 0xa0, 0x1f, 0x46, 0x04, 0xfb, 0x3e, 0x75, 0x30, 0x27, 0xd2, 0x3e, 0x6d, 0x2b,
-	0x0b, 0x32, 0xd7, 0xe0, 0x42, 0x25, 0x73, 0xb5, 0x40, 0xf3, 0x83, 0xc2, 0xea, 0xe9, 0x68, 0xe4,
-	0x35, 0x12, 0x6d, 0x5e, 0x2c, 0xad, 0x5a, 0x52, 0x6a, 0xbe, 0xdf, 0x2e, 0xcc, 0xfa, 0x04, 0x07,
-	0x71, 0xd8, 0x88, 0x71, 0x71, 0xc5, 0x09, 0x69, 0xca, 0x79, 0x55, 0xb9, 0xcd, 0x0e, 0xf5, 0x7d,
-	0xe2, 0x70, 0x8f, 0x06, 0x09, 0xdb, 0x75, 0x00, 0x27, 0x05, 0x6a, 0x27, 0xca, 0x40, 0x4c, 0x03,
-	0x86, 0x65, 0x52, 0xcd, 0xf6, 0x0f, 0x2d, 0x78, 0xe5, 0xa6, 0x36, 0x9a, 0x12, 0xdc, 0x68, 0x03,
-	0xf2, 0x22, 0xcf, 0x14, 0x45, 0x16, 0x37, 0xa8, 0x5d, 0xda, 0x20, 0x81, 0x11, 0x91, 0xd0, 0xf7,
-	0x1c, 0x2c, 0x59, 0x74, 0x24, 0x8b, 0x2c, 0x08, 0x2d, 0x41, 0x9b, 0x73, 0x5f, 0x7a, 0x6e, 0xcf,
-	0x12, 0x43, 0x73, 0x08, 0xe7, 0x8b, 0x6b, 0xd5, 0x6a, 0x7c, 0x0b, 0x56, 0x15, 0x64, 0x6f, 0x1c,
-	0x38, 0x7b, 0x32, 0x4e, 0x1a, 0x19, 0xfd, 0xdf, 0x2d, 0x18, 0x96, 0x09, 0xb5, 0x17, 0xbf, 0xa8,
-	0x05, 0x4e, 0xab, 0x1f, 0xba, 0x04, 0x7d, 0x8e, 0x3d, 0xdf, 0xa6, 0x07, 0x07, 0x8c, 0xf0, 0xe1,
-	0xdc, 0x46, 0x6b, 0xb3, 0x63, 0x81, 0x00, 0xdd, 0x97, 0x10, 0x74, 0x05, 0x96, 0x1c, 0xe5, 0xc9,
-	0x76, 0x44, 0x8e, 0x3d, 0x19, 0xd9, 0xf3, 0x72, 0x61, 0x8b, 0x4e, 0xe2, 0xe1, 0x0a, 0x8c, 0x4c,
-	0x58, 0xf0, 0xdc, 0x67, 0xb6, 0x4c, 0x2d, 0x32, 0x31, 0x74, 0x25, 0xb7, 0xbe, 0xe7, 0x3e, 0xbb,
-	0xeb, 0xf9, 0x64, 0xcf, 0x7b, 0x4e, 0xcc, 0x87, 0x70, 0x51, 0x29, 0x7f, 0x2f, 0x70, 0x22, 0x32,
-	0x22, 0x01, 0xc7, 0xfe, 0x0e, 0x0d, 0xc7, 0x8d, 0x5c, 0xe0, 0x55, 0xe8, 0x32, 0x2f, 0x70, 0x88,
-	0x1d, 0xa8, 0x04, 0xd5, 0xb1, 0xe6, 0xe5, 0xf7, 0x2e, 0x33, 0x6f, 0xc1, 0x5a, 0x0d, 0x5f, 0x6d,
-	0xd9, 0xd7, 0x60, 0x20, 0x17, 0xe6, 0xd0, 0x80, 0x93, 0x80, 0x4b, 0xde, 0x03, 0xab, 0x2f, 0x60,
-	0x3b, 0x0a, 0x64, 0xbe, 0x0b, 0x48, 0xf1, 0xf8, 0x8c, 0xc6, 0x41, 0xb3, 0xd0, 0x7c, 0x05, 0x96,
-	0x73, 0x24, 0xda, 0x37, 0xde, 0x83, 0x15, 0x05, 0xfe, 0x22, 0x18, 0x35, 0xe6, 0xb5, 0x0a, 0xaf,
-	0x14, 0x88, 0x34, 0xb7, 0xed, 0x44, 0x48, 0xfe, 0x08, 0x39, 0x91, 0xd9, 0xf9, 0x64, 0x05, 0xf9,
-	0x53, 0x44, 0x66, 0x21, 0xb5, 0x60, 0x1c, 0x1d, 0x59, 0x04, 0xbb, 0x34, 0xf0, 0xc7, 0x8d, 0xb3,
-	0x50, 0x05, 0xa5, 0xe6, 0xfb, 0xc7, 0x16, 0x9c, 0x4b, 0xd2, 0x53, 0xc3, 0xdd, 0x3c, 0xa5, 0x3b,
-	0xb7, 0x6b, 0xdd, 0xb9, 0x33, 0x71, 0xe7, 0x4d, 0x58, 0x62, 0x34, 0x8e, 0x1c, 0x62, 0xbb, 0x98,
-	0x63, 0x3b, 0xa0, 0x2e, 0xd1, 0xde, 0x7e, 0x56, 0xc1, 0x6f, 0x63, 0x8e, 0x77, 0xa9, 0x4b, 0xcc,
-	0xef, 0x25, 0x9b, 0x9d, 0xf3, 0x92, 0x2b, 0x70, 0xce, 0xc7, 0x8c, 0xdb, 0x38, 0x0c, 0x49, 0xe0,
-	0xda, 0x98, 0x0b, 0x57, 0x6b, 0x49, 0x57, 0x3b, 0x2b, 0x26, 0x6e, 0x4a, 0xf8, 0x4d, 0xbe, 0xcb,
-	0xcc, 0xbf, 0xb6, 0x60, 0x51, 0xd0, 0x0a, 0xd7, 0x6e, 0xa4, 0xef, 0x12, 0xb4, 0xc9, 0x33, 0xae,
-	0x15, 0x15, 0x43, 0x74, 0x1d, 0x96, 0x75, 0x0c, 0x79, 0x34, 0x98, 0x84, 0x57, 0x5b, 0x12, 0xa2,
-	0xc9, 0x54, 0x1a, 0x61, 0x97, 0xa0, 0xcf, 0x38, 0x0d, 0x93, 0x68, 0xed, 0xa8, 0x68, 0x15, 0x20,
-	0x1d, 0xad, 0x79, 0x9b, 0xce, 0x56, 0xd8, 0x74, 0xe0, 0x31, 0x9b, 0x38, 0xb6, 0x5a, 0x95, 0x8c,
-	0xf7, 0xae, 0x05, 0x1e, 0xbb, 0xe3, 0x28, 0x6b, 0x98, 0xff, 0x0f, 0x4b, 0x13, 0xad, 0x9a, 0xc7,
-	0xce, 0xd7, 0xad, 0x24, 0x1d, 0x3e, 0xc0, 0x9e, 0xbf, 0x47, 0x02, 0x97, 0x44, 0x2f, 0x18, 0xd3,
-	0xe8, 0x06, 0xac, 0x78, 0xae, 0x4f, 0x6c, 0xee, 0x8d, 0x08, 0x8d, 0xb9, 0xcd, 0x88, 0x43, 0x03,
-	0x97, 0x25, 0xf6, 0x11, 0x73, 0x0f, 0xd4, 0xd4, 0x9e, 0x9a, 0x31, 0x7f, 0x9d, 0xe6, 0xd6, 0xec,
-	0x2a, 0x26, 0x15, 0x42, 0x40, 0x88, 0x60, 0x78, 0x48, 0xb0, 0x4b, 0x22, 0xad, 0xc6, 0x40, 0x01,
-	0x7f, 0x28, 0x61, 0xc2, 0xc2, 0x1a, 0x69, 0x9f, 0xba, 0x63, 0xb9, 0xa2, 0x81, 0x05, 0x0a, 0x74,
-	0x8b, 0xba, 0x63, 0x99, 0xe4, 0x98, 0x2d, 0x9d, 0xc4, 0x39, 0x8c, 0x83, 0x23, 0xb9, 0x9a, 0xae,
-	0xd5, 0xf7, 0xd8, 0xa7, 0x98, 0xf1, 0x1d, 0x01, 0x32, 0xff, 0xd4, 0x4a, 0xa2, 0x4c, 0x2c, 0xc3,
-	0x22, 0x0e, 0xf1, 0x8e, 0xff, 0x0b, 0xe6, 0x10, 0x14, 0x3a, 0x1a, 0x72, 0x95, 0xa2, 0x0e, 0x18,
-	0xa4, 0xe6, 0xf4, 0x59, 0x24, 0x67, 0x26, 0x41, 0x9e, 0x5f, 0xb8, 0x0e, 0xf2, 0xaf, 0x92, 0x24,
-	0x7b, 0xc7, 0xd9, 0x3b, 0xc4, 0x91, 0xcb, 0x7e, 0x40, 0x02, 0x12, 0x61, 0xfe, 0x52, 0x0e, 0x70,
-	0x73, 0x03, 0xd6, 0xeb, 0xb8, 0x6b, 0xf9, 0x8f, 0x92, 0xc3, 0x23, 0xc1, 0xb0, 0xc8, 0x7e, 0xec,
-	0xf9, 0xee, 0x4b, 0x11, 0xff, 0x49, 0x51, 0xb9, 0x94, 0xb9, 0xf6, 0x9f, 0xab, 0x70, 0x2e, 0x92,
-	0x20, 0x6e, 0x33, 0x81, 0x90, 0xd6, 0xee, 0x0b, 0xd6, 0xa2, 0x9e, 0x90, 0x84, 0xa2, 0x86, 0xff,
-	0x73, 0xea, 0x01, 0x09, 0xb7, 0x97, 0x96, 0x16, 0x2f, 0x40, 0x6f, 0x22, 0xbe, 0x2d, 0xc5, 0x77,
-	0x99, 0x96, 0x2b, 0xbc, 0xd3, 0xa1, 0xe1, 0xd8, 0x26, 0x8e, 0x3a, 0x87, 0xe5, 0x56, 0x77, 0xad,
-	0xbe, 0x00, 0xde, 0x71, 0xe4, 0x31, 0x7c, 0x8a, 0x1c, 0x99, 0x7a, 0x43, 0x5e, 0x09, 0xbd, 0x1b,
-	0x4f, 0xe1, 0x42, 0x7e, 0xb6, 0xf9, 0xf1, 0xf4, 0x42, 0x4a, 0x9a, 0xeb, 0x45, 0x37, 0x28, 0x9c,
-	0x71, 0xc7, 0xc5, 0x65, 0x37, 0x3e, 0xcf, 0x5f, 0x6c, 0x5d, 0x6b, 0x45, 0x83, 0xe4, 0x8b, 0x82,
-	0x2f, 0x8b, 0xcb, 0x3e, 0x45, 0x71, 0x70, 0xb2, 0xe0, 0x4b, 0x45, 0xd7, 0x2d, 0x56, 0x10, 0xbf,
-	0x4d, 0xf3, 0xa2, 0xc6, 0x10, 0xe7, 0x77, 0xe3, 0x7c, 0xa4, 0xe5, 0x4a, 0x73, 0x2c, 0x58, 0xf3,
-	0x5a, 0xac, 0xb8, 0x2c, 0xea, 0x73, 0x48, 0xd5, 0xda, 0xfa, 0x2b, 0x77, 0x2d, 0x6c, 0xeb, 0x6b,
-	0x61, 0x72, 0xdd, 0x3d, 0x22, 0x63, 0xe9, 0x6b, 0x1d, 0x75, 0xdd, 0xfd, 0x84, 0x8c, 0xcd, 0xdd,
-	0x42, 0xa4, 0xa8, 0xa5, 0xe9, 0x98, 0x43, 0xd0, 0x11, 0x4e, 0xaa, 0x53, 0xb5, 0x1c, 0xa3, 0x35,
-	0x00, 0x8f, 0xd9, 0xae, 0xdc, 0x73, 0xb5, 0xa8, 0xae, 0xd5, 0xf3, 0xb4, 0x13, 0xb8, 0xe6, 0x6f,
-	0x32, 0xa1, 0x77, 0xcb, 0xa7, 0xfb, 0x2f, 0xd1, 0x2b, 0xb3, 0x5a, 0xb4, 0x73, 0x5a, 0x64, 0xef,
-	0xbd, 0x9d, 0xfc, 0xbd, 0x37, 0x13, 0x44, 0xd9, 0xe5, 0xe8, 0x9d, 0xf9, 0x10, 0x2e, 0x08, 0x85,
-	0x15, 0x86, 0xac, 0x92, 0x9b, 0xdf, 0x24, 0xfe, 0x71, 0x06, 0x2e, 0x56, 0x13, 0x37, 0xb9, 0x4d,
-	0x7c, 0x04, 0x46, 0x5a, 0xad, 0x8b, 0x23, 0x85, 0x71, 0x3c, 0x0a, 0xd3, 0x43, 0x45, 0x9d, 0x3d,
-	0xab, 0xba, 0x74, 0x7f, 0x90, 0xcc, 0x27, 0x27, 0x4b, 0xa9, 0xd4, 0x6f, 0x97, 0x4a, 0x7d, 0x21,
-	0xc0, 0xc5, 0xbc, 0x4e, 0x80, 0xaa, 0x5d, 0x56, 0x5d, 0xcc, 0xeb, 0x04, 0xa4, 0xc4, 0x52, 0x80,
-	0xf2, 0x9a, 0xbe, 0xc6, 0x97, 0x02, 0xd6, 0x00, 0x74, 0x59, 0x12, 0x07, 0xc9, 0xd5, 0xa5, 0xa7,
-	0x8a, 0x92, 0x38, 0xa8, 0xad, 0xae, 0xe6, 0x6b, 0xab, 0xab, 0xfc, 0xf6, 0x77, 0x4b, 0x27, 0xc4,
-	0x97, 0x00, 0xb7, 0x3d, 0x76, 0xa4, 0x8c, 0x2c, 0xca, 0x39, 0xd7, 0x8b, 0xf4, 0xdd, 0x57, 0x0c,
-	0x05, 0x04, 0xfb, 0xbe, 0x36, 0x9d, 0x18, 0x0a, 0xf7, 0x8d, 0x19, 0x71, 0xb5, 0x75, 0xe4, 0x58,
-	0xc0, 0x0e, 0x22, 0x42, 0xb4, 0x01, 0xe4, 0xd8, 0xfc, 0x5d, 0x0b, 0x7a, 0x9f, 0x91, 0x91, 0xe6,
-	0xbc, 0x0e, 0xf0, 0x98, 0x46, 0x34, 0xe6, 0x5e, 0x40, 0x54, 0xf5, 0x39, 0x6b, 0x65, 0x20, 0xdf,
-	0x5c, 0x8e, 0x0c, 0x4d, 0xe2, 0x1f, 0x68, 0x63, 0xca, 0xb1, 0x80, 0x1d, 0x12, 0x1c, 0x6a, 0xfb,
-	0xc9, 0x31, 0x5a, 0x81, 0x59, 0xc6, 0xb1, 0x73, 0x24, 0x8d, 0xd5, 0xb1, 0xd4, 0x87, 0xf9, 0xaf,
-	0x01, 0x0c, 0x3e, 0x8f, 0x49, 0x34, 0xce, 0x74, 0x01, 0x18, 0xd1, 0xd6, 0x49, 0xda, 0x58, 0x19,
-	0x88, 0xd8, 0xc4, 0x83, 0x88, 0x8e, 0xec, 0xb4, 0xd3, 0x75, 0x46, 0xa2, 0xf4, 0x05, 0xf0, 0xae,
-	0xea, 0x76, 0xa1, 0x8f, 0x61, 0xee, 0xc0, 0xf3, 0x39, 0x51, 0xbd, 0xa5, 0xfe, 0xf6, 0x9b, 0xe5,
-	0xae, 0x56, 0x56, 0xe6, 0xd6, 0x5d, 0x89, 0x6c, 0x69, 0x22, 0xb4, 0x0f, 0xcb, 0x5e, 0x10, 0xca,
-	0x6a, 0x28, 0xf2, 0xb0, 0xef, 0x3d, 0x9f, 0xdc, 0x7d, 0xfb, 0xdb, 0xef, 0x4e, 0xe1, 0x75, 0x4f,
-	0x50, 0xee, 0x65, 0x09, 0x2d, 0xe4, 0x95, 0x60, 0x88, 0xc0, 0x0a, 0x8d, 0x79, 0x59, 0xc8, 0xac,
-	0x14, 0xb2, 0x3d, 0x45, 0xc8, 0x7d, 0x49, 0x9a, 0x97, 0xb2, 0x4c, 0xcb, 0x40, 0x63, 0x17, 0xe6,
-	0x94, 0x72, 0xc2, 0xfc, 0x07, 0x1e, 0xf1, 0x93, 0xee, 0x9c, 0xfa, 0x10, 0x29, 0x86, 0x86, 0x24,
-	0xc2, 0x81, 0xab, 0x53, 0x53, 0xf2, 0x29, 0xf0, 0x8f, 0xb1, 0x1f, 0x93, 0xa4, 0x3d, 0x27, 0x3f,
-	0x8c, 0xbf, 0xcd, 0x02, 0x2a, 0x6b, 0x98, 0x5c, 0xe8, 0x23, 0xc2, 0x84, 0xd3, 0xdb, 0x7c, 0x1c,
-	0x12, 0x2d, 0x67, 0x31, 0x03, 0x7f, 0x30, 0x0e, 0x09, 0xfa, 0x09, 0xf4, 0x1c, 0x76, 0x6c, 0x4b,
-	0x93, 0x48, 0x99, 0xfd, 0xed, 0x0f, 0x4f, 0x6d, 0xd2, 0xad, 0x9d, 0xbd, 0x87, 0x12, 0x6a, 0x75,
-	0x1d, 0x76, 0x2c, 0x47, 0xe8, 0x67, 0x00, 0xbf, 0x60, 0x34, 0xd0, 0x9c, 0xd5, 0xc6, 0x7f, 0x74,
-	0x7a, 0xce, 0x3f, 0xda, 0xbb, 0xbf, 0xab, 0x58, 0xf7, 0x04, 0x3b, 0xc5, 0xdb, 0x81, 0x85, 0x10,
-	0x47, 0x4f, 0x62, 0xc2, 0x35, 0x7b, 0xe5, 0x0b, 0xdf, 0x3d, 0x3d, 0xfb, 0x1f, 0x2b, 0x36, 0x4a,
-	0xc2, 0x20, 0xcc, 0x7c, 0x19, 0x7f, 0x39, 0x03, 0xdd, 0x44, 0x2f, 0x51, 0x50, 0x49, 0x0f, 0x57,
-	0xd7, 0x0a, 0xdb, 0x0b, 0x0e, 0xa8, 0xb6, 0xe8, 0x59, 0x01, 0x57, 0x37, 0x8b, 0x7b, 0xc1, 0x01,
-	0x15, 0xb6, 0x8f, 0x88, 0x43, 0x23, 0x57, 0x1c, 0x5f, 0xde, 0xc8, 0x13, 0x6e, 0xaf, 0xf6, 0x72,
-	0x51, 0xc1, 0x6f, 0x27, 0x60, 0x74, 0x19, 0x16, 0xe5, 0xb6, 0x67, 0x30, 0xdb, 0x09, 0x4f, 0xe2,
-	0x67, 0x10, 0xaf, 0xc0, 0xd2, 0x93, 0x98, 0x72, 0x62, 0x3b, 0x87, 0x38, 0xc2, 0x0e, 0xa7, 0x69,
-	0x81, 0xbf, 0x28, 0xe1, 0x3b, 0x29, 0x18, 0xfd, 0x1f, 0x9c, 0x57, 0xa8, 0x84, 0x39, 0x38, 0x4c,
-	0x29, 0x48, 0xa4, 0xeb, 0xbf, 0x15, 0x39, 0x7b, 0x47, 0x4e, 0xee, 0x24, 0x73, 0xc8, 0x80, 0xae,
-	0x43, 0x47, 0x23, 0x12, 0x70, 0x26, 0x93, 0x44, 0xcf, 0x4a, 0xbf, 0xd1, 0x4d, 0x58, 0xc3, 0xbe,
-	0x4f, 0x9f, 0xda, 0x92, 0xd2, 0xb5, 0x4b, 0xda, 0xcd, 0xcb, 0xe3, 0xd9, 0x90, 0x48, 0x9f, 0x4b,
-	0x1c, 0x2b, 0xaf, 0xa8, 0x71, 0x09, 0x7a, 0xe9, 0x3e, 0x8a, 0x64, 0x94, 0x71, 0x48, 0x39, 0x36,
-	0xce, 0xc2, 0x20, 0xbb, 0x13, 0xc6, 0x3f, 0xdb, 0xb0, 0x5c, 0x11, 0x54, 0xe8, 0x11, 0x80, 0xf0,
-	0x56, 0x15, 0x5a, 0xda, 0x5d, 0xbf, 0x73, 0xfa, 0xe0, 0x14, 0xfe, 0xaa, 0xc0, 0x96, 0xf0, 0x7e,
-	0x35, 0x44, 0x3f, 0x87, 0xbe, 0xf4, 0x58, 0xcd, 0x5d, 0xb9, 0xec, 0xc7, 0xdf, 0x80, 0xbb, 0xd0,
-	0x55, 0xb3, 0x97, 0x31, 0xa0, 0xc6, 0xc6, 0xdf, 0x5b, 0xd0, 0x4b, 0x05, 0x8b, 0x0b, 0xb7, 0xda,
-	0x28, 0xb9, 0xd7, 0x4c, 0x9b, 0xa3, 0x2f, 0x61, 0x77, 0x25, 0xe8, 0x7f, 0xd2, 0x95, 0x8c, 0xf7,
-	0x01, 0x26, 0xfa, 0x57, 0xaa, 0xd0, 0xaa, 0x54, 0xc1, 0xbc, 0x02, 0x0b, 0xc2, 0xb2, 0x1e, 0x71,
-	0xf7, 0x78, 0xe4, 0x85, 0xf2, 0x9d, 0x41, 0xe1, 0x30, 0x5d, 0x1b, 0x26, 0x9f, 0xdb, 0xbf, 0x5f,
-	0x85, 0x41, 0xf6, 0x4e, 0x8b, 0xbe, 0x82, 0x7e, 0xe6, 0x3d, 0x05, 0xbd, 0x51, 0xde, 0xb4, 0xf2,
-	0xfb, 0x8c, 0xf1, 0xe6, 0x14, 0x2c, 0x5d, 0xbe, 0xcd, 0xa0, 0x00, 0xce, 0x95, 0x1e, 0x25, 0xd0,
-	0xd5, 0x32, 0x75, 0xdd, 0x93, 0x87, 0x71, 0xad, 0x11, 0x6e, 0x2a, 0x8f, 0xc3, 0x72, 0xc5, 0x2b,
-	0x03, 0x7a, 0x7b, 0x0a, 0x97, 0xdc, 0x4b, 0x87, 0xf1, 0x4e, 0x43, 0xec, 0x54, 0xea, 0x13, 0x40,
-	0xe5, 0x27, 0x08, 0x74, 0x6d, 0x2a, 0x9b, 0xc9, 0x13, 0x87, 0xf1, 0x76, 0x33, 0xe4, 0x5a, 0x45,
-	0xd5, 0xe3, 0xc4, 0x54, 0x45, 0x73, 0xcf, 0x1f, 0x53, 0x15, 0x2d, 0xbc, 0x78, 0xcc, 0xa0, 0x23,
-	0x58, 0x2a, 0x3e, 0x5c, 0xa0, 0x2b, 0x75, 0x0f, 0x6d, 0xa5, 0x77, 0x11, 0xe3, 0x6a, 0x13, 0xd4,
-	0x54, 0x18, 0x81, 0xb3, 0xf9, 0xc7, 0x05, 0x74, 0xb9, 0x4c, 0x5f, 0xf9, 0x54, 0x62, 0x6c, 0x4e,
-	0x47, 0xcc, 0xea, 0x54, 0x7c, 0x70, 0xa8, 0xd2, 0xa9, 0xe6, 0x35, 0xa3, 0x4a, 0xa7, 0xba, 0xf7,
-	0x0b, 0x73, 0x06, 0xfd, 0x32, 0xe9, 0x62, 0x17, 0x1a, 0xf1, 0x68, 0xab, 0x8e, 0x4d, 0xf5, 0x4b,
-	0x80, 0x71, 0xbd, 0x31, 0x7e, 0x22, 0xfb, 0x46, 0x4b, 0xc4, 0x7a, 0xa6, 0x1f, 0x5f, 0x15, 0xeb,
-	0xe5, 0x0e, 0x7f, 0x55, 0xac, 0x57, 0x35, 0xf5, 0x67, 0xd0, 0x3e, 0x2c, 0xe4, 0x3a, 0xf4, 0xe8,
-	0xad, 0x3a, 0xca, 0xfc, 0xd5, 0xde, 0xb8, 0x3c, 0x15, 0x2f, 0x95, 0x61, 0x27, 0xd9, 0x4b, 0xa7,
-	0xab, 0xda, 0xc5, 0xe5, 0xf3, 0xd5, 0x5b, 0xd3, 0xd0, 0x72, 0xa1, 0x5c, 0xea, 0xe3, 0x57, 0x86,
-	0x72, 0xdd, 0x3b, 0x41, 0x65, 0x28, 0xd7, 0x3f, 0x0d, 0xcc, 0xa0, 0x9f, 0x02, 0x4c, 0x7a, 0xed,
-	0xe8, 0xf5, 0x3a, 0xea, 0xec, 0xee, 0xbf, 0x71, 0x32, 0x52, 0xca, 0xfa, 0x29, 0xac, 0x54, 0x5d,
-	0x81, 0x51, 0x45, 0xe0, 0x9f, 0x70, 0xcf, 0x36, 0xb6, 0x9a, 0xa2, 0xa7, 0x82, 0xbf, 0x80, 0x6e,
-	0xd2, 0x27, 0x47, 0xaf, 0x95, 0xa9, 0x0b, 0x2f, 0x03, 0x86, 0x79, 0x12, 0x4a, 0xc6, 0x81, 0x47,
-	0x49, 0xac, 0x4e, 0x1a, 0xd8, 0xf5, 0xb1, 0x5a, 0x6a, 0xb5, 0xd7, 0xc7, 0x6a, 0xb9, 0x1f, 0x2e,
-	0xc5, 0xa5, 0xce, 0x90, 0xed, 0xf7, 0xd6, 0x3b, 0x43, 0x45, 0x3b, 0xbb, 0xde, 0x19, 0x2a, 0x5b,
-	0xc8, 0x33, 0xe8, 0x57, 0x70, 0xbe, 0xba, 0xcd, 0x8b, 0x6a, 0x23, 0xbe, 0xa6, 0xdd, 0x6c, 0xdc,
-	0x68, 0x4e, 0x90, 0x8a, 0x7f, 0x9e, 0xe4, 0xa7, 0x42, 0x9b, 0xb7, 0x3e, 0x3f, 0x55, 0x37, 0x9b,
-	0x8d, 0xeb, 0x8d, 0xf1, 0xcb, 0xa1, 0x97, 0xed, 0xa7, 0xd6, 0x5b, 0xbb, 0xa2, 0x75, 0x5c, 0x6f,
-	0xed, 0xca, 0x16, 0xad, 0x8c, 0x8f, 0xaa, 0x5e, 0x69, 0x55, 0x7c, 0x9c, 0xd0, 0xcc, 0x35, 0xb6,
-	0x9a, 0xa2, 0xe7, 0x8e, 0xef, 0x72, 0x33, 0x14, 0x4d, 0x5d, 0x7f, 0x2e, 0x33, 0xbf, 0xd3, 0x10,
-	0xbb, 0x7e, 0x77, 0x93, 0x4c, 0x3d, 0x55, 0x81, 0x42, 0xc6, 0xbe, 0xde, 0x18, 0x3f, 0x95, 0x1d,
-	0x26, 0x2f, 0xa0, 0x99, 0x46, 0x26, 0xba, 0x3a, 0x85, 0x4f, 0xa6, 0x11, 0x6b, 0x5c, 0x6b, 0x84,
-	0x5b, 0x15, 0xbd, 0xd9, 0xd6, 0xe2, 0x49, 0xfe, 0x54, 0xea, 0x87, 0x9e, 0xe4, 0x4f, 0x15, 0xdd,
-	0xca, 0x19, 0xf4, 0x29, 0xcc, 0xca, 0x2b, 0x0e, 0x5a, 0x3f, 0xf9, 0xee, 0x63, 0x5c, 0xaa, 0x9e,
-	0x4f, 0x2b, 0x78, 0xa1, 0xc0, 0xfe, 0x9c, 0xfc, 0x69, 0xea, 0xbd, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x03, 0xe0, 0xe6, 0x48, 0x4b, 0x25, 0x00, 0x00,
+	// 2889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x5f, 0x6f, 0xdc, 0xc6,
+	0xf1, 0xbf, 0xd3, 0x9d, 0xa4, 0xbb, 0xb9, 0x93, 0x25, 0xaf, 0x64, 0xfb, 0x4c, 0x5b, 0xb6, 0xc3,
+	0xc4, 0x89, 0xec, 0xd8, 0x72, 0xa2, 0xfc, 0xda, 0xb8, 0x49, 0xd3, 0xd6, 0x96, 0xe5, 0xd4, 0x4d,
+	0x2c, 0x27, 0x94, 0xe3, 0xa4, 0x4d, 0x10, 0x62, 0x45, 0xee, 0x49, 0x6b, 0xf1, 0x9f, 0xb9, 0x7b,
+	0xb2, 0xcf, 0x68, 0x9f, 0xd2, 0xd7, 0x7e, 0x80, 0xa2, 0x4f, 0x45, 0x3f, 0x44, 0x3f, 0x40, 0x1f,
+	0xfb, 0xda, 0x6f, 0x50, 0xa0, 0x2f, 0x45, 0x1f, 0x0a, 0x14, 0x68, 0x81, 0xbe, 0x14, 0xfb, 0x87,
+	0x3c, 0xf2, 0x48, 0xea, 0xa8, 0xd8, 0x40, 0xd1, 0xb7, 0xe5, 0xec, 0xfc, 0xd9, 0x99, 0x9d, 0x99,
+	0xdd, 0x9d, 0x21, 0x2c, 0x1f, 0x86, 0xde, 0xd0, 0x27, 0x36, 0x23, 0xf1, 0x21, 0x89, 0xd7, 0xa3,
+	0x38, 0xe4, 0x21, 0x5a, 0xca, 0x01, 0xed, 0x68, 0xd7, 0xbc, 0x01, 0xe8, 0x36, 0xe6, 0xce, 0xfe,
+	0x1d, 0xe2, 0x11, 0x4e, 0x2c, 0xf2, 0x64, 0x48, 0x18, 0x47, 0x67, 0xa1, 0x3d, 0xa0, 0x1e, 0xb1,
+	0xa9, 0xcb, 0xfa, 0x8d, 0x4b, 0xcd, 0xb5, 0x8e, 0x35, 0x2f, 0xbe, 0xef, 0xb9, 0xcc, 0x7c, 0x00,
+	0xcb, 0x39, 0x02, 0x16, 0x85, 0x01, 0x23, 0xe8, 0x26, 0xcc, 0xc7, 0x84, 0x0d, 0x3d, 0xae, 0x08,
+	0xba, 0x1b, 0x17, 0xd6, 0x27, 0x65, 0xad, 0xa7, 0x24, 0x43, 0x8f, 0x5b, 0x09, 0xba, 0xf9, 0x4d,
+	0x03, 0x7a, 0xd9, 0x19, 0x74, 0x06, 0xe6, 0xb5, 0xf0, 0x7e, 0xe3, 0x52, 0x63, 0xad, 0x63, 0xcd,
+	0x29, 0xd9, 0xe8, 0x34, 0xcc, 0x31, 0x8e, 0xf9, 0x90, 0xf5, 0x67, 0x2e, 0x35, 0xd6, 0x66, 0x2d,
+	0xfd, 0x85, 0x56, 0x60, 0x96, 0xc4, 0x71, 0x18, 0xf7, 0x9b, 0x12, 0x5d, 0x7d, 0x20, 0x04, 0x2d,
+	0x46, 0x9f, 0x93, 0x7e, 0xeb, 0x52, 0x63, 0x6d, 0xc1, 0x92, 0x63, 0xd4, 0x87, 0xf9, 0x43, 0x12,
+	0x33, 0x1a, 0x06, 0xfd, 0x59, 0x09, 0x4e, 0x3e, 0xcd, 0x79, 0x98, 0xdd, 0xf2, 0x23, 0x3e, 0x32,
+	0xdf, 0x85, 0xfe, 0x23, 0xec, 0x0c, 0x87, 0xfe, 0x23, 0xb9, 0xfc, 0xcd, 0x7d, 0xe2, 0x1c, 0x24,
+	0x66, 0x39, 0x07, 0x1d, 0xad, 0x94, 0x5e, 0xdb, 0x82, 0xd5, 0x56, 0x80, 0x7b, 0xae, 0xf9, 0x23,
+	0x38, 0x5b, 0x42, 0xa8, 0xcd, 0xf3, 0x2a, 0x2c, 0xec, 0xe1, 0x78, 0x17, 0xef, 0x11, 0x3b, 0xc6,
+	0x9c, 0x86, 0x92, 0xba, 0x61, 0xf5, 0x34, 0xd0, 0x12, 0x30, 0xf3, 0x4b, 0x30, 0x72, 0x1c, 0x42,
+	0x3f, 0xc2, 0x0e, 0xaf, 0x23, 0x1c, 0x5d, 0x82, 0x6e, 0x14, 0x13, 0xec, 0x79, 0xa1, 0x83, 0x39,
+	0x91, 0xf6, 0x69, 0x5a, 0x59, 0x90, 0xb9, 0x0a, 0xe7, 0x4a, 0x99, 0xab, 0x05, 0x9a, 0x37, 0x27,
+	0x56, 0x1f, 0xfa, 0x3e, 0xad, 0x25, 0xda, 0x3c, 0x0f, 0x46, 0x19, 0xa5, 0xe6, 0xfb, 0xbd, 0x89,
+	0x59, 0x8f, 0xe0, 0x60, 0x18, 0xd5, 0x62, 0x3c, 0xb9, 0xe2, 0x84, 0x34, 0xe5, 0x7c, 0x46, 0xb9,
+	0xcd, 0x66, 0xe8, 0x79, 0xc4, 0xe1, 0x34, 0x0c, 0x12, 0xb6, 0x17, 0x00, 0x9c, 0x14, 0xa8, 0x9d,
+	0x28, 0x03, 0x31, 0x0d, 0xe8, 0x17, 0x49, 0x35, 0xdb, 0x7f, 0x36, 0xe0, 0xd4, 0x2d, 0x6d, 0x34,
+	0x25, 0xb8, 0xd6, 0x06, 0xe4, 0x45, 0xce, 0x4c, 0x8a, 0x9c, 0xdc, 0xa0, 0x66, 0x61, 0x83, 0x04,
+	0x46, 0x4c, 0x22, 0x8f, 0x3a, 0x58, 0xb2, 0x68, 0x49, 0x16, 0x59, 0x10, 0x5a, 0x82, 0x26, 0xe7,
+	0x9e, 0xf4, 0xdc, 0x8e, 0x25, 0x86, 0x68, 0x1d, 0x90, 0x4f, 0xfc, 0x30, 0x1e, 0xf9, 0x38, 0xf2,
+	0xf1, 0x33, 0xe1, 0xe3, 0xfe, 0x6e, 0x7f, 0x4e, 0x46, 0x47, 0xc9, 0x8c, 0x50, 0xc1, 0xa5, 0xec,
+	0xc0, 0xe6, 0xa3, 0x88, 0xf4, 0xe7, 0x25, 0x9f, 0xb6, 0x00, 0x3c, 0x1c, 0x45, 0xc4, 0xec, 0xc3,
+	0xe9, 0x49, 0xc5, 0xb5, 0x4d, 0xbe, 0x0b, 0x67, 0x14, 0x64, 0x67, 0x14, 0x38, 0x3b, 0x32, 0xe8,
+	0x6a, 0xed, 0xe0, 0xbf, 0x1b, 0xd0, 0x2f, 0x12, 0xea, 0x90, 0x78, 0x51, 0x73, 0x1e, 0xdb, 0x58,
+	0x17, 0xa1, 0xcb, 0x31, 0xf5, 0xec, 0x70, 0x30, 0x60, 0x84, 0x4b, 0x2b, 0xb5, 0x2c, 0x10, 0xa0,
+	0x07, 0x12, 0x82, 0xae, 0xc0, 0x92, 0xa3, 0xc2, 0xc2, 0x8e, 0xc9, 0x21, 0x95, 0x69, 0x62, 0x5e,
+	0x2e, 0x6c, 0xd1, 0x49, 0xc2, 0x45, 0x81, 0x91, 0x09, 0x0b, 0xd4, 0x7d, 0x66, 0xcb, 0x3c, 0x25,
+	0xb3, 0x4c, 0x5b, 0x72, 0xeb, 0x52, 0xf7, 0xd9, 0x5d, 0xea, 0x91, 0x1d, 0xfa, 0x9c, 0x98, 0x8f,
+	0xe0, 0xbc, 0x52, 0xfe, 0x5e, 0xe0, 0xc4, 0xc4, 0x27, 0x01, 0xc7, 0xde, 0x66, 0x18, 0x8d, 0x6a,
+	0xf9, 0xd3, 0x59, 0x68, 0x33, 0x1a, 0x38, 0xc4, 0x0e, 0x54, 0xb6, 0x6b, 0x59, 0xf3, 0xf2, 0x7b,
+	0x9b, 0x99, 0xb7, 0x61, 0xb5, 0x82, 0xaf, 0xb6, 0xec, 0x2b, 0xd0, 0x93, 0x0b, 0x73, 0xc2, 0x80,
+	0x93, 0x80, 0x4b, 0xde, 0x3d, 0xab, 0x2b, 0x60, 0x9b, 0x0a, 0x64, 0xbe, 0x0d, 0x48, 0xf1, 0xb8,
+	0x1f, 0x0e, 0x83, 0x7a, 0x71, 0x7e, 0x0a, 0x96, 0x73, 0x24, 0xda, 0x37, 0xde, 0x81, 0x15, 0x05,
+	0xfe, 0x2c, 0xf0, 0x6b, 0xf3, 0x3a, 0x03, 0xa7, 0x26, 0x88, 0x34, 0xb7, 0x8d, 0x44, 0x48, 0xfe,
+	0x3c, 0x3a, 0x92, 0xd9, 0x69, 0x58, 0xc9, 0xd3, 0x64, 0x52, 0x9a, 0x5a, 0x30, 0x8e, 0x0f, 0x2c,
+	0x82, 0xdd, 0x30, 0xf0, 0x46, 0xb5, 0x53, 0x5a, 0x09, 0xa5, 0xe6, 0xfb, 0xc7, 0x06, 0x9c, 0x4c,
+	0x72, 0x5d, 0xcd, 0xdd, 0x3c, 0xa6, 0x3b, 0x37, 0x2b, 0xdd, 0xb9, 0x35, 0x76, 0xe7, 0x35, 0x58,
+	0x62, 0xe1, 0x30, 0x76, 0x88, 0xed, 0x62, 0x8e, 0xed, 0x20, 0x74, 0x89, 0xf6, 0xf6, 0x13, 0x0a,
+	0x7e, 0x07, 0x73, 0xbc, 0x1d, 0xba, 0x24, 0x1f, 0xf5, 0x73, 0x13, 0x51, 0xff, 0x43, 0x40, 0x59,
+	0x65, 0xb4, 0x0b, 0x5d, 0x81, 0x93, 0x1e, 0x66, 0xdc, 0xc6, 0x51, 0x44, 0x02, 0xd7, 0xc6, 0x5c,
+	0xf8, 0x61, 0x43, 0xfa, 0xe1, 0x09, 0x31, 0x71, 0x4b, 0xc2, 0x6f, 0xf1, 0x6d, 0x66, 0xfe, 0xa9,
+	0x01, 0x8b, 0x82, 0x56, 0xf8, 0x7d, 0x2d, 0x63, 0x2c, 0x41, 0x93, 0x3c, 0xe3, 0xda, 0x0a, 0x62,
+	0x88, 0x6e, 0xc0, 0xb2, 0x0e, 0x30, 0x1a, 0x06, 0xe3, 0xd8, 0x6b, 0x4a, 0x42, 0x34, 0x9e, 0x4a,
+	0xc3, 0xef, 0x22, 0x74, 0x19, 0x0f, 0xa3, 0x24, 0x94, 0x5b, 0x2a, 0x94, 0x05, 0x48, 0x87, 0x72,
+	0xde, 0xe0, 0xb3, 0x25, 0x06, 0xef, 0x51, 0x66, 0x13, 0xc7, 0x56, 0xab, 0x92, 0x56, 0x69, 0x5b,
+	0x40, 0xd9, 0x96, 0xa3, 0xac, 0x61, 0x7e, 0x07, 0x96, 0xc6, 0x5a, 0xd5, 0x0f, 0xac, 0x6f, 0x1a,
+	0x49, 0xae, 0x7c, 0x88, 0xa9, 0xb7, 0x43, 0x02, 0x97, 0xc4, 0x2f, 0x18, 0xf0, 0xe8, 0x2d, 0x58,
+	0xa1, 0xae, 0x47, 0x6c, 0x4e, 0x7d, 0x12, 0x0e, 0xb9, 0xcd, 0x88, 0x13, 0x06, 0x2e, 0x4b, 0xec,
+	0x23, 0xe6, 0x1e, 0xaa, 0xa9, 0x1d, 0x35, 0x63, 0xfe, 0x32, 0x4d, 0xbc, 0xd9, 0x55, 0x8c, 0xef,
+	0x22, 0x01, 0x21, 0x82, 0xe1, 0x3e, 0xc1, 0x2e, 0x89, 0xb5, 0x1a, 0x3d, 0x05, 0xfc, 0xb1, 0x84,
+	0x09, 0x0b, 0x6b, 0xa4, 0xdd, 0xd0, 0x1d, 0xc9, 0x15, 0xf5, 0x2c, 0x50, 0xa0, 0xdb, 0xa1, 0x3b,
+	0x92, 0x19, 0x90, 0xd9, 0xd2, 0x49, 0x9c, 0xfd, 0x61, 0x70, 0x20, 0x57, 0xd3, 0xb6, 0xba, 0x94,
+	0x7d, 0x8c, 0x19, 0xdf, 0x14, 0x20, 0xf3, 0xf7, 0x0d, 0x38, 0x3b, 0x5e, 0x86, 0x45, 0x1c, 0x42,
+	0x0f, 0xff, 0x0b, 0xe6, 0x10, 0x14, 0x3a, 0x54, 0x72, 0x77, 0x52, 0x1d, 0x4d, 0x48, 0xcd, 0xe9,
+	0x83, 0x4a, 0xce, 0x8c, 0x33, 0x40, 0x7e, 0xe1, 0x3a, 0x03, 0x7c, 0x95, 0x64, 0xe0, 0x2d, 0x67,
+	0x67, 0x1f, 0xc7, 0x2e, 0xfb, 0x90, 0x04, 0x24, 0xc6, 0xfc, 0xa5, 0x5c, 0x15, 0xcc, 0x4b, 0x70,
+	0xa1, 0x8a, 0xbb, 0x96, 0xff, 0x25, 0x9c, 0xcf, 0x63, 0x58, 0x64, 0x77, 0x48, 0x3d, 0xf7, 0xa5,
+	0x88, 0xff, 0x08, 0x56, 0x2b, 0x98, 0x6b, 0xff, 0xb9, 0x0a, 0x27, 0x63, 0x09, 0xe2, 0x36, 0x13,
+	0x08, 0xe9, 0x2b, 0x61, 0xc1, 0x5a, 0xd4, 0x13, 0x92, 0x50, 0xbc, 0x16, 0xfe, 0x90, 0x7a, 0x40,
+	0xc2, 0xed, 0xa5, 0xe5, 0xcc, 0x73, 0xd0, 0x19, 0x8b, 0x6f, 0x4a, 0xf1, 0x6d, 0xa6, 0xe5, 0x0a,
+	0xef, 0x74, 0xc2, 0x68, 0x64, 0x13, 0x47, 0x1d, 0xd2, 0x72, 0xab, 0xdb, 0x56, 0x57, 0x00, 0xb7,
+	0x1c, 0x79, 0x46, 0xd7, 0x4f, 0xa0, 0x63, 0x6f, 0xc8, 0x2b, 0xa1, 0x77, 0xe3, 0x29, 0x9c, 0xcb,
+	0xcf, 0xd6, 0x3f, 0xbb, 0x5e, 0x48, 0x49, 0xf3, 0x02, 0x9c, 0x2f, 0x17, 0xac, 0x17, 0x76, 0x38,
+	0xb9, 0xec, 0xda, 0x87, 0xfd, 0x8b, 0xad, 0x6b, 0x15, 0xce, 0x95, 0xca, 0xd5, 0xcb, 0xfa, 0x62,
+	0x72, 0xd9, 0xc7, 0xb8, 0x39, 0x1c, 0x2d, 0xf8, 0x22, 0xac, 0x56, 0x70, 0xd6, 0xa2, 0x7f, 0x9d,
+	0xe6, 0x45, 0x8d, 0x21, 0x0e, 0xf7, 0xda, 0xf9, 0x48, 0xcb, 0x95, 0xe6, 0x58, 0xb0, 0xe6, 0xb5,
+	0x58, 0xf1, 0x2c, 0xd5, 0xe7, 0x90, 0xba, 0xd5, 0xeb, 0xaf, 0xdc, 0x03, 0xb4, 0xa9, 0x1f, 0xa0,
+	0xc9, 0xc3, 0xfa, 0x80, 0x8c, 0xa4, 0xaf, 0xb5, 0xd4, 0xc3, 0xfa, 0x23, 0x32, 0x32, 0xb7, 0xe1,
+	0x6c, 0xc9, 0xd2, 0x74, 0xcc, 0x21, 0x68, 0x09, 0x27, 0xd5, 0xa9, 0x5a, 0x8e, 0xd1, 0x2a, 0x00,
+	0x65, 0xb6, 0x2b, 0xf7, 0x5c, 0x2d, 0xaa, 0x6d, 0x75, 0xa8, 0x76, 0x02, 0xd7, 0xfc, 0x55, 0x26,
+	0xf4, 0x6e, 0x7b, 0xe1, 0xee, 0x4b, 0xf4, 0xca, 0xac, 0x16, 0xcd, 0x9c, 0x16, 0xd9, 0x17, 0x76,
+	0x2b, 0xff, 0xc2, 0xce, 0x04, 0x51, 0x76, 0x39, 0x7a, 0x67, 0xde, 0x83, 0x73, 0x42, 0x61, 0x85,
+	0x21, 0xaf, 0xd0, 0xf5, 0x9f, 0x19, 0x7f, 0x9b, 0x81, 0xf3, 0xe5, 0xc4, 0x75, 0x9e, 0x1a, 0xef,
+	0x83, 0x91, 0x5e, 0xe5, 0xc5, 0x91, 0xc2, 0x38, 0xf6, 0xa3, 0xf4, 0x50, 0x51, 0x67, 0xcf, 0x19,
+	0x7d, 0xaf, 0x7f, 0x98, 0xcc, 0x27, 0x27, 0x4b, 0xe1, 0x1d, 0xd0, 0x2c, 0xbc, 0x03, 0x84, 0x00,
+	0x17, 0xf3, 0x2a, 0x01, 0xea, 0xee, 0x72, 0xc6, 0xc5, 0xbc, 0x4a, 0x40, 0x4a, 0x2c, 0x05, 0x28,
+	0xaf, 0xe9, 0x6a, 0x7c, 0x29, 0x60, 0x15, 0x40, 0x5f, 0x4b, 0x86, 0x41, 0xf2, 0xae, 0xe9, 0xa8,
+	0x4b, 0xc9, 0x30, 0xa8, 0xbc, 0x5d, 0xcd, 0x57, 0xde, 0xae, 0xf2, 0xdb, 0xdf, 0x2e, 0x9c, 0x10,
+	0xbf, 0x6d, 0x02, 0xfa, 0x3c, 0xa6, 0x9c, 0x6c, 0xcb, 0xeb, 0x40, 0xb2, 0x47, 0x95, 0x75, 0x1b,
+	0x04, 0xad, 0x00, 0xfb, 0x44, 0x3b, 0x92, 0x1c, 0x0b, 0x98, 0x4f, 0x7d, 0xa2, 0xaf, 0xba, 0x72,
+	0x8c, 0xb6, 0x60, 0x36, 0xc2, 0x34, 0x16, 0x36, 0x11, 0x15, 0xa4, 0x1b, 0xc5, 0x0a, 0x52, 0x51,
+	0xea, 0xfa, 0x27, 0x82, 0x62, 0x2b, 0xe0, 0xf1, 0xc8, 0x52, 0xd4, 0x25, 0x2f, 0x3f, 0x15, 0x29,
+	0x7b, 0xcf, 0x69, 0x14, 0x11, 0x57, 0xdf, 0xf5, 0x3a, 0x94, 0x7d, 0xa8, 0x00, 0xe2, 0x42, 0x24,
+	0xef, 0x31, 0x7e, 0xe8, 0xd2, 0x01, 0x25, 0xae, 0x34, 0x4d, 0xcb, 0xea, 0x09, 0xe0, 0x7d, 0x0d,
+	0x13, 0xa7, 0x1e, 0x65, 0xea, 0xaa, 0x63, 0xfb, 0x38, 0xa0, 0x03, 0xc2, 0xb8, 0xb4, 0x4d, 0xdb,
+	0x5a, 0xa4, 0x4c, 0xde, 0x77, 0xee, 0x6b, 0xb0, 0x58, 0xc1, 0xe3, 0xa7, 0xbc, 0xdf, 0x51, 0x2b,
+	0x78, 0xfc, 0x94, 0x8b, 0x9b, 0x23, 0x65, 0x76, 0x72, 0xa1, 0x27, 0x7d, 0x48, 0x2e, 0x4b, 0x56,
+	0x02, 0x4a, 0x43, 0xbc, 0x3b, 0x0e, 0x71, 0xe3, 0x26, 0xc0, 0x58, 0x3f, 0xc1, 0x56, 0x44, 0x9c,
+	0x32, 0xae, 0x18, 0x8a, 0xca, 0xd7, 0x21, 0xf6, 0x86, 0x89, 0x69, 0xd5, 0xc7, 0x7b, 0x33, 0x37,
+	0x1b, 0x26, 0x87, 0xe5, 0x9c, 0xb1, 0xc6, 0x79, 0x44, 0x6e, 0x45, 0x23, 0xbf, 0x15, 0xd2, 0xb3,
+	0x66, 0x32, 0x85, 0x32, 0x04, 0x2d, 0xc2, 0xf1, 0x5e, 0xb2, 0x3d, 0x62, 0xac, 0x75, 0x18, 0x06,
+	0xce, 0x3e, 0x0e, 0xf6, 0x88, 0x9b, 0x1c, 0xa9, 0x94, 0x7d, 0x96, 0x80, 0xcc, 0xc7, 0x70, 0x52,
+	0x04, 0x62, 0x4d, 0xbf, 0x18, 0x27, 0xce, 0x99, 0xd2, 0xc4, 0xd9, 0xcc, 0x24, 0x4e, 0x6d, 0xd2,
+	0x56, 0x6a, 0x52, 0xf3, 0x1f, 0x33, 0x80, 0xb2, 0xc2, 0x8e, 0xd6, 0x50, 0x3a, 0xdb, 0x4c, 0x99,
+	0xb3, 0x35, 0xab, 0x9c, 0xad, 0xc8, 0xbc, 0xc4, 0xd9, 0xf2, 0xae, 0xd5, 0x9a, 0xea, 0x5a, 0xb3,
+	0x75, 0x5d, 0x6b, 0xae, 0xdc, 0xb5, 0x92, 0x8d, 0x99, 0xcf, 0x6c, 0xcc, 0x2a, 0x00, 0x0f, 0x39,
+	0xf6, 0xb2, 0x95, 0x88, 0x8e, 0x84, 0xec, 0xe8, 0xbd, 0x94, 0x8e, 0xd5, 0x79, 0x29, 0x8e, 0xf5,
+	0x05, 0xc0, 0x1d, 0xca, 0x0e, 0x54, 0x86, 0x15, 0x94, 0x2e, 0x8d, 0x13, 0x4a, 0x97, 0xc6, 0x02,
+	0x82, 0x3d, 0x4f, 0xe7, 0x4d, 0x31, 0x14, 0xf2, 0x87, 0x8c, 0xb8, 0x3a, 0x35, 0xca, 0xb1, 0x80,
+	0x0d, 0x62, 0x42, 0x74, 0xf6, 0x93, 0x63, 0xf3, 0x77, 0x0d, 0xe8, 0xdc, 0x27, 0xbe, 0xe6, 0x7c,
+	0x01, 0x60, 0x2f, 0x8c, 0xc3, 0x21, 0xa7, 0x01, 0x51, 0x4f, 0xcf, 0x59, 0x2b, 0x03, 0xf9, 0xf6,
+	0x72, 0x04, 0x8c, 0x11, 0x6f, 0xa0, 0xb7, 0x42, 0x8e, 0x05, 0x6c, 0x9f, 0xe0, 0x48, 0x27, 0x4f,
+	0x39, 0x16, 0x36, 0x60, 0x1c, 0x3b, 0x07, 0x3a, 0x1d, 0xa8, 0x0f, 0xf3, 0x37, 0x0b, 0xd0, 0xfb,
+	0x74, 0x48, 0xe2, 0x51, 0xa6, 0xd8, 0xc8, 0x88, 0x4e, 0x8d, 0x49, 0xb5, 0x3c, 0x03, 0x11, 0x19,
+	0x7c, 0x10, 0x87, 0xbe, 0x9d, 0x16, 0xd4, 0x67, 0x24, 0x4a, 0x57, 0x00, 0xef, 0xaa, 0xa2, 0x3a,
+	0xfa, 0x00, 0x44, 0x4c, 0x70, 0xa2, 0x4a, 0xd8, 0xdd, 0x8d, 0xcb, 0x45, 0x6f, 0xcc, 0xca, 0x5c,
+	0xbf, 0x2b, 0x91, 0x2d, 0x4d, 0x84, 0x76, 0x61, 0x99, 0x06, 0x91, 0x7c, 0x0a, 0xc5, 0x14, 0x7b,
+	0xf4, 0xf9, 0xb8, 0x2a, 0xd6, 0xdd, 0x78, 0x7b, 0x0a, 0xaf, 0x7b, 0x82, 0x72, 0x27, 0x4b, 0x68,
+	0x21, 0x5a, 0x80, 0x21, 0x02, 0x2b, 0xe1, 0x90, 0x17, 0x85, 0xcc, 0x4a, 0x21, 0x1b, 0x53, 0x84,
+	0x3c, 0x18, 0xf2, 0x49, 0x8e, 0xd6, 0x72, 0x58, 0x04, 0x1a, 0xdb, 0x30, 0xa7, 0x94, 0x13, 0xe6,
+	0x1f, 0x50, 0xe2, 0x25, 0x49, 0x43, 0x7d, 0x88, 0xfb, 0x45, 0x18, 0x91, 0x18, 0x07, 0xae, 0x76,
+	0xcd, 0xe4, 0x73, 0xec, 0xb2, 0xcd, 0x8c, 0xcb, 0x1a, 0xff, 0x9a, 0x05, 0x54, 0xd4, 0x30, 0x29,
+	0xf5, 0xc5, 0x84, 0x89, 0x13, 0x4f, 0x55, 0x46, 0x94, 0x9c, 0xc5, 0x0c, 0x5c, 0x14, 0x48, 0xd0,
+	0xe7, 0xd0, 0x71, 0xd8, 0xa1, 0x2d, 0x4d, 0x22, 0x65, 0x76, 0x37, 0xde, 0x3b, 0xb6, 0x49, 0xd7,
+	0x37, 0x77, 0x1e, 0x49, 0xa8, 0xd5, 0x76, 0xd8, 0xa1, 0x1c, 0xa1, 0x9f, 0x01, 0x3c, 0x66, 0x61,
+	0xa0, 0x39, 0xab, 0x8d, 0x7f, 0xff, 0xf8, 0x9c, 0x7f, 0xb2, 0xf3, 0x60, 0x5b, 0xb1, 0xee, 0x08,
+	0x76, 0x8a, 0xb7, 0x03, 0x0b, 0x11, 0x8e, 0x9f, 0x0c, 0x09, 0xd7, 0xec, 0x95, 0x2f, 0xfc, 0xe0,
+	0xf8, 0xec, 0x3f, 0x51, 0x6c, 0x94, 0x84, 0x5e, 0x94, 0xf9, 0x32, 0xfe, 0x3a, 0x03, 0xed, 0x44,
+	0x2f, 0xf1, 0x9a, 0x1a, 0xd0, 0xb4, 0xa6, 0x60, 0xd3, 0x60, 0x10, 0x6a, 0x8b, 0x9e, 0x18, 0xd0,
+	0xa4, 0xac, 0x70, 0x2f, 0x18, 0x84, 0xc2, 0xf6, 0x31, 0x71, 0xc2, 0xd8, 0xb5, 0x5d, 0xe2, 0x51,
+	0x9f, 0x0a, 0xb7, 0x57, 0x7b, 0xb9, 0xa8, 0xe0, 0x77, 0x12, 0x30, 0x7a, 0x03, 0x16, 0xe5, 0xb6,
+	0x67, 0x30, 0x9b, 0x09, 0x4f, 0xe2, 0x65, 0x10, 0xaf, 0xc0, 0xd2, 0x93, 0x61, 0xc8, 0x89, 0xed,
+	0xec, 0xe3, 0x18, 0x3b, 0x3c, 0x4c, 0x5f, 0xf7, 0x8b, 0x12, 0xbe, 0x99, 0x82, 0xd1, 0xff, 0xc3,
+	0x69, 0x85, 0x4a, 0x98, 0x83, 0xa3, 0x94, 0x82, 0xc4, 0xfa, 0xc6, 0xb0, 0x22, 0x67, 0xb7, 0xe4,
+	0xe4, 0x66, 0x32, 0x87, 0x0c, 0x68, 0x3b, 0xa1, 0xef, 0x93, 0x80, 0xb3, 0xa4, 0x84, 0x96, 0x7c,
+	0xa3, 0x5b, 0xb0, 0x8a, 0x3d, 0x2f, 0x7c, 0x6a, 0x4b, 0x4a, 0xd7, 0x2e, 0x68, 0x37, 0x2f, 0x73,
+	0xb9, 0x21, 0x91, 0x3e, 0x95, 0x38, 0xd6, 0x84, 0xa2, 0xaf, 0x40, 0xcf, 0x11, 0x3b, 0x13, 0xd8,
+	0xe2, 0xc0, 0x62, 0xfd, 0xb6, 0xca, 0x11, 0x0a, 0xb6, 0x2d, 0x40, 0xc6, 0x45, 0xe8, 0xa4, 0x5b,
+	0x2d, 0xf2, 0x55, 0xc6, 0x67, 0xe5, 0xd8, 0x38, 0x01, 0xbd, 0xec, 0x66, 0x19, 0x7f, 0x6f, 0xc2,
+	0x72, 0x49, 0xdc, 0xa1, 0x2f, 0x01, 0x84, 0x43, 0xab, 0xe8, 0xd3, 0x1e, 0xfd, 0xfd, 0xe3, 0xc7,
+	0xaf, 0x70, 0x69, 0x05, 0xb6, 0x44, 0x80, 0xa8, 0x21, 0xfa, 0x1a, 0xba, 0xd2, 0xa9, 0x35, 0x77,
+	0xe5, 0xd5, 0x1f, 0x7c, 0x0b, 0xee, 0x42, 0x57, 0xcd, 0x5e, 0x86, 0x89, 0x1a, 0x1b, 0x7f, 0x6e,
+	0x40, 0x27, 0x15, 0x2c, 0xcc, 0xa6, 0xf6, 0x52, 0xba, 0x03, 0xd3, 0xe6, 0xe8, 0x4a, 0xd8, 0x5d,
+	0x09, 0xfa, 0x9f, 0xf4, 0x36, 0xe3, 0x5d, 0x80, 0xb1, 0xfe, 0xa5, 0x2a, 0x34, 0x4a, 0x55, 0x10,
+	0xe5, 0xc7, 0x05, 0x61, 0x5a, 0x4a, 0xdc, 0x1d, 0x1e, 0xd3, 0x48, 0xb6, 0x3c, 0x15, 0x12, 0xd3,
+	0x8f, 0xc7, 0xe4, 0x53, 0x9f, 0x79, 0xae, 0xae, 0xed, 0xc9, 0xb1, 0x80, 0x89, 0x86, 0x88, 0xd4,
+	0xbb, 0x67, 0xc9, 0xb1, 0xa8, 0x9e, 0xed, 0x63, 0x56, 0xf4, 0x6a, 0x75, 0xd9, 0x41, 0xfb, 0x98,
+	0x4d, 0x78, 0xf3, 0xc6, 0x5f, 0xfa, 0xd0, 0xcb, 0x96, 0xd3, 0xd0, 0x57, 0xd0, 0xcd, 0x34, 0x8d,
+	0xd1, 0x6b, 0x45, 0x7f, 0x28, 0x36, 0xa1, 0x8d, 0xcb, 0x53, 0xb0, 0xf4, 0xcb, 0xf1, 0xff, 0x50,
+	0x00, 0x27, 0x0b, 0x9d, 0x57, 0x74, 0xb5, 0x48, 0x5d, 0xd5, 0xd7, 0x35, 0xde, 0xac, 0x85, 0x9b,
+	0xca, 0xe3, 0xb0, 0x5c, 0xd2, 0x4a, 0x45, 0xd7, 0xa6, 0x70, 0xc9, 0xb5, 0x73, 0x8d, 0xeb, 0x35,
+	0xb1, 0x53, 0xa9, 0x4f, 0x00, 0x15, 0xfb, 0xac, 0xe8, 0xcd, 0xa9, 0x6c, 0xc6, 0x7d, 0x5c, 0xe3,
+	0x5a, 0x3d, 0xe4, 0x4a, 0x45, 0x55, 0x07, 0x76, 0xaa, 0xa2, 0xb9, 0x1e, 0xaf, 0x71, 0xbd, 0x26,
+	0x76, 0x2a, 0xf5, 0x00, 0x96, 0x26, 0xbb, 0xb3, 0xe8, 0x4a, 0xd5, 0xdf, 0x04, 0x85, 0xe6, 0xaf,
+	0x71, 0xb5, 0x0e, 0x6a, 0x2a, 0x8c, 0xc0, 0x89, 0x7c, 0xd3, 0x13, 0xbd, 0x51, 0xa4, 0x2f, 0xed,
+	0x07, 0x1b, 0x6b, 0xd3, 0x11, 0xb3, 0x3a, 0x4d, 0x36, 0x42, 0xcb, 0x74, 0xaa, 0xe8, 0xb2, 0x1a,
+	0x57, 0xeb, 0xa0, 0xa6, 0xc2, 0x7e, 0x0e, 0xa7, 0x4a, 0x1b, 0x84, 0x68, 0xbd, 0x8a, 0x4d, 0x79,
+	0x87, 0xd2, 0xb8, 0x51, 0x1b, 0x3f, 0x91, 0xfd, 0x56, 0x43, 0xc4, 0x7a, 0xa6, 0x4f, 0x58, 0x16,
+	0xeb, 0xc5, 0xce, 0xa3, 0x71, 0x79, 0x0a, 0x56, 0xaa, 0xdb, 0x2e, 0x2c, 0xe4, 0x3a, 0x87, 0xe8,
+	0xf5, 0x2a, 0xca, 0x7c, 0x55, 0xd1, 0x78, 0x63, 0x2a, 0x5e, 0x2a, 0xc3, 0x4e, 0xb2, 0x97, 0x4e,
+	0x57, 0x95, 0x8b, 0xcb, 0xe7, 0xab, 0xd7, 0xa7, 0xa1, 0xe5, 0x42, 0xb9, 0xd0, 0x5f, 0x2c, 0x0d,
+	0xe5, 0xaa, 0xfe, 0xa5, 0x71, 0xad, 0x1e, 0x72, 0x2a, 0xf2, 0xa7, 0x00, 0xe3, 0x36, 0x1f, 0x7a,
+	0xb5, 0x8a, 0x3a, 0xbb, 0xfb, 0xaf, 0x1d, 0x8d, 0x94, 0xb2, 0x7e, 0x0a, 0x2b, 0x65, 0xd5, 0x37,
+	0x74, 0xbd, 0xfc, 0x49, 0x5d, 0x51, 0xe2, 0x33, 0xd6, 0xeb, 0xa2, 0xa7, 0x82, 0x3f, 0x83, 0x76,
+	0xd2, 0xa2, 0x43, 0xaf, 0x14, 0xa9, 0x27, 0x9a, 0x92, 0x86, 0x79, 0x14, 0x4a, 0xc6, 0x81, 0x7d,
+	0x58, 0x1a, 0xf7, 0x7e, 0x54, 0xef, 0xac, 0x3a, 0x56, 0x0b, 0x5d, 0x3e, 0xe3, 0x6a, 0x1d, 0xd4,
+	0x8c, 0xb8, 0xd4, 0x19, 0xb2, 0xad, 0xa6, 0x6a, 0x67, 0x28, 0xe9, 0xa4, 0x19, 0xd7, 0xea, 0x21,
+	0xa7, 0x86, 0xfb, 0x05, 0x9c, 0x2e, 0xef, 0x30, 0xa1, 0xca, 0x88, 0xaf, 0xe8, 0x74, 0x19, 0x6f,
+	0xd5, 0x27, 0x48, 0xc5, 0x3f, 0x87, 0x53, 0x79, 0x1c, 0xdd, 0x61, 0xaa, 0xce, 0x4f, 0xe5, 0x7d,
+	0x2e, 0xe3, 0x46, 0x6d, 0xfc, 0x62, 0xe8, 0x65, 0x5b, 0x39, 0xd5, 0xd6, 0x2e, 0xe9, 0x5a, 0x19,
+	0xd7, 0xea, 0x21, 0x67, 0xe3, 0xa3, 0xac, 0x4d, 0x53, 0x16, 0x1f, 0x47, 0xf4, 0x91, 0x8c, 0xf5,
+	0xba, 0xe8, 0xb9, 0xe3, 0xbb, 0xd8, 0x87, 0x41, 0x53, 0xd7, 0x9f, 0xcb, 0xcc, 0xd7, 0x6b, 0x62,
+	0x57, 0xef, 0x6e, 0x92, 0xa9, 0xa7, 0x2a, 0x30, 0x91, 0xb1, 0x6f, 0xd4, 0xc6, 0x4f, 0x65, 0x47,
+	0x70, 0x32, 0x87, 0x22, 0x12, 0x08, 0xba, 0x3a, 0x85, 0x4f, 0xa6, 0x07, 0x64, 0xbc, 0x59, 0x0b,
+	0xb7, 0x2c, 0x7a, 0xb3, 0x5d, 0x8d, 0xa3, 0xfc, 0xa9, 0xd0, 0x8a, 0x31, 0xae, 0xd5, 0x43, 0x4e,
+	0x95, 0xfc, 0x1a, 0xba, 0x99, 0xd2, 0x6e, 0xd9, 0x01, 0x5b, 0x2c, 0x93, 0x1b, 0x97, 0xa7, 0x60,
+	0x25, 0xdc, 0xd7, 0x1a, 0xe2, 0x7d, 0x38, 0x2e, 0x7d, 0x96, 0x1d, 0x15, 0x85, 0x12, 0xaf, 0xf1,
+	0xda, 0xd1, 0x48, 0x19, 0x7b, 0x7d, 0x0c, 0xb3, 0xf2, 0xe9, 0x87, 0x2e, 0x1c, 0xfd, 0x26, 0x34,
+	0x2e, 0x96, 0xcf, 0xa7, 0x0f, 0x1b, 0xc1, 0x6d, 0x77, 0x4e, 0xfe, 0xd6, 0xfa, 0xce, 0x7f, 0x06,
+	0x00, 0xd9, 0x67, 0xd4, 0x15, 0xed, 0x2a, 0x00, 0x00,
 }
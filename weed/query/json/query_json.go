@@ -14,8 +14,9 @@ type Query struct {
 	Value string
 }
 
+// QueryJson returns the projected values if the json line passes the query, or all lines pass if query has no field
 func QueryJson(jsonLine string, projections []string, query Query) (passedFilter bool, values []sqltypes.Value) {
-	if filterJson(jsonLine, query) {
+	if query.Field == "" || filterJson(jsonLine, query) {
 		passedFilter = true
 		fields := gjson.GetMany(jsonLine, projections...)
 		for _, f := range fields {
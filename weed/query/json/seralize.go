@@ -1,6 +1,10 @@
 package json
 
-import "github.com/chrislusf/seaweedfs/weed/query/sqltypes"
+import (
+	"encoding/json"
+
+	"github.com/chrislusf/seaweedfs/weed/query/sqltypes"
+)
 
 func ToJson(buf []byte, selections []string, values []sqltypes.Value) []byte {
 	buf = append(buf, '{')
@@ -8,9 +12,14 @@ func ToJson(buf []byte, selections []string, values []sqltypes.Value) []byte {
 		if i > 0 {
 			buf = append(buf, ',')
 		}
-		buf = append(buf, selections[i]...)
+		name, _ := json.Marshal(selections[i])
+		buf = append(buf, name...)
 		buf = append(buf, ':')
-		buf = append(buf, value.Raw()...)
+		if raw := value.Raw(); len(raw) > 0 {
+			buf = append(buf, raw...)
+		} else {
+			buf = append(buf, "null"...)
+		}
 	}
 	buf = append(buf, '}')
 	return buf
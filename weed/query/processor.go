@@ -0,0 +1,329 @@
+package query
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query/json"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/match"
+)
+
+// Processor evaluates the records of a QueryRequest.
+// The same processor is used by volume servers on the needles,
+// and by the clients on the records spanning several needles.
+type Processor struct {
+	selections []string
+	names      []string // output names of the selections
+	filter     json.Query
+
+	isCsvInput      bool
+	recordDelimiter []byte
+	fieldDelimiter  rune
+	comments        string
+	columns         map[string]int
+
+	isCsvOutput           bool
+	outputRecordDelimiter []byte
+	outputFieldDelimiter  string
+	quoteAlways           bool
+}
+
+func NewProcessor(req *volume_server_pb.QueryRequest) (*Processor, error) {
+
+	p := &Processor{
+		selections:      req.Selections,
+		recordDelimiter: []byte("\n"),
+	}
+	if req.Filter != nil {
+		p.filter = json.Query{
+			Field: req.Filter.Field,
+			Op:    req.Filter.Operand,
+			Value: req.Filter.Value,
+		}
+	}
+
+	input := req.InputSerialization
+	if input == nil || (input.CsvInput == nil && input.JsonInput == nil) {
+		return nil, fmt.Errorf("unsupported input serialization")
+	}
+	if input.CompressionType != "" && input.CompressionType != "NONE" {
+		return nil, fmt.Errorf("unsupported compression type %s", input.CompressionType)
+	}
+
+	if csvInput := input.CsvInput; csvInput != nil {
+		p.isCsvInput = true
+		if csvInput.RecordDelimiter != "" {
+			p.recordDelimiter = []byte(csvInput.RecordDelimiter)
+		}
+		p.fieldDelimiter = ','
+		if csvInput.FieldDelimiter != "" {
+			p.fieldDelimiter = []rune(csvInput.FieldDelimiter)[0]
+		}
+		p.comments = csvInput.Comments
+		p.columns = make(map[string]int)
+		for i, name := range csvInput.ColumnNames {
+			p.columns[name] = i
+		}
+		if len(p.selections) == 0 {
+			for i := range csvInput.ColumnNames {
+				p.selections = append(p.selections, fmt.Sprintf("_%d", i+1))
+			}
+		}
+		for _, selection := range p.selections {
+			if _, found := p.columnIndex(selection); !found {
+				return nil, fmt.Errorf("unknown column %s", selection)
+			}
+		}
+	} else if input.JsonInput.Type == "DOCUMENT" {
+		return nil, fmt.Errorf("unsupported json type DOCUMENT")
+	}
+
+	for _, selection := range p.selections {
+		p.names = append(p.names, p.outputName(selection))
+	}
+
+	p.outputRecordDelimiter = []byte("\n")
+	output := req.OutputSerialization
+	if output != nil && output.CsvOutput != nil {
+		p.isCsvOutput = true
+		if output.CsvOutput.RecordDelimiter != "" {
+			p.outputRecordDelimiter = []byte(output.CsvOutput.RecordDelimiter)
+		}
+		p.outputFieldDelimiter = ","
+		if output.CsvOutput.FieldDelimiter != "" {
+			p.outputFieldDelimiter = output.CsvOutput.FieldDelimiter
+		}
+		p.quoteAlways = output.CsvOutput.QuoteFields == "ALWAYS"
+	} else if output != nil && output.JsonOutput != nil && output.JsonOutput.RecordDelimiter != "" {
+		p.outputRecordDelimiter = []byte(output.JsonOutput.RecordDelimiter)
+	}
+
+	return p, nil
+}
+
+func (p *Processor) RecordDelimiter() []byte {
+	return p.recordDelimiter
+}
+
+func (p *Processor) OutputRecordDelimiter() []byte {
+	return p.outputRecordDelimiter
+}
+
+// Query evaluates the complete records in data,
+// and keeps the bytes before the first and after the last record delimiter in head and tail.
+func (p *Processor) Query(data []byte) *volume_server_pb.QueriedStripe {
+
+	stripe := &volume_server_pb.QueriedStripe{}
+
+	first := bytes.Index(data, p.recordDelimiter)
+	if first < 0 {
+		stripe.Head = data
+		return stripe
+	}
+	last := bytes.LastIndex(data, p.recordDelimiter)
+
+	stripe.HasRecordDelimiter = true
+	stripe.Head = data[:first]
+	stripe.Tail = data[last+len(p.recordDelimiter):]
+
+	if first < last {
+		records := data[first+len(p.recordDelimiter) : last]
+		for _, record := range bytes.Split(records, p.recordDelimiter) {
+			stripe.Records = p.QueryRecord(stripe.Records, record)
+		}
+	}
+
+	return stripe
+}
+
+// QueryRecord appends the output of one complete record, if it passes the filter
+func (p *Processor) QueryRecord(out []byte, record []byte) []byte {
+
+	if len(bytes.TrimSpace(record)) == 0 {
+		return out
+	}
+
+	if !p.isCsvInput {
+		passed, values := json.QueryJson(string(record), p.selections, p.filter)
+		if !passed {
+			return out
+		}
+		if len(p.selections) == 0 {
+			return p.appendRecord(out, bytes.TrimSpace(record))
+		}
+		if !p.isCsvOutput {
+			return p.appendRecord(out, json.ToJson(nil, p.names, values))
+		}
+		fields := make([]string, len(values))
+		for i, v := range values {
+			fields[i] = gjson.ParseBytes(v.Raw()).String()
+		}
+		return p.appendRecord(out, p.toCsv(fields))
+	}
+
+	if p.comments != "" && bytes.HasPrefix(record, []byte(p.comments)) {
+		return out
+	}
+	reader := csv.NewReader(bytes.NewReader(record))
+	reader.Comma = p.fieldDelimiter
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	columns, err := reader.Read()
+	if err != nil {
+		return out
+	}
+
+	if p.filter.Field != "" {
+		i, _ := p.columnIndex(p.filter.Field)
+		if i >= len(columns) || !filterString(columns[i], p.filter) {
+			return out
+		}
+	}
+
+	// without the selections or column names, all columns are selected
+	fields, names := columns, p.names
+	if len(p.selections) > 0 {
+		fields = make([]string, len(p.selections))
+		for k, selection := range p.selections {
+			if i, _ := p.columnIndex(selection); i < len(columns) {
+				fields[k] = columns[i]
+			}
+		}
+	}
+
+	if p.isCsvOutput {
+		return p.appendRecord(out, p.toCsv(fields))
+	}
+	buf := []byte{'{'}
+	for k, field := range fields {
+		if k > 0 {
+			buf = append(buf, ',')
+		}
+		if k < len(names) {
+			buf = appendJsonString(buf, names[k])
+		} else {
+			buf = appendJsonString(buf, fmt.Sprintf("_%d", k+1))
+		}
+		buf = append(buf, ':')
+		buf = appendJsonString(buf, field)
+	}
+	buf = append(buf, '}')
+	return p.appendRecord(out, buf)
+}
+
+func (p *Processor) appendRecord(out, record []byte) []byte {
+	out = append(out, record...)
+	return append(out, p.outputRecordDelimiter...)
+}
+
+// columnIndex resolves a column name or its position _1, _2, ...
+func (p *Processor) columnIndex(name string) (int, bool) {
+	if i, found := p.columns[name]; found {
+		return i, true
+	}
+	if strings.HasPrefix(name, "_") {
+		if i, err := strconv.Atoi(name[1:]); err == nil && i > 0 {
+			return i - 1, true
+		}
+	}
+	return 0, false
+}
+
+func (p *Processor) outputName(selection string) string {
+	if p.isCsvInput {
+		if i, err := strconv.Atoi(strings.TrimPrefix(selection, "_")); err == nil && strings.HasPrefix(selection, "_") {
+			for name, index := range p.columns {
+				if index == i-1 {
+					return name
+				}
+			}
+		}
+		return selection
+	}
+	// the last element of a json path
+	if i := strings.LastIndex(selection, "."); i >= 0 {
+		return selection[i+1:]
+	}
+	return selection
+}
+
+func (p *Processor) toCsv(fields []string) []byte {
+	var buf []byte
+	for i, field := range fields {
+		if i > 0 {
+			buf = append(buf, p.outputFieldDelimiter...)
+		}
+		if p.quoteAlways || strings.ContainsAny(field, "\"\r\n"+p.outputFieldDelimiter) {
+			buf = append(buf, '"')
+			buf = append(buf, strings.Replace(field, "\"", "\"\"", -1)...)
+			buf = append(buf, '"')
+		} else {
+			buf = append(buf, field...)
+		}
+	}
+	return buf
+}
+
+func appendJsonString(buf []byte, s string) []byte {
+	buf = append(buf, '"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			buf = append(buf, '\\', byte(r))
+		case r < 0x20:
+			buf = append(buf, fmt.Sprintf("\\u%04x", r)...)
+		default:
+			buf = append(buf, string(r)...)
+		}
+	}
+	return append(buf, '"')
+}
+
+// filterString compares numerically if both sides are numbers, otherwise as strings
+func filterString(value string, query json.Query) bool {
+
+	if query.Op == "" {
+		return value != ""
+	}
+	if query.Op == "%" {
+		return match.Match(value, query.Value)
+	}
+	if query.Op == "!%" {
+		return !match.Match(value, query.Value)
+	}
+
+	var c int
+	a, errA := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	b, errB := strconv.ParseFloat(query.Value, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case a < b:
+			c = -1
+		case a > b:
+			c = 1
+		}
+	} else {
+		c = strings.Compare(value, query.Value)
+	}
+
+	switch query.Op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+)
+
+func TestProcessorJsonLines(t *testing.T) {
+
+	p, err := NewProcessor(&volume_server_pb.QueryRequest{
+		Selections: []string{"name", "address.city"},
+		Filter:     &volume_server_pb.QueryRequest_Filter{Field: "age", Operand: ">", Value: "20"},
+		InputSerialization: &volume_server_pb.QueryRequest_InputSerialization{
+			JsonInput: &volume_server_pb.QueryRequest_InputSerialization_JSONInput{Type: "LINES"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("new processor: %v", err)
+	}
+
+	data := `{"name":"a","age":18}` + "\n" +
+		`{"name":"b","age":30,"address":{"city":"x"}}` + "\n" +
+		`{"name":"c","age":40}` + "\n" +
+		`{"name":"d",`
+
+	stripe := p.Query([]byte(data))
+	if !stripe.HasRecordDelimiter || string(stripe.Head) != `{"name":"a","age":18}` || string(stripe.Tail) != `{"name":"d",` {
+		t.Fatalf("unexpected head %q tail %q", stripe.Head, stripe.Tail)
+	}
+	expected := `{"name":"b","city":"x"}` + "\n" + `{"name":"c","city":null}` + "\n"
+	if string(stripe.Records) != expected {
+		t.Errorf("expected %q, got %q", expected, stripe.Records)
+	}
+}
+
+func TestProcessorCsv(t *testing.T) {
+
+	p, err := NewProcessor(&volume_server_pb.QueryRequest{
+		Selections: []string{"name", "_3"},
+		Filter:     &volume_server_pb.QueryRequest_Filter{Field: "city", Operand: "%", Value: "S*"},
+		InputSerialization: &volume_server_pb.QueryRequest_InputSerialization{
+			CsvInput: &volume_server_pb.QueryRequest_InputSerialization_CSVInput{
+				ColumnNames: []string{"name", "city", "age"},
+			},
+		},
+		OutputSerialization: &volume_server_pb.QueryRequest_OutputSerialization{
+			CsvOutput: &volume_server_pb.QueryRequest_OutputSerialization_CSVOutput{},
+		},
+	})
+	if err != nil {
+		t.Fatalf("new processor: %v", err)
+	}
+
+	out := p.QueryRecord(nil, []byte(`"Doe, John",Seattle,42`))
+	out = p.QueryRecord(out, []byte(`Jane,Portland,36`))
+	if string(out) != "\"Doe, John\",42\n" {
+		t.Errorf("unexpected output %q", out)
+	}
+
+	if _, err = NewProcessor(&volume_server_pb.QueryRequest{
+		Selections:         []string{"missing"},
+		InputSerialization: &volume_server_pb.QueryRequest_InputSerialization{CsvInput: &volume_server_pb.QueryRequest_InputSerialization_CSVInput{}},
+	}); err == nil {
+		t.Errorf("expected unknown column error")
+	}
+}
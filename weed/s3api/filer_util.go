@@ -31,8 +31,9 @@ func (s3a *S3ApiServer) mkdir(ctx context.Context, parentDirectoryPath string, d
 		}
 
 		request := &filer_pb.CreateEntryRequest{
-			Directory: parentDirectoryPath,
-			Entry:     entry,
+			Directory:   parentDirectoryPath,
+			Entry:       entry,
+			SetExtended: true,
 		}
 
 		glog.V(1).Infof("mkdir: %v", request)
@@ -89,14 +90,15 @@ func (s3a *S3ApiServer) list(ctx context.Context, parentDirectoryPath, prefix, s
 		}
 
 		glog.V(4).Infof("read directory: %v", request)
-		resp, err := client.ListEntries(ctx, request)
+		err := filer_pb.ListEntries(ctx, client, request, func(entry *filer_pb.Entry) error {
+			entries = append(entries, entry)
+			return nil
+		})
 		if err != nil {
 			glog.V(0).Infof("read directory %v: %v", request, err)
 			return fmt.Errorf("list dir %v: %v", parentDirectoryPath, err)
 		}
 
-		entries = resp.Entries
-
 		return nil
 	})
 
@@ -157,3 +159,46 @@ func objectKey(key *string) *string {
 	}
 	return key
 }
+
+func (s3a *S3ApiServer) getEntry(ctx context.Context, parentDirectoryPath, entryName string) (entry *filer_pb.Entry, err error) {
+
+	err = s3a.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+		request := &filer_pb.LookupDirectoryEntryRequest{
+			Directory: parentDirectoryPath,
+			Name:      entryName,
+		}
+
+		glog.V(4).Infof("lookup entry %v/%v: %v", parentDirectoryPath, entryName, request)
+		resp, err := client.LookupDirectoryEntry(ctx, request)
+		if err != nil {
+			return fmt.Errorf("lookup entry %s/%s: %v", parentDirectoryPath, entryName, err)
+		}
+
+		entry = resp.Entry
+
+		return nil
+	})
+
+	return
+}
+
+func (s3a *S3ApiServer) updateEntry(ctx context.Context, parentDirectoryPath string, entry *filer_pb.Entry) error {
+
+	return s3a.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+		request := &filer_pb.UpdateEntryRequest{
+			Directory:   parentDirectoryPath,
+			Entry:       entry,
+			SetExtended: true,
+		}
+
+		glog.V(1).Infof("update entry %v/%v", parentDirectoryPath, entry.Name)
+		if _, err := client.UpdateEntry(ctx, request); err != nil {
+			glog.V(0).Infof("update entry %v: %v", request, err)
+			return fmt.Errorf("update entry %s/%s: %v", parentDirectoryPath, entry.Name, err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,119 @@
+package s3api
+
+import (
+	"context"
+	"encoding/xml"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+const bucketConfigCacheDuration = time.Minute
+
+// bucketConfig is the decoded configurations kept with a bucket folder
+type bucketConfig struct {
+	lifecycle *LifecycleConfiguration
+	cors      *CORSConfiguration
+	loadedAt  time.Time
+}
+
+// bucketConfigCache keeps recently read bucket configurations,
+// to avoid looking up the bucket for every object request
+type bucketConfigCache struct {
+	sync.Mutex
+	buckets map[string]*bucketConfig
+}
+
+func (c *bucketConfigCache) invalidate(bucket string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.buckets, bucket)
+}
+
+// getBucketConfig returns nil if the bucket does not exist
+func (s3a *S3ApiServer) getBucketConfig(ctx context.Context, bucket string) *bucketConfig {
+
+	s3a.bucketConfigs.Lock()
+	config, found := s3a.bucketConfigs.buckets[bucket]
+	s3a.bucketConfigs.Unlock()
+	if found && time.Since(config.loadedAt) < bucketConfigCacheDuration {
+		return config
+	}
+
+	entry, err := s3a.getEntry(ctx, s3a.option.BucketsPath, bucket)
+	if err != nil || entry == nil {
+		glog.V(3).Infof("load bucket %s config: %v", bucket, err)
+		return nil
+	}
+
+	config = newBucketConfig(bucket, entry)
+
+	s3a.bucketConfigs.Lock()
+	if s3a.bucketConfigs.buckets == nil {
+		s3a.bucketConfigs.buckets = make(map[string]*bucketConfig)
+	}
+	s3a.bucketConfigs.buckets[bucket] = config
+	s3a.bucketConfigs.Unlock()
+
+	return config
+}
+
+func newBucketConfig(bucket string, entry *filer_pb.Entry) *bucketConfig {
+
+	config := &bucketConfig{loadedAt: time.Now()}
+
+	if data, found := entry.Extended[bucketLifecycleKey]; found {
+		lifecycle := &LifecycleConfiguration{}
+		if err := xml.Unmarshal(data, lifecycle); err != nil {
+			glog.Errorf("decode bucket %s lifecycle configuration: %v", bucket, err)
+		} else {
+			config.lifecycle = lifecycle
+		}
+	}
+
+	if data, found := entry.Extended[bucketCorsKey]; found {
+		cors := &CORSConfiguration{}
+		if err := xml.Unmarshal(data, cors); err != nil {
+			glog.Errorf("decode bucket %s cors configuration: %v", bucket, err)
+		} else {
+			config.cors = cors
+		}
+	}
+
+	return config
+}
+
+// saveBucketConfig stores the encoded configuration with the bucket, or removes it if config is nil
+func (s3a *S3ApiServer) saveBucketConfig(ctx context.Context, bucket, key string, config interface{}) ErrorCode {
+
+	entry, err := s3a.getEntry(ctx, s3a.option.BucketsPath, bucket)
+	if err != nil || entry == nil {
+		glog.V(1).Infof("load bucket %s: %v", bucket, err)
+		return ErrNoSuchBucket
+	}
+
+	if config == nil {
+		delete(entry.Extended, key)
+	} else {
+		data, err := xml.Marshal(config)
+		if err != nil {
+			glog.Errorf("encode bucket %s %s: %v", bucket, key, err)
+			return ErrInternalError
+		}
+		if entry.Extended == nil {
+			entry.Extended = make(map[string][]byte)
+		}
+		entry.Extended[key] = data
+	}
+
+	if err = s3a.updateEntry(ctx, s3a.option.BucketsPath, entry); err != nil {
+		glog.Errorf("save bucket %s %s: %v", bucket, key, err)
+		return ErrInternalError
+	}
+
+	s3a.bucketConfigs.invalidate(bucket)
+
+	return ErrNone
+}
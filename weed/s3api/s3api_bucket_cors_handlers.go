@@ -0,0 +1,249 @@
+package s3api
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// the cors configuration is kept in the extended attributes of the bucket folder
+	bucketCorsKey = "s3.cors"
+
+	maxCorsRules = 100
+)
+
+type CORSConfiguration struct {
+	XMLName   xml.Name   `xml:"CORSConfiguration"`
+	Xmlns     string     `xml:"xmlns,attr,omitempty"`
+	CORSRules []CORSRule `xml:"CORSRule"`
+}
+
+type CORSRule struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedHeaders []string `xml:"AllowedHeader"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	ExposeHeaders  []string `xml:"ExposeHeader"`
+	MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+}
+
+func (s3a *S3ApiServer) PutBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, ErrInternalError, r.URL)
+		return
+	}
+
+	config := &CORSConfiguration{}
+	if err = xml.Unmarshal(data, config); err != nil {
+		glog.V(1).Infof("bucket %s cors configuration: %v", bucket, err)
+		writeErrorResponse(w, ErrMalformedXML, r.URL)
+		return
+	}
+	if !config.isValid() {
+		writeErrorResponse(w, ErrMalformedXML, r.URL)
+		return
+	}
+
+	if errCode := s3a.saveBucketConfig(context.Background(), bucket, bucketCorsKey, config); errCode != ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+}
+
+func (s3a *S3ApiServer) GetBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	entry, err := s3a.getEntry(context.Background(), s3a.option.BucketsPath, bucket)
+	if err != nil || entry == nil {
+		writeErrorResponse(w, ErrNoSuchBucket, r.URL)
+		return
+	}
+
+	config := newBucketConfig(bucket, entry).cors
+	if config == nil {
+		writeErrorResponse(w, ErrNoSuchCORSConfiguration, r.URL)
+		return
+	}
+
+	config.Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+	writeSuccessResponseXML(w, encodeResponse(config))
+}
+
+func (s3a *S3ApiServer) DeleteBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	if errCode := s3a.saveBucketConfig(context.Background(), bucket, bucketCorsKey, nil); errCode != ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PreflightHandler answers the OPTIONS requests sent by browsers before the actual cross-origin requests
+func (s3a *S3ApiServer) PreflightHandler(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	origin := r.Header.Get("Origin")
+	method := r.Header.Get("Access-Control-Request-Method")
+	if origin == "" || method == "" {
+		writeErrorResponse(w, ErrCORSForbidden, r.URL)
+		return
+	}
+
+	var headers []string
+	for _, h := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			headers = append(headers, h)
+		}
+	}
+
+	config := s3a.getBucketConfig(context.Background(), bucket)
+	if config == nil {
+		writeErrorResponse(w, ErrNoSuchBucket, r.URL)
+		return
+	}
+
+	rule := config.cors.match(origin, method, headers)
+	if rule == nil {
+		writeErrorResponse(w, ErrCORSForbidden, r.URL)
+		return
+	}
+
+	rule.setHeaders(w, origin)
+	w.Header().Set("Access-Control-Allow-Methods", strings.Join(rule.AllowedMethods, ", "))
+	if len(headers) > 0 {
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(rule.MaxAgeSeconds))
+	}
+
+	writeSuccessResponseEmpty(w)
+}
+
+// corsMiddleware decorates the responses of cross-origin requests according to the matching cors rule
+func (s3a *S3ApiServer) corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		origin := r.Header.Get("Origin")
+		if origin != "" && r.Method != "OPTIONS" {
+			bucket := mux.Vars(r)["bucket"]
+			if config := s3a.getBucketConfig(context.Background(), bucket); config != nil {
+				if rule := config.cors.match(origin, r.Method, nil); rule != nil {
+					rule.setHeaders(w, origin)
+				}
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (config *CORSConfiguration) isValid() bool {
+	if len(config.CORSRules) == 0 || len(config.CORSRules) > maxCorsRules {
+		return false
+	}
+	for _, rule := range config.CORSRules {
+		if len(rule.AllowedMethods) == 0 || len(rule.AllowedOrigins) == 0 || rule.MaxAgeSeconds < 0 {
+			return false
+		}
+		for _, method := range rule.AllowedMethods {
+			switch method {
+			case "GET", "PUT", "HEAD", "POST", "DELETE":
+			default:
+				return false
+			}
+		}
+		for _, origin := range rule.AllowedOrigins {
+			if strings.Count(origin, "*") > 1 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// match returns the first rule allowing the origin, method, and all the headers
+func (config *CORSConfiguration) match(origin, method string, headers []string) *CORSRule {
+	if config == nil {
+		return nil
+	}
+	for i := range config.CORSRules {
+		rule := &config.CORSRules[i]
+		if !matchesAny(rule.AllowedOrigins, origin, false) {
+			continue
+		}
+		if !matchesAny(rule.AllowedMethods, method, false) {
+			continue
+		}
+		allowed := true
+		for _, h := range headers {
+			if !matchesAny(rule.AllowedHeaders, h, true) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (rule *CORSRule) setHeaders(w http.ResponseWriter, origin string) {
+	if len(rule.AllowedOrigins) == 1 && rule.AllowedOrigins[0] == "*" {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	w.Header().Add("Vary", "Origin, Access-Control-Request-Headers, Access-Control-Request-Method")
+	if len(rule.ExposeHeaders) > 0 {
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(rule.ExposeHeaders, ", "))
+	}
+}
+
+// matchesAny checks the value against the patterns, each of which may contain one "*" wildcard
+func matchesAny(patterns []string, value string, ignoreCase bool) bool {
+	if ignoreCase {
+		value = strings.ToLower(value)
+	}
+	for _, pattern := range patterns {
+		if ignoreCase {
+			pattern = strings.ToLower(pattern)
+		}
+		star := strings.Index(pattern, "*")
+		if star < 0 {
+			if pattern == value {
+				return true
+			}
+			continue
+		}
+		prefix, suffix := pattern[:star], pattern[star+1:]
+		if len(value) >= len(prefix)+len(suffix) && strings.HasPrefix(value, prefix) && strings.HasSuffix(value, suffix) {
+			return true
+		}
+	}
+	return false
+}
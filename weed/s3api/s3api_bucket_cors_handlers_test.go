@@ -0,0 +1,51 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestCorsRuleMatching(t *testing.T) {
+
+	input := `<CORSConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <CORSRule>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedHeader>x-amz-*</AllowedHeader>
+    <AllowedHeader>Content-Type</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>3000</MaxAgeSeconds>
+  </CORSRule>
+  <CORSRule>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+
+	config := &CORSConfiguration{}
+	if err := xml.Unmarshal([]byte(input), config); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !config.isValid() {
+		t.Fatalf("unexpected invalid configuration %+v", config)
+	}
+
+	if rule := config.match("https://app.example.com", "PUT", []string{"X-Amz-Date", "content-type"}); rule != &config.CORSRules[0] {
+		t.Errorf("expected the first rule, got %+v", rule)
+	}
+	if rule := config.match("https://app.example.com", "PUT", []string{"Authorization"}); rule != nil {
+		t.Errorf("header Authorization should not be allowed, got %+v", rule)
+	}
+	if rule := config.match("https://other.org", "GET", nil); rule != &config.CORSRules[1] {
+		t.Errorf("expected the wildcard rule, got %+v", rule)
+	}
+	if rule := config.match("https://other.org", "DELETE", nil); rule != nil {
+		t.Errorf("method DELETE should not be allowed, got %+v", rule)
+	}
+
+	var empty *CORSConfiguration
+	if rule := empty.match("https://other.org", "GET", nil); rule != nil {
+		t.Errorf("missing configuration should not match")
+	}
+}
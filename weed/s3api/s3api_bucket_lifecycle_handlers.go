@@ -0,0 +1,147 @@
+package s3api
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// the lifecycle configuration is kept in the extended attributes of the bucket folder
+	bucketLifecycleKey = "s3.lifecycle"
+
+	maxLifecycleRules = 1000
+)
+
+type LifecycleConfiguration struct {
+	XMLName xml.Name        `xml:"LifecycleConfiguration"`
+	Xmlns   string          `xml:"xmlns,attr,omitempty"`
+	Rules   []LifecycleRule `xml:"Rule"`
+}
+
+type LifecycleRule struct {
+	ID                             string                          `xml:"ID,omitempty"`
+	Prefix                         *string                         `xml:"Prefix"` // deprecated, replaced by Filter
+	Filter                         *LifecycleFilter                `xml:"Filter,omitempty"`
+	Status                         string                          `xml:"Status"`
+	Expiration                     *LifecycleExpiration            `xml:"Expiration,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+}
+
+type LifecycleFilter struct {
+	Prefix *string       `xml:"Prefix"`
+	Tag    *Tag          `xml:"Tag,omitempty"`
+	And    *LifecycleAnd `xml:"And,omitempty"`
+}
+
+type LifecycleAnd struct {
+	Prefix string `xml:"Prefix,omitempty"`
+	Tags   []Tag  `xml:"Tag"`
+}
+
+type Tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type LifecycleExpiration struct {
+	Days int        `xml:"Days,omitempty"`
+	Date *time.Time `xml:"Date,omitempty"`
+}
+
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+// PutBucketLifecycleConfigurationHandler replaces the lifecycle rules of the bucket.
+// Unlike AWS S3, the prefix-wide expirations are also written into the ttl of new objects,
+// so the new rules do not apply to the data of objects written under the previous rules.
+func (s3a *S3ApiServer) PutBucketLifecycleConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, ErrInternalError, r.URL)
+		return
+	}
+
+	config := &LifecycleConfiguration{}
+	if err = xml.Unmarshal(data, config); err != nil {
+		glog.V(1).Infof("bucket %s lifecycle configuration: %v", bucket, err)
+		writeErrorResponse(w, ErrMalformedXML, r.URL)
+		return
+	}
+	if !config.isValid() {
+		writeErrorResponse(w, ErrMalformedXML, r.URL)
+		return
+	}
+
+	if errCode := s3a.saveBucketConfig(context.Background(), bucket, bucketLifecycleKey, config); errCode != ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+}
+
+func (s3a *S3ApiServer) GetBucketLifecycleConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	config, errCode := s3a.loadLifecycleConfiguration(context.Background(), bucket)
+	if errCode != ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+	if config == nil {
+		writeErrorResponse(w, ErrNoSuchLifecycleConfiguration, r.URL)
+		return
+	}
+
+	config.Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+	writeSuccessResponseXML(w, encodeResponse(config))
+}
+
+func (s3a *S3ApiServer) DeleteBucketLifecycleHandler(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	if errCode := s3a.saveBucketConfig(context.Background(), bucket, bucketLifecycleKey, nil); errCode != ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loadLifecycleConfiguration returns nil if the bucket has no lifecycle configuration
+func (s3a *S3ApiServer) loadLifecycleConfiguration(ctx context.Context, bucket string) (*LifecycleConfiguration, ErrorCode) {
+
+	entry, err := s3a.getEntry(ctx, s3a.option.BucketsPath, bucket)
+	if err != nil || entry == nil {
+		glog.V(1).Infof("load bucket %s lifecycle configuration: %v", bucket, err)
+		return nil, ErrNoSuchBucket
+	}
+
+	data, found := entry.Extended[bucketLifecycleKey]
+	if !found {
+		return nil, ErrNone
+	}
+
+	config := &LifecycleConfiguration{}
+	if err = xml.Unmarshal(data, config); err != nil {
+		glog.Errorf("decode bucket %s lifecycle configuration: %v", bucket, err)
+		return nil, ErrInternalError
+	}
+
+	return config, ErrNone
+}
@@ -0,0 +1,404 @@
+package s3api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+const (
+	// object tags are kept in the entry extended attributes, keyed by this prefix and the tag key
+	objectTagPrefix = "X-Amz-Tagging-"
+
+	lifecycleListLimit = 1024
+	lifecycleLeaseSec  = 60
+
+	// the count of a needle ttl is stored in one byte
+	maxTtlDays = 255
+)
+
+func (config *LifecycleConfiguration) isValid() bool {
+	if len(config.Rules) == 0 || len(config.Rules) > maxLifecycleRules {
+		return false
+	}
+	ids := make(map[string]bool)
+	for _, rule := range config.Rules {
+		if len(rule.ID) > 255 {
+			return false
+		}
+		if rule.ID != "" {
+			if ids[rule.ID] {
+				return false
+			}
+			ids[rule.ID] = true
+		}
+		if rule.Status != "Enabled" && rule.Status != "Disabled" {
+			return false
+		}
+		if rule.Prefix != nil && rule.Filter != nil {
+			return false
+		}
+		if rule.Expiration == nil && rule.AbortIncompleteMultipartUpload == nil {
+			return false
+		}
+		if rule.Expiration != nil {
+			if (rule.Expiration.Days > 0) == (rule.Expiration.Date != nil) || rule.Expiration.Days < 0 {
+				return false
+			}
+		}
+		if rule.AbortIncompleteMultipartUpload != nil {
+			if rule.AbortIncompleteMultipartUpload.DaysAfterInitiation <= 0 {
+				return false
+			}
+			// multipart uploads have no tags
+			if len(rule.tags()) > 0 {
+				return false
+			}
+		}
+		if filter := rule.Filter; filter != nil {
+			set := 0
+			if filter.Prefix != nil {
+				set++
+			}
+			if filter.Tag != nil {
+				set++
+			}
+			if filter.And != nil {
+				set++
+			}
+			if set > 1 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (rule *LifecycleRule) isEnabled() bool {
+	return rule.Status == "Enabled"
+}
+
+func (rule *LifecycleRule) prefix() string {
+	if rule.Prefix != nil {
+		return *rule.Prefix
+	}
+	if rule.Filter == nil {
+		return ""
+	}
+	if rule.Filter.Prefix != nil {
+		return *rule.Filter.Prefix
+	}
+	if rule.Filter.And != nil {
+		return rule.Filter.And.Prefix
+	}
+	return ""
+}
+
+func (rule *LifecycleRule) tags() []Tag {
+	if rule.Filter == nil {
+		return nil
+	}
+	if rule.Filter.Tag != nil {
+		return []Tag{*rule.Filter.Tag}
+	}
+	if rule.Filter.And != nil {
+		return rule.Filter.And.Tags
+	}
+	return nil
+}
+
+// matches checks the object key, without the leading "/", and its tags against the rule filter
+func (rule *LifecycleRule) matches(key string, tags map[string]string) bool {
+	if !strings.HasPrefix(key, rule.prefix()) {
+		return false
+	}
+	for _, tag := range rule.tags() {
+		if value, found := tags[tag.Key]; !found || value != tag.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// isExpired checks the expiration of an object last modified at mtime
+func (rule *LifecycleRule) isExpired(mtime, now time.Time) bool {
+	if rule.Expiration == nil {
+		return false
+	}
+	if rule.Expiration.Date != nil {
+		return now.After(*rule.Expiration.Date)
+	}
+	return now.After(mtime.Add(time.Duration(rule.Expiration.Days) * 24 * time.Hour))
+}
+
+// isStaleUpload checks whether a multipart upload initiated at crtime should be aborted
+func (rule *LifecycleRule) isStaleUpload(crtime, now time.Time) bool {
+	if rule.AbortIncompleteMultipartUpload == nil {
+		return false
+	}
+	days := rule.AbortIncompleteMultipartUpload.DaysAfterInitiation
+	return now.After(crtime.Add(time.Duration(days) * 24 * time.Hour))
+}
+
+// ttlFor maps the prefix-wide expirations without tag filters onto the needle ttl,
+// so the volumes holding the object data can be dropped as a whole once expired.
+// The metadata is still removed by the lifecycle worker.
+//
+// The ttl is fixed when the object is written. Changing or removing the rule later
+// does not extend the life of the existing objects: their data is still dropped
+// with the volume after the days of the rule at the time of writing.
+func (config *LifecycleConfiguration) ttlFor(key string) string {
+	days := math.MaxInt32
+	for _, rule := range config.Rules {
+		if !rule.isEnabled() || rule.Expiration == nil || rule.Expiration.Days <= 0 {
+			continue
+		}
+		if len(rule.tags()) > 0 || !strings.HasPrefix(key, rule.prefix()) {
+			continue
+		}
+		if rule.Expiration.Days < days {
+			days = rule.Expiration.Days
+		}
+	}
+	if days > maxTtlDays {
+		return ""
+	}
+	return fmt.Sprintf("%dd", days)
+}
+
+func objectTags(entry *filer_pb.Entry) map[string]string {
+	tags := make(map[string]string)
+	for k, v := range entry.Extended {
+		if strings.HasPrefix(k, objectTagPrefix) {
+			tags[k[len(objectTagPrefix):]] = string(v)
+		}
+	}
+	return tags
+}
+
+// lifecycleTtl returns the ttl for a new object, or empty if it has none
+func (s3a *S3ApiServer) lifecycleTtl(ctx context.Context, bucket, object string) string {
+	config := s3a.getBucketConfig(ctx, bucket)
+	if config == nil || config.lifecycle == nil {
+		return ""
+	}
+	return config.lifecycle.ttlFor(strings.TrimPrefix(object, "/"))
+}
+
+func (s3a *S3ApiServer) loopProcessingLifecycle(interval time.Duration) {
+
+	hostname, _ := os.Hostname()
+	clientId := fmt.Sprintf("s3-%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano())
+
+	for {
+		time.Sleep(interval)
+		if err := s3a.processLifecycle(context.Background(), clientId, time.Now()); err != nil {
+			glog.V(0).Infof("process bucket lifecycles: %v", err)
+		}
+	}
+}
+
+// processLifecycle applies the lifecycle rules of all buckets once.
+// Only one S3 gateway of the same filer processes the buckets at a time.
+func (s3a *S3ApiServer) processLifecycle(ctx context.Context, clientId string, now time.Time) error {
+
+	dir, name := filer2.FullPath(s3a.option.BucketsPath).DirAndName()
+	lock := &filer_pb.FileLock{
+		ClientId:    clientId,
+		IsExclusive: true,
+		IsFlock:     true,
+	}
+
+	var acquired bool
+	err := s3a.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.Lock(ctx, &filer_pb.LockRequest{
+			Directory: dir,
+			Name:      name,
+			Lock:      lock,
+			LeaseSec:  lifecycleLeaseSec,
+		})
+		if err != nil {
+			return err
+		}
+		acquired = resp.Acquired
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("lock %s: %v", s3a.option.BucketsPath, err)
+	}
+	if !acquired {
+		glog.V(1).Infof("bucket lifecycles are processed by another s3 gateway")
+		return nil
+	}
+
+	done := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(lifecycleLeaseSec * time.Second / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				s3a.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+					_, err := client.KeepLockLease(ctx, &filer_pb.KeepLockLeaseRequest{
+						ClientId: clientId,
+						LeaseSec: lifecycleLeaseSec,
+					})
+					return err
+				})
+			}
+		}
+	}()
+	defer func() {
+		close(done)
+		s3a.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+			_, err := client.Unlock(ctx, &filer_pb.UnlockRequest{
+				Directory: dir,
+				Name:      name,
+				Lock:      lock,
+			})
+			return err
+		})
+	}()
+
+	buckets, err := s3a.list(ctx, s3a.option.BucketsPath, "", "", false, math.MaxInt32)
+	if err != nil {
+		return err
+	}
+
+	for _, bucket := range buckets {
+		if !bucket.IsDirectory {
+			continue
+		}
+		config, errCode := s3a.loadLifecycleConfiguration(ctx, bucket.Name)
+		if errCode != ErrNone || config == nil {
+			continue
+		}
+		if err = s3a.applyLifecycle(ctx, bucket.Name, config, now); err != nil {
+			glog.V(0).Infof("bucket %s lifecycle: %v", bucket.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s3a *S3ApiServer) applyLifecycle(ctx context.Context, bucket string, config *LifecycleConfiguration, now time.Time) error {
+
+	var expirations, aborts []LifecycleRule
+	for _, rule := range config.Rules {
+		if !rule.isEnabled() {
+			continue
+		}
+		if rule.Expiration != nil {
+			expirations = append(expirations, rule)
+		}
+		if rule.AbortIncompleteMultipartUpload != nil {
+			aborts = append(aborts, rule)
+		}
+	}
+
+	if len(aborts) > 0 {
+		if err := s3a.abortStaleUploads(ctx, bucket, aborts, now); err != nil {
+			return err
+		}
+	}
+
+	if len(expirations) > 0 {
+		bucketDir := fmt.Sprintf("%s/%s", s3a.option.BucketsPath, bucket)
+		if err := s3a.expireObjects(ctx, bucketDir, "", expirations, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s3a *S3ApiServer) abortStaleUploads(ctx context.Context, bucket string, rules []LifecycleRule, now time.Time) error {
+
+	uploadsFolder := s3a.genUploadsFolder(bucket)
+
+	return s3a.eachEntry(ctx, uploadsFolder, func(entry *filer_pb.Entry) error {
+		if !entry.IsDirectory || entry.Attributes == nil {
+			return nil
+		}
+		key := strings.TrimPrefix(string(entry.Extended["key"]), "/")
+		crtime := time.Unix(entry.Attributes.Crtime, 0)
+		for _, rule := range rules {
+			if !rule.matches(key, nil) || !rule.isStaleUpload(crtime, now) {
+				continue
+			}
+			glog.V(1).Infof("bucket %s lifecycle rule %s aborts upload %s of %s", bucket, rule.ID, entry.Name, key)
+			return s3a.rm(ctx, uploadsFolder, entry.Name, true, true, true)
+		}
+		return nil
+	})
+}
+
+// expireObjects walks the bucket folder recursively and removes the expired objects
+func (s3a *S3ApiServer) expireObjects(ctx context.Context, dir, keyPrefix string, rules []LifecycleRule, now time.Time) error {
+
+	return s3a.eachEntry(ctx, dir, func(entry *filer_pb.Entry) error {
+		key := keyPrefix + entry.Name
+		if entry.IsDirectory {
+			if keyPrefix == "" && entry.Name == ".uploads" {
+				return nil
+			}
+			if !lifecycleMayMatch(rules, key+"/") {
+				return nil
+			}
+			return s3a.expireObjects(ctx, dir+"/"+entry.Name, key+"/", rules, now)
+		}
+		if entry.Attributes == nil {
+			return nil
+		}
+		mtime := time.Unix(entry.Attributes.Mtime, 0)
+		tags := objectTags(entry)
+		for _, rule := range rules {
+			if !rule.matches(key, tags) || !rule.isExpired(mtime, now) {
+				continue
+			}
+			glog.V(1).Infof("bucket lifecycle rule %s expires %s/%s", rule.ID, dir, entry.Name)
+			return s3a.rm(ctx, dir, entry.Name, false, true, false)
+		}
+		return nil
+	})
+}
+
+// lifecycleMayMatch checks whether any object under the folder could match one of the rules
+func lifecycleMayMatch(rules []LifecycleRule, folderKey string) bool {
+	for _, rule := range rules {
+		prefix := rule.prefix()
+		if strings.HasPrefix(folderKey, prefix) || strings.HasPrefix(prefix, folderKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// eachEntry lists the folder page by page, since entries can be removed while visiting them
+func (s3a *S3ApiServer) eachEntry(ctx context.Context, dir string, fn func(entry *filer_pb.Entry) error) error {
+	lastFileName := ""
+	for {
+		entries, err := s3a.list(ctx, dir, "", lastFileName, false, lifecycleListLimit)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err = fn(entry); err != nil {
+				return err
+			}
+			lastFileName = entry.Name
+		}
+		if len(entries) < lifecycleListLimit {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestLifecycleConfiguration(t *testing.T) {
+
+	input := `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Rule>
+    <ID>logs</ID>
+    <Filter><Prefix>logs/</Prefix></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>7</Days></Expiration>
+  </Rule>
+  <Rule>
+    <ID>tagged</ID>
+    <Filter><And><Prefix>data/</Prefix><Tag><Key>tmp</Key><Value>true</Value></Tag></And></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>1</Days></Expiration>
+  </Rule>
+  <Rule>
+    <ID>uploads</ID>
+    <Filter><Prefix></Prefix></Filter>
+    <Status>Enabled</Status>
+    <AbortIncompleteMultipartUpload><DaysAfterInitiation>3</DaysAfterInitiation></AbortIncompleteMultipartUpload>
+  </Rule>
+</LifecycleConfiguration>`
+
+	config := &LifecycleConfiguration{}
+	if err := xml.Unmarshal([]byte(input), config); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !config.isValid() || len(config.Rules) != 3 {
+		t.Fatalf("unexpected configuration %+v", config)
+	}
+
+	logs, tagged, uploads := config.Rules[0], config.Rules[1], config.Rules[2]
+
+	if !logs.matches("logs/2019/a.log", nil) || logs.matches("data/a.log", nil) {
+		t.Errorf("prefix filter mismatch")
+	}
+	if tagged.matches("data/a", map[string]string{"tmp": "false"}) || !tagged.matches("data/a", map[string]string{"tmp": "true"}) {
+		t.Errorf("tag filter mismatch")
+	}
+
+	now := time.Now()
+	if logs.isExpired(now.Add(-6*24*time.Hour), now) || !logs.isExpired(now.Add(-8*24*time.Hour), now) {
+		t.Errorf("expiration days mismatch")
+	}
+	if !uploads.isStaleUpload(now.Add(-4*24*time.Hour), now) || uploads.isStaleUpload(now.Add(-time.Hour), now) {
+		t.Errorf("abort incomplete multipart upload mismatch")
+	}
+
+	if ttl := config.ttlFor("logs/a.log"); ttl != "7d" {
+		t.Errorf("expected ttl 7d, got %q", ttl)
+	}
+	if ttl := config.ttlFor("data/a"); ttl != "" {
+		t.Errorf("tagged rules should not map to ttl, got %q", ttl)
+	}
+
+	config.Rules[0].Status = "Unknown"
+	if config.isValid() {
+		t.Errorf("invalid status should be rejected")
+	}
+}
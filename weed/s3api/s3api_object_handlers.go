@@ -1,12 +1,14 @@
 package s3api
 
 import (
+	"context"
 	"crypto/md5"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -46,6 +48,21 @@ func (s3a *S3ApiServer) PutObjectHandler(w http.ResponseWriter, r *http.Request)
 
 	uploadUrl := fmt.Sprintf("http://%s%s/%s%s?collection=%s",
 		s3a.option.Filer, s3a.option.BucketsPath, bucket, object, bucket)
+	if ttl := s3a.lifecycleTtl(context.Background(), bucket, object); ttl != "" {
+		uploadUrl += "&ttl=" + ttl
+	}
+
+	// the extended attributes are only set from the validated tags
+	r.Header.Del(weed_server.SeaweedExtendedHeader)
+	if tagging := r.Header.Get("X-Amz-Tagging"); tagging != "" {
+		extended, errCode := objectTagsExtended(tagging)
+		if errCode != ErrNone {
+			writeErrorResponse(w, errCode, r.URL)
+			return
+		}
+		// the filer keeps the tags with the new entry, in the same write as the object
+		r.Header.Set(weed_server.SeaweedExtendedHeader, extended)
+	}
 
 	etag, errCode := s3a.putToFiler(r, uploadUrl, dataReader)
 
@@ -207,6 +224,25 @@ func (s3a *S3ApiServer) putToFiler(r *http.Request, uploadUrl string, dataReader
 	return etag, ErrNone
 }
 
+// objectTagsExtended converts the tags, url-encoded as in the X-Amz-Tagging header,
+// to the url-encoded extended attributes of the object entry
+func objectTagsExtended(tagging string) (string, ErrorCode) {
+
+	tags, err := url.ParseQuery(tagging)
+	if err != nil {
+		return "", ErrInvalidTag
+	}
+
+	extended := make(url.Values)
+	for k, v := range tags {
+		if len(v) > 0 {
+			extended.Set(objectTagPrefix+k, v[0])
+		}
+	}
+
+	return extended.Encode(), ErrNone
+}
+
 func setEtag(w http.ResponseWriter, etag string) {
 	if etag != "" {
 		if strings.HasPrefix(etag, "\"") {
@@ -0,0 +1,197 @@
+package s3api
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+type listFilerFunc func(dir, startFrom string, inclusive bool, limit int) ([]*filer_pb.Entry, error)
+
+type listedObject struct {
+	key   string
+	entry *filer_pb.Entry
+}
+
+// objectLister walks the bucket folder depth first, visiting the objects in the S3 key order.
+// The keys are the file paths relative to the bucket folder, and the folders are only
+// visible as common prefixes, when the keys are rolled up by the delimiter.
+type objectLister struct {
+	list      listFilerFunc
+	bucketDir string
+	prefix    string
+	delimiter string
+	marker    string // only keys and common prefixes after the marker are returned
+	maxKeys   int
+
+	objects          []listedObject
+	commonPrefixes   []string
+	lastCommonPrefix string
+	nextMarker       string
+	isTruncated      bool
+}
+
+// walk visits one folder, dirKey being its key prefix, either empty or ending with "/"
+func (l *objectLister) walk(dirKey string) error {
+
+	// only read the entries whose keys can start with the prefix
+	namePrefix, onlyFolder := "", false
+	if !strings.HasPrefix(dirKey, l.prefix) {
+		if !strings.HasPrefix(l.prefix, dirKey) {
+			return nil
+		}
+		namePrefix = l.prefix[len(dirKey):]
+		if i := strings.Index(namePrefix, "/"); i >= 0 {
+			namePrefix, onlyFolder = namePrefix[:i], true
+		}
+	}
+
+	startFrom := namePrefix
+	if strings.HasPrefix(l.marker, dirKey) {
+		name := l.marker[len(dirKey):]
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[:i]
+		}
+		// keys under folder "a" start with "a/", sorting after a sibling "a-b" marker
+		if i := strings.IndexFunc(name, func(r rune) bool { return r < '/' }); i >= 0 {
+			name = name[:i]
+		}
+		if name > startFrom {
+			startFrom = name
+		}
+	}
+
+	dir := l.bucketDir
+	if dirKey != "" {
+		dir = dir + "/" + strings.TrimSuffix(dirKey, "/")
+	}
+
+	// the filer sorts folder "a" before "a-b", while the keys in "a" sort after "a-b",
+	// so a folder is walked only after the siblings sorting before "a/"
+	var pendingFolders []string
+	walkPendingFolders := func(beforeKey string) error {
+		for len(pendingFolders) > 0 && !l.isTruncated && (beforeKey == "" || pendingFolders[0]+"/" < beforeKey) {
+			name := pendingFolders[0]
+			pendingFolders = pendingFolders[1:]
+			if err := l.visitFolder(dirKey, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	inclusive, isLastPage := true, false
+	for !isLastPage && !l.isTruncated {
+		entries, err := l.list(dir, startFrom, inclusive, objectListPageSize)
+		if err != nil {
+			return err
+		}
+		isLastPage = len(entries) < objectListPageSize
+		for _, entry := range entries {
+			if l.isTruncated {
+				break
+			}
+			if !strings.HasPrefix(entry.Name, namePrefix) || onlyFolder && entry.Name != namePrefix {
+				isLastPage = true
+				break
+			}
+			if dirKey == "" && entry.Name == ".uploads" {
+				continue
+			}
+			if entry.IsDirectory {
+				if err := walkPendingFolders(entry.Name + "/"); err != nil {
+					return err
+				}
+				i := sort.Search(len(pendingFolders), func(i int) bool { return pendingFolders[i]+"/" > entry.Name+"/" })
+				pendingFolders = append(pendingFolders, "")
+				copy(pendingFolders[i+1:], pendingFolders[i:])
+				pendingFolders[i] = entry.Name
+				continue
+			}
+			if err := walkPendingFolders(entry.Name); err != nil {
+				return err
+			}
+			l.visitObject(dirKey, entry)
+		}
+		if len(entries) > 0 {
+			startFrom, inclusive = entries[len(entries)-1].Name, false
+		}
+	}
+
+	return walkPendingFolders("")
+}
+
+func (l *objectLister) visitObject(dirKey string, entry *filer_pb.Entry) {
+	key := dirKey + entry.Name
+	if !strings.HasPrefix(key, l.prefix) {
+		return
+	}
+	if commonPrefix, found := l.commonPrefix(key); found {
+		l.addCommonPrefix(commonPrefix)
+		return
+	}
+	if key <= l.marker || l.reachedMaxKeys() {
+		return
+	}
+	l.objects = append(l.objects, listedObject{key: key, entry: entry})
+	l.nextMarker = key
+}
+
+func (l *objectLister) visitFolder(dirKey, name string) error {
+	key := dirKey + name + "/"
+	if strings.HasPrefix(key, l.prefix) {
+		// all keys in the folder roll up into the same common prefix
+		if commonPrefix, found := l.commonPrefix(key); found {
+			l.addCommonPrefix(commonPrefix)
+			return nil
+		}
+	} else if !strings.HasPrefix(l.prefix, key) {
+		return nil
+	}
+	// all keys in the folder sort before the marker
+	if l.marker > key && !strings.HasPrefix(l.marker, key) {
+		return nil
+	}
+	return l.walk(key)
+}
+
+// commonPrefix rolls up the key to the first delimiter after the prefix
+func (l *objectLister) commonPrefix(key string) (string, bool) {
+	if l.delimiter == "" {
+		return "", false
+	}
+	if i := strings.Index(key[len(l.prefix):], l.delimiter); i >= 0 {
+		return key[:len(l.prefix)+i+len(l.delimiter)], true
+	}
+	return "", false
+}
+
+func (l *objectLister) addCommonPrefix(commonPrefix string) {
+	// keys of the same common prefix are visited one after another
+	if commonPrefix == l.lastCommonPrefix {
+		return
+	}
+	l.lastCommonPrefix = commonPrefix
+	// the marker can be a common prefix returned in the previous page
+	if commonPrefix <= l.marker || strings.HasPrefix(l.marker, commonPrefix) || l.reachedMaxKeys() {
+		return
+	}
+	l.commonPrefixes = append(l.commonPrefixes, commonPrefix)
+	l.nextMarker = commonPrefix
+}
+
+func (l *objectLister) reachedMaxKeys() bool {
+	if len(l.objects)+len(l.commonPrefixes) < l.maxKeys {
+		return false
+	}
+	l.isTruncated = true
+	return true
+}
+
+func (l *objectLister) commonPrefixEntries() (entries []PrefixEntry) {
+	for _, commonPrefix := range l.commonPrefixes {
+		entries = append(entries, PrefixEntry{Prefix: commonPrefix})
+	}
+	return
+}
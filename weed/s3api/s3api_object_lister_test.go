@@ -0,0 +1,124 @@
+package s3api
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// fakeBucket lists the folders implied by the object keys, sorted by name like the filer
+func fakeBucket(keys ...string) listFilerFunc {
+	folders := make(map[string]map[string]bool)
+	for _, key := range append(keys, ".uploads/x/1") {
+		dir := "/buckets/b"
+		parts := strings.Split(key, "/")
+		for i, part := range parts {
+			if folders[dir] == nil {
+				folders[dir] = make(map[string]bool)
+			}
+			folders[dir][part] = folders[dir][part] || i < len(parts)-1
+			dir = dir + "/" + part
+		}
+	}
+	return func(dir, startFrom string, inclusive bool, limit int) (entries []*filer_pb.Entry, err error) {
+		var names []string
+		for name := range folders[dir] {
+			if name > startFrom || inclusive && name == startFrom {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if len(entries) >= limit {
+				break
+			}
+			entries = append(entries, &filer_pb.Entry{Name: name, IsDirectory: folders[dir][name]})
+		}
+		return
+	}
+}
+
+func listAll(list listFilerFunc, prefix, delimiter, marker string, maxKeys int) (keys []string, isTruncated bool, nextMarker string) {
+	l := &objectLister{list: list, bucketDir: "/buckets/b", prefix: prefix, delimiter: delimiter, marker: marker, maxKeys: maxKeys}
+	if err := l.walk(""); err != nil {
+		panic(err)
+	}
+	for _, object := range l.objects {
+		keys = append(keys, object.key)
+	}
+	for _, commonPrefix := range l.commonPrefixes {
+		keys = append(keys, commonPrefix+"*")
+	}
+	return keys, l.isTruncated, l.nextMarker
+}
+
+func TestObjectListerKeyOrder(t *testing.T) {
+
+	keys := []string{"a/b/c", "a-b", "a/b-c", "a.txt", "a/b/d", "b", "a/c", "ab/x", "a/b.d/e"}
+	list := fakeBucket(keys...)
+
+	sorted := append([]string{}, keys...)
+	sort.Strings(sorted)
+
+	got, isTruncated, _ := listAll(list, "", "", "", 1000)
+	if !reflect.DeepEqual(got, sorted) || isTruncated {
+		t.Errorf("full listing: %v, expecting %v", got, sorted)
+	}
+
+	// page through with two keys at a time
+	var paged []string
+	marker := ""
+	for {
+		page, isTruncated, nextMarker := listAll(list, "", "", marker, 2)
+		paged = append(paged, page...)
+		if !isTruncated {
+			break
+		}
+		marker = nextMarker
+	}
+	if !reflect.DeepEqual(paged, sorted) {
+		t.Errorf("paged listing: %v, expecting %v", paged, sorted)
+	}
+
+	// prefix across folder boundaries
+	got, _, _ = listAll(list, "a/b", "", "", 1000)
+	if expected := []string{"a/b-c", "a/b.d/e", "a/b/c", "a/b/d"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("prefix listing: %v, expecting %v", got, expected)
+	}
+
+	got, _, _ = listAll(list, "a", "", "a/b/c", 1000)
+	if expected := []string{"a/b/d", "a/c", "ab/x"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("listing after marker: %v, expecting %v", got, expected)
+	}
+}
+
+func TestObjectListerDelimiter(t *testing.T) {
+
+	list := fakeBucket("a/b/c", "a-b", "a/b-c", "a.txt", "a/c", "ab/x", "b")
+
+	testCases := []struct {
+		prefix    string
+		delimiter string
+		marker    string
+		expected  []string
+	}{
+		{"", "/", "", []string{"a-b", "a.txt", "b", "a/*", "ab/*"}},
+		{"a/", "/", "", []string{"a/b-c", "a/c", "a/b/*"}},
+		{"a", "/", "", []string{"a-b", "a.txt", "a/*", "ab/*"}},
+		{"", "-", "", []string{"a.txt", "a/b/c", "a/c", "ab/x", "b", "a-*", "a/b-*"}},
+		{"", "b", "", []string{"a-b*", "a.txt", "a/b*", "a/c", "ab*", "b*"}},
+		{"", "/", "a/", []string{"ab/*", "b"}},
+	}
+
+	for _, tc := range testCases {
+		got, _, _ := listAll(list, tc.prefix, tc.delimiter, tc.marker, 1000)
+		sort.Strings(got)
+		sort.Strings(tc.expected)
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("prefix %q delimiter %q marker %q: %v, expecting %v", tc.prefix, tc.delimiter, tc.marker, got, tc.expected)
+		}
+	}
+}
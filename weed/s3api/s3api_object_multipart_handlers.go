@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/server"
 )
 
 const (
@@ -203,6 +205,7 @@ func (s3a *S3ApiServer) PutObjectPartHandler(w http.ResponseWriter, r *http.Requ
 	uploadUrl := fmt.Sprintf("http://%s%s/%s/%04d.part?collection=%s",
 		s3a.option.Filer, s3a.genUploadsFolder(bucket), uploadID, partID-1, bucket)
 
+	r.Header.Del(weed_server.SeaweedExtendedHeader)
 	etag, errCode := s3a.putToFiler(r, uploadUrl, dataReader)
 
 	if errCode != ErrNone {
@@ -0,0 +1,478 @@
+package s3api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query"
+	"github.com/gorilla/mux"
+)
+
+const (
+	selectConcurrency  = 8
+	selectHeaderMaxLen = 64 * 1024
+)
+
+type SelectObjectContentRequest struct {
+	XMLName             xml.Name            `xml:"SelectObjectContentRequest"`
+	Expression          string              `xml:"Expression"`
+	ExpressionType      string              `xml:"ExpressionType"`
+	InputSerialization  InputSerialization  `xml:"InputSerialization"`
+	OutputSerialization OutputSerialization `xml:"OutputSerialization"`
+	RequestProgress     struct {
+		Enabled bool `xml:"Enabled"`
+	} `xml:"RequestProgress"`
+	ScanRange *struct{} `xml:"ScanRange"`
+}
+
+type InputSerialization struct {
+	CompressionType string `xml:"CompressionType"`
+	CSV             *struct {
+		FileHeaderInfo             string `xml:"FileHeaderInfo"`
+		Comments                   string `xml:"Comments"`
+		QuoteEscapeCharacter       string `xml:"QuoteEscapeCharacter"`
+		RecordDelimiter            string `xml:"RecordDelimiter"`
+		FieldDelimiter             string `xml:"FieldDelimiter"`
+		QuoteCharacter             string `xml:"QuoteCharacter"`
+		AllowQuotedRecordDelimiter bool   `xml:"AllowQuotedRecordDelimiter"`
+	} `xml:"CSV"`
+	JSON *struct {
+		Type string `xml:"Type"`
+	} `xml:"JSON"`
+	Parquet *struct{} `xml:"Parquet"`
+}
+
+type OutputSerialization struct {
+	CSV *struct {
+		QuoteFields          string `xml:"QuoteFields"`
+		QuoteEscapeCharacter string `xml:"QuoteEscapeCharacter"`
+		RecordDelimiter      string `xml:"RecordDelimiter"`
+		FieldDelimiter       string `xml:"FieldDelimiter"`
+		QuoteCharacter       string `xml:"QuoteCharacter"`
+	} `xml:"CSV"`
+	JSON *struct {
+		RecordDelimiter string `xml:"RecordDelimiter"`
+	} `xml:"JSON"`
+}
+
+type SelectStats struct {
+	XMLName        xml.Name
+	BytesScanned   int64 `xml:"BytesScanned"`
+	BytesProcessed int64 `xml:"BytesProcessed"`
+	BytesReturned  int64 `xml:"BytesReturned"`
+}
+
+// SelectObjectContentHandler runs S3 Select queries on the volume servers holding the object chunks
+func (s3a *S3ApiServer) SelectObjectContentHandler(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+	object := getObject(vars)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, ErrInternalError, r.URL)
+		return
+	}
+	input := &SelectObjectContentRequest{}
+	if err = xml.Unmarshal(data, input); err != nil {
+		writeErrorResponse(w, ErrMalformedXML, r.URL)
+		return
+	}
+	if input.ExpressionType != "SQL" {
+		writeErrorResponse(w, ErrInvalidExpressionType, r.URL)
+		return
+	}
+	if input.InputSerialization.Parquet != nil || input.ScanRange != nil {
+		writeErrorResponse(w, ErrNotImplemented, r.URL)
+		return
+	}
+
+	stmt, err := parseSelectStatement(input.Expression)
+	if err != nil {
+		glog.V(1).Infof("select %s%s %s: %v", bucket, object, input.Expression, err)
+		writeErrorResponse(w, ErrUnsupportedSqlStructure, r.URL)
+		return
+	}
+
+	dir, name := filer2.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object)).DirAndName()
+	entry, err := s3a.getEntry(ctx, dir, name)
+	if err != nil || entry == nil || entry.IsDirectory {
+		writeErrorResponse(w, ErrNoSuchKey, r.URL)
+		return
+	}
+
+	req := toQueryRequest(input, stmt)
+
+	totalSize := int64(filer2.TotalSize(entry.Chunks))
+	views := filer2.ViewFromChunks(entry.Chunks, 0, int(totalSize))
+
+	locations, err := s3a.lookupChunkLocations(ctx, views)
+	if err != nil {
+		glog.Errorf("select %s%s: %v", bucket, object, err)
+		writeErrorResponse(w, ErrInternalError, r.URL)
+		return
+	}
+
+	skipHeader := false
+	if csvInput := input.InputSerialization.CSV; csvInput != nil {
+		switch csvInput.FileHeaderInfo {
+		case "USE":
+			skipHeader = true
+			if req.InputSerialization.CsvInput.ColumnNames, err = readCsvHeader(views, locations, req); err != nil {
+				glog.Errorf("select %s%s read header: %v", bucket, object, err)
+				writeErrorResponse(w, ErrInternalError, r.URL)
+				return
+			}
+		case "IGNORE":
+			skipHeader = true
+		}
+	}
+
+	processor, err := query.NewProcessor(req)
+	if err != nil {
+		glog.V(1).Infof("select %s%s: %v", bucket, object, err)
+		writeErrorResponse(w, ErrUnsupportedSqlStructure, r.URL)
+		return
+	}
+
+	setCommonHeaders(w)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+
+	s3a.streamSelect(ctx, w, input, stmt, processor, req, views, locations, skipHeader, totalSize)
+}
+
+func toQueryRequest(input *SelectObjectContentRequest, stmt *selectStatement) *volume_server_pb.QueryRequest {
+
+	req := &volume_server_pb.QueryRequest{
+		Selections: stmt.selections,
+		Filter:     stmt.filter,
+		InputSerialization: &volume_server_pb.QueryRequest_InputSerialization{
+			CompressionType: input.InputSerialization.CompressionType,
+		},
+		OutputSerialization: &volume_server_pb.QueryRequest_OutputSerialization{},
+	}
+
+	if c := input.InputSerialization.CSV; c != nil {
+		req.InputSerialization.CsvInput = &volume_server_pb.QueryRequest_InputSerialization_CSVInput{
+			FileHeaderInfo:             c.FileHeaderInfo,
+			RecordDelimiter:            c.RecordDelimiter,
+			FieldDelimiter:             c.FieldDelimiter,
+			QuoteCharactoer:            c.QuoteCharacter,
+			QuoteEscapeCharacter:       c.QuoteEscapeCharacter,
+			Comments:                   c.Comments,
+			AllowQuotedRecordDelimiter: c.AllowQuotedRecordDelimiter,
+		}
+	}
+	if j := input.InputSerialization.JSON; j != nil {
+		req.InputSerialization.JsonInput = &volume_server_pb.QueryRequest_InputSerialization_JSONInput{
+			Type: j.Type,
+		}
+	}
+
+	if c := input.OutputSerialization.CSV; c != nil {
+		req.OutputSerialization.CsvOutput = &volume_server_pb.QueryRequest_OutputSerialization_CSVOutput{
+			QuoteFields:          c.QuoteFields,
+			RecordDelimiter:      c.RecordDelimiter,
+			FieldDelimiter:       c.FieldDelimiter,
+			QuoteCharactoer:      c.QuoteCharacter,
+			QuoteEscapeCharacter: c.QuoteEscapeCharacter,
+		}
+	}
+	if j := input.OutputSerialization.JSON; j != nil {
+		req.OutputSerialization.JsonOutput = &volume_server_pb.QueryRequest_OutputSerialization_JSONOutput{
+			RecordDelimiter: j.RecordDelimiter,
+		}
+	}
+
+	return req
+}
+
+type selectResult struct {
+	stripe *volume_server_pb.QueriedStripe
+	err    error
+}
+
+// streamSelect queries the chunks in parallel, and writes the results in the order of the chunks.
+// The records spanning several chunks are stitched together and evaluated here.
+func (s3a *S3ApiServer) streamSelect(ctx context.Context, w http.ResponseWriter, input *SelectObjectContentRequest, stmt *selectStatement,
+	processor *query.Processor, req *volume_server_pb.QueryRequest, views []*filer2.ChunkView, locations map[string][]string,
+	skipHeader bool, totalSize int64) {
+
+	results := make([]chan selectResult, len(views))
+	for i := range views {
+		results[i] = make(chan selectResult, 1)
+	}
+
+	go func() {
+		limiter := make(chan bool, selectConcurrency)
+		for i, view := range views {
+			select {
+			case <-ctx.Done():
+				return
+			case limiter <- true:
+			}
+			go func(i int, view *filer2.ChunkView) {
+				defer func() { <-limiter }()
+				stripe, err := s3a.queryChunkView(ctx, view, locations, processor, req)
+				results[i] <- selectResult{stripe: stripe, err: err}
+			}(i, view)
+		}
+	}()
+
+	stats := &SelectStats{}
+	outputDelimiter := processor.OutputRecordDelimiter()
+	var recordCount int64
+	done := false
+
+	emit := func(out []byte) error {
+		if done || len(out) == 0 {
+			return nil
+		}
+		if stmt.limit > 0 {
+			end := 0
+			for end < len(out) && recordCount < stmt.limit {
+				k := bytes.Index(out[end:], outputDelimiter)
+				if k < 0 {
+					end = len(out)
+					break
+				}
+				end += k + len(outputDelimiter)
+				recordCount++
+			}
+			out = out[:end]
+			done = recordCount >= stmt.limit
+		}
+		stats.BytesReturned += int64(len(out))
+		return writeRecordsEvent(w, out)
+	}
+
+	var pending []byte
+	for i, view := range views {
+
+		result := <-results[i]
+		if result.err != nil {
+			glog.Errorf("select %s: %v", view.FileId, result.err)
+			writeErrorEvent(w, "InternalError", result.err.Error())
+			return
+		}
+		stripe := result.stripe
+
+		if !stripe.HasRecordDelimiter {
+			pending = append(pending, stripe.Head...)
+		} else {
+			record := append(pending, stripe.Head...)
+			if skipHeader {
+				skipHeader = false
+			} else if err := emit(processor.QueryRecord(nil, record)); err != nil {
+				return
+			}
+			if err := emit(stripe.Records); err != nil {
+				return
+			}
+			pending = append([]byte(nil), stripe.Tail...)
+		}
+
+		stats.BytesScanned += int64(view.Size)
+		stats.BytesProcessed += int64(view.Size)
+		if input.RequestProgress.Enabled {
+			stats.XMLName = xml.Name{Local: "Progress"}
+			writeProgressEvent(w, encodeStats(stats))
+		}
+		w.(http.Flusher).Flush()
+
+		if done {
+			break
+		}
+	}
+
+	if len(pending) > 0 && !skipHeader {
+		if err := emit(processor.QueryRecord(nil, pending)); err != nil {
+			return
+		}
+	}
+
+	stats.BytesScanned, stats.BytesProcessed = totalSize, totalSize
+	stats.XMLName = xml.Name{Local: "Stats"}
+	writeStatsEvent(w, encodeStats(stats))
+	writeEndEvent(w)
+	w.(http.Flusher).Flush()
+}
+
+func encodeStats(stats *SelectStats) []byte {
+	data, _ := xml.Marshal(stats)
+	return data
+}
+
+// queryChunkView runs the query on a volume server holding the chunk,
+// or locally if only part of the chunk is visible.
+func (s3a *S3ApiServer) queryChunkView(ctx context.Context, view *filer2.ChunkView, locations map[string][]string,
+	processor *query.Processor, req *volume_server_pb.QueryRequest) (stripe *volume_server_pb.QueriedStripe, err error) {
+
+	urls, err := chunkLocations(view.FileId, locations)
+	if err != nil {
+		return nil, err
+	}
+
+	if !view.IsFullChunk {
+		data, err := readChunkView(urls, view.FileId, view.Offset, int(view.Size))
+		if err != nil {
+			return nil, err
+		}
+		return processor.Query(data), nil
+	}
+
+	chunkReq := *req
+	chunkReq.FromFileIds = []string{view.FileId}
+
+	for _, url := range urls {
+		err = operation.WithVolumeServerClient(url, s3a.option.GrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			stream, err := client.Query(ctx, &chunkReq)
+			if err != nil {
+				return err
+			}
+			stripe = &volume_server_pb.QueriedStripe{}
+			for {
+				resp, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				stripe = resp
+			}
+		})
+		if err == nil {
+			return stripe, nil
+		}
+		glog.V(1).Infof("query %s on %s: %v", view.FileId, url, err)
+	}
+
+	return nil, fmt.Errorf("query %s: %v", view.FileId, err)
+}
+
+// readCsvHeader reads the first record of the object
+func readCsvHeader(views []*filer2.ChunkView, locations map[string][]string, req *volume_server_pb.QueryRequest) ([]string, error) {
+
+	if len(views) == 0 {
+		return nil, nil
+	}
+
+	csvInput := req.InputSerialization.CsvInput
+	delimiter := []byte("\n")
+	if csvInput.RecordDelimiter != "" {
+		delimiter = []byte(csvInput.RecordDelimiter)
+	}
+
+	var data []byte
+	for _, view := range views {
+		urls, err := chunkLocations(view.FileId, locations)
+		if err != nil {
+			return nil, err
+		}
+		size := int(view.Size)
+		if size > selectHeaderMaxLen {
+			size = selectHeaderMaxLen
+		}
+		chunk, err := readChunkView(urls, view.FileId, view.Offset, size)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, chunk...)
+		if k := bytes.Index(data, delimiter); k >= 0 {
+			data = data[:k]
+			break
+		}
+		if len(data) >= selectHeaderMaxLen || size < int(view.Size) {
+			return nil, fmt.Errorf("header longer than %d bytes", selectHeaderMaxLen)
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	if csvInput.FieldDelimiter != "" {
+		reader.Comma = []rune(csvInput.FieldDelimiter)[0]
+	}
+	reader.LazyQuotes = true
+	return reader.Read()
+}
+
+func readChunkView(urls []string, fileId string, offset int64, size int) (data []byte, err error) {
+	reader := filer2.NewChunkReader(func(fileId string) (targetUrls []string, err error) {
+		for _, url := range urls {
+			targetUrls = append(targetUrls, fmt.Sprintf("http://%s/%s", url, fileId))
+		}
+		return targetUrls, nil
+	}, 1)
+	data = make([]byte, size)
+	if err = reader.ReadChunkView(&filer2.ChunkView{FileId: fileId, Offset: offset, Size: uint64(size)}, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func chunkLocations(fileId string, locations map[string][]string) ([]string, error) {
+	vid, _, err := operation.ParseFileId(fileId)
+	if err != nil {
+		return nil, err
+	}
+	urls := locations[vid]
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("volume %s not found", vid)
+	}
+	return urls, nil
+}
+
+// lookupChunkLocations finds the volume server urls of all volumes of the chunks
+func (s3a *S3ApiServer) lookupChunkLocations(ctx context.Context, views []*filer2.ChunkView) (map[string][]string, error) {
+
+	var vids []string
+	seen := make(map[string]bool)
+	for _, view := range views {
+		vid, _, err := operation.ParseFileId(view.FileId)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[vid] {
+			seen[vid] = true
+			vids = append(vids, vid)
+		}
+	}
+
+	locations := make(map[string][]string)
+	if len(vids) == 0 {
+		return locations, nil
+	}
+
+	err := s3a.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.LookupVolume(ctx, &filer_pb.LookupVolumeRequest{
+			VolumeIds: vids,
+		})
+		if err != nil {
+			return err
+		}
+		for vid, locs := range resp.LocationsMap {
+			for _, loc := range locs.Locations {
+				locations[vid] = append(locations[vid], loc.Url)
+			}
+		}
+		return nil
+	})
+
+	return locations, err
+}
@@ -2,10 +2,11 @@ package s3api
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/xml"
 	"fmt"
 	"net/http"
 	"net/url"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -18,8 +19,46 @@ import (
 
 const (
 	maxObjectListSizeLimit = 1000 // Limit number of objects in a listObjectsResponse.
+	objectListPageSize     = 1024 // number of entries to read from the filer at a time
 )
 
+// ListBucketResultV2 is the ListObjectsV2 response, which is not in the generated schema
+type ListBucketResultV2 struct {
+	XMLName               xml.Name      `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name                  string        `xml:"Name"`
+	Prefix                string        `xml:"Prefix"`
+	MaxKeys               int           `xml:"MaxKeys"`
+	Delimiter             string        `xml:"Delimiter,omitempty"`
+	IsTruncated           bool          `xml:"IsTruncated"`
+	Contents              []ListEntryV2 `xml:"Contents,omitempty"`
+	CommonPrefixes        []PrefixEntry `xml:"CommonPrefixes,omitempty"`
+	ContinuationToken     string        `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string        `xml:"NextContinuationToken,omitempty"`
+	KeyCount              int           `xml:"KeyCount"`
+	StartAfter            string        `xml:"StartAfter,omitempty"`
+}
+
+// ListEntryV2 only carries the owner if fetch-owner is requested
+type ListEntryV2 struct {
+	Key          string         `xml:"Key"`
+	LastModified time.Time      `xml:"LastModified"`
+	ETag         string         `xml:"ETag"`
+	Size         int64          `xml:"Size"`
+	Owner        *CanonicalUser `xml:"Owner,omitempty"`
+	StorageClass StorageClass   `xml:"StorageClass"`
+}
+
+func (t *ListEntryV2) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type T ListEntryV2
+	var layout struct {
+		*T
+		LastModified *xsdDateTime `xml:"LastModified"`
+	}
+	layout.T = (*T)(t)
+	layout.LastModified = (*xsdDateTime)(&layout.T.LastModified)
+	return e.EncodeElement(layout, start)
+}
+
 func (s3a *S3ApiServer) ListObjectsV2Handler(w http.ResponseWriter, r *http.Request) {
 
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/v2-RESTBucketGET.html
@@ -30,30 +69,60 @@ func (s3a *S3ApiServer) ListObjectsV2Handler(w http.ResponseWriter, r *http.Requ
 
 	glog.V(4).Infof("read v2: %v", vars)
 
-	originalPrefix, marker, startAfter, delimiter, _, maxKeys := getListObjectsV2Args(r.URL.Query())
+	originalPrefix, token, startAfter, delimiter, fetchOwner, maxKeys := getListObjectsV2Args(r.URL.Query())
 
 	if maxKeys < 0 {
 		writeErrorResponse(w, ErrInvalidMaxKeys, r.URL)
 		return
 	}
-	if delimiter != "" && delimiter != "/" {
-		writeErrorResponse(w, ErrNotImplemented, r.URL)
-		return
-	}
 
-	if marker == "" {
-		marker = startAfter
+	marker := startAfter
+	if token != "" {
+		var ok bool
+		if marker, ok = decodeContinuationToken(token); !ok {
+			writeErrorResponse(w, ErrInvalidContinuationToken, r.URL)
+			return
+		}
 	}
 
 	ctx := context.Background()
 
-	response, err := s3a.listFilerEntries(ctx, bucket, originalPrefix, maxKeys, marker)
+	lister, err := s3a.listFilerEntries(ctx, bucket, originalPrefix, delimiter, marker, maxKeys)
 
 	if err != nil {
 		writeErrorResponse(w, ErrInternalError, r.URL)
 		return
 	}
 
+	response := ListBucketResultV2{
+		Name:              bucket,
+		Prefix:            originalPrefix,
+		MaxKeys:           maxKeys,
+		Delimiter:         delimiter,
+		IsTruncated:       lister.isTruncated,
+		CommonPrefixes:    lister.commonPrefixEntries(),
+		ContinuationToken: token,
+		KeyCount:          len(lister.objects) + len(lister.commonPrefixes),
+		StartAfter:        startAfter,
+	}
+	if lister.isTruncated {
+		response.NextContinuationToken = encodeContinuationToken(lister.nextMarker)
+	}
+	for _, object := range lister.objects {
+		listEntry := newListEntry(object.key, object.entry)
+		entry := ListEntryV2{
+			Key:          listEntry.Key,
+			LastModified: listEntry.LastModified,
+			ETag:         listEntry.ETag,
+			Size:         listEntry.Size,
+			StorageClass: listEntry.StorageClass,
+		}
+		if fetchOwner {
+			entry.Owner = &listEntry.Owner
+		}
+		response.Contents = append(response.Contents, entry)
+	}
+
 	writeSuccessResponseXML(w, encodeResponse(response))
 }
 
@@ -73,96 +142,81 @@ func (s3a *S3ApiServer) ListObjectsV1Handler(w http.ResponseWriter, r *http.Requ
 		writeErrorResponse(w, ErrInvalidMaxKeys, r.URL)
 		return
 	}
-	if delimiter != "" && delimiter != "/" {
-		writeErrorResponse(w, ErrNotImplemented, r.URL)
-		return
-	}
 
-	response, err := s3a.listFilerEntries(ctx, bucket, originalPrefix, maxKeys, marker)
+	lister, err := s3a.listFilerEntries(ctx, bucket, originalPrefix, delimiter, marker, maxKeys)
 
 	if err != nil {
 		writeErrorResponse(w, ErrInternalError, r.URL)
 		return
 	}
 
+	response := ListBucketResult{
+		Name:           bucket,
+		Prefix:         originalPrefix,
+		Marker:         marker,
+		MaxKeys:        maxKeys,
+		Delimiter:      delimiter,
+		IsTruncated:    lister.isTruncated,
+		CommonPrefixes: lister.commonPrefixEntries(),
+	}
+	if lister.isTruncated {
+		response.NextMarker = lister.nextMarker
+	}
+	for _, object := range lister.objects {
+		response.Contents = append(response.Contents, newListEntry(object.key, object.entry))
+	}
+
 	writeSuccessResponseXML(w, encodeResponse(response))
 }
 
-func (s3a *S3ApiServer) listFilerEntries(ctx context.Context, bucket, originalPrefix string, maxKeys int, marker string) (response ListBucketResult, err error) {
-
-	// convert full path prefix into directory name and prefix for entry name
-	dir, prefix := filepath.Split(originalPrefix)
-	if strings.HasPrefix(dir, "/") {
-		dir = dir[1:]
+func (s3a *S3ApiServer) listFilerEntries(ctx context.Context, bucket, originalPrefix, delimiter, marker string, maxKeys int) (lister *objectLister, err error) {
+
+	lister = &objectLister{
+		list: func(dir, startFrom string, inclusive bool, limit int) ([]*filer_pb.Entry, error) {
+			return s3a.list(ctx, dir, "", startFrom, inclusive, limit)
+		},
+		bucketDir: fmt.Sprintf("%s/%s", s3a.option.BucketsPath, bucket),
+		prefix:    strings.TrimPrefix(originalPrefix, "/"),
+		delimiter: delimiter,
+		marker:    marker,
+		maxKeys:   maxKeys,
 	}
 
-	// check filer
-	err = s3a.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
-
-		request := &filer_pb.ListEntriesRequest{
-			Directory:          fmt.Sprintf("%s/%s/%s", s3a.option.BucketsPath, bucket, dir),
-			Prefix:             prefix,
-			Limit:              uint32(maxKeys + 1),
-			StartFromFileName:  marker,
-			InclusiveStartFrom: false,
-		}
-
-		resp, err := client.ListEntries(ctx, request)
-		if err != nil {
-			return fmt.Errorf("list buckets: %v", err)
-		}
+	if maxKeys > 0 {
+		err = lister.walk("")
+	}
 
-		var contents []ListEntry
-		var commonPrefixes []PrefixEntry
-		var counter int
-		var lastEntryName string
-		var isTruncated bool
-		for _, entry := range resp.Entries {
-			counter++
-			if counter > maxKeys {
-				isTruncated = true
-				break
-			}
-			lastEntryName = entry.Name
-			if entry.IsDirectory {
-				if entry.Name != ".uploads" {
-					commonPrefixes = append(commonPrefixes, PrefixEntry{
-						Prefix: fmt.Sprintf("%s%s/", dir, entry.Name),
-					})
-				}
-			} else {
-				contents = append(contents, ListEntry{
-					Key:          fmt.Sprintf("%s%s", dir, entry.Name),
-					LastModified: time.Unix(entry.Attributes.Mtime, 0),
-					ETag:         "\"" + filer2.ETag(entry.Chunks) + "\"",
-					Size:         int64(filer2.TotalSize(entry.Chunks)),
-					Owner: CanonicalUser{
-						ID:          fmt.Sprintf("%x", entry.Attributes.Uid),
-						DisplayName: entry.Attributes.UserName,
-					},
-					StorageClass: "STANDARD",
-				})
-			}
-		}
+	glog.V(4).Infof("list bucket %s prefix %s delimiter %s marker %s: %d objects, %d prefixes, truncated %v",
+		bucket, originalPrefix, delimiter, marker, len(lister.objects), len(lister.commonPrefixes), lister.isTruncated)
 
-		response = ListBucketResult{
-			Name:           bucket,
-			Prefix:         originalPrefix,
-			Marker:         marker,
-			NextMarker:     lastEntryName,
-			MaxKeys:        maxKeys,
-			Delimiter:      "/",
-			IsTruncated:    isTruncated,
-			Contents:       contents,
-			CommonPrefixes: commonPrefixes,
-		}
+	return
+}
 
-		glog.V(4).Infof("read directory: %v, found: %v, %+v", request, counter, response)
+func newListEntry(key string, entry *filer_pb.Entry) ListEntry {
+	return ListEntry{
+		Key:          key,
+		LastModified: time.Unix(entry.Attributes.Mtime, 0).UTC(),
+		ETag:         "\"" + filer2.ETag(entry.Chunks) + "\"",
+		Size:         int64(filer2.TotalSize(entry.Chunks)),
+		Owner: CanonicalUser{
+			ID:          fmt.Sprintf("%x", entry.Attributes.Uid),
+			DisplayName: entry.Attributes.UserName,
+		},
+		StorageClass: "STANDARD",
+	}
+}
 
-		return nil
-	})
+// the continuation token is the last key or common prefix returned, opaque to the clients
+func encodeContinuationToken(marker string) string {
+	return base64.StdEncoding.EncodeToString([]byte(marker))
+}
 
-	return
+func decodeContinuationToken(token string) (marker string, ok bool) {
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
 }
 
 func getListObjectsV2Args(values url.Values) (prefix, token, startAfter, delimiter string, fetchOwner bool, maxkeys int) {
@@ -0,0 +1,84 @@
+package s3api
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+)
+
+// event stream framing of SelectObjectContent responses, see
+// https://docs.aws.amazon.com/AmazonS3/latest/API/RESTSelectObjectAppendix.html
+//
+//   total length (4) | headers length (4) | prelude crc (4) | headers | payload | message crc (4)
+
+const eventHeaderTypeString = 7
+
+type eventHeader struct {
+	name  string
+	value string
+}
+
+func writeEventMessage(w io.Writer, headers []eventHeader, payload []byte) error {
+
+	var headerBuf bytes.Buffer
+	for _, h := range headers {
+		headerBuf.WriteByte(byte(len(h.name)))
+		headerBuf.WriteString(h.name)
+		headerBuf.WriteByte(eventHeaderTypeString)
+		binary.Write(&headerBuf, binary.BigEndian, uint16(len(h.value)))
+		headerBuf.WriteString(h.value)
+	}
+
+	totalLength := 4 + 4 + 4 + headerBuf.Len() + len(payload) + 4
+
+	var message bytes.Buffer
+	binary.Write(&message, binary.BigEndian, uint32(totalLength))
+	binary.Write(&message, binary.BigEndian, uint32(headerBuf.Len()))
+	binary.Write(&message, binary.BigEndian, crc32.ChecksumIEEE(message.Bytes()))
+	message.Write(headerBuf.Bytes())
+	message.Write(payload)
+	binary.Write(&message, binary.BigEndian, crc32.ChecksumIEEE(message.Bytes()))
+
+	_, err := w.Write(message.Bytes())
+	return err
+}
+
+func writeRecordsEvent(w io.Writer, records []byte) error {
+	return writeEventMessage(w, []eventHeader{
+		{":event-type", "Records"},
+		{":content-type", "application/octet-stream"},
+		{":message-type", "event"},
+	}, records)
+}
+
+func writeStatsEvent(w io.Writer, stats []byte) error {
+	return writeEventMessage(w, []eventHeader{
+		{":event-type", "Stats"},
+		{":content-type", "text/xml"},
+		{":message-type", "event"},
+	}, stats)
+}
+
+func writeProgressEvent(w io.Writer, progress []byte) error {
+	return writeEventMessage(w, []eventHeader{
+		{":event-type", "Progress"},
+		{":content-type", "text/xml"},
+		{":message-type", "event"},
+	}, progress)
+}
+
+func writeEndEvent(w io.Writer) error {
+	return writeEventMessage(w, []eventHeader{
+		{":event-type", "End"},
+		{":message-type", "event"},
+	}, nil)
+}
+
+func writeErrorEvent(w io.Writer, code, message string) error {
+	return writeEventMessage(w, []eventHeader{
+		{":error-code", code},
+		{":error-message", message},
+		{":message-type", "error"},
+	}, nil)
+}
@@ -0,0 +1,305 @@
+package s3api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+)
+
+// selectStatement is the part of S3 Select SQL that can be pushed down to the volume servers,
+// in the form of SELECT * | field, ... FROM S3Object [[AS] alias] [WHERE field op literal] [LIMIT n]
+type selectStatement struct {
+	selections []string
+	filter     *volume_server_pb.QueryRequest_Filter
+	limit      int64 // 0 means no limit
+}
+
+type sqlToken struct {
+	text     string
+	isQuoted bool // a 'string' literal
+	isIdent  bool // a "quoted" identifier
+}
+
+func parseSelectStatement(sql string) (stmt *selectStatement, err error) {
+
+	tokens, err := tokenizeSql(sql)
+	if err != nil {
+		return nil, err
+	}
+	p := &sqlParser{tokens: tokens}
+	stmt = &selectStatement{}
+
+	if !p.keyword("SELECT") {
+		return nil, fmt.Errorf("expecting SELECT")
+	}
+
+	var fields []string
+	if p.peek("*") {
+		p.next()
+	} else {
+		for {
+			field, err := p.field()
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, field)
+			if !p.peek(",") {
+				break
+			}
+			p.next()
+		}
+	}
+
+	if !p.keyword("FROM") {
+		return nil, fmt.Errorf("expecting FROM")
+	}
+	from := p.next()
+	if from == nil || !strings.EqualFold(strings.TrimSuffix(from.text, "[*]"), "S3Object") {
+		return nil, fmt.Errorf("expecting S3Object")
+	}
+	p.keyword("AS")
+	if t := p.current(); t != nil && !isSqlKeyword(t.text) && (t.isIdent || isSqlIdentifier(t.text)) {
+		p.alias = t.text
+		p.next()
+	}
+
+	for _, field := range fields {
+		stmt.selections = append(stmt.selections, p.stripAlias(field))
+	}
+
+	if p.keyword("WHERE") {
+		if stmt.filter, err = p.condition(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.keyword("LIMIT") {
+		t := p.next()
+		if t == nil {
+			return nil, fmt.Errorf("expecting LIMIT count")
+		}
+		if stmt.limit, err = strconv.ParseInt(t.text, 10, 64); err != nil || stmt.limit < 0 {
+			return nil, fmt.Errorf("invalid LIMIT %s", t.text)
+		}
+	}
+
+	if p.peek(";") {
+		p.next()
+	}
+	if t := p.current(); t != nil {
+		return nil, fmt.Errorf("unsupported %s", t.text)
+	}
+
+	return stmt, nil
+}
+
+type sqlParser struct {
+	tokens []*sqlToken
+	pos    int
+	alias  string
+}
+
+func (p *sqlParser) current() *sqlToken {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *sqlParser) next() *sqlToken {
+	t := p.current()
+	if t != nil {
+		p.pos++
+	}
+	return t
+}
+
+func (p *sqlParser) peek(text string) bool {
+	t := p.current()
+	return t != nil && !t.isQuoted && !t.isIdent && t.text == text
+}
+
+// keyword consumes the keyword if it is the current token
+func (p *sqlParser) keyword(word string) bool {
+	t := p.current()
+	if t != nil && !t.isQuoted && !t.isIdent && strings.EqualFold(t.text, word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// field reads a path like s.a.b, s."a b", or _1
+func (p *sqlParser) field() (string, error) {
+	var parts []string
+	for {
+		t := p.next()
+		if t == nil || t.isQuoted || (!t.isIdent && !isSqlIdentifier(t.text)) {
+			return "", fmt.Errorf("expecting a field name")
+		}
+		if !t.isIdent && isSqlKeyword(t.text) {
+			return "", fmt.Errorf("unexpected %s", t.text)
+		}
+		parts = append(parts, t.text)
+		if !p.peek(".") {
+			break
+		}
+		p.next()
+	}
+	return strings.Join(parts, "."), nil
+}
+
+func (p *sqlParser) stripAlias(field string) string {
+	for _, prefix := range []string{p.alias, "S3Object", "s3object"} {
+		if prefix != "" && strings.HasPrefix(field, prefix+".") {
+			return field[len(prefix)+1:]
+		}
+	}
+	return field
+}
+
+func (p *sqlParser) condition() (*volume_server_pb.QueryRequest_Filter, error) {
+
+	field, err := p.field()
+	if err != nil {
+		return nil, err
+	}
+	filter := &volume_server_pb.QueryRequest_Filter{
+		Field: p.stripAlias(field),
+	}
+
+	switch {
+	case p.keyword("IS"):
+		if !p.keyword("NOT") || !p.keyword("NULL") {
+			return nil, fmt.Errorf("only IS NOT NULL is supported")
+		}
+		return filter, nil
+	case p.keyword("NOT"):
+		if !p.keyword("LIKE") {
+			return nil, fmt.Errorf("expecting LIKE")
+		}
+		filter.Operand = "!%"
+	case p.keyword("LIKE"):
+		filter.Operand = "%"
+	default:
+		op := p.next()
+		if op == nil {
+			return nil, fmt.Errorf("expecting an operator")
+		}
+		switch op.text {
+		case "=", "!=", "<", "<=", ">", ">=":
+			filter.Operand = op.text
+		case "<>":
+			filter.Operand = "!="
+		default:
+			return nil, fmt.Errorf("unsupported operator %s", op.text)
+		}
+	}
+
+	value := p.next()
+	if value == nil || value.isIdent {
+		return nil, fmt.Errorf("expecting a literal")
+	}
+	if !value.isQuoted {
+		if _, err := strconv.ParseFloat(value.text, 64); err != nil && !strings.EqualFold(value.text, "true") && !strings.EqualFold(value.text, "false") {
+			return nil, fmt.Errorf("unsupported literal %s", value.text)
+		}
+		value.text = strings.ToLower(value.text)
+	}
+	filter.Value = value.text
+	if filter.Operand == "%" || filter.Operand == "!%" {
+		filter.Value = likeToPattern(value.text)
+	}
+
+	if t := p.current(); t != nil && (strings.EqualFold(t.text, "AND") || strings.EqualFold(t.text, "OR")) {
+		return nil, fmt.Errorf("only one condition is supported")
+	}
+
+	return filter, nil
+}
+
+// likeToPattern converts the SQL LIKE wildcards to the glob wildcards
+func likeToPattern(like string) string {
+	return strings.NewReplacer("%", "*", "_", "?").Replace(like)
+}
+
+func tokenizeSql(sql string) (tokens []*sqlToken, err error) {
+	runes := []rune(sql)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '\'' || c == '"':
+			var text []rune
+			j := i + 1
+			for ; j < len(runes); j++ {
+				if runes[j] == c {
+					if j+1 < len(runes) && runes[j+1] == c {
+						text = append(text, c)
+						j++
+						continue
+					}
+					break
+				}
+				text = append(text, runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated %c", c)
+			}
+			tokens = append(tokens, &sqlToken{text: string(text), isQuoted: c == '\'', isIdent: c == '"'})
+			i = j + 1
+		case strings.ContainsRune("<>!=", c):
+			j := i + 1
+			for j < len(runes) && strings.ContainsRune("<>=", runes[j]) {
+				j++
+			}
+			tokens = append(tokens, &sqlToken{text: string(runes[i:j])})
+			i = j
+		case strings.ContainsRune(",.*;()", c):
+			tokens = append(tokens, &sqlToken{text: string(c)})
+			i++
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("'\"<>!=,.;()", runes[j]) {
+				// keep the decimal point of numbers and S3Object[*]
+				j++
+				if j < len(runes) && runes[j] == '.' && unicode.IsDigit(runes[j-1]) && j+1 < len(runes) && unicode.IsDigit(runes[j+1]) && isSqlNumberPrefix(runes[i:j]) {
+					j++
+				}
+			}
+			tokens = append(tokens, &sqlToken{text: string(runes[i:j])})
+			i = j
+		}
+	}
+	return
+}
+
+func isSqlNumberPrefix(runes []rune) bool {
+	for k, r := range runes {
+		if !unicode.IsDigit(r) && !(k == 0 && r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func isSqlIdentifier(text string) bool {
+	for i, r := range text {
+		if !(r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r))) {
+			return false
+		}
+	}
+	return text != ""
+}
+
+func isSqlKeyword(text string) bool {
+	switch strings.ToUpper(text) {
+	case "SELECT", "FROM", "WHERE", "AS", "LIMIT", "AND", "OR", "NOT", "LIKE", "IS", "NULL":
+		return true
+	}
+	return false
+}
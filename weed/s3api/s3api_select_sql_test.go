@@ -0,0 +1,46 @@
+package s3api
+
+import (
+	"testing"
+)
+
+func TestParseSelectStatement(t *testing.T) {
+
+	stmt, err := parseSelectStatement(`SELECT s.name, s."first name", s.address.city FROM S3Object s WHERE s.age >= 21 LIMIT 10`)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(stmt.selections) != 3 || stmt.selections[0] != "name" || stmt.selections[1] != "first name" || stmt.selections[2] != "address.city" {
+		t.Errorf("unexpected selections %v", stmt.selections)
+	}
+	if stmt.filter == nil || stmt.filter.Field != "age" || stmt.filter.Operand != ">=" || stmt.filter.Value != "21" {
+		t.Errorf("unexpected filter %+v", stmt.filter)
+	}
+	if stmt.limit != 10 {
+		t.Errorf("unexpected limit %d", stmt.limit)
+	}
+
+	stmt, err = parseSelectStatement(`select * from S3Object[*] where _2 like 'a%' `)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(stmt.selections) != 0 || stmt.filter.Field != "_2" || stmt.filter.Operand != "%" || stmt.filter.Value != "a*" {
+		t.Errorf("unexpected statement %+v %+v", stmt, stmt.filter)
+	}
+
+	stmt, err = parseSelectStatement(`SELECT * FROM S3Object WHERE price < 1.5`)
+	if err != nil || stmt.filter.Value != "1.5" {
+		t.Errorf("unexpected %+v: %v", stmt, err)
+	}
+
+	for _, sql := range []string{
+		`SELECT a FROM S3Object WHERE a = 1 AND b = 2`,
+		`SELECT count(*) FROM S3Object`,
+		`DELETE FROM S3Object`,
+		`SELECT a FROM S3Object WHERE a = 'x`,
+	} {
+		if _, err := parseSelectStatement(sql); err == nil {
+			t.Errorf("expected error for %s", sql)
+		}
+	}
+}
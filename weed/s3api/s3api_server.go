@@ -10,6 +10,7 @@ import (
 	"github.com/gorilla/mux"
 	"google.golang.org/grpc"
 	"net/http"
+	"time"
 )
 
 type S3ApiServerOption struct {
@@ -18,10 +19,13 @@ type S3ApiServerOption struct {
 	DomainName       string
 	BucketsPath      string
 	GrpcDialOption   grpc.DialOption
+	// how often to apply the bucket lifecycle rules, 0 to disable
+	LifecycleInterval time.Duration
 }
 
 type S3ApiServer struct {
-	option *S3ApiServerOption
+	option        *S3ApiServerOption
+	bucketConfigs bucketConfigCache
 }
 
 func NewS3ApiServer(router *mux.Router, option *S3ApiServerOption) (s3ApiServer *S3ApiServer, err error) {
@@ -31,6 +35,10 @@ func NewS3ApiServer(router *mux.Router, option *S3ApiServerOption) (s3ApiServer
 
 	s3ApiServer.registerRouter(router)
 
+	if option.LifecycleInterval > 0 {
+		go s3ApiServer.loopProcessingLifecycle(option.LifecycleInterval)
+	}
+
 	return s3ApiServer, nil
 }
 
@@ -45,6 +53,12 @@ func (s3a *S3ApiServer) registerRouter(router *mux.Router) {
 
 	for _, bucket := range routers {
 
+		bucket.Use(s3a.corsMiddleware)
+
+		// Preflight of cross-origin requests
+		bucket.Methods("OPTIONS").Path("/{object:.+}").HandlerFunc(s3a.PreflightHandler)
+		bucket.Methods("OPTIONS").HandlerFunc(s3a.PreflightHandler)
+
 		// HeadObject
 		bucket.Methods("HEAD").Path("/{object:.+}").HandlerFunc(s3a.HeadObjectHandler)
 		// HeadBucket
@@ -52,6 +66,8 @@ func (s3a *S3ApiServer) registerRouter(router *mux.Router) {
 
 		// PutObjectPart
 		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(s3a.PutObjectPartHandler).Queries("partNumber", "{partNumber:[0-9]+}", "uploadId", "{uploadId:.*}")
+		// SelectObjectContent
+		bucket.Methods("POST").Path("/{object:.+}").HandlerFunc(s3a.SelectObjectContentHandler).Queries("select", "", "select-type", "2")
 		// CompleteMultipartUpload
 		bucket.Methods("POST").Path("/{object:.+}").HandlerFunc(s3a.CompleteMultipartUploadHandler).Queries("uploadId", "{uploadId:.*}")
 		// NewMultipartUpload
@@ -65,14 +81,26 @@ func (s3a *S3ApiServer) registerRouter(router *mux.Router) {
 
 		// PutObject
 		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(s3a.PutObjectHandler)
+		// PutBucketLifecycleConfiguration
+		bucket.Methods("PUT").HandlerFunc(s3a.PutBucketLifecycleConfigurationHandler).Queries("lifecycle", "")
+		// PutBucketCors
+		bucket.Methods("PUT").HandlerFunc(s3a.PutBucketCorsHandler).Queries("cors", "")
 		// PutBucket
 		bucket.Methods("PUT").HandlerFunc(s3a.PutBucketHandler)
 
 		// DeleteObject
 		bucket.Methods("DELETE").Path("/{object:.+}").HandlerFunc(s3a.DeleteObjectHandler)
+		// DeleteBucketLifecycle
+		bucket.Methods("DELETE").HandlerFunc(s3a.DeleteBucketLifecycleHandler).Queries("lifecycle", "")
+		// DeleteBucketCors
+		bucket.Methods("DELETE").HandlerFunc(s3a.DeleteBucketCorsHandler).Queries("cors", "")
 		// DeleteBucket
 		bucket.Methods("DELETE").HandlerFunc(s3a.DeleteBucketHandler)
 
+		// GetBucketLifecycleConfiguration
+		bucket.Methods("GET").HandlerFunc(s3a.GetBucketLifecycleConfigurationHandler).Queries("lifecycle", "")
+		// GetBucketCors
+		bucket.Methods("GET").HandlerFunc(s3a.GetBucketCorsHandler).Queries("cors", "")
 		// ListObjectsV2
 		bucket.Methods("GET").HandlerFunc(s3a.ListObjectsV2Handler).Queries("list-type", "2")
 		// GetObject, but directory listing is not supported
@@ -14,6 +14,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
 func (fs *FilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.LookupDirectoryEntryRequest) (*filer_pb.LookupDirectoryEntryResponse, error) {
@@ -29,57 +30,35 @@ func (fs *FilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.L
 			IsDirectory: entry.IsDirectory(),
 			Attributes:  filer2.EntryAttributeToPb(entry),
 			Chunks:      entry.Chunks,
+			Extended:    entry.Extended,
 		},
 	}, nil
 }
 
-func (fs *FilerServer) ListEntries(ctx context.Context, req *filer_pb.ListEntriesRequest) (*filer_pb.ListEntriesResponse, error) {
+func (fs *FilerServer) ListEntries(req *filer_pb.ListEntriesRequest, stream filer_pb.SeaweedFiler_ListEntriesServer) (err error) {
 
 	limit := int(req.Limit)
 	if limit == 0 {
 		limit = fs.option.DirListingLimit
 	}
 
-	resp := &filer_pb.ListEntriesResponse{}
-	lastFileName := req.StartFromFileName
-	includeLastFile := req.InclusiveStartFrom
-	for limit > 0 {
-		entries, err := fs.filer.ListDirectoryEntries(ctx, filer2.FullPath(req.Directory), lastFileName, includeLastFile, 1024)
-		if err != nil {
-			return nil, err
-		}
-		if len(entries) == 0 {
-			return resp, nil
-		}
-
-		includeLastFile = false
-
-		for _, entry := range entries {
-
-			lastFileName = entry.Name()
-
-			if req.Prefix != "" {
-				if !strings.HasPrefix(entry.Name(), req.Prefix) {
-					continue
-				}
-			}
-
-			resp.Entries = append(resp.Entries, &filer_pb.Entry{
+	var sendErr error
+	err = fs.filer.ListDirectoryPrefixedEntries(stream.Context(), filer2.FullPath(req.Directory), req.StartFromFileName, req.InclusiveStartFrom, limit, req.Prefix, func(entry *filer2.Entry) bool {
+		sendErr = stream.Send(&filer_pb.ListEntriesResponse{
+			Entry: &filer_pb.Entry{
 				Name:        entry.Name(),
 				IsDirectory: entry.IsDirectory(),
 				Chunks:      entry.Chunks,
 				Attributes:  filer2.EntryAttributeToPb(entry),
-			})
-			limit--
-		}
-
-		if len(resp.Entries) < 1024 {
-			break
-		}
-
+				Extended:    entry.Extended,
+			},
+		})
+		return sendErr == nil
+	})
+	if err != nil {
+		return err
 	}
-
-	return resp, nil
+	return sendErr
 }
 
 func (fs *FilerServer) LookupVolume(ctx context.Context, req *filer_pb.LookupVolumeRequest) (*filer_pb.LookupVolumeResponse, error) {
@@ -101,8 +80,9 @@ func (fs *FilerServer) LookupVolume(ctx context.Context, req *filer_pb.LookupVol
 		}
 		for _, loc := range locations {
 			locs = append(locs, &filer_pb.Location{
-				Url:       loc.Url,
-				PublicUrl: loc.PublicUrl,
+				Url:        loc.Url,
+				PublicUrl:  loc.PublicUrl,
+				DataCenter: loc.DataCenter,
 			})
 		}
 		resp.LocationsMap[vidString] = &filer_pb.Locations{
@@ -122,10 +102,19 @@ func (fs *FilerServer) CreateEntry(ctx context.Context, req *filer_pb.CreateEntr
 		return nil, fmt.Errorf("can not create entry with empty attributes")
 	}
 
+	extended := req.Entry.Extended
+	if !req.SetExtended {
+		// keep the extended attributes of an existing entry for clients unaware of them
+		if existing, findErr := fs.filer.FindEntry(ctx, fullpath); findErr == nil {
+			extended = existing.Extended
+		}
+	}
+
 	err = fs.filer.CreateEntry(ctx, &filer2.Entry{
 		FullPath: fullpath,
 		Attr:     filer2.PbToEntryAttribute(req.Entry.Attributes),
 		Chunks:   chunks,
+		Extended: extended,
 	})
 
 	if err == nil {
@@ -138,10 +127,16 @@ func (fs *FilerServer) CreateEntry(ctx context.Context, req *filer_pb.CreateEntr
 func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntryRequest) (*filer_pb.UpdateEntryResponse, error) {
 
 	fullpath := filepath.ToSlash(filepath.Join(req.Directory, req.Entry.Name))
+	unlock := fs.filer.LockEntry(filer2.FullPath(fullpath))
+	defer unlock()
+
 	entry, err := fs.filer.FindEntry(ctx, filer2.FullPath(fullpath))
 	if err != nil {
 		return &filer_pb.UpdateEntryResponse{}, fmt.Errorf("not found %s: %v", fullpath, err)
 	}
+	if len(req.ExpectedChunks) > 0 && !filer2.SameChunks(entry.Chunks, req.ExpectedChunks) {
+		return &filer_pb.UpdateEntryResponse{}, fmt.Errorf("chunks of %s changed", fullpath)
+	}
 
 	// remove old chunks if not included in the new ones
 	unusedChunks := filer2.MinusChunks(entry.Chunks, req.Entry.Chunks)
@@ -152,6 +147,10 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 		FullPath: filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Entry.Name))),
 		Attr:     entry.Attr,
 		Chunks:   chunks,
+		Extended: entry.Extended,
+	}
+	if req.SetExtended {
+		newEntry.Extended = req.Entry.Extended
 	}
 
 	glog.V(3).Infof("updating %s: %+v, chunks %d: %v => %+v, chunks %d: %v",
@@ -194,10 +193,11 @@ func (fs *FilerServer) DeleteEntry(ctx context.Context, req *filer_pb.DeleteEntr
 
 func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVolumeRequest) (resp *filer_pb.AssignVolumeResponse, err error) {
 
-	ttlStr := ""
-	if req.TtlSec > 0 {
-		ttlStr = strconv.Itoa(int(req.TtlSec))
+	ttlSec := req.TtlSec
+	if ttlSec == 0 && req.ParentPath != "" {
+		ttlSec = fs.filer.DirectoryTtlSec(ctx, filer2.FullPath(cleanFilerPath(req.ParentPath)))
 	}
+	ttlStr := needle.SecondsToTTL(ttlSec)
 
 	var altRequest *operation.VolumeAssignRequest
 
@@ -206,12 +206,15 @@ func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVol
 		dataCenter = fs.option.DataCenter
 	}
 
+	diskType := fs.detectDiskType(strings.TrimSuffix(req.ParentPath, "/")+"/", req.DiskType)
+
 	assignRequest := &operation.VolumeAssignRequest{
 		Count:       uint64(req.Count),
 		Replication: req.Replication,
 		Collection:  req.Collection,
 		Ttl:         ttlStr,
 		DataCenter:  dataCenter,
+		DiskType:    diskType,
 	}
 	if dataCenter != "" {
 		altRequest = &operation.VolumeAssignRequest{
@@ -220,9 +223,10 @@ func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVol
 			Collection:  req.Collection,
 			Ttl:         ttlStr,
 			DataCenter:  "",
+			DiskType:    diskType,
 		}
 	}
-	assignResult, err := operation.Assign(fs.filer.GetMaster(), fs.grpcDialOption, assignRequest, altRequest)
+	assignResult, err := fs.assign(assignRequest, altRequest)
 	if err != nil {
 		return nil, fmt.Errorf("assign volume: %v", err)
 	}
@@ -0,0 +1,207 @@
+package weed_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+)
+
+// the chunks written through WriteFile can be read by the writer for this long, before they are saved in the file
+const writtenChunkTtl = time.Hour
+
+func (fs *FilerServer) ReadFile(req *filer_pb.ReadFileRequest, stream filer_pb.SeaweedFiler_ReadFileServer) error {
+
+	fullpath := filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Name)))
+
+	chunks, err := fs.resolveReadChunks(stream.Context(), fullpath, req.Chunks)
+	if err != nil {
+		return fmt.Errorf("read %s: %v", fullpath, err)
+	}
+
+	if req.Offset < 0 || req.Size < 0 {
+		return fmt.Errorf("read %s: invalid range offset %d size %d", fullpath, req.Offset, req.Size)
+	}
+	stopOffset := int64(filer2.TotalSize(chunks))
+	if req.Size > 0 && req.Offset+req.Size < stopOffset {
+		stopOffset = req.Offset + req.Size
+	}
+	if req.Offset >= stopOffset {
+		return nil
+	}
+
+	visibles := filer2.NonOverlappingVisibleIntervals(chunks)
+	reader := filer2.NewGrpcChunkReader(fs.filer.MasterClient.LookupFileIdUrls, filer2.DefaultPrefetchCount, fs.grpcDialOption)
+
+	bufSize := int64(BufferSizeLimit)
+	if stopOffset-req.Offset < bufSize {
+		bufSize = stopOffset - req.Offset
+	}
+	buf := make([]byte, bufSize)
+	for offset := req.Offset; offset < stopOffset; offset += bufSize {
+		data := buf
+		if offset+bufSize > stopOffset {
+			data = buf[:stopOffset-offset]
+		}
+		// the holes in the file are read as zeros
+		for i := range data {
+			data[i] = 0
+		}
+		chunkViews := filer2.ViewFromVisibleIntervals(visibles, offset, len(data))
+		if _, err := reader.ReadChunkViews(data, chunkViews, offset); err != nil {
+			return fmt.Errorf("read %s at %d: %v", fullpath, offset, err)
+		}
+		if err := stream.Send(&filer_pb.ReadFileResponse{Data: data}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveReadChunks returns the saved chunks of the file. The chunks from the client are only read
+// if each of them is saved in the file, or is written to the same file through WriteFile and not saved yet.
+func (fs *FilerServer) resolveReadChunks(ctx context.Context, fullpath filer2.FullPath, requestedChunks []*filer_pb.FileChunk) ([]*filer_pb.FileChunk, error) {
+
+	entry, err := fs.filer.FindEntry(ctx, fullpath)
+	if err != nil && (err != filer2.ErrNotFound || len(requestedChunks) == 0) {
+		return nil, err
+	}
+	if entry != nil && entry.IsDirectory() {
+		return nil, fmt.Errorf("is a directory")
+	}
+	if len(requestedChunks) == 0 {
+		return entry.Chunks, nil
+	}
+
+	savedFileIds := make(map[string]bool)
+	if entry != nil {
+		for _, chunk := range entry.Chunks {
+			savedFileIds[chunk.GetFileIdString()] = true
+		}
+	}
+	for _, chunk := range requestedChunks {
+		fileId := chunk.GetFileIdString()
+		if savedFileIds[fileId] {
+			continue
+		}
+		if item := fs.writtenChunks.Get(fileId); item != nil && !item.Expired() && item.Value().(filer2.FullPath) == fullpath {
+			continue
+		}
+		return nil, fmt.Errorf("chunk %s does not belong to the file", fileId)
+	}
+	return requestedChunks, nil
+}
+
+func (fs *FilerServer) WriteFile(stream filer_pb.SeaweedFiler_WriteFileServer) error {
+
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return stream.SendAndClose(&filer_pb.WriteFileResponse{})
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	fullpath := filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Name)))
+	chunkSize := fs.option.MaxMB * 1024 * 1024
+
+	offset := req.Offset
+	var chunks []*filer_pb.FileChunk
+	saveChunk := func(data []byte) error {
+		chunk, saveErr := fs.saveFileChunk(ctx, req, data, offset)
+		if saveErr != nil {
+			return saveErr
+		}
+		chunks = append(chunks, chunk)
+		fs.writtenChunks.Set(chunk.GetFileIdString(), fullpath, writtenChunkTtl)
+		offset += int64(len(data))
+		return nil
+	}
+
+	data := req.Data
+	for {
+		for chunkSize > 0 && len(data) >= chunkSize {
+			if err = saveChunk(data[:chunkSize]); err != nil {
+				break
+			}
+			data = data[chunkSize:]
+		}
+		if err != nil {
+			break
+		}
+		dataReq, recvErr := stream.Recv()
+		if recvErr == io.EOF {
+			if len(data) > 0 {
+				err = saveChunk(data)
+			}
+			break
+		}
+		if recvErr != nil {
+			err = recvErr
+			break
+		}
+		data = append(data, dataReq.Data...)
+	}
+
+	if err != nil {
+		// the saved chunks are not referenced by any entry
+		fs.filer.DeleteChunks(fullpath, chunks)
+		return fmt.Errorf("write %s: %v", fullpath, err)
+	}
+
+	return stream.SendAndClose(&filer_pb.WriteFileResponse{
+		Chunks: chunks,
+	})
+}
+
+// saveFileChunk uploads the data to a new file id, and retries once on another file id if the upload fails
+func (fs *FilerServer) saveFileChunk(ctx context.Context, req *filer_pb.WriteFileRequest, data []byte, offset int64) (*filer_pb.FileChunk, error) {
+
+	assignRequest := &filer_pb.AssignVolumeRequest{
+		Count:       1,
+		Collection:  req.Collection,
+		Replication: req.Replication,
+		TtlSec:      req.TtlSec,
+		DataCenter:  req.DataCenter,
+		ParentPath:  req.Directory,
+		DiskType:    req.DiskType,
+	}
+
+	for attempt := 0; ; attempt++ {
+		assignResult, err := fs.AssignVolume(ctx, assignRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		fileUrl := fmt.Sprintf("http://%s/%s", assignResult.Url, assignResult.FileId)
+		uploadResult, err := operation.UploadData(fileUrl, fs.grpcDialOption, req.Name, data, "application/octet-stream", security.EncodedJwt(assignResult.Auth))
+		if err == nil && uploadResult.Error != "" {
+			err = errors.New(uploadResult.Error)
+		}
+		if err == nil {
+			return &filer_pb.FileChunk{
+				FileId: assignResult.FileId,
+				Offset: offset,
+				Size:   uint64(len(data)),
+				Mtime:  time.Now().UnixNano(),
+				ETag:   uploadResult.ETag,
+			}, nil
+		}
+
+		glog.V(0).Infof("upload %s to %s: %v", req.Name, fileUrl, err)
+		fs.discardFileIds(assignResult.FileId)
+		if attempt > 0 {
+			return nil, fmt.Errorf("upload data: %v", err)
+		}
+	}
+}
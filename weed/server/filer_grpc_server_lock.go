@@ -0,0 +1,87 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func (fs *FilerServer) Lock(ctx context.Context, req *filer_pb.LockRequest) (*filer_pb.LockResponse, error) {
+
+	if req.Lock == nil || req.Lock.ClientId == "" {
+		return nil, fmt.Errorf("lock %s/%s: missing client id", req.Directory, req.Name)
+	}
+
+	fullpath := filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Name)))
+
+	conflict, err := fs.filer.LockManager.Acquire(ctx, fullpath, req.Lock, int(req.LeaseSec))
+	if err != nil {
+		glog.V(0).Infof("lock %s: %v", fullpath, err)
+		return nil, fmt.Errorf("lock %s: %v", fullpath, err)
+	}
+
+	glog.V(4).Infof("lock %s %+v conflict:%+v", fullpath, req.Lock, conflict)
+
+	return &filer_pb.LockResponse{
+		Acquired: conflict == nil,
+		Conflict: conflict,
+	}, nil
+}
+
+func (fs *FilerServer) Unlock(ctx context.Context, req *filer_pb.UnlockRequest) (*filer_pb.UnlockResponse, error) {
+
+	if req.Lock == nil || req.Lock.ClientId == "" {
+		return nil, fmt.Errorf("unlock %s/%s: missing client id", req.Directory, req.Name)
+	}
+
+	fullpath := filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Name)))
+
+	if err := fs.filer.LockManager.Release(ctx, fullpath, req.Lock); err != nil {
+		glog.V(0).Infof("unlock %s: %v", fullpath, err)
+		return nil, fmt.Errorf("unlock %s: %v", fullpath, err)
+	}
+
+	glog.V(4).Infof("unlock %s %+v", fullpath, req.Lock)
+
+	return &filer_pb.UnlockResponse{}, nil
+}
+
+func (fs *FilerServer) TestLock(ctx context.Context, req *filer_pb.LockRequest) (*filer_pb.LockResponse, error) {
+
+	if req.Lock == nil {
+		return nil, fmt.Errorf("test lock %s/%s: missing lock", req.Directory, req.Name)
+	}
+
+	fullpath := filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Name)))
+
+	conflict, err := fs.filer.LockManager.Test(ctx, fullpath, req.Lock)
+	if err != nil {
+		return nil, fmt.Errorf("test lock %s: %v", fullpath, err)
+	}
+
+	return &filer_pb.LockResponse{
+		Acquired: conflict == nil,
+		Conflict: conflict,
+	}, nil
+}
+
+func (fs *FilerServer) KeepLockLease(ctx context.Context, req *filer_pb.KeepLockLeaseRequest) (*filer_pb.KeepLockLeaseResponse, error) {
+
+	if req.ClientId == "" {
+		return nil, fmt.Errorf("keep lock lease: missing client id")
+	}
+
+	lockCount, err := fs.filer.LockManager.KeepLease(ctx, req.ClientId, int(req.LeaseSec))
+	if err != nil {
+		glog.V(0).Infof("keep lock lease of %s: %v", req.ClientId, err)
+		return nil, fmt.Errorf("keep lock lease of %s: %v", req.ClientId, err)
+	}
+
+	return &filer_pb.KeepLockLeaseResponse{
+		LockCount: int32(lockCount),
+	}, nil
+}
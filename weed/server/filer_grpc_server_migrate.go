@@ -0,0 +1,76 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/spf13/viper"
+)
+
+func (fs *FilerServer) MigrateStore(ctx context.Context, req *filer_pb.MigrateStoreRequest) (*filer_pb.MigrateStoreResponse, error) {
+
+	glog.V(0).Infof("migrate store %s %s", req.Action, req.Store)
+
+	switch req.Action {
+	case "start":
+		if req.Store == "" {
+			return nil, fmt.Errorf("missing target store")
+		}
+		store, err := fs.filer.LoadStore(viper.GetViper(), req.Store)
+		if err != nil {
+			return nil, err
+		}
+		if err = fs.filer.StartStoreMigration(store); err != nil {
+			return nil, err
+		}
+	case "verify":
+		if err := fs.filer.VerifyStoreMigration(); err != nil {
+			return nil, err
+		}
+	case "cutover":
+		status, err := fs.filer.CutOverStoreMigration()
+		if err != nil {
+			return nil, err
+		}
+		return storeMigrationStatusToPb(&status), nil
+	case "abort":
+		status, err := fs.filer.AbortStoreMigration()
+		if err != nil {
+			return nil, err
+		}
+		return storeMigrationStatusToPb(&status), nil
+	case "", "status":
+	default:
+		return nil, fmt.Errorf("unknown store migration action %s", req.Action)
+	}
+
+	status := fs.filer.StoreMigrationStatus()
+	if status == nil {
+		return &filer_pb.MigrateStoreResponse{
+			SourceStore: fs.filer.StoreName(),
+		}, nil
+	}
+	return storeMigrationStatusToPb(status), nil
+}
+
+func storeMigrationStatusToPb(status *filer2.StoreMigrationStatus) *filer_pb.MigrateStoreResponse {
+	return &filer_pb.MigrateStoreResponse{
+		SourceStore:      status.SourceStore,
+		TargetStore:      status.TargetStore,
+		State:            status.State,
+		StartedAtNs:      status.StartedAt.UnixNano(),
+		DirectoryCount:   status.DirectoryCount,
+		CopiedCount:      status.CopiedCount,
+		CurrentDirectory: status.CurrentDirectory,
+		DualWriteErrors:  status.DualWriteErrors,
+		SourceEntryCount: status.SourceEntryCount,
+		TargetEntryCount: status.TargetEntryCount,
+		SourceChecksum:   status.SourceChecksum,
+		TargetChecksum:   status.TargetChecksum,
+		MismatchCount:    status.MismatchCount,
+		Error:            status.Error,
+	}
+}
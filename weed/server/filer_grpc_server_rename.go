@@ -13,28 +13,39 @@ func (fs *FilerServer) AtomicRenameEntry(ctx context.Context, req *filer_pb.Atom
 
 	glog.V(1).Infof("AtomicRenameEntry %v", req)
 
-	ctx, err := fs.filer.BeginTransaction(ctx)
+	err := fs.renameEntry(ctx, filer2.FullPath(filepath.ToSlash(req.OldDirectory)), req.OldName,
+		filer2.FullPath(filepath.ToSlash(req.NewDirectory)), req.NewName)
 	if err != nil {
 		return nil, err
 	}
 
-	oldParent := filer2.FullPath(filepath.ToSlash(req.OldDirectory))
+	return &filer_pb.AtomicRenameEntryResponse{}, nil
+}
+
+// renameEntry moves the entry, and everything under it for a folder, in one transaction,
+// and then sends the notifications
+func (fs *FilerServer) renameEntry(ctx context.Context, oldParent filer2.FullPath, oldName string, newParent filer2.FullPath, newName string) error {
+
+	ctx, err := fs.filer.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 
-	oldEntry, err := fs.filer.FindEntry(ctx, oldParent.Child(req.OldName))
+	oldEntry, err := fs.filer.FindEntry(ctx, oldParent.Child(oldName))
 	if err != nil {
 		fs.filer.RollbackTransaction(ctx)
-		return nil, fmt.Errorf("%s/%s not found: %v", req.OldDirectory, req.OldName, err)
+		return fmt.Errorf("%s/%s not found: %v", oldParent, oldName, err)
 	}
 
 	var events MoveEvents
-	moveErr := fs.moveEntry(ctx, oldParent, oldEntry, filer2.FullPath(filepath.ToSlash(req.NewDirectory)), req.NewName, &events)
+	moveErr := fs.moveEntry(ctx, oldParent, oldEntry, newParent, newName, &events)
 	if moveErr != nil {
 		fs.filer.RollbackTransaction(ctx)
-		return nil, fmt.Errorf("%s/%s move error: %v", req.OldDirectory, req.OldName, err)
+		return fmt.Errorf("%s/%s move error: %v", oldParent, oldName, moveErr)
 	} else {
 		if commitError := fs.filer.CommitTransaction(ctx); commitError != nil {
 			fs.filer.RollbackTransaction(ctx)
-			return nil, fmt.Errorf("%s/%s move commit error: %v", req.OldDirectory, req.OldName, err)
+			return fmt.Errorf("%s/%s move commit error: %v", oldParent, oldName, commitError)
 		}
 	}
 
@@ -45,7 +56,7 @@ func (fs *FilerServer) AtomicRenameEntry(ctx context.Context, req *filer_pb.Atom
 		fs.filer.NotifyUpdateEvent(entry, nil, false)
 	}
 
-	return &filer_pb.AtomicRenameEntryResponse{}, nil
+	return nil
 }
 
 func (fs *FilerServer) moveEntry(ctx context.Context, oldParent filer2.FullPath, entry *filer2.Entry, newParent filer2.FullPath, newName string, events *MoveEvents) error {
@@ -68,7 +79,7 @@ func (fs *FilerServer) moveFolderSubEntries(ctx context.Context, oldParent filer
 	includeLastFile := false
 	for {
 
-		entries, err := fs.filer.ListDirectoryEntries(ctx, currentDirPath, lastFileName, includeLastFile, 1024)
+		entries, err := fs.filer.ListDirectoryRawEntries(ctx, currentDirPath, lastFileName, includeLastFile, 1024)
 		if err != nil {
 			return err
 		}
@@ -106,6 +117,7 @@ func (fs *FilerServer) moveSelfEntry(ctx context.Context, oldParent filer2.FullP
 		FullPath: newPath,
 		Attr:     entry.Attr,
 		Chunks:   entry.Chunks,
+		Extended: entry.Extended,
 	}
 	createErr := fs.filer.CreateEntry(ctx, newEntry)
 	if createErr != nil {
@@ -0,0 +1,145 @@
+package weed_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/filer2/memdb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/karlseguin/ccache"
+)
+
+func TestUpdateEntryKeepsExtended(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &memdb.MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	fs := &FilerServer{filer: filer}
+
+	ctx := context.Background()
+	newEntry := func(extended map[string][]byte) *filer_pb.Entry {
+		return &filer_pb.Entry{
+			Name:       "a.txt",
+			Attributes: &filer_pb.FuseAttributes{FileMode: 0644, Mtime: 1, Crtime: 1},
+			Extended:   extended,
+		}
+	}
+	extendedOf := func() map[string][]byte {
+		entry, err := filer.FindEntry(ctx, "/dir/a.txt")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		return entry.Extended
+	}
+
+	if _, err := fs.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+		Directory:   "/dir",
+		Entry:       newEntry(map[string][]byte{"tag": []byte("x")}),
+		SetExtended: true,
+	}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// clients unaware of the extended attributes do not wipe them
+	if _, err := fs.UpdateEntry(ctx, &filer_pb.UpdateEntryRequest{Directory: "/dir", Entry: newEntry(nil)}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, err := fs.CreateEntry(ctx, &filer_pb.CreateEntryRequest{Directory: "/dir", Entry: newEntry(nil)}); err != nil {
+		t.Fatalf("create again: %v", err)
+	}
+	if extended := extendedOf(); string(extended["tag"]) != "x" {
+		t.Fatalf("lost extended attributes: %+v", extended)
+	}
+
+	if _, err := fs.UpdateEntry(ctx, &filer_pb.UpdateEntryRequest{Directory: "/dir", Entry: newEntry(nil), SetExtended: true}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if extended := extendedOf(); len(extended) != 0 {
+		t.Fatalf("extended attributes should be removed: %+v", extended)
+	}
+}
+
+func TestUpdateEntryWithExpectedChunks(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &memdb.MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	fs := &FilerServer{filer: filer}
+
+	ctx := context.Background()
+	fragmented := []*filer_pb.FileChunk{{FileId: "1,01", Size: 5}, {FileId: "1,02", Offset: 5, Size: 5}}
+	rewritten := []*filer_pb.FileChunk{{FileId: "2,03", Size: 10}}
+	if err := filer.CreateEntry(ctx, &filer2.Entry{
+		FullPath: "/dir/a.txt",
+		Attr:     filer2.Attr{Mode: 0644},
+		Chunks:   fragmented,
+	}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	update := func(expected []*filer_pb.FileChunk) error {
+		_, err := fs.UpdateEntry(ctx, &filer_pb.UpdateEntryRequest{
+			Directory:      "/dir",
+			Entry:          &filer_pb.Entry{Name: "a.txt", Chunks: rewritten},
+			ExpectedChunks: expected,
+		})
+		return err
+	}
+	chunksOf := func() []*filer_pb.FileChunk {
+		entry, err := filer.FindEntry(ctx, "/dir/a.txt")
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		return entry.Chunks
+	}
+
+	// another write changed the chunks
+	if err := update(fragmented[:1]); err == nil {
+		t.Fatalf("update with changed chunks should fail")
+	}
+	if chunks := chunksOf(); !filer2.SameChunks(chunks, fragmented) {
+		t.Fatalf("chunks should be kept: %v", chunks)
+	}
+
+	if err := update(fragmented); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if chunks := chunksOf(); !filer2.SameChunks(chunks, rewritten) {
+		t.Fatalf("chunks should be replaced: %v", chunks)
+	}
+}
+
+func TestResolveReadChunks(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &memdb.MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	fs := &FilerServer{filer: filer, writtenChunks: ccache.New(ccache.Configure())}
+
+	ctx := context.Background()
+	saved := &filer_pb.FileChunk{FileId: "1,01", Size: 5}
+	if err := filer.CreateEntry(ctx, &filer2.Entry{
+		FullPath: "/dir/a.txt",
+		Attr:     filer2.Attr{Mode: 0644},
+		Chunks:   []*filer_pb.FileChunk{saved},
+	}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	written := &filer_pb.FileChunk{FileId: "2,02", Offset: 5, Size: 5}
+	fs.writtenChunks.Set(written.FileId, filer2.FullPath("/dir/a.txt"), time.Minute)
+	other := &filer_pb.FileChunk{FileId: "3,03", Size: 5}
+	fs.writtenChunks.Set(other.FileId, filer2.FullPath("/dir/b.txt"), time.Minute)
+
+	if chunks, err := fs.resolveReadChunks(ctx, "/dir/a.txt", nil); err != nil || len(chunks) != 1 || chunks[0].FileId != saved.FileId {
+		t.Errorf("saved chunks: %v %v", chunks, err)
+	}
+	if chunks, err := fs.resolveReadChunks(ctx, "/dir/a.txt", []*filer_pb.FileChunk{saved, written}); err != nil || len(chunks) != 2 {
+		t.Errorf("saved and written chunks: %v %v", chunks, err)
+	}
+	for _, chunk := range []*filer_pb.FileChunk{other, {FileId: "4,04", Size: 5}} {
+		if _, err := fs.resolveReadChunks(ctx, "/dir/a.txt", []*filer_pb.FileChunk{saved, chunk}); err == nil {
+			t.Errorf("read chunk %s of another file", chunk.FileId)
+		}
+	}
+}
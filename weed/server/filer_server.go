@@ -10,7 +10,9 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/karlseguin/ccache"
 	"google.golang.org/grpc"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
@@ -22,6 +24,7 @@ import (
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/mysql"
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/postgres"
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/redis"
+	_ "github.com/chrislusf/seaweedfs/weed/filer2/sqlite"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/notification"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/aws_sqs"
@@ -45,6 +48,10 @@ type FilerOption struct {
 	DefaultLevelDbDir  string
 	DisableHttp        bool
 	Port               int
+	// 0 to not delete the entries past their ttl
+	TtlSweepIntervalMinutes int
+	// file ids leased from the master at a time, 1 to assign each file id from the master
+	FileIdBatchSize int
 }
 
 type FilerServer struct {
@@ -52,6 +59,10 @@ type FilerServer struct {
 	secret         security.SigningKey
 	filer          *filer2.Filer
 	grpcDialOption grpc.DialOption
+	diskRules      []filerDiskRule
+	fileIdPool     *operation.FileIdPool
+	// the file paths of the chunks written through WriteFile, by the file id
+	writtenChunks *ccache.Cache
 }
 
 func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption) (fs *FilerServer, err error) {
@@ -59,6 +70,7 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 	fs = &FilerServer{
 		option:         option,
 		grpcDialOption: security.LoadClientTLS(viper.Sub("grpc"), "filer"),
+		writtenChunks:  ccache.New(ccache.Configure().MaxSize(64 * 1024)),
 	}
 
 	if len(option.Masters) == 0 {
@@ -66,6 +78,13 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 	}
 
 	fs.filer = filer2.NewFiler(option.Masters, fs.grpcDialOption)
+	fs.filer.MasterClient.DataCenter = option.DataCenter
+	if option.FileIdBatchSize > 1 {
+		fs.fileIdPool = operation.NewFileIdPool(uint64(option.FileIdBatchSize), operation.DefaultFileIdLeaseDuration)
+		fs.filer.MasterClient.OnVolumeUnwritable = func(vid uint32) {
+			fs.fileIdPool.DiscardVolume(needle.VolumeId(vid))
+		}
+	}
 
 	go fs.filer.KeepConnectedToMaster()
 
@@ -81,9 +100,14 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 	util.LoadConfiguration("notification", false)
 
 	fs.filer.LoadConfiguration(v)
+	fs.loadDiskRules(v)
 
 	notification.LoadConfiguration(v.Sub("notification"))
 
+	if option.TtlSweepIntervalMinutes > 0 {
+		go fs.filer.LoopDeletingExpiredEntries(time.Duration(option.TtlSweepIntervalMinutes) * time.Minute)
+	}
+
 	handleStaticResources(defaultMux)
 	if !option.DisableHttp {
 		defaultMux.HandleFunc("/", fs.filerHandler)
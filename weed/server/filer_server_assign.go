@@ -0,0 +1,36 @@
+package weed_server
+
+import (
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// assign hands out the file ids leased in batches, or asks the master for each file id if pooling is disabled
+func (fs *FilerServer) assign(primaryRequest *operation.VolumeAssignRequest, alternativeRequest *operation.VolumeAssignRequest) (*operation.AssignResult, error) {
+
+	if fs.fileIdPool == nil || primaryRequest.Count > 1 {
+		return operation.Assign(fs.filer.GetMaster(), fs.grpcDialOption, primaryRequest, alternativeRequest)
+	}
+
+	return fs.fileIdPool.Assign(operation.AssignKey(primaryRequest), func(count uint64) (*operation.AssignResult, error) {
+		primary := *primaryRequest
+		primary.Count = count
+		if alternativeRequest == nil {
+			return operation.Assign(fs.filer.GetMaster(), fs.grpcDialOption, &primary)
+		}
+		alternative := *alternativeRequest
+		alternative.Count = count
+		return operation.Assign(fs.filer.GetMaster(), fs.grpcDialOption, &primary, &alternative)
+	})
+
+}
+
+// discardFileIds stops handing out the leased file ids of the volume, after an upload to it failed
+func (fs *FilerServer) discardFileIds(fileId string) {
+	if fs.fileIdPool == nil {
+		return
+	}
+	if fid, err := needle.ParseFileIdFromString(fileId); err == nil {
+		fs.fileIdPool.DiscardVolume(fid.VolumeId)
+	}
+}
@@ -0,0 +1,80 @@
+package weed_server
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/spf13/viper"
+)
+
+// filerDiskRule chooses the disk type for new files under a path prefix
+type filerDiskRule struct {
+	locationPrefix string
+	diskType       types.DiskType
+}
+
+// loadFilerDiskRules reads the disk rules from filer.toml, sorted with the longest prefix first
+//
+//	[[filer.disk_rule]]
+//	location_prefix = "/buckets/hot/"
+//	disk = "ssd"
+func loadFilerDiskRules(v *viper.Viper) (rules []filerDiskRule, err error) {
+	var ruleTables []map[string]interface{}
+	switch t := v.Get("filer.disk_rule").(type) {
+	case nil:
+		return nil, nil
+	case []map[string]interface{}:
+		ruleTables = t
+	case []interface{}:
+		for _, x := range t {
+			m, ok := x.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("filer.disk_rule: unexpected %v", x)
+			}
+			ruleTables = append(ruleTables, m)
+		}
+	default:
+		return nil, fmt.Errorf("filer.disk_rule should be an array of tables, not %T", t)
+	}
+
+	for _, m := range ruleTables {
+		locationPrefix, _ := m["location_prefix"].(string)
+		diskTypeString, _ := m["disk"].(string)
+		if locationPrefix == "" {
+			return nil, fmt.Errorf("filer.disk_rule: missing location_prefix")
+		}
+		diskType, err := types.ToDiskType(diskTypeString)
+		if err != nil {
+			return nil, fmt.Errorf("filer.disk_rule %s: %v", locationPrefix, err)
+		}
+		rules = append(rules, filerDiskRule{locationPrefix: locationPrefix, diskType: diskType})
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return len(rules[i].locationPrefix) > len(rules[j].locationPrefix)
+	})
+	return rules, nil
+}
+
+// detectDiskType uses the requested disk type, or the disk type of the longest matching path prefix
+func (fs *FilerServer) detectDiskType(fullpath string, diskType string) string {
+	if diskType != "" {
+		return diskType
+	}
+	for _, rule := range fs.diskRules {
+		if strings.HasPrefix(fullpath, rule.locationPrefix) {
+			return string(rule.diskType)
+		}
+	}
+	return ""
+}
+
+func (fs *FilerServer) loadDiskRules(v *viper.Viper) {
+	rules, err := loadFilerDiskRules(v)
+	if err != nil {
+		glog.Fatalf("load disk rules: %v", err)
+	}
+	fs.diskRules = rules
+}
@@ -19,14 +19,38 @@ func (fs *FilerServer) filerHandler(w http.ResponseWriter, r *http.Request) {
 		fs.GetOrHeadHandler(w, r, false)
 		stats.FilerRequestHistogram.WithLabelValues("head").Observe(time.Since(start).Seconds())
 	case "DELETE":
+		if _, ok := r.URL.Query()["tagging"]; ok {
+			stats.FilerRequestCounter.WithLabelValues("deleteTagging").Inc()
+			fs.DeleteTaggingHandler(w, r)
+			stats.FilerRequestHistogram.WithLabelValues("deleteTagging").Observe(time.Since(start).Seconds())
+			return
+		}
 		stats.FilerRequestCounter.WithLabelValues("delete").Inc()
 		fs.DeleteHandler(w, r)
 		stats.FilerRequestHistogram.WithLabelValues("delete").Observe(time.Since(start).Seconds())
 	case "PUT":
+		if _, ok := r.URL.Query()["tagging"]; ok {
+			stats.FilerRequestCounter.WithLabelValues("putTagging").Inc()
+			fs.PutTaggingHandler(w, r)
+			stats.FilerRequestHistogram.WithLabelValues("putTagging").Observe(time.Since(start).Seconds())
+			return
+		}
+		if _, ok := r.URL.Query()["metadata"]; ok {
+			stats.FilerRequestCounter.WithLabelValues("putMetadata").Inc()
+			fs.PutMetadataHandler(w, r)
+			stats.FilerRequestHistogram.WithLabelValues("putMetadata").Observe(time.Since(start).Seconds())
+			return
+		}
 		stats.FilerRequestCounter.WithLabelValues("put").Inc()
 		fs.PostHandler(w, r)
 		stats.FilerRequestHistogram.WithLabelValues("put").Observe(time.Since(start).Seconds())
 	case "POST":
+		if r.URL.Query().Get("mv.from") != "" {
+			stats.FilerRequestCounter.WithLabelValues("move").Inc()
+			fs.MoveHandler(w, r)
+			stats.FilerRequestHistogram.WithLabelValues("move").Observe(time.Since(start).Seconds())
+			return
+		}
 		stats.FilerRequestCounter.WithLabelValues("post").Inc()
 		fs.PostHandler(w, r)
 		stats.FilerRequestHistogram.WithLabelValues("post").Observe(time.Since(start).Seconds())
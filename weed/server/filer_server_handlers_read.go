@@ -53,6 +53,8 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	setTagHeaders(w, entry)
+
 	if len(entry.Chunks) == 0 {
 		glog.V(1).Infof("no file chunks for %s, attr=%+v", path, entry.Attr)
 		stats.FilerRequestCounter.WithLabelValues("read.nocontent").Inc()
@@ -81,7 +83,7 @@ func (fs *FilerServer) handleSingleChunk(w http.ResponseWriter, r *http.Request,
 
 	fileId := entry.Chunks[0].GetFileIdString()
 
-	urlString, err := fs.filer.MasterClient.LookupFileId(fileId)
+	urlStrings, err := fs.filer.MasterClient.LookupFileIdUrls(fileId)
 	if err != nil {
 		glog.V(1).Infof("operation LookupFileId %s failed, err: %v", fileId, err)
 		w.WriteHeader(http.StatusNotFound)
@@ -90,34 +92,49 @@ func (fs *FilerServer) handleSingleChunk(w http.ResponseWriter, r *http.Request,
 
 	if fs.option.RedirectOnRead {
 		stats.FilerRequestCounter.WithLabelValues("redirect").Inc()
-		http.Redirect(w, r, urlString, http.StatusFound)
+		http.Redirect(w, r, urlStrings[0], http.StatusFound)
 		return
 	}
 
-	u, _ := url.Parse(urlString)
-	q := u.Query()
-	for key, values := range r.URL.Query() {
-		for _, value := range values {
-			q.Add(key, value)
+	// try the next replica if the volume server is not reachable or fails
+	var resp *http.Response
+	for _, urlString := range urlStrings {
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		u, _ := url.Parse(urlString)
+		q := u.Query()
+		for key, values := range r.URL.Query() {
+			for _, value := range values {
+				q.Add(key, value)
+			}
+		}
+		u.RawQuery = q.Encode()
+		request := &http.Request{
+			Method:        r.Method,
+			URL:           u,
+			Proto:         r.Proto,
+			ProtoMajor:    r.ProtoMajor,
+			ProtoMinor:    r.ProtoMinor,
+			Header:        r.Header,
+			Body:          r.Body,
+			Host:          r.Host,
+			ContentLength: r.ContentLength,
 		}
+		glog.V(3).Infoln("retrieving from", u)
+		resp, err = util.Do(request)
+		if err != nil {
+			glog.V(0).Infof("failing to connect to volume server %s: %v", u.Host, err)
+			continue
+		}
+		if resp.StatusCode < http.StatusInternalServerError {
+			break
+		}
+		glog.V(0).Infof("read from volume server %s: %s", u.Host, resp.Status)
 	}
-	u.RawQuery = q.Encode()
-	request := &http.Request{
-		Method:        r.Method,
-		URL:           u,
-		Proto:         r.Proto,
-		ProtoMajor:    r.ProtoMajor,
-		ProtoMinor:    r.ProtoMinor,
-		Header:        r.Header,
-		Body:          r.Body,
-		Host:          r.Host,
-		ContentLength: r.ContentLength,
-	}
-	glog.V(3).Infoln("retrieving from", u)
-	resp, do_err := util.Do(request)
-	if do_err != nil {
-		glog.V(0).Infoln("failing to connect to volume server", do_err.Error())
-		writeJsonError(w, r, http.StatusInternalServerError, do_err)
+	if err != nil {
+		writeJsonError(w, r, http.StatusInternalServerError, err)
 		return
 	}
 	defer func() {
@@ -243,6 +260,6 @@ func (fs *FilerServer) handleMultipleChunks(w http.ResponseWriter, r *http.Reque
 
 func (fs *FilerServer) writeContent(w io.Writer, entry *filer2.Entry, offset int64, size int) error {
 
-	return filer2.StreamContent(fs.filer.MasterClient, w, entry.Chunks, offset, size)
+	return filer2.StreamContent(fs.filer.MasterClient, fs.grpcDialOption, w, entry.Chunks, offset, size)
 
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"mime"
 	"net/http"
 	"net/url"
@@ -22,6 +23,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
@@ -38,18 +40,21 @@ type FilerPostResult struct {
 	Url   string `json:"url,omitempty"`
 }
 
-func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request, replication, collection string, dataCenter string) (fileId, urlLocation string, auth security.EncodedJwt, err error) {
+func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request, replication, collection string, dataCenter string, ttlString string) (fileId, urlLocation string, auth security.EncodedJwt, err error) {
 
 	stats.FilerRequestCounter.WithLabelValues("assign").Inc()
 	start := time.Now()
 	defer func() { stats.FilerRequestHistogram.WithLabelValues("assign").Observe(time.Since(start).Seconds()) }()
 
+	diskType := fs.detectDiskType(r.URL.Path, r.URL.Query().Get("disk"))
+
 	ar := &operation.VolumeAssignRequest{
 		Count:       1,
 		Replication: replication,
 		Collection:  collection,
-		Ttl:         r.URL.Query().Get("ttl"),
+		Ttl:         ttlString,
 		DataCenter:  dataCenter,
+		DiskType:    diskType,
 	}
 	var altRequest *operation.VolumeAssignRequest
 	if dataCenter != "" {
@@ -57,12 +62,13 @@ func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request,
 			Count:       1,
 			Replication: replication,
 			Collection:  collection,
-			Ttl:         r.URL.Query().Get("ttl"),
+			Ttl:         ttlString,
 			DataCenter:  "",
+			DiskType:    diskType,
 		}
 	}
 
-	assignResult, ae := operation.Assign(fs.filer.GetMaster(), fs.grpcDialOption, ar, altRequest)
+	assignResult, ae := fs.assign(ar, altRequest)
 	if ae != nil {
 		glog.Errorf("failing to assign a file id: %v", ae)
 		writeJsonError(w, r, http.StatusInternalServerError, ae)
@@ -93,11 +99,19 @@ func (fs *FilerServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 		dataCenter = fs.option.DataCenter
 	}
 
-	if autoChunked := fs.autoChunk(ctx, w, r, replication, collection, dataCenter); autoChunked {
+	ttlString, ttlSec := fs.detectTtl(ctx, r)
+
+	// check the extended attributes before writing any data
+	if _, err := extendedFromHeader(r.Header); err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if autoChunked := fs.autoChunk(ctx, w, r, replication, collection, dataCenter, ttlString, ttlSec); autoChunked {
 		return
 	}
 
-	fileId, urlLocation, auth, err := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
+	fileId, urlLocation, auth, err := fs.assignNewFileInfo(w, r, replication, collection, dataCenter, ttlString)
 
 	if err != nil || fileId == "" || urlLocation == "" {
 		glog.V(0).Infof("fail to allocate volume for %s, collection:%s, datacenter:%s", r.URL.Path, collection, dataCenter)
@@ -120,10 +134,11 @@ func (fs *FilerServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 
 	ret, err := fs.uploadToVolumeServer(r, u, auth, w, fileId)
 	if err != nil {
+		fs.discardFileIds(fileId)
 		return
 	}
 
-	if err = fs.updateFilerStore(ctx, r, w, replication, collection, ret, fileId); err != nil {
+	if err = fs.updateFilerStore(ctx, r, w, replication, collection, ttlSec, ret, fileId); err != nil {
 		return
 	}
 
@@ -139,9 +154,30 @@ func (fs *FilerServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 	writeJsonQuiet(w, r, http.StatusCreated, reply)
 }
 
+// detectTtl returns the ttl from the request, or the default ttl of the folder,
+// both for the volume assignment and for the entry
+func (fs *FilerServer) detectTtl(ctx context.Context, r *http.Request) (ttlString string, ttlSec int32) {
+	if ttlString = r.URL.Query().Get("ttl"); ttlString != "" {
+		if ttl, err := needle.ReadTTL(ttlString); err == nil {
+			// the ttl of years overflows the seconds
+			ttlSec = int32(math.MaxInt32)
+			if seconds := int64(ttl.Minutes()) * 60; seconds < math.MaxInt32 {
+				ttlSec = int32(seconds)
+			}
+		}
+		return ttlString, ttlSec
+	}
+	dir := r.URL.Path
+	if !strings.HasSuffix(dir, "/") {
+		dir, _ = filer2.FullPath(dir).DirAndName()
+	}
+	ttlSec = fs.filer.DirectoryTtlSec(ctx, filer2.FullPath(cleanFilerPath(dir)))
+	return needle.SecondsToTTL(ttlSec), ttlSec
+}
+
 // update metadata in filer store
 func (fs *FilerServer) updateFilerStore(ctx context.Context, r *http.Request, w http.ResponseWriter,
-	replication string, collection string, ret operation.UploadResult, fileId string) (err error) {
+	replication string, collection string, ttlSec int32, ret operation.UploadResult, fileId string) (err error) {
 
 	stats.FilerRequestCounter.WithLabelValues("postStoreWrite").Inc()
 	start := time.Now()
@@ -157,7 +193,8 @@ func (fs *FilerServer) updateFilerStore(ctx context.Context, r *http.Request, w
 	}
 	existingEntry, err := fs.filer.FindEntry(ctx, filer2.FullPath(path))
 	crTime := time.Now()
-	if err == nil && existingEntry != nil {
+	// the ttl counts from the latest write
+	if err == nil && existingEntry != nil && ttlSec == 0 {
 		crTime = existingEntry.Crtime
 	}
 	entry := &filer2.Entry{
@@ -170,7 +207,7 @@ func (fs *FilerServer) updateFilerStore(ctx context.Context, r *http.Request, w
 			Gid:         OS_GID,
 			Replication: replication,
 			Collection:  collection,
-			TtlSec:      int32(util.ParseInt(r.URL.Query().Get("ttl"), 0)),
+			TtlSec:      ttlSec,
 		},
 		Chunks: []*filer_pb.FileChunk{{
 			FileId: fileId,
@@ -179,6 +216,7 @@ func (fs *FilerServer) updateFilerStore(ctx context.Context, r *http.Request, w
 			ETag:   ret.ETag,
 		}},
 	}
+	entry.Extended, _ = extendedFromHeader(r.Header)
 	if ext := filenamePath.Ext(path); ext != "" {
 		entry.Attr.Mime = mime.TypeByExtension(ext)
 	}
@@ -0,0 +1,262 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+const (
+	// user tags are kept in the entry extended attributes, with the header name as the key
+	SeaweedTagPrefix = "Seaweed-"
+
+	// headers to change the entry attributes, for PUT ?metadata
+	SeaweedModeHeader = "X-Seaweed-Mode" // octal permission bits, e.g. 0644
+	SeaweedUidHeader  = "X-Seaweed-Uid"
+	SeaweedGidHeader  = "X-Seaweed-Gid"
+	SeaweedTtlHeader  = "X-Seaweed-Ttl" // in seconds
+
+	// the url-encoded extended attributes of a new file, kept in the same write as the file
+	SeaweedExtendedHeader = "X-Seaweed-Extended"
+)
+
+// curl -X POST "http://localhost:8888/path/to/new?mv.from=/path/to/old"
+// curl -X POST "http://localhost:8888/path/to/existing/folder/?mv.from=/path/to/old"
+func (fs *FilerServer) MoveHandler(w http.ResponseWriter, r *http.Request) {
+
+	ctx := context.Background()
+
+	oldPath := cleanFilerPath(r.URL.Query().Get("mv.from"))
+	newPath := cleanFilerPath(r.URL.Path)
+	if oldPath == "/" {
+		writeJsonError(w, r, http.StatusBadRequest, fmt.Errorf("can not move the root folder"))
+		return
+	}
+
+	oldEntry, err := fs.filer.FindEntry(ctx, filer2.FullPath(oldPath))
+	if err != nil {
+		glog.V(1).Infof("move %s: %v", oldPath, err)
+		writeJsonError(w, r, http.StatusNotFound, fmt.Errorf("%s not found", oldPath))
+		return
+	}
+
+	// moving into a folder keeps the name
+	newEntry, err := fs.filer.FindEntry(ctx, filer2.FullPath(newPath))
+	if strings.HasSuffix(r.URL.Path, "/") || err == nil && newEntry.IsDirectory() {
+		newPath = string(filer2.FullPath(newPath).Child(oldEntry.Name()))
+		newEntry, err = fs.filer.FindEntry(ctx, filer2.FullPath(newPath))
+	}
+	if err == nil && newEntry != nil {
+		if newPath == oldPath {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJsonError(w, r, http.StatusConflict, fmt.Errorf("%s already exists", newPath))
+		return
+	}
+	if strings.HasPrefix(newPath+"/", oldPath+"/") {
+		writeJsonError(w, r, http.StatusBadRequest, fmt.Errorf("can not move %s into itself", oldPath))
+		return
+	}
+
+	oldDir, oldName := filer2.FullPath(oldPath).DirAndName()
+	newDir, newName := filer2.FullPath(newPath).DirAndName()
+	if err = fs.renameEntry(ctx, filer2.FullPath(oldDir), oldName, filer2.FullPath(newDir), newName); err != nil {
+		glog.V(0).Infof("move %s => %s: %v", oldPath, newPath, err)
+		writeJsonError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// curl -X PUT -H "Seaweed-Owner: team-a" "http://localhost:8888/path/to/file?tagging"
+func (fs *FilerServer) PutTaggingHandler(w http.ResponseWriter, r *http.Request) {
+
+	fs.updateEntryMetadata(w, r, func(entry *filer2.Entry) error {
+		setTagsFromHeaders(entry, r.Header)
+		return nil
+	})
+
+}
+
+// curl -X PUT -H "X-Seaweed-Mode: 0644" -H "Content-Type: text/plain" "http://localhost:8888/path/to/file?metadata"
+func (fs *FilerServer) PutMetadataHandler(w http.ResponseWriter, r *http.Request) {
+
+	fs.updateEntryMetadata(w, r, func(entry *filer2.Entry) error {
+		if err := setAttrFromHeaders(&entry.Attr, r.Header); err != nil {
+			return err
+		}
+		setTagsFromHeaders(entry, r.Header)
+		return nil
+	})
+
+}
+
+// curl -X DELETE "http://localhost:8888/path/to/file?tagging"
+// curl -X DELETE "http://localhost:8888/path/to/file?tagging=Seaweed-Owner,Project"
+func (fs *FilerServer) DeleteTaggingHandler(w http.ResponseWriter, r *http.Request) {
+
+	var toDelete []string
+	for _, name := range strings.Split(r.URL.Query().Get("tagging"), ",") {
+		if name = http.CanonicalHeaderKey(strings.TrimSpace(name)); name == "" {
+			continue
+		}
+		if !strings.HasPrefix(name, SeaweedTagPrefix) {
+			name = http.CanonicalHeaderKey(SeaweedTagPrefix + name)
+		}
+		toDelete = append(toDelete, name)
+	}
+
+	fs.updateEntryMetadata(w, r, func(entry *filer2.Entry) error {
+		for key := range entry.Extended {
+			if !strings.HasPrefix(key, SeaweedTagPrefix) {
+				continue
+			}
+			if len(toDelete) == 0 {
+				delete(entry.Extended, key)
+			}
+			for _, name := range toDelete {
+				if key == name {
+					delete(entry.Extended, key)
+				}
+			}
+		}
+		return nil
+	})
+
+}
+
+// updateEntryMetadata applies the change to a copy of the entry, and saves it without touching the content
+func (fs *FilerServer) updateEntryMetadata(w http.ResponseWriter, r *http.Request, fn func(entry *filer2.Entry) error) {
+
+	ctx := context.Background()
+
+	fullPath := cleanFilerPath(r.URL.Path)
+	unlock := fs.filer.LockEntry(filer2.FullPath(fullPath))
+	defer unlock()
+
+	entry, err := fs.filer.FindEntry(ctx, filer2.FullPath(fullPath))
+	if err != nil {
+		glog.V(1).Infof("update metadata %s: %v", fullPath, err)
+		writeJsonError(w, r, http.StatusNotFound, fmt.Errorf("%s not found", fullPath))
+		return
+	}
+
+	newEntry := &filer2.Entry{
+		FullPath: entry.FullPath,
+		Attr:     entry.Attr,
+		Chunks:   entry.Chunks,
+		Extended: make(map[string][]byte, len(entry.Extended)),
+	}
+	for k, v := range entry.Extended {
+		newEntry.Extended[k] = v
+	}
+
+	if err = fn(newEntry); err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if len(newEntry.Extended) == 0 {
+		newEntry.Extended = nil
+	}
+
+	if filer2.EqualEntry(entry, newEntry) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if err = fs.filer.UpdateEntry(ctx, entry, newEntry); err != nil {
+		glog.V(0).Infof("update metadata %s: %v", fullPath, err)
+		writeJsonError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	fs.filer.NotifyUpdateEvent(entry, newEntry, false)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func setTagsFromHeaders(entry *filer2.Entry, header http.Header) {
+	for name, values := range header {
+		name = http.CanonicalHeaderKey(name)
+		if !strings.HasPrefix(name, SeaweedTagPrefix) || len(values) == 0 {
+			continue
+		}
+		entry.Extended[name] = []byte(values[0])
+	}
+}
+
+// extendedFromHeader decodes the extended attributes of a new file, keeping the keys as they are
+func extendedFromHeader(header http.Header) (map[string][]byte, error) {
+	v := header.Get(SeaweedExtendedHeader)
+	if v == "" {
+		return nil, nil
+	}
+	values, err := url.ParseQuery(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", SeaweedExtendedHeader, err)
+	}
+	extended := make(map[string][]byte, len(values))
+	for key, value := range values {
+		if len(value) > 0 {
+			extended[key] = []byte(value[0])
+		}
+	}
+	return extended, nil
+}
+
+func setAttrFromHeaders(attr *filer2.Attr, header http.Header) error {
+	if v := header.Get(SeaweedModeHeader); v != "" {
+		mode, err := strconv.ParseUint(v, 8, 32)
+		if err != nil || mode&^uint64(os.ModePerm) != 0 {
+			return fmt.Errorf("invalid %s %s", SeaweedModeHeader, v)
+		}
+		attr.Mode = attr.Mode&^os.ModePerm | os.FileMode(mode)
+	}
+	if v := header.Get(SeaweedUidHeader); v != "" {
+		uid, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid %s %s", SeaweedUidHeader, v)
+		}
+		attr.Uid = uint32(uid)
+	}
+	if v := header.Get(SeaweedGidHeader); v != "" {
+		gid, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid %s %s", SeaweedGidHeader, v)
+		}
+		attr.Gid = uint32(gid)
+	}
+	if v := header.Get(SeaweedTtlHeader); v != "" {
+		ttlSec, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || ttlSec < 0 {
+			return fmt.Errorf("invalid %s %s", SeaweedTtlHeader, v)
+		}
+		attr.TtlSec = int32(ttlSec)
+	}
+	if v := header.Get("Content-Type"); v != "" {
+		attr.Mime = v
+	}
+	return nil
+}
+
+// setTagHeaders returns the user tags as response headers
+func setTagHeaders(w http.ResponseWriter, entry *filer2.Entry) {
+	for k, v := range entry.Extended {
+		if strings.HasPrefix(k, SeaweedTagPrefix) {
+			w.Header().Set(k, string(v))
+		}
+	}
+}
+
+func cleanFilerPath(p string) string {
+	return path.Clean("/" + p)
+}
@@ -0,0 +1,82 @@
+package weed_server
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+)
+
+func TestSetAttrFromHeaders(t *testing.T) {
+	attr := filer2.Attr{Mode: os.ModeDir | 0755, Uid: 1, Gid: 1, Mime: "text/plain"}
+
+	header := make(http.Header)
+	header.Set(SeaweedModeHeader, "0700")
+	header.Set(SeaweedUidHeader, "1000")
+	header.Set(SeaweedTtlHeader, "3600")
+	header.Set("Content-Type", "application/json")
+	if err := setAttrFromHeaders(&attr, header); err != nil {
+		t.Fatalf("set attr: %v", err)
+	}
+	if attr.Mode != os.ModeDir|0700 || attr.Uid != 1000 || attr.Gid != 1 || attr.TtlSec != 3600 || attr.Mime != "application/json" {
+		t.Errorf("unexpected attr %+v", attr)
+	}
+
+	for _, h := range [][2]string{
+		{SeaweedModeHeader, "0999"},
+		{SeaweedModeHeader, "04000755"},
+		{SeaweedGidHeader, "-1"},
+		{SeaweedTtlHeader, "1d"},
+	} {
+		header := make(http.Header)
+		header.Set(h[0], h[1])
+		if err := setAttrFromHeaders(&attr, header); err == nil {
+			t.Errorf("accepted %s: %s", h[0], h[1])
+		}
+	}
+}
+
+func TestSetTagsFromHeaders(t *testing.T) {
+	entry := &filer2.Entry{Extended: map[string][]byte{"Seaweed-Owner": []byte("a"), "other": []byte("x")}}
+
+	header := make(http.Header)
+	header.Set("seaweed-owner", "b")
+	header.Set("Seaweed-Project", "p")
+	header.Set("X-Other", "ignored")
+	setTagsFromHeaders(entry, header)
+
+	if len(entry.Extended) != 3 || string(entry.Extended["Seaweed-Owner"]) != "b" || string(entry.Extended["Seaweed-Project"]) != "p" {
+		t.Errorf("unexpected tags %+v", entry.Extended)
+	}
+}
+
+func TestCleanFilerPath(t *testing.T) {
+	for p, expected := range map[string]string{
+		"":           "/",
+		"/":          "/",
+		"a/b/":       "/a/b",
+		"/a/../b//c": "/b/c",
+	} {
+		if cleaned := cleanFilerPath(p); cleaned != expected {
+			t.Errorf("%q cleaned to %q, expected %q", p, cleaned, expected)
+		}
+	}
+}
+
+func TestExtendedFromHeader(t *testing.T) {
+	header := make(http.Header)
+	header.Set(SeaweedExtendedHeader, "X-Amz-Tagging-project=a&X-Amz-Tagging-Team=b+c")
+	extended, err := extendedFromHeader(header)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(extended) != 2 || string(extended["X-Amz-Tagging-project"]) != "a" || string(extended["X-Amz-Tagging-Team"]) != "b c" {
+		t.Errorf("unexpected extended %+v", extended)
+	}
+
+	header.Set(SeaweedExtendedHeader, "a=%zz")
+	if _, err := extendedFromHeader(header); err == nil {
+		t.Errorf("accepted invalid %s", SeaweedExtendedHeader)
+	}
+}
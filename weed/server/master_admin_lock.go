@@ -0,0 +1,79 @@
+package weed_server
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// AdminLockDuration is how long an admin lock lease lasts, unless it is renewed
+const AdminLockDuration = 10 * time.Second
+
+type adminLock struct {
+	token    int64
+	client   string
+	lockTsNs int64
+	expireAt time.Time
+}
+
+// adminLocks are leased by weed shell and the maintenance scheduler,
+// so that only one of them changes the volume placement at a time
+type adminLocks struct {
+	sync.Mutex
+	locks map[string]*adminLock
+}
+
+func newAdminLocks() *adminLocks {
+	return &adminLocks{
+		locks: make(map[string]*adminLock),
+	}
+}
+
+// lease grants the lock, or renews it if the previous token is still the current one
+func (l *adminLocks) lease(lockName string, previousToken int64, client string, now time.Time) (token int64, lockTsNs int64, err error) {
+	l.Lock()
+	defer l.Unlock()
+
+	lock, found := l.locks[lockName]
+	if found && now.Before(lock.expireAt) && lock.token != previousToken {
+		return 0, 0, fmt.Errorf("%s is locked by %s since %v", lockName, lock.client, time.Unix(0, lock.lockTsNs).Format(time.RFC3339))
+	}
+
+	if found && lock.token == previousToken && previousToken != 0 {
+		lock.expireAt = now.Add(AdminLockDuration)
+		return lock.token, lock.lockTsNs, nil
+	}
+
+	token = rand.Int63()
+	for token == 0 {
+		token = rand.Int63()
+	}
+	l.locks[lockName] = &adminLock{
+		token:    token,
+		client:   client,
+		lockTsNs: now.UnixNano(),
+		expireAt: now.Add(AdminLockDuration),
+	}
+	return token, now.UnixNano(), nil
+}
+
+func (l *adminLocks) release(lockName string, previousToken int64) {
+	l.Lock()
+	defer l.Unlock()
+
+	if lock, found := l.locks[lockName]; found && lock.token == previousToken {
+		delete(l.locks, lockName)
+	}
+}
+
+// lockedBy returns the client holding the lock, if any
+func (l *adminLocks) lockedBy(lockName string, now time.Time) (client string, isLocked bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	if lock, found := l.locks[lockName]; found && now.Before(lock.expireAt) {
+		return lock.client, true
+	}
+	return "", false
+}
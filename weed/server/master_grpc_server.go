@@ -22,11 +22,14 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 		if dn != nil {
 
 			glog.V(0).Infof("unregister disconnected volume server %s:%d", dn.Ip, dn.Port)
+			// the data center is not reachable after the data node is unlinked
+			dataCenter := string(dn.GetDataCenter().Id())
 			t.UnRegisterDataNode(dn)
 
 			message := &master_pb.VolumeLocation{
-				Url:       dn.Url(),
-				PublicUrl: dn.PublicUrl,
+				Url:        dn.Url(),
+				PublicUrl:  dn.PublicUrl,
+				DataCenter: dataCenter,
 			}
 			for _, v := range dn.GetVolumes() {
 				message.DeletedVids = append(message.DeletedVids, uint32(v.Id))
@@ -79,8 +82,9 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 
 		glog.V(4).Infof("master received heartbeat %s", heartbeat.String())
 		message := &master_pb.VolumeLocation{
-			Url:       dn.Url(),
-			PublicUrl: dn.PublicUrl,
+			Url:        dn.Url(),
+			PublicUrl:  dn.PublicUrl,
+			DataCenter: string(dn.GetDataCenter().Id()),
 		}
 		if len(heartbeat.NewVolumes) > 0 || len(heartbeat.DeletedVolumes) > 0 {
 			// process delta volume ids if exists for fast volume id updates
@@ -95,6 +99,8 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 		}
 
 		if len(heartbeat.Volumes) > 0 || heartbeat.HasNoVolumes {
+			dn.UpdateDiskTypeMaxVolumeCounts(heartbeat.MaxVolumeCounts)
+
 			// process heartbeat.Volumes
 			newVolumes, deletedVolumes := t.SyncDataNodeRegistration(heartbeat.Volumes, dn)
 
@@ -259,3 +265,38 @@ func (ms *MasterServer) informNewLeader(stream master_pb.Seaweed_KeepConnectedSe
 	}
 	return nil
 }
+
+const unwritableVolumesSendTimeout = 5 * time.Second
+
+// loopBroadcastingUnwritableVolumes tells the master clients to stop using the file ids leased on the volumes
+func (ms *MasterServer) loopBroadcastingUnwritableVolumes() {
+	for vid := range ms.Topo.UnwritableVolumes() {
+		message := &master_pb.VolumeLocation{
+			UnwritableVids: []uint32{uint32(vid)},
+		}
+		// batch the volumes reported at the same time
+		for hasMore := true; hasMore; {
+			select {
+			case vid := <-ms.Topo.UnwritableVolumes():
+				message.UnwritableVids = append(message.UnwritableVids, uint32(vid))
+			default:
+				hasMore = false
+			}
+		}
+		// send without the lock, since a disconnecting client stops reading and waits for the lock to unregister
+		clientChans := make(map[string]chan *master_pb.VolumeLocation)
+		ms.clientChansLock.RLock()
+		for host, ch := range ms.clientChans {
+			clientChans[host] = ch
+		}
+		ms.clientChansLock.RUnlock()
+		for host, ch := range clientChans {
+			glog.V(1).Infof("master send to %s: %s", host, message.String())
+			select {
+			case ch <- message:
+			case <-time.After(unwritableVolumesSendTimeout):
+				glog.V(0).Infof("skip sending unwritable volumes to disconnected client %s", host)
+			}
+		}
+	}
+}
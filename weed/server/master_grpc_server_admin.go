@@ -0,0 +1,37 @@
+package weed_server
+
+import (
+	"context"
+	"time"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func (ms *MasterServer) LeaseAdminToken(ctx context.Context, req *master_pb.LeaseAdminTokenRequest) (*master_pb.LeaseAdminTokenResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	token, lockTsNs, err := ms.adminLocks.lease(req.LockName, req.PreviousToken, req.ClientName, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &master_pb.LeaseAdminTokenResponse{
+		Token:    token,
+		LockTsNs: lockTsNs,
+	}, nil
+}
+
+func (ms *MasterServer) ReleaseAdminToken(ctx context.Context, req *master_pb.ReleaseAdminTokenRequest) (*master_pb.ReleaseAdminTokenResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	ms.adminLocks.release(req.LockName, req.PreviousToken)
+
+	return &master_pb.ReleaseAdminTokenResponse{}, nil
+}
@@ -0,0 +1,69 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func (ms *MasterServer) RaftListClusterServers(ctx context.Context, req *master_pb.RaftListClusterServersRequest) (*master_pb.RaftListClusterServersResponse, error) {
+	resp := &master_pb.RaftListClusterServersResponse{}
+
+	if ms.raftServer == nil {
+		return nil, fmt.Errorf("raft is not started")
+	}
+
+	for _, member := range ms.raftServer.Members() {
+		resp.ClusterServers = append(resp.ClusterServers, &master_pb.RaftListClusterServersResponse_ClusterServer{
+			Address:        member.Address,
+			IsLeader:       member.IsLeader,
+			IsAlive:        member.IsAlive,
+			LastActivityNs: member.LastActivity.UnixNano(),
+		})
+	}
+
+	return resp, nil
+}
+
+func (ms *MasterServer) RaftAddServer(ctx context.Context, req *master_pb.RaftAddServerRequest) (*master_pb.RaftAddServerResponse, error) {
+
+	if ms.raftServer == nil {
+		return nil, fmt.Errorf("raft is not started")
+	}
+	if req.Address == "" {
+		return nil, fmt.Errorf("missing master address")
+	}
+
+	// the new master should be running, otherwise it may not be possible to reach the new quorum
+	err := operation.WithMasterServerClient(req.Address, ms.grpcDialOpiton, func(client master_pb.SeaweedClient) error {
+		_, err := client.GetMasterConfiguration(ctx, &master_pb.GetMasterConfigurationRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("master %s is not reachable: %v", req.Address, err)
+	}
+
+	if err = ms.raftServer.AddMember(req.Address); err != nil {
+		return nil, err
+	}
+	glog.V(0).Infof("raft member %s is added", req.Address)
+
+	return &master_pb.RaftAddServerResponse{}, nil
+}
+
+func (ms *MasterServer) RaftRemoveServer(ctx context.Context, req *master_pb.RaftRemoveServerRequest) (*master_pb.RaftRemoveServerResponse, error) {
+
+	if ms.raftServer == nil {
+		return nil, fmt.Errorf("raft is not started")
+	}
+
+	if err := ms.raftServer.RemoveMember(req.Address); err != nil {
+		return nil, err
+	}
+	glog.V(0).Infof("raft member %s is removed", req.Address)
+
+	return &master_pb.RaftRemoveServerResponse{}, nil
+}
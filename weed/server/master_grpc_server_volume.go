@@ -9,6 +9,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"github.com/chrislusf/seaweedfs/weed/topology"
 )
 
@@ -60,6 +61,10 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 	if err != nil {
 		return nil, err
 	}
+	diskType, err := ms.getDiskType(req.Collection, req.DiskType)
+	if err != nil {
+		return nil, err
+	}
 
 	option := &topology.VolumeGrowOption{
 		Collection:         req.Collection,
@@ -70,11 +75,12 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 		Rack:               req.Rack,
 		DataNode:           req.DataNode,
 		MemoryMapMaxSizeMB: req.MemoryMapMaxSizeMB,
+		DiskType:           diskType,
 	}
 
 	if !ms.Topo.HasWritableVolume(option) {
-		if ms.Topo.FreeSpace() <= 0 {
-			return nil, fmt.Errorf("No free volumes left!")
+		if ms.Topo.AvailableSpaceFor(diskType) <= 0 {
+			return nil, fmt.Errorf("No free %s volumes left!", diskType)
 		}
 		ms.vgLock.Lock()
 		if !ms.Topo.HasWritableVolume(option) {
@@ -117,7 +123,7 @@ func (ms *MasterServer) Statistics(ctx context.Context, req *master_pb.Statistic
 		return nil, err
 	}
 
-	volumeLayout := ms.Topo.GetVolumeLayout(req.Collection, replicaPlacement, ttl)
+	volumeLayout := ms.Topo.GetVolumeLayout(req.Collection, replicaPlacement, ttl, types.HardDriveType)
 	stats := volumeLayout.Stats()
 
 	resp := &master_pb.StatisticsResponse{
@@ -185,3 +191,23 @@ func (ms *MasterServer) GetMasterConfiguration(ctx context.Context, req *master_
 
 	return resp, nil
 }
+
+func (ms *MasterServer) VolumeServerDrain(ctx context.Context, req *master_pb.VolumeServerDrainRequest) (*master_pb.VolumeServerDrainResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	if req.Address == "" {
+		return nil, fmt.Errorf("missing volume server address")
+	}
+
+	dn, err := ms.Topo.DrainDataNode(req.Address, req.IsDraining)
+	if err != nil {
+		return nil, err
+	}
+
+	return &master_pb.VolumeServerDrainResponse{
+		IsConnected: dn != nil,
+	}, nil
+}
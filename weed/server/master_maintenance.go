@@ -0,0 +1,294 @@
+package weed_server
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/shell"
+	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/spf13/viper"
+)
+
+const (
+	maintenanceClientName     = "master maintenance"
+	maintenanceMaxOutputBytes = 64 * 1024
+	maintenanceReportCount    = 8
+)
+
+type MaintenanceCommandResult struct {
+	Command   string
+	StartedAt time.Time
+	Duration  time.Duration
+	Output    string
+	Error     string
+	Skipped   string
+}
+
+type MaintenanceReport struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	DryRun     bool
+	Skipped    string
+	Aborted    string
+	Commands   []*MaintenanceCommandResult
+}
+
+func (r *MaintenanceReport) HasError() bool {
+	if r.Aborted != "" {
+		return true
+	}
+	for _, c := range r.Commands {
+		if c.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// maintenanceScheduler runs the scripts in master.toml on the leader master.
+// It takes the admin lock for each run, so a locked weed shell postpones the scripts.
+type maintenanceScheduler struct {
+	scripts      [][]string
+	sleep        time.Duration
+	commandPause time.Duration
+	dryRun       bool
+
+	reportsLock sync.RWMutex
+	reports     []*MaintenanceReport
+}
+
+var maintenanceScriptRegexp = regexp.MustCompile(`'.*?'|".*?"|\S+`)
+
+func parseMaintenanceScripts(scripts string) (commands [][]string) {
+	for _, line := range strings.Split(scripts, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		cmds := maintenanceScriptRegexp.FindAllString(line, -1)
+		args := make([]string, len(cmds))
+		for i := range cmds {
+			args[i] = strings.Trim(cmds[i], "\"'")
+		}
+		args[0] = strings.ToLower(args[0])
+		commands = append(commands, args)
+	}
+	return
+}
+
+// dryRunArgs changes the arguments to only report the changes,
+// for the commands which can do so
+func dryRunArgs(args []string) ([]string, bool) {
+	switch args[0] {
+	case "volume.list", "collection.list", "cluster.raft.ps", "volume.fix.replication":
+	case "volume.balance", "volume.tier.move", "ec.balance", "ec.rebuild":
+	default:
+		return nil, false
+	}
+
+	var dryArgs []string
+	for _, arg := range args {
+		switch arg {
+		case "-force", "--force", "-force=true", "--force=true":
+			continue
+		}
+		dryArgs = append(dryArgs, arg)
+	}
+	if args[0] == "volume.fix.replication" && (len(dryArgs) < 2 || dryArgs[1] != "-n") {
+		dryArgs = append([]string{dryArgs[0], "-n"}, dryArgs[1:]...)
+	}
+	return dryArgs, true
+}
+
+type limitedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); room < len(p) {
+		if room > 0 {
+			b.Buffer.Write(p[:room])
+		}
+		b.truncated = true
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	if b.truncated {
+		return b.Buffer.String() + "\n...truncated"
+	}
+	return b.Buffer.String()
+}
+
+func (ms *MasterServer) startAdminScripts() {
+	v := viper.GetViper()
+	adminScripts := v.GetString("master.maintenance.scripts")
+	v.SetDefault("master.maintenance.sleep_minutes", 17)
+	sleepMinutes := v.GetInt("master.maintenance.sleep_minutes")
+
+	glog.V(0).Infof("adminScripts:\n%v", adminScripts)
+	if adminScripts == "" {
+		return
+	}
+
+	ms.maintenance = &maintenanceScheduler{
+		scripts:      parseMaintenanceScripts(adminScripts),
+		sleep:        time.Duration(sleepMinutes) * time.Minute,
+		commandPause: time.Duration(v.GetInt("master.maintenance.command_pause_seconds")) * time.Second,
+		dryRun:       v.GetBool("master.maintenance.dry_run"),
+	}
+
+	masterAddress := "localhost:" + strconv.Itoa(ms.option.Port)
+
+	var shellOptions shell.ShellOptions
+	shellOptions.GrpcDialOption = security.LoadClientTLS(viper.Sub("grpc"), "master")
+	shellOptions.Masters = &masterAddress
+	shellOptions.FilerHost = "localhost"
+	shellOptions.FilerPort = 8888
+	shellOptions.Directory = "/"
+	shellOptions.LockClientName = maintenanceClientName
+
+	commandEnv := shell.NewCommandEnv(shellOptions)
+
+	go commandEnv.MasterClient.KeepConnectedToMaster()
+
+	go func() {
+		commandEnv.MasterClient.WaitUntilConnected()
+
+		c := time.Tick(ms.maintenance.sleep)
+		for _ = range c {
+			if ms.Topo.IsLeader() {
+				ms.maintenance.addReport(ms.runMaintenance(commandEnv, os.Stdout))
+			}
+		}
+	}()
+}
+
+func (ms *MasterServer) runMaintenance(commandEnv *shell.CommandEnv, stdout io.Writer) *MaintenanceReport {
+	m := ms.maintenance
+	report := &MaintenanceReport{
+		StartedAt: time.Now(),
+		DryRun:    m.dryRun,
+	}
+	defer func() {
+		report.FinishedAt = time.Now()
+		result := "ok"
+		if report.Skipped != "" {
+			result = "skipped"
+		} else if report.HasError() {
+			result = "failed"
+		}
+		stats.MasterMaintenanceRunCounter.WithLabelValues(result).Inc()
+		stats.MasterMaintenanceLastRunGauge.Set(float64(report.FinishedAt.Unix()))
+	}()
+
+	// the commands changing the volume placement require the lock of the command env
+	locker := commandEnv.Locker()
+	if err := locker.RequestLock(); err != nil {
+		report.Skipped = err.Error()
+		glog.V(0).Infof("skip maintenance: %v", err)
+		return report
+	}
+	defer locker.ReleaseLock()
+
+	for i, args := range m.scripts {
+		if i > 0 && m.commandPause > 0 {
+			time.Sleep(m.commandPause)
+		}
+		if !ms.Topo.IsLeader() {
+			report.Skipped = "no longer the leader"
+			return report
+		}
+		// another shell may hold the lock once a renewal failed
+		if !locker.IsLocking() {
+			report.Aborted = "lost the admin lock"
+			glog.Errorf("abort maintenance: %s", report.Aborted)
+			return report
+		}
+		report.Commands = append(report.Commands, runMaintenanceCommand(args, m.dryRun, commandEnv, stdout))
+	}
+
+	return report
+}
+
+func runMaintenanceCommand(args []string, dryRun bool, commandEnv *shell.CommandEnv, stdout io.Writer) *MaintenanceCommandResult {
+	result := &MaintenanceCommandResult{
+		Command:   strings.Join(args, " "),
+		StartedAt: time.Now(),
+	}
+
+	if dryRun {
+		dryArgs, ok := dryRunArgs(args)
+		if !ok {
+			result.Skipped = "can not run as dry run"
+			stats.MasterMaintenanceCommandCounter.WithLabelValues(args[0], "skipped").Inc()
+			return result
+		}
+		args = dryArgs
+		result.Command = strings.Join(args, " ")
+	}
+
+	var cmd interface {
+		Do([]string, *shell.CommandEnv, io.Writer) error
+	}
+	for _, c := range shell.Commands {
+		if strings.ToLower(c.Name()) == args[0] {
+			cmd = c
+		}
+	}
+	if cmd == nil {
+		result.Error = "unknown command"
+		stats.MasterMaintenanceCommandCounter.WithLabelValues(args[0], "failed").Inc()
+		return result
+	}
+
+	output := &limitedBuffer{limit: maintenanceMaxOutputBytes}
+	glog.V(0).Infof("executing: %s", result.Command)
+	err := cmd.Do(args[1:], commandEnv, io.MultiWriter(stdout, output))
+	result.Duration = time.Since(result.StartedAt)
+	result.Output = output.String()
+
+	stats.MasterMaintenanceCommandHistogram.WithLabelValues(args[0]).Observe(result.Duration.Seconds())
+	if err != nil {
+		glog.V(0).Infof("error: %v", err)
+		result.Error = err.Error()
+		stats.MasterMaintenanceCommandCounter.WithLabelValues(args[0], "failed").Inc()
+	} else {
+		stats.MasterMaintenanceCommandCounter.WithLabelValues(args[0], "ok").Inc()
+	}
+	return result
+}
+
+func (m *maintenanceScheduler) addReport(report *MaintenanceReport) {
+	m.reportsLock.Lock()
+	defer m.reportsLock.Unlock()
+	m.reports = append(m.reports, report)
+	if len(m.reports) > maintenanceReportCount {
+		m.reports = m.reports[len(m.reports)-maintenanceReportCount:]
+	}
+}
+
+// Reports lists the recent runs, the latest first
+func (m *maintenanceScheduler) Reports() (reports []*MaintenanceReport) {
+	if m == nil {
+		return nil
+	}
+	m.reportsLock.RLock()
+	defer m.reportsLock.RUnlock()
+	for i := len(m.reports) - 1; i >= 0; i-- {
+		reports = append(reports, m.reports[i])
+	}
+	return
+}
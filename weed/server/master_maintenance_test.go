@@ -0,0 +1,86 @@
+package weed_server
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseMaintenanceScripts(t *testing.T) {
+	scripts := parseMaintenanceScripts(`
+  # repair first
+  volume.fix.replication
+  Volume.Balance -force -c "my collection"
+`)
+	expected := [][]string{
+		{"volume.fix.replication"},
+		{"volume.balance", "-force", "-c", "my collection"},
+	}
+	if !reflect.DeepEqual(scripts, expected) {
+		t.Errorf("parsed %+v, expected %+v", scripts, expected)
+	}
+}
+
+func TestDryRunArgs(t *testing.T) {
+	for _, c := range []struct {
+		args     []string
+		expected []string
+		ok       bool
+	}{
+		{[]string{"volume.balance", "-force", "-c", "x"}, []string{"volume.balance", "-c", "x"}, true},
+		{[]string{"ec.rebuild", "-force=true"}, []string{"ec.rebuild"}, true},
+		{[]string{"volume.fix.replication"}, []string{"volume.fix.replication", "-n"}, true},
+		{[]string{"volume.fix.replication", "-n"}, []string{"volume.fix.replication", "-n"}, true},
+		{[]string{"ec.encode", "-fullPercent=95"}, nil, false},
+		{[]string{"volume.vacuum"}, nil, false},
+	} {
+		dryArgs, ok := dryRunArgs(c.args)
+		if ok != c.ok || !reflect.DeepEqual(dryArgs, c.expected) {
+			t.Errorf("%v: got %v %v, expected %v %v", c.args, dryArgs, ok, c.expected, c.ok)
+		}
+	}
+}
+
+func TestAdminLocks(t *testing.T) {
+	locks := newAdminLocks()
+	now := time.Now()
+
+	token, _, err := locks.lease("admin", 0, "shell", now)
+	if err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+	if _, _, err = locks.lease("admin", 0, "maintenance", now); err == nil {
+		t.Fatalf("leased a held lock")
+	}
+	if client, isLocked := locks.lockedBy("admin", now); !isLocked || client != "shell" {
+		t.Errorf("locked by %s %v, expected shell", client, isLocked)
+	}
+
+	// renew before it expires
+	now = now.Add(AdminLockDuration / 2)
+	renewed, _, err := locks.lease("admin", token, "shell", now)
+	if err != nil || renewed != token {
+		t.Fatalf("renew: %d %v", renewed, err)
+	}
+	now = now.Add(AdminLockDuration * 3 / 4)
+	if _, _, err = locks.lease("admin", 0, "maintenance", now); err == nil {
+		t.Fatalf("leased a renewed lock")
+	}
+
+	// an expired lock can be taken over
+	now = now.Add(AdminLockDuration)
+	other, _, err := locks.lease("admin", 0, "maintenance", now)
+	if err != nil {
+		t.Fatalf("lease expired lock: %v", err)
+	}
+
+	// the stale token can not release the new holder's lock
+	locks.release("admin", token)
+	if _, isLocked := locks.lockedBy("admin", now); !isLocked {
+		t.Errorf("released by a stale token")
+	}
+	locks.release("admin", other)
+	if _, isLocked := locks.lockedBy("admin", now); isLocked {
+		t.Errorf("still locked after release")
+	}
+}
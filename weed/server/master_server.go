@@ -3,24 +3,19 @@ package weed_server
 import (
 	"context"
 	"fmt"
-	"github.com/chrislusf/seaweedfs/weed/shell"
 	"github.com/chrislusf/seaweedfs/weed/wdclient"
 	"google.golang.org/grpc"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
-	"os"
-	"regexp"
-	"strconv"
-	"strings"
 	"sync"
-	"time"
 
 	"github.com/chrislusf/raft"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/stats"
 	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/gorilla/mux"
@@ -60,6 +55,11 @@ type MasterServer struct {
 	grpcDialOpiton grpc.DialOption
 
 	MasterClient *wdclient.MasterClient
+
+	raftServer *RaftServer
+
+	adminLocks  *adminLocks
+	maintenance *maintenanceScheduler
 }
 
 func NewMasterServer(r *mux.Router, option *MasterOption, peers []string) *MasterServer {
@@ -85,6 +85,7 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers []string) *Maste
 		clientChans:     make(map[string]chan *master_pb.VolumeLocation),
 		grpcDialOpiton:  grpcDialOption,
 		MasterClient:    wdclient.NewMasterClient(context.Background(), grpcDialOption, "master", peers),
+		adminLocks:      newAdminLocks(),
 	}
 	ms.bounedLeaderChan = make(chan int, 16)
 	seq := sequence.NewMemorySequencer()
@@ -105,6 +106,7 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers []string) *Maste
 		r.HandleFunc("/vol/grow", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeGrowHandler)))
 		r.HandleFunc("/vol/status", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeStatusHandler)))
 		r.HandleFunc("/vol/vacuum", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeVacuumHandler)))
+		r.HandleFunc("/maintenance/status", ms.proxyToLeader(ms.guard.WhiteList(ms.maintenanceStatusHandler)))
 		r.HandleFunc("/submit", ms.guard.WhiteList(ms.submitFromMasterServerHandler))
 		r.HandleFunc("/stats/health", ms.guard.WhiteList(statsHealthHandler))
 		r.HandleFunc("/stats/counter", ms.guard.WhiteList(statsCounterHandler))
@@ -113,13 +115,22 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers []string) *Maste
 	}
 
 	ms.Topo.StartRefreshWritableVolumes(ms.grpcDialOpiton, ms.option.GarbageThreshold, ms.preallocateSize)
+	go ms.loopBroadcastingUnwritableVolumes()
 
 	ms.startAdminScripts()
 
+	if ms.option.MetricsAddress != "" {
+		go stats.LoopPushingMetric("master", stats.SourceName(ms.option.Port), stats.MasterGather,
+			func() (addr string, intervalSeconds int) {
+				return ms.option.MetricsAddress, ms.option.MetricsIntervalSec
+			})
+	}
+
 	return ms
 }
 
 func (ms *MasterServer) SetRaftServer(raftServer *RaftServer) {
+	ms.raftServer = raftServer
 	ms.Topo.RaftServer = raftServer.raftServer
 	ms.Topo.RaftServer.AddEventListener(raft.LeaderChangeEventType, func(e raft.Event) {
 		glog.V(0).Infof("event: %+v", e)
@@ -170,63 +181,3 @@ func (ms *MasterServer) proxyToLeader(f func(w http.ResponseWriter, r *http.Requ
 		}
 	}
 }
-
-func (ms *MasterServer) startAdminScripts() {
-	v := viper.GetViper()
-	adminScripts := v.GetString("master.maintenance.scripts")
-	v.SetDefault("master.maintenance.sleep_minutes", 17)
-	sleepMinutes := v.GetInt("master.maintenance.sleep_minutes")
-
-	glog.V(0).Infof("adminScripts:\n%v", adminScripts)
-	if adminScripts == "" {
-		return
-	}
-
-	scriptLines := strings.Split(adminScripts, "\n")
-
-	masterAddress := "localhost:" + strconv.Itoa(ms.option.Port)
-
-	var shellOptions shell.ShellOptions
-	shellOptions.GrpcDialOption = security.LoadClientTLS(viper.Sub("grpc"), "master")
-	shellOptions.Masters = &masterAddress
-	shellOptions.FilerHost = "localhost"
-	shellOptions.FilerPort = 8888
-	shellOptions.Directory = "/"
-
-	commandEnv := shell.NewCommandEnv(shellOptions)
-
-	reg, _ := regexp.Compile(`'.*?'|".*?"|\S+`)
-
-	go commandEnv.MasterClient.KeepConnectedToMaster()
-
-	go func() {
-		commandEnv.MasterClient.WaitUntilConnected()
-
-		c := time.Tick(time.Duration(sleepMinutes) * time.Minute)
-		for _ = range c {
-			if ms.Topo.IsLeader() {
-				for _, line := range scriptLines {
-
-					cmds := reg.FindAllString(line, -1)
-					if len(cmds) == 0 {
-						continue
-					}
-					args := make([]string, len(cmds[1:]))
-					for i := range args {
-						args[i] = strings.Trim(string(cmds[1+i]), "\"'")
-					}
-					cmd := strings.ToLower(cmds[0])
-
-					for _, c := range shell.Commands {
-						if c.Name() == cmd {
-							glog.V(0).Infof("executing: %s %v", cmd, args)
-							if err := c.Do(args, commandEnv, os.Stdout); err != nil {
-								glog.V(0).Infof("error: %v", err)
-							}
-						}
-					}
-				}
-			}
-		}
-	}()
-}
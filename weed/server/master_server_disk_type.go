@@ -0,0 +1,20 @@
+package weed_server
+
+import (
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/spf13/viper"
+)
+
+// getDiskType uses the requested disk type, or the disk type of the collection
+// configured in master.toml
+//
+//	[master.collection_disk]
+//	hot_images = "ssd"
+func (ms *MasterServer) getDiskType(collection string, diskType string) (types.DiskType, error) {
+	if diskType == "" && collection != "" {
+		diskType = viper.GetStringMapString("master.collection_disk")[strings.ToLower(collection)]
+	}
+	return types.ToDiskType(diskType)
+}
@@ -101,8 +101,8 @@ func (ms *MasterServer) dirAssignHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	if !ms.Topo.HasWritableVolume(option) {
-		if ms.Topo.FreeSpace() <= 0 {
-			writeJsonQuiet(w, r, http.StatusNotFound, operation.AssignResult{Error: "No free volumes left!"})
+		if ms.Topo.AvailableSpaceFor(option.DiskType) <= 0 {
+			writeJsonQuiet(w, r, http.StatusNotFound, operation.AssignResult{Error: fmt.Sprintf("No free %s volumes left!", option.DiskType)})
 			return
 		}
 		ms.vgLock.Lock()
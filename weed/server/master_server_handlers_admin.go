@@ -7,10 +7,12 @@ import (
 	"math/rand"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/shell"
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/topology"
@@ -70,8 +72,8 @@ func (ms *MasterServer) volumeGrowHandler(w http.ResponseWriter, r *http.Request
 	}
 	if err == nil {
 		if count, err = strconv.Atoi(r.FormValue("count")); err == nil {
-			if ms.Topo.FreeSpace() < int64(count*option.ReplicaPlacement.GetCopyCount()) {
-				err = fmt.Errorf("only %d volumes left, not enough for %d", ms.Topo.FreeSpace(), count*option.ReplicaPlacement.GetCopyCount())
+			if ms.Topo.AvailableSpaceFor(option.DiskType) < int64(count*option.ReplicaPlacement.GetCopyCount()) {
+				err = fmt.Errorf("only %d %s volumes left, not enough for %d", ms.Topo.AvailableSpaceFor(option.DiskType), option.DiskType, count*option.ReplicaPlacement.GetCopyCount())
 			} else {
 				count, err = ms.vg.GrowByCountAndType(ms.grpcDialOpiton, count, option, ms.Topo)
 			}
@@ -130,8 +132,23 @@ func (ms *MasterServer) submitFromMasterServerHandler(w http.ResponseWriter, r *
 	}
 }
 
+func (ms *MasterServer) maintenanceStatusHandler(w http.ResponseWriter, r *http.Request) {
+	m := make(map[string]interface{})
+	m["Version"] = util.VERSION
+	m["Enabled"] = ms.maintenance != nil
+	if ms.maintenance != nil {
+		m["DryRun"] = ms.maintenance.dryRun
+		m["IntervalMinutes"] = int(ms.maintenance.sleep / time.Minute)
+	}
+	if client, isLocked := ms.adminLocks.lockedBy(shell.AdminLockName, time.Now()); isLocked {
+		m["LockedBy"] = client
+	}
+	m["Reports"] = ms.maintenance.Reports()
+	writeJsonQuiet(w, r, http.StatusOK, m)
+}
+
 func (ms *MasterServer) HasWritableVolume(option *topology.VolumeGrowOption) bool {
-	vl := ms.Topo.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl)
+	vl := ms.Topo.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl, option.DiskType)
 	return vl.GetActiveVolumeCount(option) > 0
 }
 
@@ -152,6 +169,10 @@ func (ms *MasterServer) getVolumeGrowOption(r *http.Request) (*topology.VolumeGr
 	if err != nil {
 		return nil, err
 	}
+	diskType, err := ms.getDiskType(r.FormValue("collection"), r.FormValue("disk"))
+	if err != nil {
+		return nil, err
+	}
 
 	preallocate := ms.preallocateSize
 	if r.FormValue("preallocate") != "" {
@@ -169,6 +190,7 @@ func (ms *MasterServer) getVolumeGrowOption(r *http.Request) (*topology.VolumeGr
 		Rack:               r.FormValue("rack"),
 		DataNode:           r.FormValue("dataNode"),
 		MemoryMapMaxSizeMB: memoryMapMaxSizeMB,
+		DiskType:           diskType,
 	}
 	return volumeGrowOption, nil
 }
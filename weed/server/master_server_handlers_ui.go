@@ -13,17 +13,19 @@ func (ms *MasterServer) uiStatusHandler(w http.ResponseWriter, r *http.Request)
 	infos := make(map[string]interface{})
 	infos["Version"] = util.VERSION
 	args := struct {
-		Version    string
-		Topology   interface{}
-		RaftServer raft.Server
-		Stats      map[string]interface{}
-		Counters   *stats.ServerStats
+		Version     string
+		Topology    interface{}
+		RaftServer  raft.Server
+		Stats       map[string]interface{}
+		Counters    *stats.ServerStats
+		Maintenance []*MaintenanceReport
 	}{
 		util.VERSION,
 		ms.Topo.ToMap(),
 		ms.Topo.RaftServer,
 		infos,
 		serverStats,
+		ms.maintenance.Reports(),
 	}
 	ui.StatusTpl.Execute(w, args)
 }
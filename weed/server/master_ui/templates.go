@@ -87,7 +87,7 @@ var StatusTpl = template.Must(template.New("status").Parse(`<!DOCTYPE html>
             <tr>
               <td><code>{{ $dc.Id }}</code></td>
               <td>{{ $rack.Id }}</td>
-              <td><a href="http://{{ $dn.Url }}/ui/index.html">{{ $dn.Url }}</a></td>
+              <td><a href="http://{{ $dn.Url }}/ui/index.html">{{ $dn.Url }}</a>{{ if $dn.IsDraining }} <span class="label label-warning">draining</span>{{ end }}</td>
               <td>{{ $dn.Volumes }}</td>
               <td>{{ $dn.EcShards }}</td>
               <td>{{ $dn.Max }}</td>
@@ -99,6 +99,34 @@ var StatusTpl = template.Must(template.New("status").Parse(`<!DOCTYPE html>
         </table>
       </div>
 
+      {{ with .Maintenance }}
+      <div class="row">
+        <h2>Maintenance</h2>
+        {{ range $report := . }}
+        <h4>{{ $report.StartedAt.Format "2006-01-02 15:04:05" }} - {{ $report.FinishedAt.Format "15:04:05" }}
+          {{ if $report.DryRun }}<small>dry run</small>{{ end }}
+          {{ with $report.Skipped }}<small>skipped: {{ . }}</small>{{ end }}
+          {{ with $report.Aborted }}<small class="text-danger">aborted: {{ . }}</small>{{ end }}
+        </h4>
+        <table class="table table-condensed">
+          <tbody>
+          {{ range $c := $report.Commands }}
+            <tr>
+              <td class="col-sm-3"><code>{{ $c.Command }}</code></td>
+              <td class="col-sm-1">{{ $c.Duration }}</td>
+              <td class="col-sm-8">
+                {{ with $c.Error }}<span class="text-danger">{{ . }}</span>{{ end }}
+                {{ with $c.Skipped }}<span class="text-muted">{{ . }}</span>{{ end }}
+                {{ with $c.Output }}<pre class="pre-scrollable">{{ . }}</pre>{{ end }}
+              </td>
+            </tr>
+          {{ end }}
+          </tbody>
+        </table>
+        {{ end }}
+      </div>
+      {{ end }}
+
     </div>
   </body>
 </html>
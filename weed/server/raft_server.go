@@ -25,7 +25,7 @@ type RaftServer struct {
 	*raft.GrpcServer
 }
 
-func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr string, dataDir string, topo *topology.Topology, pulseSeconds int) *RaftServer {
+func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr string, dataDir string, topo *topology.Topology, pulseSeconds int, raftJoin bool) *RaftServer {
 	s := &RaftServer{
 		peers:      peers,
 		serverAddr: serverAddr,
@@ -38,11 +38,24 @@ func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr st
 	}
 
 	raft.RegisterCommand(&topology.MaxVolumeIdCommand{})
+	raft.RegisterCommand(&topology.DataNodeDrainingCommand{})
 
 	var err error
 	transporter := raft.NewGrpcTransporter(grpcDialOption)
 	glog.V(0).Infof("Starting RaftServer with %v", serverAddr)
 
+	// The members changed by cluster.raft.add/remove take precedence over the -peers option
+	if members, err := loadRaftMembers(s.dataDir); err != nil {
+		glog.Warningf("load raft members from %s: %v", s.dataDir, err)
+	} else if len(members) > 0 {
+		if isMember(serverAddr, members) {
+			glog.V(0).Infof("Using raft members %v saved in %s", members, s.dataDir)
+			s.peers = members
+		} else {
+			glog.Warningf("%s is removed from raft members %v, joining with %v", serverAddr, members, s.peers)
+		}
+	}
+
 	// Clear old cluster configurations if peers are changed
 	if oldPeers, changed := isPeersChanged(s.dataDir, serverAddr, s.peers); changed {
 		glog.V(0).Infof("Peers Change: %v => %v", oldPeers, s.peers)
@@ -66,7 +79,9 @@ func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr st
 
 	s.GrpcServer = raft.NewGrpcServer(s.raftServer)
 
-	if s.raftServer.IsLogEmpty() && isTheFirstOne(serverAddr, s.peers) {
+	if s.raftServer.IsLogEmpty() && raftJoin {
+		glog.V(0).Infoln("Waiting to be added to an existing cluster")
+	} else if s.raftServer.IsLogEmpty() && isTheFirstOne(serverAddr, s.peers) {
 		// Initialize the server by joining itself.
 		glog.V(0).Infoln("Initializing new cluster")
 
@@ -81,6 +96,10 @@ func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr st
 		}
 	}
 
+	// persist the membership changes after the startup
+	s.raftServer.AddEventListener(raft.AddPeerEventType, s.onMembershipChange)
+	s.raftServer.AddEventListener(raft.RemovePeerEventType, s.onMembershipChange)
+
 	glog.V(0).Infof("current cluster leader: %v", s.raftServer.Leader())
 
 	return s
@@ -0,0 +1,164 @@
+package weed_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const raftMembersFile = "members"
+
+type RaftMember struct {
+	Address      string
+	IsLeader     bool
+	IsAlive      bool
+	LastActivity time.Time
+}
+
+func loadRaftMembers(dir string) (members []string, err error) {
+	b, err := ioutil.ReadFile(path.Join(dir, raftMembersFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &members)
+	return
+}
+
+func saveRaftMembers(dir string, members []string) error {
+	b, err := json.Marshal(members)
+	if err != nil {
+		return err
+	}
+	tmpPath := path.Join(dir, raftMembersFile+".tmp")
+	if err = ioutil.WriteFile(tmpPath, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path.Join(dir, raftMembersFile))
+}
+
+func isMember(address string, members []string) bool {
+	for _, m := range members {
+		if m == address {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *RaftServer) onMembershipChange(e raft.Event) {
+	members := append(s.Peers(), s.serverAddr)
+	sort.Strings(members)
+	glog.V(0).Infof("raft %s %v, members: %v", e.Type(), e.Value(), members)
+	if err := saveRaftMembers(s.dataDir, members); err != nil {
+		glog.Errorf("save raft members to %s: %v", s.dataDir, err)
+	}
+}
+
+// Members lists all masters in the raft cluster, as seen by this master.
+// Only the leader tracks whether the other masters are alive.
+func (s *RaftServer) Members() (members []*RaftMember) {
+	leader := s.raftServer.Leader()
+	isLeader := s.raftServer.State() == raft.Leader
+	aliveThreshold := 2 * s.raftServer.ElectionTimeout()
+
+	members = append(members, &RaftMember{
+		Address:      s.serverAddr,
+		IsLeader:     s.serverAddr == leader,
+		IsAlive:      true,
+		LastActivity: time.Now(),
+	})
+	for _, peer := range s.raftServer.Peers() {
+		lastActivity := peer.LastActivity()
+		members = append(members, &RaftMember{
+			Address:      peer.Name,
+			IsLeader:     peer.Name == leader,
+			IsAlive:      peer.Name == leader || isLeader && time.Since(lastActivity) < aliveThreshold,
+			LastActivity: lastActivity,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Address < members[j].Address
+	})
+	return
+}
+
+// AddMember adds a master to the raft cluster through the raft log, so all masters apply the change
+func (s *RaftServer) AddMember(address string) error {
+	if s.raftServer.State() != raft.Leader {
+		return fmt.Errorf("%s is not the raft leader %s", s.serverAddr, s.raftServer.Leader())
+	}
+	if address == s.serverAddr || s.raftServer.Peers()[address] != nil {
+		return fmt.Errorf("%s is already a raft member", address)
+	}
+
+	_, err := s.raftServer.Do(&raft.DefaultJoinCommand{
+		Name:             address,
+		ConnectionString: util.ServerToGrpcAddress(address),
+	})
+	return err
+}
+
+// RemoveMember removes a master from the raft cluster, if the remaining masters keep the quorum
+func (s *RaftServer) RemoveMember(address string) error {
+	if s.raftServer.State() != raft.Leader {
+		return fmt.Errorf("%s is not the raft leader %s", s.serverAddr, s.raftServer.Leader())
+	}
+	if address == s.serverAddr {
+		return fmt.Errorf("%s is the raft leader, stop it first and remove it from the new leader", address)
+	}
+
+	alive := make(map[string]bool)
+	for _, m := range s.Members() {
+		alive[m.Address] = m.IsAlive
+	}
+	if err := checkRaftMemberRemoval(alive, address); err != nil {
+		return err
+	}
+
+	_, err := s.raftServer.Do(&raft.DefaultLeaveCommand{
+		Name: address,
+	})
+	return err
+}
+
+// checkRaftMemberRemoval checks the alive members can commit the removal,
+// and still form a quorum after the removal
+func checkRaftMemberRemoval(alive map[string]bool, address string) error {
+	isAlive, found := alive[address]
+	if !found {
+		return fmt.Errorf("%s is not a raft member", address)
+	}
+	if len(alive) <= 1 {
+		return fmt.Errorf("can not remove the last raft member %s", address)
+	}
+
+	aliveCount := 0
+	for _, a := range alive {
+		if a {
+			aliveCount++
+		}
+	}
+	if quorum := len(alive)/2 + 1; aliveCount < quorum {
+		return fmt.Errorf("only %d of %d raft members are alive, less than the quorum %d", aliveCount, len(alive), quorum)
+	}
+
+	remaining, remainingAlive := len(alive)-1, aliveCount
+	if isAlive {
+		remainingAlive--
+	}
+	if quorum := remaining/2 + 1; remainingAlive < quorum {
+		return fmt.Errorf("removing %s leaves %d of %d raft members alive, less than the quorum %d", address, remainingAlive, remaining, quorum)
+	}
+	return nil
+}
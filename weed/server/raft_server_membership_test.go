@@ -0,0 +1,55 @@
+package weed_server
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestCheckRaftMemberRemoval(t *testing.T) {
+	tests := []struct {
+		alive   map[string]bool
+		address string
+		ok      bool
+	}{
+		{map[string]bool{"a": true, "b": true, "c": false}, "c", true},
+		{map[string]bool{"a": true, "b": true, "c": false}, "b", false},
+		{map[string]bool{"a": true, "b": true, "c": true}, "b", true},
+		{map[string]bool{"a": true, "b": false, "c": false}, "c", false},
+		{map[string]bool{"a": true, "b": true}, "b", true},
+		{map[string]bool{"a": true, "b": false}, "b", false},
+		{map[string]bool{"a": true}, "a", false},
+		{map[string]bool{"a": true, "b": true, "c": true}, "d", false},
+		{map[string]bool{"a": true, "b": true, "c": true, "d": true, "e": false}, "d", true},
+		{map[string]bool{"a": true, "b": true, "c": true, "d": false, "e": false}, "c", false},
+	}
+	for _, test := range tests {
+		err := checkRaftMemberRemoval(test.alive, test.address)
+		if (err == nil) != test.ok {
+			t.Errorf("remove %s from %v: %v", test.address, test.alive, err)
+		}
+	}
+}
+
+func TestRaftMembersFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	members, err := loadRaftMembers(dir)
+	if err != nil || members != nil {
+		t.Errorf("load missing members: %v %v", members, err)
+	}
+
+	expected := []string{"localhost:9333", "localhost:9334"}
+	if err = saveRaftMembers(dir, expected); err != nil {
+		t.Fatalf("save members: %v", err)
+	}
+	members, err = loadRaftMembers(dir)
+	if err != nil || !reflect.DeepEqual(members, expected) {
+		t.Errorf("load members: %v %v", members, err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 )
 
 func (vs *VolumeServer) DeleteCollection(ctx context.Context, req *volume_server_pb.DeleteCollectionRequest) (*volume_server_pb.DeleteCollectionResponse, error) {
@@ -28,7 +29,12 @@ func (vs *VolumeServer) AllocateVolume(ctx context.Context, req *volume_server_p
 
 	resp := &volume_server_pb.AllocateVolumeResponse{}
 
-	err := vs.store.AddVolume(
+	diskType, err := types.ToDiskType(req.DiskType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vs.store.AddVolume(
 		needle.VolumeId(req.VolumeId),
 		req.Collection,
 		vs.needleMapKind,
@@ -36,6 +42,7 @@ func (vs *VolumeServer) AllocateVolume(ctx context.Context, req *volume_server_p
 		req.Ttl,
 		req.Preallocate,
 		req.MemoryMapMaxSizeMB,
+		diskType,
 	)
 
 	if err != nil {
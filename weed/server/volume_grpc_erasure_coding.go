@@ -92,7 +92,11 @@ func (vs *VolumeServer) VolumeEcShardsRebuild(ctx context.Context, req *volume_s
 // VolumeEcShardsCopy copy the .ecx and some ec data slices
 func (vs *VolumeServer) VolumeEcShardsCopy(ctx context.Context, req *volume_server_pb.VolumeEcShardsCopyRequest) (*volume_server_pb.VolumeEcShardsCopyResponse, error) {
 
-	location := vs.store.FindFreeLocation()
+	// erasure coding shards are not placed by disk type
+	location := vs.store.FindFreeLocation(types.HardDriveType)
+	if location == nil {
+		location = vs.store.FindFreeLocation(types.SsdType)
+	}
 	if location == nil {
 		return nil, fmt.Errorf("no space left")
 	}
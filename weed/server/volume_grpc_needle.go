@@ -0,0 +1,184 @@
+package weed_server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/topology"
+	"google.golang.org/grpc/peer"
+)
+
+func (vs *VolumeServer) WriteNeedle(stream volume_server_pb.VolumeServer_WriteNeedleServer) error {
+
+	stats.VolumeServerRequestCounter.WithLabelValues("grpcWrite").Inc()
+	start := time.Now()
+	defer func() {
+		stats.VolumeServerRequestHistogram.WithLabelValues("grpcWrite").Observe(time.Since(start).Seconds())
+	}()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	vid, fid, volumeId, err := parseGrpcFileId(req.FileId)
+	if err != nil {
+		return err
+	}
+
+	if !vs.checkJwtAuthorization(security.EncodedJwt(req.Jwt), grpcRemoteAddr(stream.Context()), vid, fid, true) {
+		return fmt.Errorf("wrong jwt for %s", req.FileId)
+	}
+
+	data := req.Data
+	for {
+		dataReq, recvErr := stream.Recv()
+		if recvErr == io.EOF {
+			break
+		}
+		if recvErr != nil {
+			return recvErr
+		}
+		data = append(data, dataReq.Data...)
+	}
+
+	ttl, err := needle.ReadTTL(req.Ttl)
+	if err != nil {
+		return fmt.Errorf("parse ttl %s: %v", req.Ttl, err)
+	}
+	n := needle.NewNeedle(req.Name, data, req.Mime, req.Pairs, req.IsGzipped, req.LastModified, ttl, req.IsChunkManifest, vs.FixJpgOrientation)
+	if err = n.ParsePath(fid); err != nil {
+		return fmt.Errorf("parse file id %s: %v", req.FileId, err)
+	}
+
+	_, isUnchanged, err := topology.ReplicatedWriteNeedle(vs.GetMaster(), vs.store, volumeId, n, "/"+req.FileId, security.EncodedJwt(req.Jwt), req.IsReplicate)
+	if err != nil {
+		return err
+	}
+
+	resp := &volume_server_pb.WriteNeedleResponse{
+		Size:        uint32(len(data)),
+		Etag:        n.Etag(),
+		IsUnchanged: isUnchanged,
+	}
+	if n.HasName() {
+		resp.Name = string(n.Name)
+	}
+	return stream.SendAndClose(resp)
+}
+
+// ReadNeedle streams the needle data as stored, so a gzipped needle is sent gzipped,
+// and the offset and size apply to the stored data.
+func (vs *VolumeServer) ReadNeedle(req *volume_server_pb.ReadNeedleRequest, stream volume_server_pb.VolumeServer_ReadNeedleServer) error {
+
+	stats.VolumeServerRequestCounter.WithLabelValues("grpcRead").Inc()
+	start := time.Now()
+	defer func() {
+		stats.VolumeServerRequestHistogram.WithLabelValues("grpcRead").Observe(time.Since(start).Seconds())
+	}()
+
+	vid, fid, volumeId, err := parseGrpcFileId(req.FileId)
+	if err != nil {
+		return err
+	}
+
+	if !vs.checkJwtAuthorization(security.EncodedJwt(req.Jwt), grpcRemoteAddr(stream.Context()), vid, fid, false) {
+		return fmt.Errorf("wrong jwt for %s", req.FileId)
+	}
+
+	n := new(needle.Needle)
+	if err = n.ParsePath(fid); err != nil {
+		return fmt.Errorf("parse file id %s: %v", req.FileId, err)
+	}
+	cookie := n.Cookie
+
+	var count int
+	if vs.store.HasVolume(volumeId) {
+		count, err = vs.store.ReadVolumeNeedle(volumeId, n)
+	} else if _, hasEcVolume := vs.store.FindEcVolume(volumeId); hasEcVolume {
+		count, err = vs.store.ReadEcShardNeedle(context.Background(), volumeId, n)
+	} else {
+		return fmt.Errorf("volume %d is not local", volumeId)
+	}
+	if err != nil || count < 0 {
+		glog.V(0).Infof("read %s: %v", req.FileId, err)
+		return fmt.Errorf("not found %s", req.FileId)
+	}
+	if n.Cookie != cookie {
+		glog.V(0).Infof("request %s with cookie:%x expected:%x", req.FileId, cookie, n.Cookie)
+		return fmt.Errorf("not found %s", req.FileId)
+	}
+
+	dataSize := int64(len(n.Data))
+	if req.Offset < 0 || req.Offset > dataSize || req.Size < 0 {
+		return fmt.Errorf("invalid range offset %d size %d of %s with %d bytes", req.Offset, req.Size, req.FileId, dataSize)
+	}
+	stopOffset := dataSize
+	if req.Size > 0 && req.Offset+req.Size < stopOffset {
+		stopOffset = req.Offset + req.Size
+	}
+
+	resp := &volume_server_pb.ReadNeedleResponse{
+		IsGzipped:       n.IsGzipped(),
+		LastModified:    n.LastModified,
+		IsChunkManifest: n.IsChunkedManifest(),
+		Etag:            n.Etag(),
+		TotalSize:       uint64(dataSize),
+	}
+	if n.HasName() {
+		resp.Name = string(n.Name)
+	}
+	if n.HasMime() {
+		resp.Mime = string(n.Mime)
+	}
+	if n.HasPairs() {
+		if err = json.Unmarshal(n.Pairs, &resp.Pairs); err != nil {
+			glog.V(0).Infoln("Unmarshal pairs error:", err)
+		}
+	}
+
+	// the metadata goes with the first piece of data, even if there is no data
+	for offset := req.Offset; offset == req.Offset || offset < stopOffset; offset += BufferSizeLimit {
+		end := offset + BufferSizeLimit
+		if end > stopOffset {
+			end = stopOffset
+		}
+		resp.Data = n.Data[offset:end]
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+		resp = &volume_server_pb.ReadNeedleResponse{}
+	}
+
+	return nil
+}
+
+// parseGrpcFileId splits the "vid,fid" file id
+func parseGrpcFileId(fileId string) (vid, fid string, volumeId needle.VolumeId, err error) {
+	commaIndex := strings.Index(fileId, ",")
+	if commaIndex <= 0 {
+		return "", "", 0, fmt.Errorf("invalid file id %s", fileId)
+	}
+	vid, fid = fileId[:commaIndex], fileId[commaIndex+1:]
+	volumeId, err = needle.NewVolumeId(vid)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid volume id in file id %s: %v", fileId, err)
+	}
+	return
+}
+
+func grpcRemoteAddr(ctx context.Context) string {
+	if pr, ok := peer.FromContext(ctx); ok {
+		return pr.Addr.String()
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package weed_server
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+)
+
+func TestGrpcWriteAndReadNeedle(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "grpc_needle")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := storage.NewStore(grpc.WithInsecure(), 0, "127.0.0.1", "", []string{dir}, []int{1}, []types.DiskType{types.HardDriveType}, storage.NeedleMapInMemory)
+	if err := store.AddVolume(1, "", storage.NeedleMapInMemory, "000", "", 0, 0, types.HardDriveType); err != nil {
+		t.Fatalf("add volume: %v", err)
+	}
+	vs := &VolumeServer{store: store, guard: security.NewGuard(nil, "", 0, "", 0)}
+
+	// the volume server grpc port is the http port + 10000
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	grpcPort := listener.Addr().(*net.TCPAddr).Port
+	if grpcPort <= 10000 {
+		t.Skipf("grpc port %d can not be derived from a http port", grpcPort)
+	}
+	grpcServer := util.NewGrpcServer()
+	volume_server_pb.RegisterVolumeServerServer(grpcServer, vs)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+	volumeServer := fmt.Sprintf("127.0.0.1:%d", grpcPort-10000)
+
+	content := bytes.Repeat([]byte("0123456789"), 300*1024)
+	fileId := needle.NewFileId(1, 0x1234, 0x5678).String()
+
+	uploadResult, err := operation.GrpcUpload(volumeServer, grpc.WithInsecure(), fileId, "a.bin", bytes.NewReader(content),
+		false, "application/octet-stream", map[string]string{"Seaweed-Color": "red"}, "")
+	if err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+	if uploadResult.Name != "a.bin" || int(uploadResult.Size) != len(content) {
+		t.Errorf("upload result %+v", uploadResult)
+	}
+
+	var buf bytes.Buffer
+	meta, err := operation.GrpcReadNeedle(volumeServer, grpc.WithInsecure(), fileId, 0, 0, "", &buf)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), content) {
+		t.Errorf("read %d bytes, expected %d", buf.Len(), len(content))
+	}
+	if meta.Name != "a.bin" || meta.Pairs["Color"] != "red" || meta.TotalSize != uint64(len(content)) || meta.Etag != uploadResult.ETag {
+		t.Errorf("read meta %+v", meta)
+	}
+
+	buf.Reset()
+	if _, err = operation.GrpcReadNeedle(volumeServer, grpc.WithInsecure(), fileId, 5, 12, "", &buf); err != nil {
+		t.Fatalf("read range: %v", err)
+	}
+	if buf.String() != "567890123456" {
+		t.Errorf("read range %q", buf.String())
+	}
+
+	// a gzipped needle is unzipped before the range is applied
+	text := bytes.Repeat([]byte("the quick brown fox "), 1000)
+	textFileId := needle.NewFileId(1, 0x1235, 0x5678).String()
+	if _, err = operation.UploadData("http://"+volumeServer+"/"+textFileId, grpc.WithInsecure(), "a.txt", text, "text/plain", ""); err != nil {
+		t.Fatalf("upload text: %v", err)
+	}
+	textBuf := make([]byte, 9)
+	if err = operation.GrpcReadNeedleContent(volumeServer, grpc.WithInsecure(), textFileId, 4, textBuf); err != nil {
+		t.Fatalf("read text range: %v", err)
+	}
+	if string(textBuf) != "quick bro" {
+		t.Errorf("read text range %q", textBuf)
+	}
+
+	wrongCookie := needle.NewFileId(1, 0x1234, 0x5679).String()
+	if _, err = operation.GrpcReadNeedle(volumeServer, grpc.WithInsecure(), wrongCookie, 0, 0, "", &buf); err == nil {
+		t.Errorf("read with a wrong cookie")
+	}
+}
@@ -1,16 +1,24 @@
 package weed_server
 
 import (
+	"fmt"
+
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
-	"github.com/chrislusf/seaweedfs/weed/query/json"
+	"github.com/chrislusf/seaweedfs/weed/query"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
-	"github.com/tidwall/gjson"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 func (vs *VolumeServer) Query(req *volume_server_pb.QueryRequest, stream volume_server_pb.VolumeServer_QueryServer) error {
 
+	processor, err := query.NewProcessor(req)
+	if err != nil {
+		glog.V(0).Infof("volume query %+v: %v", req, err)
+		return err
+	}
+
 	for _, fid := range req.FromFileIds {
 
 		vid, id_cookie, err := operation.ParseFileId(fid)
@@ -30,37 +38,19 @@ func (vs *VolumeServer) Query(req *volume_server_pb.QueryRequest, stream volume_
 		}
 
 		if n.Cookie != cookie {
-			glog.V(0).Infof("volume query failed to read fid cookie %s: %v", fid, err)
-			return err
-		}
-
-		if req.InputSerialization.CsvInput != nil {
-
+			glog.V(0).Infof("volume query failed to read fid cookie %s", fid)
+			return fmt.Errorf("volume query %s: cookie mismatch", fid)
 		}
 
-		if req.InputSerialization.JsonInput != nil {
-
-			stripe := &volume_server_pb.QueriedStripe{
-				Records: nil,
+		data := n.Data
+		if n.IsGzipped() {
+			if data, err = util.UnGzipData(n.Data); err != nil {
+				return fmt.Errorf("volume query %s: %v", fid, err)
 			}
+		}
 
-			filter := json.Query{
-				Field: req.Filter.Field,
-				Op:    req.Filter.Operand,
-				Value: req.Filter.Value,
-			}
-			gjson.ForEachLine(string(n.Data), func(line gjson.Result) bool {
-				passedFilter, values := json.QueryJson(line.Raw, req.Selections, filter)
-				if !passedFilter {
-					return true
-				}
-				stripe.Records = json.ToJson(stripe.Records, req.Selections, values)
-				return true
-			})
-			err = stream.Send(stripe)
-			if err != nil {
-				return err
-			}
+		if err = stream.Send(processor.Query(data)); err != nil {
+			return err
 		}
 
 	}
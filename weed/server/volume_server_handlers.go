@@ -50,6 +50,11 @@ func (vs *VolumeServer) publicReadOnlyHandler(w http.ResponseWriter, r *http.Req
 }
 
 func (vs *VolumeServer) maybeCheckJwtAuthorization(r *http.Request, vid, fid string, isWrite bool) bool {
+	return vs.checkJwtAuthorization(security.GetJwt(r), r.RemoteAddr, vid, fid, isWrite)
+}
+
+// checkJwtAuthorization verifies the jwt of the file id, if the signing key is configured
+func (vs *VolumeServer) checkJwtAuthorization(tokenStr security.EncodedJwt, remoteAddr string, vid, fid string, isWrite bool) bool {
 
 	var signingKey security.SigningKey
 
@@ -67,19 +72,18 @@ func (vs *VolumeServer) maybeCheckJwtAuthorization(r *http.Request, vid, fid str
 		}
 	}
 
-	tokenStr := security.GetJwt(r)
 	if tokenStr == "" {
-		glog.V(1).Infof("missing jwt from %s", r.RemoteAddr)
+		glog.V(1).Infof("missing jwt from %s", remoteAddr)
 		return false
 	}
 
 	token, err := security.DecodeJwt(signingKey, tokenStr)
 	if err != nil {
-		glog.V(1).Infof("jwt verification error from %s: %v", r.RemoteAddr, err)
+		glog.V(1).Infof("jwt verification error from %s: %v", remoteAddr, err)
 		return false
 	}
 	if !token.Valid {
-		glog.V(1).Infof("jwt invalid from %s: %v", r.RemoteAddr, tokenStr)
+		glog.V(1).Infof("jwt invalid from %s: %v", remoteAddr, tokenStr)
 		return false
 	}
 
@@ -89,6 +93,6 @@ func (vs *VolumeServer) maybeCheckJwtAuthorization(r *http.Request, vid, fid str
 		}
 		return sc.Fid == vid+","+fid
 	}
-	glog.V(1).Infof("unexpected jwt from %s: %v", r.RemoteAddr, tokenStr)
+	glog.V(1).Infof("unexpected jwt from %s: %v", remoteAddr, tokenStr)
 	return false
 }
@@ -130,7 +130,10 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	if vs.tryHandleChunkedFile(n, filename, w, r) {
+	// the etag changes with the content, so the derived images of an overwritten file are not reused
+	imageCacheKey := vid + "," + fid + "/" + n.Etag()
+
+	if vs.tryHandleChunkedFile(n, filename, imageCacheKey, w, r) {
 		return
 	}
 
@@ -160,14 +163,21 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	rs := conditionallyResizeImages(bytes.NewReader(n.Data), ext, r)
+	rs, newExt, err := vs.conditionallyProcessImage(bytes.NewReader(n.Data), ext, imageCacheKey, r)
+	if err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if newExt != ext {
+		filename, mtype = renameProcessedImage(filename, newExt)
+	}
 
 	if e := writeResponseContent(filename, mtype, rs, w, r); e != nil {
 		glog.V(2).Infoln("response write error:", e)
 	}
 }
 
-func (vs *VolumeServer) tryHandleChunkedFile(n *needle.Needle, fileName string, w http.ResponseWriter, r *http.Request) (processed bool) {
+func (vs *VolumeServer) tryHandleChunkedFile(n *needle.Needle, fileName, imageCacheKey string, w http.ResponseWriter, r *http.Request) (processed bool) {
 	if !n.IsChunkedManifest() || r.URL.Query().Get("cm") == "false" {
 		return false
 	}
@@ -199,7 +209,14 @@ func (vs *VolumeServer) tryHandleChunkedFile(n *needle.Needle, fileName string,
 	}
 	defer chunkedFileReader.Close()
 
-	rs := conditionallyResizeImages(chunkedFileReader, ext, r)
+	rs, newExt, err := vs.conditionallyProcessImage(chunkedFileReader, ext, imageCacheKey, r)
+	if err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return true
+	}
+	if newExt != ext {
+		fileName, mType = renameProcessedImage(fileName, newExt)
+	}
 
 	if e := writeResponseContent(fileName, mType, rs, w, r); e != nil {
 		glog.V(2).Infoln("response write error:", e)
@@ -207,22 +224,64 @@ func (vs *VolumeServer) tryHandleChunkedFile(n *needle.Needle, fileName string,
 	return true
 }
 
-func conditionallyResizeImages(originalDataReaderSeeker io.ReadSeeker, ext string, r *http.Request) io.ReadSeeker {
-	rs := originalDataReaderSeeker
-	if len(ext) > 0 {
-		ext = strings.ToLower(ext)
+// conditionallyProcessImage crops, rotates, resizes or converts the image by the query parameters.
+// The derived images are kept in the image cache if it is enabled.
+func (vs *VolumeServer) conditionallyProcessImage(originalDataReaderSeeker io.ReadSeeker, ext, imageCacheKey string, r *http.Request) (rs io.ReadSeeker, newExt string, err error) {
+	rs, newExt = originalDataReaderSeeker, ext
+	if !images.IsProcessable(ext) {
+		return
 	}
-	if ext == ".png" || ext == ".jpg" || ext == ".jpeg" || ext == ".gif" {
-		width, height := 0, 0
-		if r.FormValue("width") != "" {
-			width, _ = strconv.Atoi(r.FormValue("width"))
+
+	option := images.ProcessOptions{Mode: r.FormValue("mode"), Format: r.FormValue("format")}
+	for name, value := range map[string]*int{
+		"width": &option.Width, "height": &option.Height,
+		"crop_x1": &option.CropX1, "crop_y1": &option.CropY1, "crop_x2": &option.CropX2, "crop_y2": &option.CropY2,
+		"rotate": &option.Rotate, "quality": &option.Quality,
+	} {
+		if r.FormValue(name) != "" {
+			*value, _ = strconv.Atoi(r.FormValue(name))
 		}
-		if r.FormValue("height") != "" {
-			height, _ = strconv.Atoi(r.FormValue("height"))
+	}
+	if !option.NeedProcessing() {
+		return
+	}
+	if err = option.Validate(); err != nil {
+		return
+	}
+	newExt = option.OutputExt(ext)
+
+	cacheKey := imageCacheKey + "?" + option.String()
+	if vs.imageCache != nil {
+		if data, found := vs.imageCache.Get(cacheKey); found {
+			return bytes.NewReader(data), newExt, nil
 		}
-		rs, _, _ = images.Resized(ext, originalDataReaderSeeker, width, height, r.FormValue("mode"))
 	}
-	return rs
+
+	processed, processedExt, processErr := images.Process(ext, originalDataReaderSeeker, option)
+	if processErr != nil {
+		glog.V(0).Infof("process image %s: %v", r.URL.Path, processErr)
+		originalDataReaderSeeker.Seek(0, 0)
+		return originalDataReaderSeeker, ext, nil
+	}
+	if processed == nil {
+		originalDataReaderSeeker.Seek(0, 0)
+		return originalDataReaderSeeker, ext, nil
+	}
+	if vs.imageCache != nil {
+		vs.imageCache.Put(cacheKey, processed)
+	}
+	return bytes.NewReader(processed), processedExt, nil
+}
+
+func renameProcessedImage(filename, newExt string) (newFilename, mimeType string) {
+	if filename != "" {
+		newFilename = strings.TrimSuffix(filename, path.Ext(filename)) + newExt
+	}
+	if newExt == ".webp" {
+		// not registered in older mime tables
+		return newFilename, "image/webp"
+	}
+	return newFilename, mime.TypeByExtension(newExt)
 }
 
 func writeResponseContent(filename, mimeType string, rs io.ReadSeeker, w http.ResponseWriter, r *http.Request) error {
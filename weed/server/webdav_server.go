@@ -31,6 +31,7 @@ type WebDavOption struct {
 	Collection       string
 	Uid              uint32
 	Gid              uint32
+	FilerProxy       bool
 }
 
 type WebDavServer struct {
@@ -50,7 +51,7 @@ func NewWebDavServer(option *WebDavOption) (ws *WebDavServer, err error) {
 		grpcDialOption: security.LoadClientTLS(viper.Sub("grpc"), "filer"),
 		Handler: &webdav.Handler{
 			FileSystem: fs,
-			LockSystem: webdav.NewMemLS(),
+			LockSystem: NewWebDavLockSystem(fs.(*WebDavFileSystem)),
 		},
 	}
 
@@ -114,6 +115,9 @@ func clearName(name string) (string, error) {
 	if !strings.HasPrefix(name, "/") {
 		return "", os.ErrInvalid
 	}
+	if isWebDavSystemPath(path.Clean(name)) {
+		return "", os.ErrNotExist
+	}
 	return name, nil
 }
 
@@ -386,15 +390,63 @@ func (f *WebDavFile) Write(buf []byte) (int, error) {
 		return 0, err
 	}
 
+	dir, name := filer2.FullPath(f.name).DirAndName()
+
+	var chunks []*filer_pb.FileChunk
+	if f.fs.option.FilerProxy {
+		chunks, err = filer2.WriteThroughFiler(ctx, f.fs, &filer_pb.WriteFileRequest{
+			Directory:   dir,
+			Name:        name,
+			Offset:      f.off,
+			Collection:  f.fs.option.Collection,
+			Replication: "000",
+		}, buf)
+	} else {
+		var chunk *filer_pb.FileChunk
+		chunk, err = f.saveDataAsChunk(ctx, buf)
+		chunks = append(chunks, chunk)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	f.entry.Chunks = append(f.entry.Chunks, chunks...)
+
+	err = f.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		f.entry.Attributes.Mtime = time.Now().Unix()
+
+		request := &filer_pb.UpdateEntryRequest{
+			Directory: dir,
+			Entry:     f.entry,
+		}
+
+		if _, err := client.UpdateEntry(ctx, request); err != nil {
+			return fmt.Errorf("update %s: %v", f.name, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		f.off += int64(len(buf))
+	}
+	return len(buf), err
+}
+
+// saveDataAsChunk uploads the data to the volume server assigned by the filer
+func (f *WebDavFile) saveDataAsChunk(ctx context.Context, buf []byte) (*filer_pb.FileChunk, error) {
+
 	var fileId, host string
 	var auth security.EncodedJwt
 
-	if err = f.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+	if err := f.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
+		dir, _ := filer2.FullPath(f.name).DirAndName()
 		request := &filer_pb.AssignVolumeRequest{
 			Count:       1,
 			Replication: "000",
 			Collection:  f.fs.option.Collection,
+			ParentPath:  dir,
 		}
 
 		resp, err := client.AssignVolume(ctx, request)
@@ -407,7 +459,7 @@ func (f *WebDavFile) Write(buf []byte) (int, error) {
 
 		return nil
 	}); err != nil {
-		return 0, fmt.Errorf("filerGrpcAddress assign volume: %v", err)
+		return nil, fmt.Errorf("filerGrpcAddress assign volume: %v", err)
 	}
 
 	fileUrl := fmt.Sprintf("http://%s/%s", host, fileId)
@@ -415,43 +467,20 @@ func (f *WebDavFile) Write(buf []byte) (int, error) {
 	uploadResult, err := operation.Upload(fileUrl, f.name, bufReader, false, "application/octet-stream", nil, auth)
 	if err != nil {
 		glog.V(0).Infof("upload data %v to %s: %v", f.name, fileUrl, err)
-		return 0, fmt.Errorf("upload data: %v", err)
+		return nil, fmt.Errorf("upload data: %v", err)
 	}
 	if uploadResult.Error != "" {
 		glog.V(0).Infof("upload failure %v to %s: %v", f.name, fileUrl, err)
-		return 0, fmt.Errorf("upload result: %v", uploadResult.Error)
+		return nil, fmt.Errorf("upload result: %v", uploadResult.Error)
 	}
 
-	chunk := &filer_pb.FileChunk{
+	return &filer_pb.FileChunk{
 		FileId: fileId,
 		Offset: f.off,
 		Size:   uint64(len(buf)),
 		Mtime:  time.Now().UnixNano(),
 		ETag:   uploadResult.ETag,
-	}
-
-	f.entry.Chunks = append(f.entry.Chunks, chunk)
-	dir, _ := filer2.FullPath(f.name).DirAndName()
-
-	err = f.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
-		f.entry.Attributes.Mtime = time.Now().Unix()
-
-		request := &filer_pb.UpdateEntryRequest{
-			Directory: dir,
-			Entry:     f.entry,
-		}
-
-		if _, err := client.UpdateEntry(ctx, request); err != nil {
-			return fmt.Errorf("update %s: %v", f.name, err)
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		f.off += int64(len(buf))
-	}
-	return len(buf), err
+	}, nil
 }
 
 func (f *WebDavFile) Close() error {
@@ -483,12 +512,17 @@ func (f *WebDavFile) Read(p []byte) (readSize int, err error) {
 	if len(f.entry.Chunks) == 0 {
 		return 0, io.EOF
 	}
-	if f.entryViewCache == nil {
-		f.entryViewCache = filer2.NonOverlappingVisibleIntervals(f.entry.Chunks)
-	}
-	chunkViews := filer2.ViewFromVisibleIntervals(f.entryViewCache, f.off, len(p))
+	var totalRead int64
+	if f.fs.option.FilerProxy {
+		totalRead, err = filer2.ReadThroughFiler(ctx, f.fs, f.name, p, f.entry.Chunks, f.off)
+	} else {
+		if f.entryViewCache == nil {
+			f.entryViewCache = filer2.NonOverlappingVisibleIntervals(f.entry.Chunks)
+		}
+		chunkViews := filer2.ViewFromVisibleIntervals(f.entryViewCache, f.off, len(p))
 
-	totalRead, err := filer2.ReadIntoBuffer(ctx, f.fs, f.name, p, chunkViews, f.off)
+		totalRead, err = filer2.ReadIntoBuffer(ctx, f.fs, f.name, p, chunkViews, f.off, "")
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -512,6 +546,9 @@ func (f *WebDavFile) Readdir(count int) (ret []os.FileInfo, err error) {
 	}
 
 	err = filer2.ReadDirAllEntries(ctx, f.fs, dir, func(entry *filer_pb.Entry) {
+		if isWebDavSystemPath(path.Join(dir, entry.Name)) {
+			return
+		}
 		fi := FileInfo{
 			size:          int64(filer2.TotalSize(entry.GetChunks())),
 			name:          entry.Name,
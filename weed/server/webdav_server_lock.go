@@ -0,0 +1,585 @@
+package weed_server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/webdav"
+)
+
+const (
+	// WebDavSystemFolder keeps the WebDAV states in the filer, and is hidden from WebDAV clients
+	WebDavSystemFolder = "/.webdav"
+	WebDavLocksFolder  = WebDavSystemFolder + "/locks"
+	webDavLockKey      = "webdav.lock"
+
+	// the locks without a timeout are kept by the server creating them, and expire after the lease
+	// if the server is gone, so they do not keep the resources locked forever
+	webDavInfiniteLockLease = time.Minute
+
+	webDavLockTokenPrefix = "opaquelocktoken:"
+	webDavLockUuidLength  = 36
+)
+
+// WebDavLockSystem implements webdav.LockSystem with the locks stored as entries in the filer,
+// so the locks survive restarts and are shared by all WebDAV servers of the same filer.
+//
+// Each lock is stored under the escaped path of its root, so the locks of a resource and its parent folders
+// are found by their paths, and the locks inside a folder are listed by the name prefix.
+// The lock token also carries the root, to find the lock of a token.
+type WebDavLockSystem struct {
+	fs       *WebDavFileSystem
+	clientId string
+
+	// tokens confirmed by requests that are still being served by this server
+	held     map[string]bool
+	heldLock sync.Mutex
+
+	// tokens of the locks without a timeout, whose leases are renewed by this server
+	renewed     map[string]bool
+	renewedLock sync.Mutex
+}
+
+type webDavLock struct {
+	Token     string `json:"token"`
+	Root      string `json:"root"`
+	OwnerXML  string `json:"owner,omitempty"`
+	ZeroDepth bool   `json:"zeroDepth,omitempty"`
+	Duration  int64  `json:"duration"`         // in nanoseconds, negative means infinite
+	Expiry    int64  `json:"expiry,omitempty"` // unix time in nanoseconds
+}
+
+func (l *webDavLock) isExpired(now time.Time) bool {
+	return l.Expiry < now.UnixNano()
+}
+
+// setExpiry also leases the locks without a timeout
+func (l *webDavLock) setExpiry(now time.Time, duration time.Duration) {
+	l.Duration = int64(duration)
+	if duration < 0 {
+		duration = webDavInfiniteLockLease
+	}
+	l.Expiry = now.Add(duration).UnixNano()
+}
+
+func (l *webDavLock) details() webdav.LockDetails {
+	return webdav.LockDetails{
+		Root:      l.Root,
+		Duration:  time.Duration(l.Duration),
+		OwnerXML:  l.OwnerXML,
+		ZeroDepth: l.ZeroDepth,
+	}
+}
+
+// covers checks whether the lock applies to the resource name
+func (l *webDavLock) covers(name string) bool {
+	if name == l.Root {
+		return true
+	}
+	if l.ZeroDepth {
+		return false
+	}
+	return l.Root == "/" || strings.HasPrefix(name, l.Root+"/")
+}
+
+func NewWebDavLockSystem(fs *WebDavFileSystem) *WebDavLockSystem {
+	hostname, _ := os.Hostname()
+	ls := &WebDavLockSystem{
+		fs:       fs,
+		clientId: fmt.Sprintf("webdav-%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+		held:     make(map[string]bool),
+		renewed:  make(map[string]bool),
+	}
+	go ls.loopRenewingInfiniteLocks()
+	return ls
+}
+
+func (ls *WebDavLockSystem) Confirm(now time.Time, name0, name1 string, conditions ...webdav.Condition) (release func(), err error) {
+
+	ctx := context.Background()
+
+	// only the locks of the submitted tokens can confirm the request, so look them up by token
+	byToken := make(map[string]*webDavLock)
+	for _, c := range conditions {
+		if c.Token == "" {
+			continue
+		}
+		if _, found := byToken[c.Token]; found {
+			continue
+		}
+		lock, err := ls.loadLock(ctx, c.Token)
+		if err != nil {
+			return nil, err
+		}
+		if lock != nil && lock.isExpired(now) {
+			lock = nil
+		}
+		byToken[c.Token] = lock
+	}
+
+	ls.heldLock.Lock()
+	defer ls.heldLock.Unlock()
+
+	lookup := func(name string) *webDavLock {
+		for _, c := range conditions {
+			l := byToken[c.Token]
+			if l == nil || ls.held[l.Token] {
+				continue
+			}
+			if l.covers(name) {
+				return l
+			}
+		}
+		return nil
+	}
+
+	var l0, l1 *webDavLock
+	if name0 != "" {
+		if l0 = lookup(slashClean(name0)); l0 == nil {
+			return nil, webdav.ErrConfirmationFailed
+		}
+	}
+	if name1 != "" {
+		if l1 = lookup(slashClean(name1)); l1 == nil {
+			return nil, webdav.ErrConfirmationFailed
+		}
+	}
+
+	// don't hold the same lock twice
+	if l1 == l0 {
+		l1 = nil
+	}
+
+	if l0 != nil {
+		ls.held[l0.Token] = true
+	}
+	if l1 != nil {
+		ls.held[l1.Token] = true
+	}
+
+	return func() {
+		ls.heldLock.Lock()
+		defer ls.heldLock.Unlock()
+		if l1 != nil {
+			delete(ls.held, l1.Token)
+		}
+		if l0 != nil {
+			delete(ls.held, l0.Token)
+		}
+	}, nil
+}
+
+func (ls *WebDavLockSystem) Create(now time.Time, details webdav.LockDetails) (token string, err error) {
+
+	ctx := context.Background()
+
+	name := slashClean(details.Root)
+
+	err = ls.withLocksFolderLocked(ctx, func() error {
+
+		// the target or an ancestor with infinite depth is already locked
+		for _, root := range ancestorsOf(name) {
+			lock, err := ls.loadLiveLock(ctx, root, now)
+			if err != nil {
+				return err
+			}
+			if lock != nil && lock.covers(name) {
+				return webdav.ErrLocked
+			}
+		}
+
+		// a descendant of the target with infinite depth lock is locked
+		if !details.ZeroDepth {
+			hasLock, err := ls.hasLiveLockInside(ctx, name, now)
+			if err != nil {
+				return err
+			}
+			if hasLock {
+				return webdav.ErrLocked
+			}
+		}
+
+		lockId, _ := uuid.NewV4()
+		lock := &webDavLock{
+			Token:     webDavLockToken(lockId.String(), name),
+			Root:      name,
+			OwnerXML:  details.OwnerXML,
+			ZeroDepth: details.ZeroDepth,
+		}
+		lock.setExpiry(now, details.Duration)
+
+		if err = ls.saveLock(ctx, lock); err != nil {
+			return err
+		}
+		ls.setRenewing(lock)
+		token = lock.Token
+		return nil
+	})
+
+	return
+}
+
+func (ls *WebDavLockSystem) Refresh(now time.Time, token string, duration time.Duration) (details webdav.LockDetails, err error) {
+
+	ctx := context.Background()
+
+	err = ls.withLocksFolderLocked(ctx, func() error {
+
+		lock, err := ls.loadLock(ctx, token)
+		if err != nil {
+			return err
+		}
+		if lock == nil || lock.isExpired(now) {
+			return webdav.ErrNoSuchLock
+		}
+		if ls.isHeld(token) {
+			return webdav.ErrLocked
+		}
+
+		lock.setExpiry(now, duration)
+
+		if err = ls.saveLock(ctx, lock); err != nil {
+			return err
+		}
+		ls.setRenewing(lock)
+		details = lock.details()
+		return nil
+	})
+
+	return
+}
+
+func (ls *WebDavLockSystem) Unlock(now time.Time, token string) error {
+
+	ctx := context.Background()
+
+	return ls.withLocksFolderLocked(ctx, func() error {
+
+		lock, err := ls.loadLock(ctx, token)
+		if err != nil {
+			return err
+		}
+		if lock == nil || lock.isExpired(now) {
+			return webdav.ErrNoSuchLock
+		}
+		if ls.isHeld(token) {
+			return webdav.ErrLocked
+		}
+
+		ls.renewedLock.Lock()
+		delete(ls.renewed, token)
+		ls.renewedLock.Unlock()
+
+		return ls.deleteLock(ctx, lock.Root)
+	})
+}
+
+// setRenewing keeps renewing the lease of the lock if it has no timeout
+func (ls *WebDavLockSystem) setRenewing(lock *webDavLock) {
+	ls.renewedLock.Lock()
+	defer ls.renewedLock.Unlock()
+	if lock.Duration < 0 {
+		ls.renewed[lock.Token] = true
+	} else {
+		delete(ls.renewed, lock.Token)
+	}
+}
+
+func (ls *WebDavLockSystem) loopRenewingInfiniteLocks() {
+	for range time.Tick(webDavInfiniteLockLease / 3) {
+		ls.renewedLock.Lock()
+		var tokens []string
+		for token := range ls.renewed {
+			tokens = append(tokens, token)
+		}
+		ls.renewedLock.Unlock()
+		if len(tokens) == 0 {
+			continue
+		}
+
+		ctx := context.Background()
+		err := ls.withLocksFolderLocked(ctx, func() error {
+			now := time.Now()
+			for _, token := range tokens {
+				lock, err := ls.loadLock(ctx, token)
+				if err != nil {
+					return err
+				}
+				if lock == nil || lock.isExpired(now) || lock.Duration >= 0 {
+					// unlocked, expired or refreshed with a timeout through another server
+					ls.renewedLock.Lock()
+					delete(ls.renewed, token)
+					ls.renewedLock.Unlock()
+					continue
+				}
+				lock.setExpiry(now, time.Duration(lock.Duration))
+				if err = ls.saveLock(ctx, lock); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			glog.V(0).Infof("renew webdav locks: %v", err)
+		}
+	}
+}
+
+func (ls *WebDavLockSystem) isHeld(token string) bool {
+	ls.heldLock.Lock()
+	defer ls.heldLock.Unlock()
+	return ls.held[token]
+}
+
+// withLocksFolderLocked serializes lock changes across all WebDAV servers with the filer lock service
+func (ls *WebDavLockSystem) withLocksFolderLocked(ctx context.Context, fn func() error) error {
+
+	dir, name := filer2.FullPath(WebDavLocksFolder).DirAndName()
+	fileLock := &filer_pb.FileLock{
+		ClientId:    ls.clientId,
+		IsExclusive: true,
+		IsFlock:     true,
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		var acquired bool
+		err := ls.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.Lock(ctx, &filer_pb.LockRequest{
+				Directory: dir,
+				Name:      name,
+				Lock:      fileLock,
+				LeaseSec:  10,
+			})
+			if err != nil {
+				return err
+			}
+			acquired = resp.Acquired
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("lock %s: %v", WebDavLocksFolder, err)
+		}
+		if acquired {
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("lock %s: timed out", WebDavLocksFolder)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	defer ls.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.Unlock(ctx, &filer_pb.UnlockRequest{
+			Directory: dir,
+			Name:      name,
+			Lock:      fileLock,
+		})
+		return err
+	})
+
+	return fn()
+}
+
+// loadLiveLock returns the unexpired lock of the root, and removes the expired one
+func (ls *WebDavLockSystem) loadLiveLock(ctx context.Context, root string, now time.Time) (*webDavLock, error) {
+	lock, err := ls.loadLockOf(ctx, root)
+	if err != nil || lock == nil {
+		return nil, err
+	}
+	if lock.isExpired(now) {
+		if err = ls.deleteLock(ctx, root); err != nil {
+			glog.V(0).Infof("delete expired webdav lock %s: %v", root, err)
+		}
+		return nil, nil
+	}
+	return lock, nil
+}
+
+// hasLiveLockInside checks for unexpired locks of the resources inside the folder, and removes the expired ones
+func (ls *WebDavLockSystem) hasLiveLockInside(ctx context.Context, folder string, now time.Time) (hasLock bool, err error) {
+
+	prefix := webDavLockEntryName(strings.TrimSuffix(folder, "/") + "/")
+
+	var expired []string
+	err = ls.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		lastEntryName := ""
+		for {
+			count := 0
+			err := filer_pb.ListEntries(ctx, client, &filer_pb.ListEntriesRequest{
+				Directory:         WebDavLocksFolder,
+				Prefix:            prefix,
+				StartFromFileName: lastEntryName,
+				Limit:             1024,
+			}, func(entry *filer_pb.Entry) error {
+				count++
+				lastEntryName = entry.Name
+				lock, decodeErr := decodeWebDavLock(entry)
+				if decodeErr != nil {
+					glog.V(0).Infof("webdav lock %s: %v", entry.Name, decodeErr)
+					return nil
+				}
+				if lock.Root == folder {
+					return nil
+				}
+				if lock.isExpired(now) {
+					expired = append(expired, lock.Root)
+					return nil
+				}
+				hasLock = true
+				return io.EOF
+			})
+			if err == io.EOF || hasLock {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("list %s: %v", WebDavLocksFolder, err)
+			}
+			if count < 1024 {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, root := range expired {
+		if deleteErr := ls.deleteLock(ctx, root); deleteErr != nil {
+			glog.V(0).Infof("delete expired webdav lock %s: %v", root, deleteErr)
+		}
+	}
+
+	return
+}
+
+// loadLock finds the lock of the token by the root in the token
+func (ls *WebDavLockSystem) loadLock(ctx context.Context, token string) (*webDavLock, error) {
+	root, ok := webDavLockRootOf(token)
+	if !ok {
+		return nil, nil
+	}
+	lock, err := ls.loadLockOf(ctx, root)
+	if err != nil || lock == nil || lock.Token != token {
+		return nil, err
+	}
+	return lock, nil
+}
+
+func (ls *WebDavLockSystem) loadLockOf(ctx context.Context, root string) (*webDavLock, error) {
+	entry, err := filer2.GetEntry(ctx, ls.fs, path.Join(WebDavLocksFolder, webDavLockEntryName(root)))
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return decodeWebDavLock(entry)
+}
+
+func (ls *WebDavLockSystem) saveLock(ctx context.Context, lock *webDavLock) error {
+
+	data, err := json.Marshal(lock)
+	if err != nil {
+		return err
+	}
+
+	return ls.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		request := &filer_pb.CreateEntryRequest{
+			Directory: WebDavLocksFolder,
+			Entry: &filer_pb.Entry{
+				Name: webDavLockEntryName(lock.Root),
+				Attributes: &filer_pb.FuseAttributes{
+					Mtime:    time.Now().Unix(),
+					Crtime:   time.Now().Unix(),
+					FileMode: uint32(0600),
+					Uid:      ls.fs.option.Uid,
+					Gid:      ls.fs.option.Gid,
+				},
+				Extended: map[string][]byte{
+					webDavLockKey: data,
+				},
+			},
+			SetExtended: true,
+		}
+		if _, err := client.CreateEntry(ctx, request); err != nil {
+			return fmt.Errorf("save webdav lock %s: %v", lock.Root, err)
+		}
+		return nil
+	})
+}
+
+func (ls *WebDavLockSystem) deleteLock(ctx context.Context, root string) error {
+	return ls.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.DeleteEntry(ctx, &filer_pb.DeleteEntryRequest{
+			Directory: WebDavLocksFolder,
+			Name:      webDavLockEntryName(root),
+		})
+		return err
+	})
+}
+
+// webDavLockEntryName escapes the slashes of the root, so all locks are in one folder
+func webDavLockEntryName(root string) string {
+	return url.PathEscape(root)
+}
+
+// webDavLockToken appends the root to the token as the path extension of RFC 2518
+func webDavLockToken(lockId string, root string) string {
+	return webDavLockTokenPrefix + lockId + (&url.URL{Path: root}).EscapedPath()
+}
+
+func webDavLockRootOf(token string) (root string, ok bool) {
+	if !strings.HasPrefix(token, webDavLockTokenPrefix) || len(token) <= len(webDavLockTokenPrefix)+webDavLockUuidLength {
+		return "", false
+	}
+	root, err := url.PathUnescape(token[len(webDavLockTokenPrefix)+webDavLockUuidLength:])
+	if err != nil || !strings.HasPrefix(root, "/") {
+		return "", false
+	}
+	return root, true
+}
+
+// ancestorsOf lists the name and its parent folders
+func ancestorsOf(name string) (names []string) {
+	for {
+		names = append(names, name)
+		if name == "/" {
+			return
+		}
+		name = path.Dir(name)
+	}
+}
+
+func decodeWebDavLock(entry *filer_pb.Entry) (*webDavLock, error) {
+	data, found := entry.Extended[webDavLockKey]
+	if !found {
+		return nil, fmt.Errorf("missing lock details")
+	}
+	lock := &webDavLock{}
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// isWebDavSystemPath checks whether the cleaned name is the WebDAV system folder or inside it
+func isWebDavSystemPath(name string) bool {
+	return name == WebDavSystemFolder || strings.HasPrefix(name, WebDavSystemFolder+"/")
+}
+
+func slashClean(name string) string {
+	if name == "" || name[0] != '/' {
+		name = "/" + name
+	}
+	return path.Clean(name)
+}
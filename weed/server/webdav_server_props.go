@@ -0,0 +1,145 @@
+package weed_server
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"golang.org/x/net/webdav"
+)
+
+// dead properties are kept in the entry extended attributes, keyed by "webdav.prop.{space}local"
+const webDavPropKeyPrefix = "webdav.prop."
+
+var _ = webdav.DeadPropsHolder(&WebDavFile{})
+
+type webDavProp struct {
+	Lang     string `json:"lang,omitempty"`
+	InnerXML []byte `json:"xml,omitempty"`
+}
+
+func webDavPropKey(name xml.Name) string {
+	return fmt.Sprintf("%s{%s}%s", webDavPropKeyPrefix, name.Space, name.Local)
+}
+
+func parseWebDavPropKey(key string) (name xml.Name, ok bool) {
+	if !strings.HasPrefix(key, webDavPropKeyPrefix+"{") {
+		return
+	}
+	key = key[len(webDavPropKeyPrefix)+1:]
+	closing := strings.Index(key, "}")
+	if closing < 0 {
+		return
+	}
+	return xml.Name{Space: key[:closing], Local: key[closing+1:]}, true
+}
+
+// fullpath is the file name without the trailing slash of directories
+func (f *WebDavFile) fullpath() string {
+	if f.name != "/" {
+		return strings.TrimSuffix(f.name, "/")
+	}
+	return f.name
+}
+
+func (f *WebDavFile) DeadProps() (map[xml.Name]webdav.Property, error) {
+
+	glog.V(2).Infof("WebDavFile.DeadProps %v", f.name)
+
+	ctx := context.Background()
+
+	entry, err := filer2.GetEntry(ctx, f.fs, f.fullpath())
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	props := make(map[xml.Name]webdav.Property)
+	for key, value := range entry.Extended {
+		name, ok := parseWebDavPropKey(key)
+		if !ok {
+			continue
+		}
+		var prop webDavProp
+		if err := json.Unmarshal(value, &prop); err != nil {
+			glog.V(0).Infof("decode dead property %s of %s: %v", key, f.name, err)
+			continue
+		}
+		props[name] = webdav.Property{
+			XMLName:  name,
+			Lang:     prop.Lang,
+			InnerXML: prop.InnerXML,
+		}
+	}
+
+	return props, nil
+}
+
+func (f *WebDavFile) Patch(patches []webdav.Proppatch) ([]webdav.Propstat, error) {
+
+	glog.V(2).Infof("WebDavFile.Patch %v", f.name)
+
+	ctx := context.Background()
+
+	entry, err := filer2.GetEntry(ctx, f.fs, f.fullpath())
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("%s not found", f.name)
+	}
+
+	if entry.Extended == nil {
+		entry.Extended = make(map[string][]byte)
+	}
+
+	pstat := webdav.Propstat{Status: http.StatusOK}
+	for _, patch := range patches {
+		for _, p := range patch.Props {
+			pstat.Props = append(pstat.Props, webdav.Property{XMLName: p.XMLName})
+			key := webDavPropKey(p.XMLName)
+			if patch.Remove {
+				delete(entry.Extended, key)
+				continue
+			}
+			value, err := json.Marshal(&webDavProp{
+				Lang:     p.Lang,
+				InnerXML: p.InnerXML,
+			})
+			if err != nil {
+				return nil, err
+			}
+			entry.Extended[key] = value
+		}
+	}
+
+	dir, _ := filer2.FullPath(f.fullpath()).DirAndName()
+	err = f.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		request := &filer_pb.UpdateEntryRequest{
+			Directory:   dir,
+			Entry:       entry,
+			SetExtended: true,
+		}
+		if _, err := client.UpdateEntry(ctx, request); err != nil {
+			return fmt.Errorf("update %s: %v", f.name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if f.entry != nil {
+		f.entry.Extended = entry.Extended
+	}
+
+	return []webdav.Propstat{pstat}, nil
+}
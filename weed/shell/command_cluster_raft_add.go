@@ -0,0 +1,61 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandClusterRaftAdd{})
+}
+
+type commandClusterRaftAdd struct {
+}
+
+func (c *commandClusterRaftAdd) Name() string {
+	return "cluster.raft.add"
+}
+
+func (c *commandClusterRaftAdd) Help() string {
+	return `add a master server to the raft cluster
+
+	cluster.raft.add -address=<master_host>:<port>
+
+	Start the new master first with "weed master -raftJoin", so that it waits to receive
+	the raft log from the leader instead of starting its own cluster.
+
+	The membership is saved in the meta folder of each master, and used instead of -peers on restart.
+
+`
+}
+
+func (c *commandClusterRaftAdd) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	raftAddCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	address := raftAddCommand.String("address", "", "the new master server <host>:<port>")
+	if err = raftAddCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *address == "" {
+		return fmt.Errorf("missing -address")
+	}
+
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.RaftAddServer(ctx, &master_pb.RaftAddServerRequest{
+			Address: *address,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "added master %s\n", *address)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandClusterRaftPs{})
+}
+
+type commandClusterRaftPs struct {
+}
+
+func (c *commandClusterRaftPs) Name() string {
+	return "cluster.raft.ps"
+}
+
+func (c *commandClusterRaftPs) Help() string {
+	return `list the master servers in the raft cluster
+
+	cluster.raft.ps
+
+	Whether the other masters are alive is only known by the leader.
+
+`
+}
+
+func (c *commandClusterRaftPs) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	var resp *master_pb.RaftListClusterServersResponse
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.RaftListClusterServers(ctx, &master_pb.RaftListClusterServersRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, server := range resp.ClusterServers {
+		role, state := "follower", "alive"
+		if server.IsLeader {
+			role = "leader"
+		}
+		if !server.IsAlive {
+			state = "unknown"
+			if server.LastActivityNs > 0 {
+				state = fmt.Sprintf("last seen %v", time.Unix(0, server.LastActivityNs).Format(time.RFC3339))
+			}
+		}
+		fmt.Fprintf(writer, "%-24s %-8s %s\n", server.Address, role, state)
+	}
+	fmt.Fprintf(writer, "Total %d masters.\n", len(resp.ClusterServers))
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandClusterRaftRemove{})
+}
+
+type commandClusterRaftRemove struct {
+}
+
+func (c *commandClusterRaftRemove) Name() string {
+	return "cluster.raft.remove"
+}
+
+func (c *commandClusterRaftRemove) Help() string {
+	return `remove a master server from the raft cluster
+
+	cluster.raft.remove -address=<master_host>:<port>
+
+	The removal is refused if the remaining alive masters can not form a quorum.
+	The leader can not be removed. Stop it first, and remove it from the new leader.
+
+`
+}
+
+func (c *commandClusterRaftRemove) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	raftRemoveCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	address := raftRemoveCommand.String("address", "", "the master server <host>:<port> to remove")
+	if err = raftRemoveCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *address == "" {
+		return fmt.Errorf("missing -address")
+	}
+
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.RaftRemoveServer(ctx, &master_pb.RaftRemoveServerRequest{
+			Address: *address,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "removed master %s\n", *address)
+
+	return nil
+}
@@ -28,6 +28,10 @@ func (c *commandCollectionDelete) Help() string {
 
 func (c *commandCollectionDelete) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
 
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return
+	}
+
 	if len(args) == 0 {
 		return nil
 	}
@@ -61,7 +61,7 @@ func (c *commandFsCat) Do(args []string, commandEnv *CommandEnv, writer io.Write
 			return err
 		}
 
-		return filer2.StreamContent(commandEnv.MasterClient, writer, respLookupEntry.Entry.Chunks, 0, math.MaxInt32)
+		return filer2.StreamContent(commandEnv.MasterClient, nil, writer, respLookupEntry.Entry.Chunks, 0, math.MaxInt32)
 
 	})
 
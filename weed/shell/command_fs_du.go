@@ -61,21 +61,25 @@ func paginateDirectory(ctx context.Context, writer io.Writer, client filer_pb.Se
 	startFromFileName := ""
 
 	for paginatedCount == -1 || paginatedCount == paginateSize {
-		resp, listErr := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
+		var entries []*filer_pb.Entry
+		listErr := filer_pb.ListEntries(ctx, client, &filer_pb.ListEntriesRequest{
 			Directory:          dir,
 			Prefix:             name,
 			StartFromFileName:  startFromFileName,
 			InclusiveStartFrom: false,
 			Limit:              uint32(paginateSize),
+		}, func(entry *filer_pb.Entry) error {
+			entries = append(entries, entry)
+			return nil
 		})
 		if listErr != nil {
 			err = listErr
 			return
 		}
 
-		paginatedCount = len(resp.Entries)
+		paginatedCount = len(entries)
 
-		for _, entry := range resp.Entries {
+		for _, entry := range entries {
 			if entry.IsDirectory {
 				subDir := fmt.Sprintf("%s/%s", dir, entry.Name)
 				if dir == "/" {
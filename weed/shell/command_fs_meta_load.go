@@ -81,8 +81,9 @@ func (c *commandFsMetaLoad) Do(args []string, commandEnv *CommandEnv, writer io.
 			}
 
 			if _, err = client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
-				Directory: fullEntry.Dir,
-				Entry:     fullEntry.Entry,
+				Directory:   fullEntry.Dir,
+				Entry:       fullEntry.Entry,
+				SetExtended: true,
 			}); err != nil {
 				return err
 			}
@@ -125,21 +125,25 @@ func doTraverse(ctx context.Context, writer io.Writer, client filer_pb.SeaweedFi
 	paginateSize := 1000
 
 	for paginatedCount == -1 || paginatedCount == paginateSize {
-		resp, listErr := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
+		var entries []*filer_pb.Entry
+		listErr := filer_pb.ListEntries(ctx, client, &filer_pb.ListEntriesRequest{
 			Directory:          string(parentPath),
 			Prefix:             "",
 			StartFromFileName:  startFromFileName,
 			InclusiveStartFrom: false,
 			Limit:              uint32(paginateSize),
+		}, func(entry *filer_pb.Entry) error {
+			entries = append(entries, entry)
+			return nil
 		})
 		if listErr != nil {
 			err = listErr
 			return
 		}
 
-		paginatedCount = len(resp.Entries)
+		paginatedCount = len(entries)
 
-		for _, entry := range resp.Entries {
+		for _, entry := range entries {
 
 			if err = fn(parentPath, entry); err != nil {
 				return err
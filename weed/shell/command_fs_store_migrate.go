@@ -0,0 +1,136 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsStoreMigrate{})
+}
+
+type commandFsStoreMigrate struct {
+}
+
+func (c *commandFsStoreMigrate) Name() string {
+	return "fs.store.migrate"
+}
+
+func (c *commandFsStoreMigrate) Help() string {
+	return `move the filer meta data to another filer store while the filer keeps serving
+
+	fs.store.migrate -store=postgres     # write to both stores, and copy existing meta data to postgres
+	fs.store.migrate                     # show the migration progress
+	fs.store.migrate -verify             # compare both stores entry by entry, with checksums
+	fs.store.migrate -cutover            # switch the filer to the new store, after a successful verification
+	fs.store.migrate -abort              # stop writing to the new store
+	fs.store.migrate -verify -wait       # wait for the copying or the verification to finish
+	fs.store.migrate http://<filer_host>:<port>/ # talk to another filer than the current one
+
+	The new store is configured in its own section of the filer's filer.toml, and does not need to be enabled.
+	After the cut-over, enable the new store in filer.toml, so that the filer uses it after restarting.
+
+	Only changes made through this filer are written to both stores. Other filers sharing
+	the same store should be stopped during the migration.
+
+`
+}
+
+func (c *commandFsStoreMigrate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	fsStoreMigrateCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	store := fsStoreMigrateCommand.String("store", "", "start migrating to this store, configured in filer.toml")
+	verify := fsStoreMigrateCommand.Bool("verify", false, "compare the current store and the new store")
+	cutover := fsStoreMigrateCommand.Bool("cutover", false, "switch to the new store")
+	abort := fsStoreMigrateCommand.Bool("abort", false, "stop the migration")
+	wait := fsStoreMigrateCommand.Bool("wait", false, "wait until the copying or the verification is done")
+	if err = fsStoreMigrateCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	action := "status"
+	actionCount := 0
+	if *store != "" {
+		action, actionCount = "start", actionCount+1
+	}
+	if *verify {
+		action, actionCount = "verify", actionCount+1
+	}
+	if *cutover {
+		action, actionCount = "cutover", actionCount+1
+	}
+	if *abort {
+		action, actionCount = "abort", actionCount+1
+	}
+	if actionCount > 1 {
+		return fmt.Errorf("only one of -store, -verify, -cutover, -abort can be used")
+	}
+
+	filerServer, filerPort, _, err := commandEnv.parseUrl(findInputDirectory(fsStoreMigrateCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		resp, err := client.MigrateStore(ctx, &filer_pb.MigrateStoreRequest{
+			Action: action,
+			Store:  *store,
+		})
+		if err != nil {
+			return err
+		}
+		printStoreMigration(writer, resp)
+
+		for *wait && (resp.State == filer2.StoreMigrationBackfilling || resp.State == filer2.StoreMigrationVerifying) {
+			time.Sleep(5 * time.Second)
+			resp, err = client.MigrateStore(ctx, &filer_pb.MigrateStoreRequest{
+				Action: "status",
+			})
+			if err != nil {
+				return err
+			}
+			printStoreMigration(writer, resp)
+		}
+
+		if resp.State == filer2.StoreMigrationCutOver {
+			fmt.Fprintf(writer, "enable [%s] and disable [%s] in filer.toml before restarting the filer\n",
+				resp.TargetStore, resp.SourceStore)
+		}
+
+		return nil
+	})
+
+}
+
+func printStoreMigration(writer io.Writer, resp *filer_pb.MigrateStoreResponse) {
+	if resp.State == "" {
+		fmt.Fprintf(writer, "filer store %s, no migration\n", resp.SourceStore)
+		return
+	}
+
+	fmt.Fprintf(writer, "migrating %s => %s: %s since %v\n", resp.SourceStore, resp.TargetStore,
+		resp.State, time.Unix(0, resp.StartedAtNs).Format(time.RFC3339))
+	fmt.Fprintf(writer, "  copied %d entries in %d directories, %d failed writes to %s\n",
+		resp.CopiedCount, resp.DirectoryCount, resp.DualWriteErrors, resp.TargetStore)
+	if resp.CurrentDirectory != "" {
+		fmt.Fprintf(writer, "  at %s\n", resp.CurrentDirectory)
+	}
+	if resp.SourceEntryCount > 0 || resp.TargetEntryCount > 0 || resp.MismatchCount > 0 {
+		fmt.Fprintf(writer, "  verified %s: %d entries checksum %x, %s: %d entries checksum %x, %d mismatches\n",
+			resp.SourceStore, resp.SourceEntryCount, resp.SourceChecksum,
+			resp.TargetStore, resp.TargetEntryCount, resp.TargetChecksum,
+			resp.MismatchCount)
+	}
+	if resp.Error != "" {
+		fmt.Fprintf(writer, "  error: %s\n", resp.Error)
+	}
+}
@@ -58,24 +58,28 @@ func treeTraverseDirectory(ctx context.Context, writer io.Writer, client filer_p
 	paginateSize := 1000
 
 	for paginatedCount == -1 || paginatedCount == paginateSize {
-		resp, listErr := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
+		var entries []*filer_pb.Entry
+		listErr := filer_pb.ListEntries(ctx, client, &filer_pb.ListEntriesRequest{
 			Directory:          dir,
 			Prefix:             name,
 			StartFromFileName:  startFromFileName,
 			InclusiveStartFrom: false,
 			Limit:              uint32(paginateSize),
+		}, func(entry *filer_pb.Entry) error {
+			entries = append(entries, entry)
+			return nil
 		})
 		if listErr != nil {
 			err = listErr
 			return
 		}
 
-		paginatedCount = len(resp.Entries)
+		paginatedCount = len(entries)
 		if paginatedCount > 0 {
 			prefix.addMarker(level)
 		}
 
-		for i, entry := range resp.Entries {
+		for i, entry := range entries {
 
 			if level < 0 && name != "" {
 				if entry.Name != name {
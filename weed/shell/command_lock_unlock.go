@@ -0,0 +1,62 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+)
+
+func init() {
+	Commands = append(Commands, &commandLock{})
+	Commands = append(Commands, &commandUnlock{})
+}
+
+// =========== Lock ==============
+type commandLock struct {
+}
+
+func (c *commandLock) Name() string {
+	return "lock"
+}
+
+func (c *commandLock) Help() string {
+	return `lock in order to exclusively manage the cluster
+
+	The commands changing the volume placement, such as volume.balance and ec.encode, require the lock.
+	The lock is held on the leader master until "unlock" or exiting the shell.
+	While locked, the master maintenance scripts are skipped, and other shells can not lock.
+
+`
+}
+
+func (c *commandLock) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	if err = commandEnv.locker.RequestLock(); err != nil {
+		return err
+	}
+	fmt.Fprintf(writer, "locked\n")
+
+	return nil
+}
+
+// =========== Unlock ==============
+
+type commandUnlock struct {
+}
+
+func (c *commandUnlock) Name() string {
+	return "unlock"
+}
+
+func (c *commandUnlock) Help() string {
+	return `unlock the cluster-wide lock
+
+`
+}
+
+func (c *commandUnlock) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	commandEnv.locker.ReleaseLock()
+	fmt.Fprintf(writer, "unlocked\n")
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 )
 
 func init() {
@@ -33,8 +34,10 @@ func (c *commandVolumeBalance) Help() string {
 
 	For each type of volume server (different max volume count limit){
 		for each collection {
-			balanceWritableVolumes()
-			balanceReadOnlyVolumes()
+			for each disk type {
+				balanceWritableVolumes()
+				balanceReadOnlyVolumes()
+			}
 		}
 	}
 
@@ -60,6 +63,10 @@ func (c *commandVolumeBalance) Help() string {
 
 func (c *commandVolumeBalance) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
 
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return
+	}
+
 	balanceCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
 	collection := balanceCommand.String("collection", "EACH_COLLECTION", "collection name, or use \"ALL_COLLECTIONS\" across collections, \"EACH_COLLECTION\" for each collection")
 	dc := balanceCommand.String("dataCenter", "", "only apply the balancing for this dataCenter")
@@ -108,6 +115,39 @@ func (c *commandVolumeBalance) Do(args []string, commandEnv *CommandEnv, writer
 }
 
 func balanceVolumeServers(commandEnv *CommandEnv, dataNodeInfos []*master_pb.DataNodeInfo, volumeSizeLimit uint64, collection string, applyBalancing bool) error {
+	// volumes only move between disks of the same type
+	diskTypes := make(map[string]bool)
+	for _, dn := range dataNodeInfos {
+		for diskType := range dataNodeMaxVolumeCounts(dn) {
+			diskTypes[diskType] = true
+		}
+	}
+	for diskType := range diskTypes {
+		var diskTypeNodes []*master_pb.DataNodeInfo
+		for _, dn := range dataNodeInfos {
+			if dataNodeMaxVolumeCounts(dn)[diskType] > 0 {
+				diskTypeNodes = append(diskTypeNodes, dn)
+			}
+		}
+		if len(diskTypeNodes) < 2 {
+			continue
+		}
+		if err := balanceDiskTypeVolumeServers(commandEnv, diskTypeNodes, volumeSizeLimit, collection, diskType, applyBalancing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dataNodeMaxVolumeCounts lists the volume slots per disk type, all on hard drives for older volume servers
+func dataNodeMaxVolumeCounts(dn *master_pb.DataNodeInfo) map[string]uint64 {
+	if len(dn.MaxVolumeCounts) == 0 {
+		return map[string]uint64{types.HardDriveType.String(): dn.MaxVolumeCount}
+	}
+	return dn.MaxVolumeCounts
+}
+
+func balanceDiskTypeVolumeServers(commandEnv *CommandEnv, dataNodeInfos []*master_pb.DataNodeInfo, volumeSizeLimit uint64, collection string, diskType string, applyBalancing bool) error {
 	var nodes []*Node
 	for _, dn := range dataNodeInfos {
 		nodes = append(nodes, &Node{
@@ -123,6 +163,9 @@ func balanceVolumeServers(commandEnv *CommandEnv, dataNodeInfos []*master_pb.Dat
 					return false
 				}
 			}
+			if types.DiskType(v.DiskType).String() != diskType {
+				return false
+			}
 			return !v.ReadOnly && v.Size < volumeSizeLimit
 		})
 	}
@@ -138,6 +181,9 @@ func balanceVolumeServers(commandEnv *CommandEnv, dataNodeInfos []*master_pb.Dat
 					return false
 				}
 			}
+			if types.DiskType(v.DiskType).String() != diskType {
+				return false
+			}
 			return v.ReadOnly || v.Size >= volumeSizeLimit
 		})
 	}
@@ -156,6 +202,10 @@ func collectVolumeServersByType(t *master_pb.TopologyInfo, selectedDataCenter st
 		}
 		for _, r := range dc.RackInfos {
 			for _, dn := range r.DataNodeInfos {
+				// draining volume servers are emptied by volumeServer.evacuate
+				if dn.IsDraining {
+					continue
+				}
 				typeToNodes[dn.MaxVolumeCount] = append(typeToNodes[dn.MaxVolumeCount], dn)
 			}
 		}
@@ -231,7 +281,7 @@ func moveVolume(commandEnv *CommandEnv, v *master_pb.VolumeInformationMessage, f
 	fmt.Fprintf(os.Stdout, "moving volume %s%d %s => %s\n", collectionPrefix, v.Id, fullNode.info.Id, emptyNode.info.Id)
 	if applyBalancing {
 		ctx := context.Background()
-		return LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(v.Id), fullNode.info.Id, emptyNode.info.Id, 5*time.Second)
+		return LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(v.Id), fullNode.info.Id, emptyNode.info.Id, 5*time.Second, v.DiskType)
 	}
 	return nil
 }
@@ -32,6 +32,10 @@ func (c *commandVolumeCopy) Help() string {
 
 func (c *commandVolumeCopy) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
 
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return
+	}
+
 	if len(args) != 3 {
 		fmt.Fprintf(writer, "received args: %+v\n", args)
 		return fmt.Errorf("need 3 args of <source volume server host:port> <target volume server host:port> <volume id>")
@@ -48,6 +52,10 @@ func (c *commandVolumeCopy) Do(args []string, commandEnv *CommandEnv, writer io.
 	}
 
 	ctx := context.Background()
-	_, err = copyVolume(ctx, commandEnv.option.GrpcDialOption, volumeId, sourceVolumeServer, targetVolumeServer)
+	diskType, err := lookupVolumeDiskType(ctx, commandEnv, volumeId, sourceVolumeServer)
+	if err != nil {
+		return err
+	}
+	_, err = copyVolume(ctx, commandEnv.option.GrpcDialOption, volumeId, sourceVolumeServer, targetVolumeServer, diskType)
 	return
 }
@@ -43,6 +43,10 @@ func (c *commandVolumeFixReplication) Help() string {
 
 func (c *commandVolumeFixReplication) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
 
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return
+	}
+
 	takeAction := true
 	if len(args) > 0 && args[0] == "-n" {
 		takeAction = false
@@ -101,7 +105,7 @@ func (c *commandVolumeFixReplication) Do(args []string, commandEnv *CommandEnv,
 		foundNewLocation := false
 		for _, dst := range allLocations {
 			// check whether data nodes satisfy the constraints
-			if dst.dataNode.FreeVolumeCount > 0 && satisfyReplicaPlacement(replicaPlacement, locations, dst) {
+			if dst.dataNode.FreeVolumeCount > 0 && !dst.dataNode.IsDraining && satisfyReplicaPlacement(replicaPlacement, locations, dst) {
 				// ask the volume server to replicate the volume
 				sourceNodes := underReplicatedVolumeLocations[vid]
 				sourceNode := sourceNodes[rand.Intn(len(sourceNodes))]
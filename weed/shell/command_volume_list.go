@@ -82,7 +82,7 @@ func writeRackInfo(writer io.Writer, t *master_pb.RackInfo) statistics {
 	return s
 }
 func writeDataNodeInfo(writer io.Writer, t *master_pb.DataNodeInfo) statistics {
-	fmt.Fprintf(writer, "      DataNode %s volume:%d/%d active:%d free:%d\n", t.Id, t.VolumeCount, t.MaxVolumeCount, t.ActiveVolumeCount, t.FreeVolumeCount)
+	fmt.Fprintf(writer, "      DataNode %s volume:%d/%d active:%d free:%d%s\n", t.Id, t.VolumeCount, t.MaxVolumeCount, t.ActiveVolumeCount, t.FreeVolumeCount, diskTypeSlots(t))
 	var s statistics
 	sort.Slice(t.VolumeInfos, func(i, j int) bool {
 		return t.VolumeInfos[i].Id < t.VolumeInfos[j].Id
@@ -96,6 +96,17 @@ func writeDataNodeInfo(writer io.Writer, t *master_pb.DataNodeInfo) statistics {
 	fmt.Fprintf(writer, "      DataNode %s %+v \n", t.Id, s)
 	return s
 }
+func diskTypeSlots(t *master_pb.DataNodeInfo) (slots string) {
+	var diskTypes []string
+	for diskType := range t.MaxVolumeCounts {
+		diskTypes = append(diskTypes, diskType)
+	}
+	sort.Strings(diskTypes)
+	for _, diskType := range diskTypes {
+		slots += fmt.Sprintf(" %s:%d", diskType, t.MaxVolumeCounts[diskType])
+	}
+	return
+}
 func writeVolumeInformationMessage(writer io.Writer, t *master_pb.VolumeInformationMessage) statistics {
 	fmt.Fprintf(writer, "        volume %+v \n", t)
 	return newStatistics(t)
@@ -0,0 +1,374 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeServerEvacuate{})
+}
+
+type commandVolumeServerEvacuate struct {
+}
+
+func (c *commandVolumeServerEvacuate) Name() string {
+	return "volumeServer.evacuate"
+}
+
+func (c *commandVolumeServerEvacuate) Help() string {
+	return `move out all data on a volume server, to decommission it
+
+	volumeServer.evacuate -node <host:port> [-force] [-timeout=1m]
+	volumeServer.evacuate -node <host:port> -cancel
+
+	This command marks the volume server as draining on the master. The master does not assign
+	new volumes or writes to a draining volume server.
+
+	Each volume is moved to another volume server with a free slot of the same disk type,
+	keeping the replica placement with the other replicas, and preferring the same rack and data center.
+	Each erasure coding shard is moved to the rack, and then the volume server, with the fewest shards of its volume.
+
+	Without -force, only the moving plan is printed.
+	With -force, the command waits until the master sees no volumes and no shards on the volume server.
+	Then the volume server can be stopped.
+
+	-cancel stops draining the volume server, so that it takes new volumes again.
+
+`
+}
+
+func (c *commandVolumeServerEvacuate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return
+	}
+
+	evacuateCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeServer := evacuateCommand.String("node", "", "<host>:<port> of the volume server")
+	cancelDraining := evacuateCommand.Bool("cancel", false, "stop draining the volume server")
+	applyChange := evacuateCommand.Bool("force", false, "actually move the volumes and erasure coding shards")
+	timeout := evacuateCommand.Duration("timeout", time.Minute, "wait time for the master to see the volume server empty")
+	if err = evacuateCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	if *volumeServer == "" {
+		return fmt.Errorf("need to specify volume server by -node=<host>:<port>")
+	}
+
+	ctx := context.Background()
+
+	if *cancelDraining {
+		return setVolumeServerDraining(ctx, commandEnv, *volumeServer, false, writer)
+	}
+
+	if *applyChange {
+		if err = setVolumeServerDraining(ctx, commandEnv, *volumeServer, true, writer); err != nil {
+			return err
+		}
+	}
+
+	topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+
+	plan, err := planEvacuation(topologyInfo, *volumeServer)
+	if err != nil {
+		return err
+	}
+
+	failedCount := 0
+	for _, m := range plan.volumeMoves {
+		if m.target == nil {
+			fmt.Fprintf(writer, "volume %d %s: no volume server has a free %s slot satisfying replication %s\n",
+				m.volume.Id, m.volume.Collection, types.DiskType(m.volume.DiskType), replicaPlacementString(m.volume))
+			failedCount++
+			continue
+		}
+		fmt.Fprintf(writer, "moving volume %d %s => %s\n", m.volume.Id, *volumeServer, m.target.Id)
+		if !*applyChange {
+			continue
+		}
+		if err = LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(m.volume.Id), *volumeServer, m.target.Id, 5*time.Second, m.volume.DiskType); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range plan.ecShardMoves {
+		if m.target == nil {
+			fmt.Fprintf(writer, "ec shard %d.%d %s: no volume server has a free slot\n", m.volumeId, m.shardId, m.collection)
+			failedCount++
+			continue
+		}
+		fmt.Fprintf(writer, "moving ec shard %d.%d %s => %s\n", m.volumeId, m.shardId, *volumeServer, m.target.info.Id)
+		if !*applyChange {
+			continue
+		}
+		if err = evacuateEcShard(ctx, commandEnv, *volumeServer, m); err != nil {
+			return err
+		}
+	}
+
+	if failedCount > 0 {
+		return fmt.Errorf("%d volumes or ec shards can not be moved out of %s", failedCount, *volumeServer)
+	}
+
+	if !*applyChange {
+		return nil
+	}
+
+	return waitUntilEvacuated(ctx, commandEnv, *volumeServer, *timeout, writer)
+}
+
+// evacuateEcShard is moveMountedShardToEcNode without the bookkeeping, which is already done in the plan
+func evacuateEcShard(ctx context.Context, commandEnv *CommandEnv, volumeServer string, m *evacuateEcShardMove) error {
+
+	copiedShardIds, err := oneServerCopyAndMountEcShardsFromSource(ctx, commandEnv.option.GrpcDialOption, m.target, uint32(m.shardId), 1, m.volumeId, m.collection, volumeServer)
+	if err != nil {
+		return err
+	}
+
+	if err = unmountEcShards(ctx, commandEnv.option.GrpcDialOption, m.volumeId, volumeServer, copiedShardIds); err != nil {
+		return err
+	}
+
+	return sourceServerDeleteEcShards(ctx, commandEnv.option.GrpcDialOption, m.collection, m.volumeId, volumeServer, copiedShardIds)
+}
+
+func setVolumeServerDraining(ctx context.Context, commandEnv *CommandEnv, volumeServer string, isDraining bool, writer io.Writer) error {
+	return commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err := client.VolumeServerDrain(ctx, &master_pb.VolumeServerDrainRequest{
+			Address:    volumeServer,
+			IsDraining: isDraining,
+		})
+		if err != nil {
+			return fmt.Errorf("set volume server %s draining %v: %v", volumeServer, isDraining, err)
+		}
+		if !resp.IsConnected {
+			fmt.Fprintf(writer, "volume server %s is not connected to the master\n", volumeServer)
+		}
+		fmt.Fprintf(writer, "volume server %s draining: %v\n", volumeServer, isDraining)
+		return nil
+	})
+}
+
+func collectTopologyInfo(ctx context.Context, commandEnv *CommandEnv) (topologyInfo *master_pb.TopologyInfo, err error) {
+	var resp *master_pb.VolumeListResponse
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.TopologyInfo, nil
+}
+
+func waitUntilEvacuated(ctx context.Context, commandEnv *CommandEnv, volumeServer string, timeout time.Duration, writer io.Writer) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+		if err != nil {
+			return err
+		}
+		volumeCount, ecShardCount, found := 0, 0, false
+		eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+			if dn.Id == volumeServer {
+				found = true
+				volumeCount, ecShardCount = len(dn.VolumeInfos), countShards(dn.EcShardInfos)
+			}
+		})
+		// a disconnected volume server may still hold its data, so only a connected and empty one is evacuated
+		if found && volumeCount == 0 && ecShardCount == 0 {
+			fmt.Fprintf(writer, "volume server %s is evacuated\n", volumeServer)
+			return nil
+		}
+		if time.Now().After(deadline) {
+			if !found {
+				return fmt.Errorf("volume server %s is not connected to the master, can not confirm it is evacuated", volumeServer)
+			}
+			return fmt.Errorf("volume server %s still has %d volumes and %d ec shards", volumeServer, volumeCount, ecShardCount)
+		}
+		time.Sleep(2 * time.Second)
+	}
+}
+
+type evacuationPlan struct {
+	volumeMoves  []*evacuateVolumeMove
+	ecShardMoves []*evacuateEcShardMove
+}
+
+type evacuateVolumeMove struct {
+	volume *master_pb.VolumeInformationMessage
+	target *master_pb.DataNodeInfo
+}
+
+type evacuateEcShardMove struct {
+	volumeId   needle.VolumeId
+	collection string
+	shardId    erasure_coding.ShardId
+	target     *EcNode
+}
+
+type evacuateNode struct {
+	location
+	freeSlots map[string]int // by disk type
+	volumes   map[uint32]bool
+}
+
+// planEvacuation finds the targets for all volumes and erasure coding shards on the volume server.
+// The targets are not draining, and each move is accounted for in the following moves.
+func planEvacuation(topologyInfo *master_pb.TopologyInfo, volumeServer string) (plan *evacuationPlan, err error) {
+
+	plan = &evacuationPlan{}
+	var nodes []*evacuateNode
+	var source *evacuateNode
+	var ecNodes []*EcNode
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		node := &evacuateNode{
+			location:  newLocation(dc, string(rack), dn),
+			freeSlots: make(map[string]int),
+			volumes:   make(map[uint32]bool),
+		}
+		for diskType, count := range dataNodeMaxVolumeCounts(dn) {
+			node.freeSlots[diskType] = int(count)
+		}
+		for _, v := range dn.VolumeInfos {
+			node.volumes[v.Id] = true
+			node.freeSlots[types.DiskType(v.DiskType).String()]--
+		}
+		// the erasure coding shards take the hard drive slots
+		if hddFree := int(dn.FreeVolumeCount); node.freeSlots[types.HardDriveType.String()] > hddFree {
+			node.freeSlots[types.HardDriveType.String()] = hddFree
+		}
+		if dn.Id == volumeServer {
+			source = node
+			return
+		}
+		nodes = append(nodes, node)
+		if !dn.IsDraining {
+			ecNodes = append(ecNodes, &EcNode{
+				info:       dn,
+				dc:         dc,
+				rack:       rack,
+				freeEcSlot: countFreeShardSlots(dn),
+			})
+		}
+	})
+	if source == nil {
+		return nil, fmt.Errorf("volume server %s is not found", volumeServer)
+	}
+
+	volumes := append([]*master_pb.VolumeInformationMessage{}, source.dataNode.VolumeInfos...)
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Id < volumes[j].Id
+	})
+	for _, v := range volumes {
+		target := pickEvacuationTarget(nodes, source, v)
+		if target != nil {
+			target.freeSlots[types.DiskType(v.DiskType).String()]--
+			target.volumes[v.Id] = true
+		}
+		move := &evacuateVolumeMove{volume: v}
+		if target != nil {
+			move.target = target.dataNode
+		}
+		plan.volumeMoves = append(plan.volumeMoves, move)
+	}
+
+	ecShardInfos := append([]*master_pb.VolumeEcShardInformationMessage{}, source.dataNode.EcShardInfos...)
+	sort.Slice(ecShardInfos, func(i, j int) bool {
+		return ecShardInfos[i].Id < ecShardInfos[j].Id
+	})
+	for _, ecShardInfo := range ecShardInfos {
+		vid := needle.VolumeId(ecShardInfo.Id)
+		for _, shardId := range erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIds() {
+			target := pickEcShardEvacuationTarget(ecNodes, vid)
+			if target != nil {
+				target.addEcVolumeShards(vid, ecShardInfo.Collection, []uint32{uint32(shardId)})
+			}
+			plan.ecShardMoves = append(plan.ecShardMoves, &evacuateEcShardMove{
+				volumeId:   vid,
+				collection: ecShardInfo.Collection,
+				shardId:    shardId,
+				target:     target,
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// pickEvacuationTarget finds a volume server satisfying the replica placement with the other replicas,
+// preferring the same rack, then the same data center, as the evacuated volume server
+func pickEvacuationTarget(nodes []*evacuateNode, source *evacuateNode, v *master_pb.VolumeInformationMessage) (target *evacuateNode) {
+	replicaPlacement, _ := storage.NewReplicaPlacementFromByte(byte(v.ReplicaPlacement))
+	var otherReplicas []location
+	for _, n := range nodes {
+		if n.volumes[v.Id] {
+			otherReplicas = append(otherReplicas, n.location)
+		}
+	}
+
+	diskType := types.DiskType(v.DiskType).String()
+	targetScore := -1
+	for _, n := range nodes {
+		if n.dataNode.IsDraining || n.freeSlots[diskType] <= 0 || n.volumes[v.Id] {
+			continue
+		}
+		if !satisfyReplicaPlacement(replicaPlacement, otherReplicas, n.location) {
+			continue
+		}
+		score := 0
+		if n.dc == source.dc {
+			score++
+			if n.rack == source.rack {
+				score++
+			}
+		}
+		if score > targetScore || score == targetScore && n.freeSlots[diskType] > target.freeSlots[diskType] {
+			target, targetScore = n, score
+		}
+	}
+	return
+}
+
+// pickEcShardEvacuationTarget spreads the shards of one volume across racks, and then volume servers
+func pickEcShardEvacuationTarget(ecNodes []*EcNode, vid needle.VolumeId) (target *EcNode) {
+	rackShardCounts := make(map[RackId]int)
+	for _, ecNode := range ecNodes {
+		rackShardCounts[ecNode.rack] += findEcVolumeShards(ecNode, vid).ShardIdCount()
+	}
+
+	var targetRackCount, targetNodeCount int
+	for _, ecNode := range ecNodes {
+		if ecNode.freeEcSlot <= 0 {
+			continue
+		}
+		rackCount, nodeCount := rackShardCounts[ecNode.rack], findEcVolumeShards(ecNode, vid).ShardIdCount()
+		if target == nil ||
+			rackCount < targetRackCount ||
+			rackCount == targetRackCount && nodeCount < targetNodeCount ||
+			rackCount == targetRackCount && nodeCount == targetNodeCount && ecNode.freeEcSlot > target.freeEcSlot {
+			target, targetRackCount, targetNodeCount = ecNode, rackCount, nodeCount
+		}
+	}
+	return
+}
+
+func replicaPlacementString(v *master_pb.VolumeInformationMessage) string {
+	replicaPlacement, _ := storage.NewReplicaPlacementFromByte(byte(v.ReplicaPlacement))
+	return replicaPlacement.String()
+}
@@ -0,0 +1,81 @@
+package shell
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+)
+
+func TestPlanEvacuation(t *testing.T) {
+
+	dataNode := func(id string, max uint64, isDraining bool, volumes ...*master_pb.VolumeInformationMessage) *master_pb.DataNodeInfo {
+		return &master_pb.DataNodeInfo{
+			Id:              id,
+			MaxVolumeCount:  max,
+			FreeVolumeCount: max - uint64(len(volumes)),
+			VolumeInfos:     volumes,
+			IsDraining:      isDraining,
+		}
+	}
+	volume := func(id uint32, replicaPlacement uint32) *master_pb.VolumeInformationMessage {
+		return &master_pb.VolumeInformationMessage{Id: id, ReplicaPlacement: replicaPlacement}
+	}
+
+	source := dataNode("dn1", 10, true, volume(1, 0), volume(2, 10), volume(3, 0))
+	source.EcShardInfos = []*master_pb.VolumeEcShardInformationMessage{
+		{Id: 5, Collection: "c1", EcIndexBits: uint32(erasure_coding.ShardBits(0).AddShardId(0).AddShardId(1))},
+	}
+	topo := &master_pb.TopologyInfo{
+		DataCenterInfos: []*master_pb.DataCenterInfo{{
+			Id: "dc1",
+			RackInfos: []*master_pb.RackInfo{
+				{Id: "rack1", DataNodeInfos: []*master_pb.DataNodeInfo{
+					source,
+					dataNode("dn2", 1, false),
+					dataNode("dn3", 10, true),
+				}},
+				{Id: "rack2", DataNodeInfos: []*master_pb.DataNodeInfo{
+					dataNode("dn4", 10, false, volume(2, 10)),
+					dataNode("dn5", 10, false),
+				}},
+			},
+		}},
+	}
+
+	plan, err := planEvacuation(topo, "dn1")
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+
+	expected := map[uint32]string{
+		1: "dn2", // same rack first
+		2: "",    // "010" needs another rack than dn4, but dn2 is full and dn3 is draining
+		3: "dn5", // the most free slots in the other rack
+	}
+	for _, m := range plan.volumeMoves {
+		target := ""
+		if m.target != nil {
+			target = m.target.Id
+		}
+		if target != expected[m.volume.Id] {
+			t.Errorf("volume %d moved to %q, expected %q", m.volume.Id, target, expected[m.volume.Id])
+		}
+	}
+
+	if len(plan.ecShardMoves) != 2 {
+		t.Fatalf("ec shard moves: %d", len(plan.ecShardMoves))
+	}
+	for _, m := range plan.ecShardMoves {
+		if m.target == nil || m.target.info.Id == "dn1" || m.target.info.Id == "dn3" {
+			t.Errorf("ec shard %d.%d moved to %+v", m.volumeId, m.shardId, m.target)
+		}
+	}
+	if plan.ecShardMoves[0].target.rack == plan.ecShardMoves[1].target.rack {
+		t.Errorf("ec shards are not spread across racks")
+	}
+
+	if _, err = planEvacuation(topo, "dn9"); err == nil {
+		t.Errorf("planned for a missing volume server")
+	}
+}
@@ -0,0 +1,188 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeTierMove{})
+}
+
+type commandVolumeTierMove struct {
+}
+
+func (c *commandVolumeTierMove) Name() string {
+	return "volume.tier.move"
+}
+
+func (c *commandVolumeTierMove) Help() string {
+	return `move volumes of a collection from one disk type to another
+
+	volume.tier.move -collection=<name> -fromDiskType=hdd -toDiskType=ssd [-fullPercent=95] [-quietFor=1h] [-force]
+
+	Each replica of the selected volumes is moved to another volume server with free slots of the target disk type,
+	preferring volume servers in the same rack, then in the same data center, to keep the replica placement.
+
+	Without -force, only the moving plan is printed.
+
+`
+}
+
+func (c *commandVolumeTierMove) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return
+	}
+
+	tierCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := tierCommand.String("collection", "", "the collection name")
+	fromDiskTypeString := tierCommand.String("fromDiskType", "hdd", "the source disk type, hdd or ssd")
+	toDiskTypeString := tierCommand.String("toDiskType", "ssd", "the target disk type, hdd or ssd")
+	fullPercentage := tierCommand.Float64("fullPercent", 0, "only move volumes fuller than this percentage of the volume size limit")
+	quietPeriod := tierCommand.Duration("quietFor", 0, "only move volumes without writes for this period")
+	applyChange := tierCommand.Bool("force", false, "apply the moving plan")
+	if err = tierCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	fromDiskType, err := types.ToDiskType(*fromDiskTypeString)
+	if err != nil {
+		return err
+	}
+	toDiskType, err := types.ToDiskType(*toDiskTypeString)
+	if err != nil {
+		return err
+	}
+	if fromDiskType == toDiskType {
+		return fmt.Errorf("the source and target disk types are both %s", fromDiskType)
+	}
+
+	var resp *master_pb.VolumeListResponse
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	moves := planVolumeTierMoves(resp.TopologyInfo, *collection, fromDiskType, toDiskType,
+		*fullPercentage/100*float64(resp.VolumeSizeLimitMb)*1024*1024, *quietPeriod, time.Now())
+
+	for _, m := range moves {
+		if m.target == nil {
+			fmt.Fprintf(writer, "volume %d on %s: no volume server has free %s slots\n", m.volumeId, m.source.info.Id, toDiskType)
+			continue
+		}
+		fmt.Fprintf(writer, "moving volume %d %s:%s => %s:%s\n", m.volumeId, m.source.info.Id, fromDiskType, m.target.info.Id, toDiskType)
+		if !*applyChange {
+			continue
+		}
+		if err = LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, m.volumeId, m.source.info.Id, m.target.info.Id, 5*time.Second, string(toDiskType)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type tierNode struct {
+	info      *master_pb.DataNodeInfo
+	dc        string
+	rack      RackId
+	freeSlots int
+	volumes   map[uint32]bool
+}
+
+type tierMove struct {
+	volumeId needle.VolumeId
+	source   *tierNode
+	target   *tierNode
+}
+
+// planVolumeTierMoves finds a target volume server for each replica of the selected volumes.
+// A target without the volume, in the same rack or else the same data center as the source, is preferred.
+func planVolumeTierMoves(topo *master_pb.TopologyInfo, collection string, fromDiskType, toDiskType types.DiskType,
+	minSize float64, quietPeriod time.Duration, now time.Time) (moves []*tierMove) {
+
+	var nodes []*tierNode
+	eachDataNode(topo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		node := &tierNode{
+			info:      dn,
+			dc:        dc,
+			rack:      rack,
+			freeSlots: int(dataNodeMaxVolumeCounts(dn)[toDiskType.String()]),
+			volumes:   make(map[uint32]bool),
+		}
+		for _, v := range dn.VolumeInfos {
+			node.volumes[v.Id] = true
+			if types.DiskType(v.DiskType) == toDiskType {
+				node.freeSlots--
+			}
+		}
+		nodes = append(nodes, node)
+	})
+
+	quietSeconds := int64(quietPeriod / time.Second)
+	for _, source := range nodes {
+		var vids []uint32
+		for _, v := range source.info.VolumeInfos {
+			if v.Collection != collection || types.DiskType(v.DiskType) != fromDiskType {
+				continue
+			}
+			if float64(v.Size) < minSize || v.ModifiedAtSecond+quietSeconds > now.Unix() {
+				continue
+			}
+			vids = append(vids, v.Id)
+		}
+		sort.Slice(vids, func(i, j int) bool {
+			return vids[i] < vids[j]
+		})
+		for _, vid := range vids {
+			target := pickTierMoveTarget(nodes, source, vid)
+			if target != nil {
+				target.freeSlots--
+				target.volumes[vid] = true
+			}
+			moves = append(moves, &tierMove{
+				volumeId: needle.VolumeId(vid),
+				source:   source,
+				target:   target,
+			})
+		}
+	}
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].volumeId < moves[j].volumeId
+	})
+	return
+}
+
+func pickTierMoveTarget(nodes []*tierNode, source *tierNode, vid uint32) (target *tierNode) {
+	targetScore := -1
+	for _, n := range nodes {
+		if n == source || n.freeSlots <= 0 || n.volumes[vid] || n.info.IsDraining {
+			continue
+		}
+		score := 0
+		if n.dc == source.dc {
+			score++
+			if n.rack == source.rack {
+				score++
+			}
+		}
+		if score > targetScore || score == targetScore && n.freeSlots > target.freeSlots {
+			target, targetScore = n, score
+		}
+	}
+	return
+}
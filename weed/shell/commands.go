@@ -18,6 +18,8 @@ import (
 type ShellOptions struct {
 	Masters        *string
 	GrpcDialOption grpc.DialOption
+	// LockClientName is shown as the holder of the admin lock, defaults to the shell and its host
+	LockClientName string
 	// shell transient context
 	FilerHost string
 	FilerPort int64
@@ -28,6 +30,7 @@ type CommandEnv struct {
 	env          map[string]string
 	MasterClient *wdclient.MasterClient
 	option       ShellOptions
+	locker       *ExclusiveLocker
 }
 
 type command interface {
@@ -41,12 +44,27 @@ var (
 )
 
 func NewCommandEnv(options ShellOptions) *CommandEnv {
-	return &CommandEnv{
+	ce := &CommandEnv{
 		env: make(map[string]string),
 		MasterClient: wdclient.NewMasterClient(context.Background(),
 			options.GrpcDialOption, "shell", strings.Split(*options.Masters, ",")),
 		option: options,
 	}
+	ce.locker = NewExclusiveLocker(ce.MasterClient, options.LockClientName)
+	return ce
+}
+
+// Locker returns the admin lock of this shell
+func (ce *CommandEnv) Locker() *ExclusiveLocker {
+	return ce.locker
+}
+
+// confirmIsLocked is required by the commands changing the volume placement
+func (ce *CommandEnv) confirmIsLocked() error {
+	if ce.locker.IsLocking() {
+		return nil
+	}
+	return fmt.Errorf("need to run \"lock\" first to continue")
 }
 
 func (ce *CommandEnv) parseUrl(input string) (filerServer string, filerPort int64, path string, err error) {
@@ -71,26 +89,30 @@ func (ce *CommandEnv) checkDirectory(ctx context.Context, filerServer string, fi
 
 	return ce.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
 
-		resp, listErr := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
+		var entries []*filer_pb.Entry
+		listErr := filer_pb.ListEntries(ctx, client, &filer_pb.ListEntriesRequest{
 			Directory:          dir,
 			Prefix:             name,
 			StartFromFileName:  name,
 			InclusiveStartFrom: true,
 			Limit:              1,
+		}, func(entry *filer_pb.Entry) error {
+			entries = append(entries, entry)
+			return nil
 		})
 		if listErr != nil {
 			return listErr
 		}
 
-		if len(resp.Entries) == 0 {
+		if len(entries) == 0 {
 			return fmt.Errorf("entry not found")
 		}
 
-		if resp.Entries[0].Name != name {
-			return fmt.Errorf("not a valid directory, found %s", resp.Entries[0].Name)
+		if entries[0].Name != name {
+			return fmt.Errorf("not a valid directory, found %s", entries[0].Name)
 		}
 
-		if !resp.Entries[0].IsDirectory {
+		if !entries[0].IsDirectory {
 			return fmt.Errorf("not a directory")
 		}
 
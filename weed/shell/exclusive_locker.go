@@ -0,0 +1,138 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/wdclient"
+)
+
+const (
+	// AdminLockName is the lock shared by weed shell and the master maintenance scheduler
+	AdminLockName = "admin"
+
+	adminLockRenewInterval = 4 * time.Second
+)
+
+// ExclusiveLocker holds the admin lock on the leader master, and keeps renewing it until released.
+// The lock is given up as soon as a renewal fails, since the master may grant it to another client.
+type ExclusiveLocker struct {
+	sync.Mutex
+	masterClient *wdclient.MasterClient
+	clientName   string
+	token        int64
+	lockTsNs     int64
+	isLocking    bool
+	stopChan     chan struct{}
+}
+
+func NewExclusiveLocker(masterClient *wdclient.MasterClient, clientName string) *ExclusiveLocker {
+	if clientName == "" {
+		clientName = lockClientName()
+	}
+	return &ExclusiveLocker{
+		masterClient: masterClient,
+		clientName:   clientName,
+	}
+}
+
+func (l *ExclusiveLocker) IsLocking() bool {
+	l.Lock()
+	defer l.Unlock()
+	return l.isLocking
+}
+
+func (l *ExclusiveLocker) RequestLock() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.isLocking {
+		return nil
+	}
+
+	if err := l.lease(); err != nil {
+		return err
+	}
+	l.isLocking = true
+	l.stopChan = make(chan struct{})
+
+	go l.keepRenewing(l.stopChan)
+
+	return nil
+}
+
+func (l *ExclusiveLocker) ReleaseLock() {
+	l.Lock()
+	defer l.Unlock()
+
+	if !l.isLocking {
+		return
+	}
+	close(l.stopChan)
+	l.isLocking = false
+
+	ctx := context.Background()
+	err := l.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.ReleaseAdminToken(ctx, &master_pb.ReleaseAdminTokenRequest{
+			PreviousToken: l.token,
+			LockName:      AdminLockName,
+		})
+		return err
+	})
+	if err != nil {
+		glog.V(0).Infof("release admin lock: %v", err)
+	}
+	l.token, l.lockTsNs = 0, 0
+}
+
+// lease needs the lock of the locker
+func (l *ExclusiveLocker) lease() error {
+	ctx := context.Background()
+	return l.masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err := client.LeaseAdminToken(ctx, &master_pb.LeaseAdminTokenRequest{
+			PreviousToken: l.token,
+			LockName:      AdminLockName,
+			ClientName:    l.clientName,
+		})
+		if err != nil {
+			return err
+		}
+		l.token, l.lockTsNs = resp.Token, resp.LockTsNs
+		return nil
+	})
+}
+
+func (l *ExclusiveLocker) keepRenewing(stopChan chan struct{}) {
+	ticker := time.NewTicker(adminLockRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			l.Lock()
+			if l.isLocking {
+				if err := l.lease(); err != nil {
+					glog.Errorf("renew admin lock: %v", err)
+					l.isLocking = false
+					l.token, l.lockTsNs = 0, 0
+				}
+			}
+			isLocking := l.isLocking
+			l.Unlock()
+			if !isLocking {
+				return
+			}
+		}
+	}
+}
+
+func lockClientName() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("weed shell on %s", hostname)
+}
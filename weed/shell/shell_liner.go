@@ -37,6 +37,8 @@ func RunShell(options ShellOptions) {
 	go commandEnv.MasterClient.KeepConnectedToMaster()
 	commandEnv.MasterClient.WaitUntilConnected()
 
+	defer commandEnv.locker.ReleaseLock()
+
 	for {
 		cmd, err := line.Prompt("> ")
 		if err != nil {
@@ -66,7 +68,7 @@ func RunShell(options ShellOptions) {
 			} else {
 				foundCommand := false
 				for _, c := range Commands {
-					if c.Name() == cmd {
+					if strings.ToLower(c.Name()) == cmd {
 						if err := c.Do(args, commandEnv, os.Stdout); err != nil {
 							fmt.Fprintf(os.Stderr, "error: %v\n", err)
 						}
@@ -111,7 +113,7 @@ func printHelp(cmds []string) {
 		})
 
 		for _, c := range Commands {
-			if c.Name() == cmd {
+			if strings.ToLower(c.Name()) == cmd {
 				fmt.Printf("  %s\t# %s\n", c.Name(), c.Help())
 			}
 		}
@@ -121,7 +123,7 @@ func printHelp(cmds []string) {
 func setCompletionHandler() {
 	line.SetCompleter(func(line string) (c []string) {
 		for _, i := range Commands {
-			if strings.HasPrefix(i.Name(), strings.ToLower(line)) {
+			if strings.HasPrefix(strings.ToLower(i.Name()), strings.ToLower(line)) {
 				c = append(c, i.Name())
 			}
 		}
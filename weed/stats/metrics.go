@@ -13,6 +13,40 @@ import (
 var (
 	FilerGather        = prometheus.NewRegistry()
 	VolumeServerGather = prometheus.NewRegistry()
+	MasterGather       = prometheus.NewRegistry()
+
+	MasterMaintenanceRunCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "maintenance_runs_total",
+			Help:      "Counter of maintenance script runs, by result.",
+		}, []string{"result"})
+
+	MasterMaintenanceLastRunGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "maintenance_last_run_timestamp_seconds",
+			Help:      "Unix time when the last maintenance run finished.",
+		})
+
+	MasterMaintenanceCommandCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "maintenance_commands_total",
+			Help:      "Counter of maintenance commands, by command and result.",
+		}, []string{"command", "result"})
+
+	MasterMaintenanceCommandHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "maintenance_command_seconds",
+			Help:      "Bucketed histogram of maintenance command running time.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 20),
+		}, []string{"command"})
 
 	FilerRequestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -92,6 +126,12 @@ var (
 
 func init() {
 
+	MasterGather.MustRegister(MasterMaintenanceRunCounter)
+	MasterGather.MustRegister(MasterMaintenanceLastRunGauge)
+	MasterGather.MustRegister(MasterMaintenanceCommandCounter)
+	MasterGather.MustRegister(MasterMaintenanceCommandHistogram)
+	MasterGather.MustRegister(prometheus.NewGoCollector())
+
 	FilerGather.MustRegister(FilerRequestCounter)
 	FilerGather.MustRegister(FilerRequestHistogram)
 	FilerGather.MustRegister(FilerStoreCounter)
@@ -79,13 +79,36 @@ func ParseUpload(r *http.Request) (
 	return
 }
 func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle, originalSize int, e error) {
-	var pairMap map[string]string
-	fname, mimeType, isGzipped, isChunkedFile := "", "", false, false
-	n = new(Needle)
-	fname, n.Data, mimeType, pairMap, isGzipped, originalSize, n.LastModified, n.Ttl, isChunkedFile, e = ParseUpload(r)
+	fname, data, mimeType, pairMap, isGzipped, originalSize, lastModified, ttl, isChunkedFile, e := ParseUpload(r)
 	if e != nil {
-		return
+		return new(Needle), originalSize, e
+	}
+
+	trimmedPairMap := make(map[string]string)
+	for k, v := range pairMap {
+		trimmedPairMap[k[len(PairNamePrefix):]] = v
+	}
+
+	n = NewNeedle(fname, data, mimeType, trimmedPairMap, isGzipped, lastModified, ttl, isChunkedFile, fixJpgOrientation)
+
+	commaSep := strings.LastIndex(r.URL.Path, ",")
+	dotSep := strings.LastIndex(r.URL.Path, ".")
+	fid := r.URL.Path[commaSep+1:]
+	if dotSep > 0 {
+		fid = r.URL.Path[commaSep+1 : dotSep]
 	}
+
+	e = n.ParsePath(fid)
+
+	return
+}
+
+// NewNeedle creates the needle of the uploaded content, without the needle id and cookie.
+// The pair names are without the PairNamePrefix.
+func NewNeedle(fname string, data []byte, mimeType string, pairMap map[string]string, isGzipped bool,
+	lastModified uint64, ttl *TTL, isChunkedFile bool, fixJpgOrientation bool) (n *Needle) {
+	n = new(Needle)
+	n.Data, n.LastModified, n.Ttl = data, lastModified, ttl
 	if len(fname) < 256 {
 		n.Name = []byte(fname)
 		n.SetHasName()
@@ -95,12 +118,7 @@ func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle
 		n.SetHasMime()
 	}
 	if len(pairMap) != 0 {
-		trimmedPairMap := make(map[string]string)
-		for k, v := range pairMap {
-			trimmedPairMap[k[len(PairNamePrefix):]] = v
-		}
-
-		pairs, _ := json.Marshal(trimmedPairMap)
+		pairs, _ := json.Marshal(pairMap)
 		if len(pairs) < 65536 {
 			n.Pairs = pairs
 			n.PairsSize = uint16(len(pairs))
@@ -131,17 +149,9 @@ func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle
 
 	n.Checksum = NewCRC(n.Data)
 
-	commaSep := strings.LastIndex(r.URL.Path, ",")
-	dotSep := strings.LastIndex(r.URL.Path, ".")
-	fid := r.URL.Path[commaSep+1:]
-	if dotSep > 0 {
-		fid = r.URL.Path[commaSep+1 : dotSep]
-	}
-
-	e = n.ParsePath(fid)
-
 	return
 }
+
 func (n *Needle) ParsePath(fid string) (err error) {
 	length := len(fid)
 	if length <= CookieSize*2 {
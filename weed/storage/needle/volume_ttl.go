@@ -1,6 +1,7 @@
 package needle
 
 import (
+	"fmt"
 	"strconv"
 )
 
@@ -99,6 +100,39 @@ func (t *TTL) String() string {
 	return ""
 }
 
+var ttlUnits = []struct {
+	unit    byte
+	minutes int64
+}{
+	{'m', 1},
+	{'h', 60},
+	{'d', 60 * 24},
+	{'w', 60 * 24 * 7},
+	{'M', 60 * 24 * 31},
+	{'y', 60 * 24 * 365},
+}
+
+// SecondsToTTL translates seconds to a readable ttl, which is never shorter, e.g. 7200 => "2h", 90 => "2m".
+// Exact units are preferred, otherwise it is rounded up with the smallest unit that fits.
+func SecondsToTTL(seconds int32) string {
+	if seconds <= 0 {
+		return ""
+	}
+	minutes := (int64(seconds) + 59) / 60
+	for i := len(ttlUnits) - 1; i >= 0; i-- {
+		u := ttlUnits[i]
+		if minutes%u.minutes == 0 && minutes/u.minutes <= 255 {
+			return fmt.Sprintf("%d%c", minutes/u.minutes, u.unit)
+		}
+	}
+	for _, u := range ttlUnits {
+		if count := (minutes + u.minutes - 1) / u.minutes; count <= 255 {
+			return fmt.Sprintf("%d%c", count, u.unit)
+		}
+	}
+	return "255y"
+}
+
 func toStoredByte(readableUnitByte byte) byte {
 	switch readableUnitByte {
 	case 'm':
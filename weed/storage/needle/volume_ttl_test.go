@@ -58,3 +58,32 @@ func TestTTLReadWrite(t *testing.T) {
 	}
 
 }
+
+func TestSecondsToTTL(t *testing.T) {
+	for seconds, expected := range map[int32]string{
+		0:                  "",
+		30:                 "1m",
+		90:                 "2m",
+		7200:               "2h",
+		3 * 24 * 3600:      "3d",
+		14 * 24 * 3600:     "2w",
+		300 * 60:           "5h",
+		256 * 60:           "5h",
+		400 * 24 * 3600:    "58w",
+		1000 * 24 * 3600:   "143w",
+		10 * 365 * 86400:   "10y",
+		7*24*3600 + 60:     "169h",
+		255 * 24 * 3600:    "255d",
+		31 * 24 * 3600 * 2: "2M",
+	} {
+		if ttl := SecondsToTTL(seconds); ttl != expected {
+			t.Errorf("%d seconds: %s, expected %s", seconds, ttl, expected)
+		}
+		if seconds > 0 {
+			ttl, _ := ReadTTL(SecondsToTTL(seconds))
+			if int64(ttl.Minutes())*60 < int64(seconds) {
+				t.Errorf("%d seconds: %v is shorter", seconds, ttl)
+			}
+		}
+	}
+}
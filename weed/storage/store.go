@@ -42,11 +42,11 @@ func (s *Store) String() (str string) {
 	return
 }
 
-func NewStore(grpcDialOption grpc.DialOption, port int, ip, publicUrl string, dirnames []string, maxVolumeCounts []int, needleMapKind NeedleMapType) (s *Store) {
+func NewStore(grpcDialOption grpc.DialOption, port int, ip, publicUrl string, dirnames []string, maxVolumeCounts []int, diskTypes []DiskType, needleMapKind NeedleMapType) (s *Store) {
 	s = &Store{grpcDialOption: grpcDialOption, Port: port, Ip: ip, PublicUrl: publicUrl, NeedleMapType: needleMapKind}
 	s.Locations = make([]*DiskLocation, 0)
 	for i := 0; i < len(dirnames); i++ {
-		location := NewDiskLocation(dirnames[i], maxVolumeCounts[i])
+		location := NewDiskLocation(dirnames[i], maxVolumeCounts[i], diskTypes[i])
 		location.loadExistingVolumes(needleMapKind)
 		s.Locations = append(s.Locations, location)
 		stats.VolumeServerMaxVolumeCounter.Add(float64(maxVolumeCounts[i]))
@@ -59,7 +59,7 @@ func NewStore(grpcDialOption grpc.DialOption, port int, ip, publicUrl string, di
 
 	return
 }
-func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement string, ttlString string, preallocate int64, memoryMapMaxSizeMB uint32) error {
+func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement string, ttlString string, preallocate int64, memoryMapMaxSizeMB uint32, diskType DiskType) error {
 	rt, e := NewReplicaPlacementFromString(replicaPlacement)
 	if e != nil {
 		return e
@@ -68,7 +68,7 @@ func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMap
 	if e != nil {
 		return e
 	}
-	e = s.addVolume(volumeId, collection, needleMapKind, rt, ttl, preallocate, memoryMapMaxSizeMB)
+	e = s.addVolume(volumeId, collection, needleMapKind, rt, ttl, preallocate, memoryMapMaxSizeMB, diskType)
 	return e
 }
 func (s *Store) DeleteCollection(collection string) (e error) {
@@ -90,9 +90,20 @@ func (s *Store) findVolume(vid needle.VolumeId) *Volume {
 	}
 	return nil
 }
-func (s *Store) FindFreeLocation() (ret *DiskLocation) {
+func (s *Store) findVolumeLocation(vid needle.VolumeId) (*Volume, *DiskLocation) {
+	for _, location := range s.Locations {
+		if v, found := location.FindVolume(vid); found {
+			return v, location
+		}
+	}
+	return nil, nil
+}
+func (s *Store) FindFreeLocation(diskType DiskType) (ret *DiskLocation) {
 	max := 0
 	for _, location := range s.Locations {
+		if location.DiskType != diskType {
+			continue
+		}
 		currentFreeCount := location.MaxVolumeCount - location.VolumesLen()
 		if currentFreeCount > max {
 			max = currentFreeCount
@@ -101,29 +112,34 @@ func (s *Store) FindFreeLocation() (ret *DiskLocation) {
 	}
 	return ret
 }
-func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMB uint32) error {
+func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMB uint32, diskType DiskType) error {
 	if s.findVolume(vid) != nil {
 		return fmt.Errorf("Volume Id %d already exists!", vid)
 	}
-	if location := s.FindFreeLocation(); location != nil {
-		glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v",
-			location.Directory, vid, collection, replicaPlacement, ttl)
+	if location := s.FindFreeLocation(diskType); location != nil {
+		glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v disk:%s",
+			location.Directory, vid, collection, replicaPlacement, ttl, diskType)
 		if volume, err := NewVolume(location.Directory, collection, vid, needleMapKind, replicaPlacement, ttl, preallocate, memoryMapMaxSizeMB); err == nil {
 			location.SetVolume(vid, volume)
 			glog.V(0).Infof("add volume %d", vid)
-			s.NewVolumesChan <- master_pb.VolumeShortInformationMessage{
-				Id:               uint32(vid),
-				Collection:       collection,
-				ReplicaPlacement: uint32(replicaPlacement.Byte()),
-				Version:          uint32(volume.Version()),
-				Ttl:              ttl.ToUint32(),
-			}
+			s.NewVolumesChan <- toVolumeShortInformationMessage(volume, location)
 			return nil
 		} else {
 			return err
 		}
 	}
-	return fmt.Errorf("No more free space left")
+	return fmt.Errorf("No more free %s space left", diskType)
+}
+
+func toVolumeShortInformationMessage(v *Volume, location *DiskLocation) master_pb.VolumeShortInformationMessage {
+	return master_pb.VolumeShortInformationMessage{
+		Id:               uint32(v.Id),
+		Collection:       v.Collection,
+		ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
+		Version:          uint32(v.Version()),
+		Ttl:              v.Ttl.ToUint32(),
+		DiskType:         string(location.DiskType),
+	}
 }
 
 func (s *Store) Status() []*VolumeInfo {
@@ -143,6 +159,7 @@ func (s *Store) Status() []*VolumeInfo {
 				ReadOnly:         v.readOnly,
 				Ttl:              v.Ttl,
 				CompactRevision:  uint32(v.CompactionRevision),
+				DiskType:         string(location.DiskType),
 			}
 			stats = append(stats, s)
 		}
@@ -162,17 +179,21 @@ func (s *Store) SetRack(rack string) {
 func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 	var volumeMessages []*master_pb.VolumeInformationMessage
 	maxVolumeCount := 0
+	maxVolumeCounts := make(map[string]uint32)
 	var maxFileKey NeedleId
 	collectionVolumeSize := make(map[string]uint64)
 	for _, location := range s.Locations {
 		maxVolumeCount = maxVolumeCount + location.MaxVolumeCount
+		maxVolumeCounts[location.DiskType.String()] += uint32(location.MaxVolumeCount)
 		location.Lock()
 		for _, v := range location.volumes {
 			if maxFileKey < v.MaxFileKey() {
 				maxFileKey = v.MaxFileKey()
 			}
 			if !v.expired(s.GetVolumeSizeLimit()) {
-				volumeMessages = append(volumeMessages, v.ToVolumeInformationMessage())
+				volumeMessage := v.ToVolumeInformationMessage()
+				volumeMessage.DiskType = string(location.DiskType)
+				volumeMessages = append(volumeMessages, volumeMessage)
 			} else {
 				if v.expiredLongEnough(MAX_TTL_VOLUME_REMOVAL_DELAY) {
 					location.deleteVolumeById(v.Id)
@@ -192,15 +213,16 @@ func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 	}
 
 	return &master_pb.Heartbeat{
-		Ip:             s.Ip,
-		Port:           uint32(s.Port),
-		PublicUrl:      s.PublicUrl,
-		MaxVolumeCount: uint32(maxVolumeCount),
-		MaxFileKey:     NeedleIdToUint64(maxFileKey),
-		DataCenter:     s.dataCenter,
-		Rack:           s.rack,
-		Volumes:        volumeMessages,
-		HasNoVolumes:   len(volumeMessages) == 0,
+		Ip:              s.Ip,
+		Port:            uint32(s.Port),
+		PublicUrl:       s.PublicUrl,
+		MaxVolumeCount:  uint32(maxVolumeCount),
+		MaxVolumeCounts: maxVolumeCounts,
+		MaxFileKey:      NeedleIdToUint64(maxFileKey),
+		DataCenter:      s.dataCenter,
+		Rack:            s.rack,
+		Volumes:         volumeMessages,
+		HasNoVolumes:    len(volumeMessages) == 0,
 	}
 
 }
@@ -272,13 +294,7 @@ func (s *Store) MountVolume(i needle.VolumeId) error {
 		if found := location.LoadVolume(i, s.NeedleMapType); found == true {
 			glog.V(0).Infof("mount volume %d", i)
 			v := s.findVolume(i)
-			s.NewVolumesChan <- master_pb.VolumeShortInformationMessage{
-				Id:               uint32(v.Id),
-				Collection:       v.Collection,
-				ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
-				Version:          uint32(v.Version()),
-				Ttl:              v.Ttl.ToUint32(),
-			}
+			s.NewVolumesChan <- toVolumeShortInformationMessage(v, location)
 			return nil
 		}
 	}
@@ -287,17 +303,11 @@ func (s *Store) MountVolume(i needle.VolumeId) error {
 }
 
 func (s *Store) UnmountVolume(i needle.VolumeId) error {
-	v := s.findVolume(i)
+	v, location := s.findVolumeLocation(i)
 	if v == nil {
 		return nil
 	}
-	message := master_pb.VolumeShortInformationMessage{
-		Id:               uint32(v.Id),
-		Collection:       v.Collection,
-		ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
-		Version:          uint32(v.Version()),
-		Ttl:              v.Ttl.ToUint32(),
-	}
+	message := toVolumeShortInformationMessage(v, location)
 
 	for _, location := range s.Locations {
 		if err := location.UnloadVolume(i); err == nil {
@@ -311,17 +321,11 @@ func (s *Store) UnmountVolume(i needle.VolumeId) error {
 }
 
 func (s *Store) DeleteVolume(i needle.VolumeId) error {
-	v := s.findVolume(i)
+	v, location := s.findVolumeLocation(i)
 	if v == nil {
 		return nil
 	}
-	message := master_pb.VolumeShortInformationMessage{
-		Id:               uint32(v.Id),
-		Collection:       v.Collection,
-		ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
-		Version:          uint32(v.Version()),
-		Ttl:              v.Ttl.ToUint32(),
-	}
+	message := toVolumeShortInformationMessage(v, location)
 	for _, location := range s.Locations {
 		if error := location.deleteVolumeById(i); error == nil {
 			glog.V(0).Infof("DeleteVolume %d", i)
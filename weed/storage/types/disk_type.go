@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiskType is the media type of the disks of a volume server folder.
+// The hard drive type is the empty string, so the volume servers and requests without a disk type use hard drives.
+type DiskType string
+
+const (
+	HardDriveType DiskType = ""
+	SsdType       DiskType = "ssd"
+)
+
+func ToDiskType(diskType string) (DiskType, error) {
+	switch strings.ToLower(diskType) {
+	case "", "hdd":
+		return HardDriveType, nil
+	case "ssd":
+		return SsdType, nil
+	}
+	return HardDriveType, fmt.Errorf("unknown disk type %s, expecting hdd or ssd", diskType)
+}
+
+func (diskType DiskType) String() string {
+	if diskType == HardDriveType {
+		return "hdd"
+	}
+	return string(diskType)
+}
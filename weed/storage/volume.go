@@ -132,6 +132,17 @@ func (v *Volume) MaxFileKey() types.NeedleId {
 	return v.nm.MaxFileKey()
 }
 
+// HasNeedle tells whether the needle id is written and not deleted
+func (v *Volume) HasNeedle(id types.NeedleId) bool {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+	if v.nm == nil {
+		return false
+	}
+	nv, ok := v.nm.Get(id)
+	return ok && !nv.Offset.IsZero() && nv.Size != types.TombstoneFileSize
+}
+
 func (v *Volume) IndexFileSize() uint64 {
 	v.dataFileAccessLock.Lock()
 	defer v.dataFileAccessLock.Unlock()
@@ -21,6 +21,7 @@ type VolumeInfo struct {
 	ReadOnly         bool
 	CompactRevision  uint32
 	ModifiedAtSecond int64
+	DiskType         string
 }
 
 func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err error) {
@@ -35,6 +36,7 @@ func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err er
 		Version:          needle.Version(m.Version),
 		CompactRevision:  m.CompactRevision,
 		ModifiedAtSecond: m.ModifiedAtSecond,
+		DiskType:         m.DiskType,
 	}
 	rp, e := NewReplicaPlacementFromByte(byte(m.ReplicaPlacement))
 	if e != nil {
@@ -50,6 +52,7 @@ func NewVolumeInfoFromShort(m *master_pb.VolumeShortInformationMessage) (vi Volu
 		Id:         needle.VolumeId(m.Id),
 		Collection: m.Collection,
 		Version:    needle.Version(m.Version),
+		DiskType:   m.DiskType,
 	}
 	rp, e := NewReplicaPlacementFromByte(byte(m.ReplicaPlacement))
 	if e != nil {
@@ -79,6 +82,7 @@ func (vi VolumeInfo) ToVolumeInformationMessage() *master_pb.VolumeInformationMe
 		Ttl:              vi.Ttl.ToUint32(),
 		CompactRevision:  vi.CompactRevision,
 		ModifiedAtSecond: vi.ModifiedAtSecond,
+		DiskType:         vi.DiskType,
 	}
 }
 
@@ -114,6 +114,16 @@ func (v *Volume) writeNeedle(n *needle.Needle) (offset uint64, size uint32, isUn
 	return
 }
 
+// WriteNeedle appends the needle to a volume that is not managed by a store, e.g., to import files offline
+func (v *Volume) WriteNeedle(n *needle.Needle) (size uint32, isUnchanged bool, err error) {
+	if MaxPossibleVolumeSize < v.ContentSize()+uint64(needle.GetActualSize(uint32(len(n.Data)), v.Version())) {
+		err = fmt.Errorf("volume %d size limit %d exceeded, current size is %d", v.Id, uint64(MaxPossibleVolumeSize), v.ContentSize())
+		return
+	}
+	_, size, isUnchanged, err = v.writeNeedle(n)
+	return
+}
+
 func (v *Volume) deleteNeedle(n *needle.Needle) (uint32, error) {
 	glog.V(4).Infof("delete needle %s", needle.NewFileIdFromNeedle(v.Id, n).String())
 	if v.readOnly {
@@ -29,3 +29,30 @@ func (c *MaxVolumeIdCommand) Apply(server raft.Server) (interface{}, error) {
 
 	return nil, nil
 }
+
+// DataNodeDrainingCommand keeps the draining volume servers in the raft log,
+// so all masters know them, also after a restart or a leader change
+type DataNodeDrainingCommand struct {
+	Id         string `json:"id"`
+	IsDraining bool   `json:"isDraining"`
+}
+
+func NewDataNodeDrainingCommand(id string, isDraining bool) *DataNodeDrainingCommand {
+	return &DataNodeDrainingCommand{
+		Id:         id,
+		IsDraining: isDraining,
+	}
+}
+
+func (c *DataNodeDrainingCommand) CommandName() string {
+	return "DataNodeDraining"
+}
+
+func (c *DataNodeDrainingCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	topo.SetDataNodeDraining(c.Id, c.IsDraining)
+
+	glog.V(1).Infoln("volume server", c.Id, "draining:", c.IsDraining)
+
+	return nil, nil
+}
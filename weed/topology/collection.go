@@ -5,6 +5,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
@@ -12,6 +13,7 @@ type Collection struct {
 	Name                     string
 	volumeSizeLimit          uint64
 	storageType2VolumeLayout *util.ConcurrentReadMap
+	unwritableVolumes        chan<- needle.VolumeId
 }
 
 func NewCollection(name string, volumeSizeLimit uint64) *Collection {
@@ -24,13 +26,18 @@ func (c *Collection) String() string {
 	return fmt.Sprintf("Name:%s, volumeSizeLimit:%d, storageType2VolumeLayout:%v", c.Name, c.volumeSizeLimit, c.storageType2VolumeLayout)
 }
 
-func (c *Collection) GetOrCreateVolumeLayout(rp *storage.ReplicaPlacement, ttl *needle.TTL) *VolumeLayout {
+func (c *Collection) GetOrCreateVolumeLayout(rp *storage.ReplicaPlacement, ttl *needle.TTL, diskType types.DiskType) *VolumeLayout {
 	keyString := rp.String()
 	if ttl != nil {
 		keyString += ttl.String()
 	}
+	if diskType != types.HardDriveType {
+		keyString += string(diskType)
+	}
 	vl := c.storageType2VolumeLayout.Get(keyString, func() interface{} {
-		return NewVolumeLayout(rp, ttl, c.volumeSizeLimit)
+		vl := NewVolumeLayout(rp, ttl, c.volumeSizeLimit)
+		vl.unwritableVolumes = c.unwritableVolumes
+		return vl
 	})
 	return vl.(*VolumeLayout)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 
 	"strconv"
 
@@ -23,6 +24,7 @@ type DataNode struct {
 	LastSeen     int64 // unix time in seconds
 	ecShards     map[needle.VolumeId]*erasure_coding.EcVolumeInfo
 	ecShardsLock sync.RWMutex
+	isDraining   bool
 }
 
 func NewDataNode(id string) *DataNode {
@@ -47,6 +49,7 @@ func (dn *DataNode) AddOrUpdateVolume(v storage.VolumeInfo) (isNew bool) {
 	if _, ok := dn.volumes[v.Id]; !ok {
 		dn.volumes[v.Id] = v
 		dn.UpAdjustVolumeCountDelta(1)
+		dn.UpAdjustDiskTypeVolumeCountDelta(types.DiskType(v.DiskType), 1)
 		if !v.ReadOnly {
 			dn.UpAdjustActiveVolumeCountDelta(1)
 		}
@@ -65,11 +68,13 @@ func (dn *DataNode) UpdateVolumes(actualVolumes []storage.VolumeInfo) (newVolume
 	}
 	dn.Lock()
 	for vid, v := range dn.volumes {
-		if _, ok := actualVolumeMap[vid]; !ok {
+		// a volume moved to another disk type is in another volume layout
+		if actualVolume, ok := actualVolumeMap[vid]; !ok || actualVolume.DiskType != v.DiskType {
 			glog.V(0).Infoln("Deleting volume id:", vid)
 			delete(dn.volumes, vid)
 			deletedVolumes = append(deletedVolumes, v)
 			dn.UpAdjustVolumeCountDelta(-1)
+			dn.UpAdjustDiskTypeVolumeCountDelta(types.DiskType(v.DiskType), -1)
 			dn.UpAdjustActiveVolumeCountDelta(-1)
 		}
 	}
@@ -86,6 +91,9 @@ func (dn *DataNode) UpdateVolumes(actualVolumes []storage.VolumeInfo) (newVolume
 func (dn *DataNode) DeltaUpdateVolumes(newlVolumes, deletedVolumes []storage.VolumeInfo) {
 	dn.Lock()
 	for _, v := range deletedVolumes {
+		if _, ok := dn.volumes[v.Id]; ok {
+			dn.UpAdjustDiskTypeVolumeCountDelta(types.DiskType(v.DiskType), -1)
+		}
 		delete(dn.volumes, v.Id)
 		dn.UpAdjustVolumeCountDelta(-1)
 		dn.UpAdjustActiveVolumeCountDelta(-1)
@@ -150,6 +158,7 @@ func (dn *DataNode) ToMap() interface{} {
 	ret["Max"] = dn.GetMaxVolumeCount()
 	ret["Free"] = dn.FreeSpace()
 	ret["PublicUrl"] = dn.PublicUrl
+	ret["IsDraining"] = dn.IsDraining()
 	return ret
 }
 
@@ -160,6 +169,13 @@ func (dn *DataNode) ToDataNodeInfo() *master_pb.DataNodeInfo {
 		MaxVolumeCount:    uint64(dn.GetMaxVolumeCount()),
 		FreeVolumeCount:   uint64(dn.FreeSpace()),
 		ActiveVolumeCount: uint64(dn.GetActiveVolumeCount()),
+		IsDraining:        dn.IsDraining(),
+	}
+	for _, diskType := range dn.DiskTypes() {
+		if m.MaxVolumeCounts == nil {
+			m.MaxVolumeCounts = make(map[string]uint64)
+		}
+		m.MaxVolumeCounts[diskType.String()] = uint64(dn.GetDiskTypeMaxVolumeCount(diskType))
 	}
 	for _, v := range dn.GetVolumes() {
 		m.VolumeInfos = append(m.VolumeInfos, v.ToVolumeInformationMessage())
@@ -0,0 +1,107 @@
+package topology
+
+import (
+	"sync/atomic"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// DrainDataNode marks a volume server as draining, or back to normal, on all masters through the raft log.
+// It returns the volume server if it is connected.
+func (t *Topology) DrainDataNode(id string, isDraining bool) (*DataNode, error) {
+	if _, err := t.RaftServer.Do(NewDataNodeDrainingCommand(id, isDraining)); err != nil {
+		return nil, err
+	}
+	return t.FindDataNode(id), nil
+}
+
+// SetDataNodeDraining marks a volume server as draining, or back to normal.
+// A draining volume server gets no new volumes, and its volumes are not writable,
+// so that its data can be moved away. The mark stays if the volume server reconnects.
+func (t *Topology) SetDataNodeDraining(id string, isDraining bool) (dn *DataNode) {
+	t.drainingNodesLock.Lock()
+	if isDraining {
+		t.drainingNodes[id] = true
+	} else {
+		delete(t.drainingNodes, id)
+	}
+	t.drainingNodesLock.Unlock()
+
+	dn = t.FindDataNode(id)
+	if dn == nil || !dn.setDraining(isDraining) {
+		return
+	}
+	glog.V(0).Infof("volume server %s draining: %v", id, isDraining)
+
+	// re-evaluate whether the volumes are writable
+	for _, v := range dn.GetVolumes() {
+		t.RegisterVolumeLayout(v, dn)
+	}
+	return
+}
+
+func (t *Topology) IsDataNodeDraining(id string) bool {
+	t.drainingNodesLock.RLock()
+	defer t.drainingNodesLock.RUnlock()
+	return t.drainingNodes[id]
+}
+
+func (t *Topology) FindDataNode(id string) *DataNode {
+	for _, dc := range t.Children() {
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				if string(n.Id()) == id {
+					return n.(*DataNode)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (dn *DataNode) IsDraining() bool {
+	dn.RLock()
+	defer dn.RUnlock()
+	return dn.isDraining
+}
+
+// setDraining returns true if the draining state is changed
+func (dn *DataNode) setDraining(isDraining bool) bool {
+	dn.Lock()
+	changed := dn.isDraining != isDraining
+	dn.isDraining = isDraining
+	dn.Unlock()
+	if !changed {
+		return false
+	}
+	if isDraining {
+		dn.UpAdjustDrainingNodeCountDelta(1)
+	} else {
+		dn.UpAdjustDrainingNodeCountDelta(-1)
+	}
+	return true
+}
+
+func (n *NodeImpl) UpAdjustDrainingNodeCountDelta(drainingNodeCountDelta int64) { //can be negative
+	atomic.AddInt64(&n.drainingNodeCount, drainingNodeCountDelta)
+	if n.parent != nil {
+		n.parent.UpAdjustDrainingNodeCountDelta(drainingNodeCountDelta)
+	}
+}
+
+func (n *NodeImpl) GetDrainingNodeCount() int64 {
+	return atomic.LoadInt64(&n.drainingNodeCount)
+}
+
+// hasDrainingLocation needs the accessLock
+func (vl *VolumeLayout) hasDrainingLocation(vid needle.VolumeId) bool {
+	if location, found := vl.vid2location[vid]; found {
+		for _, dn := range location.list {
+			if dn.IsDraining() {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func TestDataNodeDraining(t *testing.T) {
+	topo, servers := setupDiskTypes()
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	v := storage.VolumeInfo{Id: 3, ReplicaPlacement: rp, Version: needle.CurrentVersion}
+	servers["server11"].AddOrUpdateVolume(v)
+	topo.RegisterVolumeLayout(v, servers["server11"])
+
+	option := &VolumeGrowOption{ReplicaPlacement: rp}
+	if !topo.HasWritableVolume(option) {
+		t.Fatalf("volume 3 is not writable")
+	}
+
+	if dn := topo.SetDataNodeDraining("server11:8080", true); dn != servers["server11"] {
+		t.Fatalf("found %v for server11", dn)
+	}
+	if topo.HasWritableVolume(option) {
+		t.Errorf("volume 3 on a draining server is writable")
+	}
+	if free := servers["server11"].AvailableSpaceFor(types.HardDriveType); free != 0 {
+		t.Errorf("draining server11 hdd free: %d", free)
+	}
+	if free := topo.AvailableSpaceFor(types.HardDriveType); free != 4 {
+		t.Errorf("hdd free without server11: %d, expected 4", free)
+	}
+
+	// the draining mark stays when the volume server reconnects
+	topo.UnRegisterDataNode(servers["server11"])
+	if count := topo.GetDrainingNodeCount(); count != 0 {
+		t.Errorf("draining count after unregistering: %d", count)
+	}
+	dn := topo.GetOrCreateDataCenter("dc1").GetOrCreateRack("rack1").GetOrCreateDataNode("server11", 8080, "server11", 5)
+	if !dn.IsDraining() || topo.GetDrainingNodeCount() != 1 {
+		t.Errorf("reconnected server11 is not draining")
+	}
+
+	topo.SetDataNodeDraining("server11:8080", false)
+	if free := topo.AvailableSpaceFor(types.HardDriveType); free != 9 {
+		t.Errorf("hdd free after draining: %d, expected 9", free)
+	}
+}
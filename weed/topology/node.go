@@ -10,6 +10,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 )
 
 type NodeId string
@@ -17,18 +18,24 @@ type Node interface {
 	Id() NodeId
 	String() string
 	FreeSpace() int64
-	ReserveOneVolume(r int64) (*DataNode, error)
+	AvailableSpaceFor(diskType types.DiskType) int64
+	ReserveOneVolume(r int64, diskType types.DiskType) (*DataNode, error)
 	UpAdjustMaxVolumeCountDelta(maxVolumeCountDelta int64)
 	UpAdjustVolumeCountDelta(volumeCountDelta int64)
+	UpAdjustDiskTypeMaxVolumeCountDelta(diskType types.DiskType, maxVolumeCountDelta int64)
+	UpAdjustDiskTypeVolumeCountDelta(diskType types.DiskType, volumeCountDelta int64)
 	UpAdjustEcShardCountDelta(ecShardCountDelta int64)
 	UpAdjustActiveVolumeCountDelta(activeVolumeCountDelta int64)
+	UpAdjustDrainingNodeCountDelta(drainingNodeCountDelta int64)
 	UpAdjustMaxVolumeId(vid needle.VolumeId)
 
 	GetVolumeCount() int64
 	GetEcShardCount() int64
 	GetActiveVolumeCount() int64
 	GetMaxVolumeCount() int64
+	GetDrainingNodeCount() int64
 	GetMaxVolumeId() needle.VolumeId
+	copyDiskTypeCounts() map[types.DiskType]diskTypeCount
 	SetParent(Node)
 	LinkChildNode(node Node)
 	UnlinkChildNode(nodeId NodeId)
@@ -53,6 +60,13 @@ type NodeImpl struct {
 	children          map[NodeId]Node
 	maxVolumeId       needle.VolumeId
 
+	// volume slots of the disk types other than hard drives
+	diskTypeCounts map[types.DiskType]*diskTypeCount
+	diskTypeLock   sync.RWMutex
+
+	// number of draining data nodes in this subtree
+	drainingNodeCount int64
+
 	//for rack, data center, topology
 	nodeType string
 	value    interface{}
@@ -154,11 +168,11 @@ func (n *NodeImpl) Parent() Node {
 func (n *NodeImpl) GetValue() interface{} {
 	return n.value
 }
-func (n *NodeImpl) ReserveOneVolume(r int64) (assignedNode *DataNode, err error) {
+func (n *NodeImpl) ReserveOneVolume(r int64, diskType types.DiskType) (assignedNode *DataNode, err error) {
 	n.RLock()
 	defer n.RUnlock()
 	for _, node := range n.children {
-		freeSpace := node.FreeSpace()
+		freeSpace := node.AvailableSpaceFor(diskType)
 		// fmt.Println("r =", r, ", node =", node, ", freeSpace =", freeSpace)
 		if freeSpace <= 0 {
 			continue
@@ -166,11 +180,11 @@ func (n *NodeImpl) ReserveOneVolume(r int64) (assignedNode *DataNode, err error)
 		if r >= freeSpace {
 			r -= freeSpace
 		} else {
-			if node.IsDataNode() && node.FreeSpace() > 0 {
+			if node.IsDataNode() && node.AvailableSpaceFor(diskType) > 0 {
 				// fmt.Println("vid =", vid, " assigned to node =", node, ", freeSpace =", node.FreeSpace())
 				return node.(*DataNode), nil
 			}
-			assignedNode, err = node.ReserveOneVolume(r)
+			assignedNode, err = node.ReserveOneVolume(r, diskType)
 			if err == nil {
 				return
 			}
@@ -237,6 +251,8 @@ func (n *NodeImpl) LinkChildNode(node Node) {
 		n.UpAdjustVolumeCountDelta(node.GetVolumeCount())
 		n.UpAdjustEcShardCountDelta(node.GetEcShardCount())
 		n.UpAdjustActiveVolumeCountDelta(node.GetActiveVolumeCount())
+		n.upAdjustDiskTypeCounts(node, 1)
+		n.UpAdjustDrainingNodeCountDelta(node.GetDrainingNodeCount())
 		node.SetParent(n)
 		glog.V(0).Infoln(n, "adds child", node.Id())
 	}
@@ -253,6 +269,8 @@ func (n *NodeImpl) UnlinkChildNode(nodeId NodeId) {
 		n.UpAdjustEcShardCountDelta(-node.GetEcShardCount())
 		n.UpAdjustActiveVolumeCountDelta(-node.GetActiveVolumeCount())
 		n.UpAdjustMaxVolumeCountDelta(-node.GetMaxVolumeCount())
+		n.upAdjustDiskTypeCounts(node, -1)
+		n.UpAdjustDrainingNodeCountDelta(-node.GetDrainingNodeCount())
 		glog.V(0).Infoln(n, "removes", node.Id())
 	}
 }
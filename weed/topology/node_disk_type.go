@@ -0,0 +1,141 @@
+package topology
+
+import (
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+// diskTypeCount counts the volume slots of one disk type other than hard drives.
+// The hard drive slots are the rest of the total slots, so the volume servers
+// not reporting disk types only have hard drives.
+type diskTypeCount struct {
+	maxVolumeCount int64
+	volumeCount    int64
+}
+
+func (n *NodeImpl) UpAdjustDiskTypeMaxVolumeCountDelta(diskType types.DiskType, maxVolumeCountDelta int64) {
+	if diskType == types.HardDriveType || maxVolumeCountDelta == 0 {
+		return
+	}
+	n.diskTypeLock.Lock()
+	n.getDiskTypeCount(diskType).maxVolumeCount += maxVolumeCountDelta
+	n.diskTypeLock.Unlock()
+	if n.parent != nil {
+		n.parent.UpAdjustDiskTypeMaxVolumeCountDelta(diskType, maxVolumeCountDelta)
+	}
+}
+
+func (n *NodeImpl) UpAdjustDiskTypeVolumeCountDelta(diskType types.DiskType, volumeCountDelta int64) {
+	if diskType == types.HardDriveType || volumeCountDelta == 0 {
+		return
+	}
+	n.diskTypeLock.Lock()
+	n.getDiskTypeCount(diskType).volumeCount += volumeCountDelta
+	n.diskTypeLock.Unlock()
+	if n.parent != nil {
+		n.parent.UpAdjustDiskTypeVolumeCountDelta(diskType, volumeCountDelta)
+	}
+}
+
+// getDiskTypeCount needs the diskTypeLock
+func (n *NodeImpl) getDiskTypeCount(diskType types.DiskType) *diskTypeCount {
+	if n.diskTypeCounts == nil {
+		n.diskTypeCounts = make(map[types.DiskType]*diskTypeCount)
+	}
+	c, found := n.diskTypeCounts[diskType]
+	if !found {
+		c = &diskTypeCount{}
+		n.diskTypeCounts[diskType] = c
+	}
+	return c
+}
+
+func (n *NodeImpl) copyDiskTypeCounts() map[types.DiskType]diskTypeCount {
+	n.diskTypeLock.RLock()
+	defer n.diskTypeLock.RUnlock()
+	counts := make(map[types.DiskType]diskTypeCount, len(n.diskTypeCounts))
+	for diskType, c := range n.diskTypeCounts {
+		counts[diskType] = *c
+	}
+	return counts
+}
+
+func (n *NodeImpl) GetDiskTypeMaxVolumeCount(diskType types.DiskType) int64 {
+	counts := n.copyDiskTypeCounts()
+	if diskType != types.HardDriveType {
+		return counts[diskType].maxVolumeCount
+	}
+	max := n.GetMaxVolumeCount()
+	for _, c := range counts {
+		max -= c.maxVolumeCount
+	}
+	return max
+}
+
+func (n *NodeImpl) GetDiskTypeVolumeCount(diskType types.DiskType) int64 {
+	counts := n.copyDiskTypeCounts()
+	if diskType != types.HardDriveType {
+		return counts[diskType].volumeCount
+	}
+	count := n.GetVolumeCount()
+	for _, c := range counts {
+		count -= c.volumeCount
+	}
+	return count
+}
+
+// AvailableSpaceFor counts the free volume slots of one disk type,
+// without the slots on draining volume servers
+func (n *NodeImpl) AvailableSpaceFor(diskType types.DiskType) int64 {
+	if n.GetDrainingNodeCount() > 0 {
+		if n.IsDataNode() {
+			return 0
+		}
+		var free int64
+		for _, child := range n.Children() {
+			free += child.AvailableSpaceFor(diskType)
+		}
+		return free
+	}
+	free := n.GetDiskTypeMaxVolumeCount(diskType) - n.GetDiskTypeVolumeCount(diskType)
+	// the erasure coding shards are only counted in the total
+	if totalFree := n.FreeSpace(); totalFree < free {
+		free = totalFree
+	}
+	return free
+}
+
+// DiskTypes lists the disk types with any volume slots
+func (n *NodeImpl) DiskTypes() (diskTypes []types.DiskType) {
+	if n.GetDiskTypeMaxVolumeCount(types.HardDriveType) > 0 {
+		diskTypes = append(diskTypes, types.HardDriveType)
+	}
+	for diskType, c := range n.copyDiskTypeCounts() {
+		if c.maxVolumeCount > 0 {
+			diskTypes = append(diskTypes, diskType)
+		}
+	}
+	return
+}
+
+func (n *NodeImpl) upAdjustDiskTypeCounts(node Node, sign int64) {
+	for diskType, c := range node.copyDiskTypeCounts() {
+		n.UpAdjustDiskTypeMaxVolumeCountDelta(diskType, sign*c.maxVolumeCount)
+		n.UpAdjustDiskTypeVolumeCountDelta(diskType, sign*c.volumeCount)
+	}
+}
+
+// UpdateDiskTypeMaxVolumeCounts applies the slots per disk type reported by the volume server
+func (dn *DataNode) UpdateDiskTypeMaxVolumeCounts(maxVolumeCounts map[string]uint32) {
+	counts := dn.copyDiskTypeCounts()
+	for diskTypeString, maxVolumeCount := range maxVolumeCounts {
+		diskType, err := types.ToDiskType(diskTypeString)
+		if err != nil || diskType == types.HardDriveType {
+			continue
+		}
+		dn.UpAdjustDiskTypeMaxVolumeCountDelta(diskType, int64(maxVolumeCount)-counts[diskType].maxVolumeCount)
+		delete(counts, diskType)
+	}
+	for diskType, c := range counts {
+		dn.UpAdjustDiskTypeMaxVolumeCountDelta(diskType, -c.maxVolumeCount)
+	}
+}
@@ -0,0 +1,101 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func setupDiskTypes() (topo *Topology, servers map[string]*DataNode) {
+	topo = NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	servers = make(map[string]*DataNode)
+	dc := NewDataCenter("dc1")
+	topo.LinkChildNode(dc)
+	for _, r := range []struct {
+		rack, server string
+		max, ssdMax  int64
+	}{
+		{"rack1", "server11", 5, 2},
+		{"rack1", "server12", 4, 0},
+		{"rack2", "server21", 4, 3},
+	} {
+		rack := dc.GetOrCreateRack(r.rack)
+		server := rack.GetOrCreateDataNode(r.server, 8080, r.server, r.max)
+		if r.ssdMax > 0 {
+			server.UpdateDiskTypeMaxVolumeCounts(map[string]uint32{"ssd": uint32(r.ssdMax), "hdd": uint32(r.max - r.ssdMax)})
+		}
+		servers[r.server] = server
+	}
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	servers["server21"].AddOrUpdateVolume(storage.VolumeInfo{Id: 1, ReplicaPlacement: rp, Version: needle.CurrentVersion, DiskType: "ssd"})
+	servers["server21"].AddOrUpdateVolume(storage.VolumeInfo{Id: 2, ReplicaPlacement: rp, Version: needle.CurrentVersion})
+	return
+}
+
+func TestDiskTypeSlots(t *testing.T) {
+	topo, servers := setupDiskTypes()
+
+	for _, c := range []struct {
+		node      Node
+		diskType  types.DiskType
+		max, free int64
+	}{
+		{topo, types.SsdType, 5, 4},
+		{topo, types.HardDriveType, 8, 7},
+		{servers["server12"], types.SsdType, 0, 0},
+		{servers["server21"], types.SsdType, 3, 2},
+		{servers["server21"], types.HardDriveType, 1, 0},
+	} {
+		if max := c.node.(interface {
+			GetDiskTypeMaxVolumeCount(types.DiskType) int64
+		}).GetDiskTypeMaxVolumeCount(c.diskType); max != c.max {
+			t.Errorf("%s %s max: %d, expected %d", c.node.Id(), c.diskType, max, c.max)
+		}
+		if free := c.node.AvailableSpaceFor(c.diskType); free != c.free {
+			t.Errorf("%s %s free: %d, expected %d", c.node.Id(), c.diskType, free, c.free)
+		}
+	}
+
+	// the volume server stops reporting ssd slots
+	servers["server11"].UpdateDiskTypeMaxVolumeCounts(map[string]uint32{"hdd": 5})
+	if free := topo.AvailableSpaceFor(types.SsdType); free != 2 {
+		t.Errorf("ssd free after update: %d, expected 2", free)
+	}
+
+	topo.UnRegisterDataNode(servers["server21"])
+	if free := topo.AvailableSpaceFor(types.SsdType); free != 0 {
+		t.Errorf("ssd free after unregistering: %d, expected 0", free)
+	}
+	if free := topo.AvailableSpaceFor(types.HardDriveType); free != 9 {
+		t.Errorf("hdd free after unregistering: %d, expected 9", free)
+	}
+}
+
+func TestFindEmptySlotsForDiskType(t *testing.T) {
+	topo, servers := setupDiskTypes()
+	vg := NewDefaultVolumeGrowth()
+
+	rp, _ := storage.NewReplicaPlacementFromString("010")
+	option := &VolumeGrowOption{ReplicaPlacement: rp, DiskType: types.SsdType}
+	for i := 0; i < 10; i++ {
+		picked, err := vg.findEmptySlotsForOneVolume(topo, option)
+		if err != nil {
+			t.Fatalf("find ssd slots: %v", err)
+		}
+		for _, dn := range picked {
+			if dn == servers["server12"] {
+				t.Fatalf("picked %s without ssd slots", dn.Id())
+			}
+		}
+	}
+
+	// only server21 has a free ssd slot in its rack
+	rp, _ = storage.NewReplicaPlacementFromString("001")
+	option = &VolumeGrowOption{ReplicaPlacement: rp, DiskType: types.SsdType}
+	if _, err := vg.findEmptySlotsForOneVolume(topo, option); err == nil {
+		t.Errorf("found 2 ssd slots in one rack")
+	}
+}
@@ -42,10 +42,22 @@ func (r *Rack) GetOrCreateDataNode(ip string, port int, publicUrl string, maxVol
 	dn.PublicUrl = publicUrl
 	dn.maxVolumeCount = maxVolumeCount
 	dn.LastSeen = time.Now().Unix()
+	if t := r.getTopology(); t != nil && t.IsDataNodeDraining(string(dn.Id())) {
+		dn.isDraining = true
+		dn.drainingNodeCount = 1
+	}
 	r.LinkChildNode(dn)
 	return dn
 }
 
+func (r *Rack) getTopology() *Topology {
+	if r.Parent() == nil || r.Parent().Parent() == nil {
+		return nil
+	}
+	t, _ := r.Parent().Parent().GetValue().(*Topology)
+	return t
+}
+
 func (r *Rack) ToMap() interface{} {
 	m := make(map[string]interface{})
 	m["Id"] = r.Id()
@@ -25,6 +25,15 @@ func ReplicatedWrite(masterNode string, s *storage.Store,
 	//check JWT
 	jwt := security.GetJwt(r)
 
+	return ReplicatedWriteNeedle(masterNode, s, volumeId, n, r.URL.Path, jwt, r.FormValue("type") == "replicate")
+}
+
+// ReplicatedWriteNeedle writes the needle locally, and to the other replicas unless it is a replica write itself.
+// The urlPath is the "/vid,fid" path to upload to the other replicas.
+func ReplicatedWriteNeedle(masterNode string, s *storage.Store,
+	volumeId needle.VolumeId, n *needle.Needle,
+	urlPath string, jwt security.EncodedJwt, isReplicate bool) (size uint32, isUnchanged bool, err error) {
+
 	size, isUnchanged, err = s.WriteVolumeNeedle(volumeId, n)
 	if err != nil {
 		err = fmt.Errorf("failed to write to local disk: %v", err)
@@ -37,13 +46,13 @@ func ReplicatedWrite(masterNode string, s *storage.Store,
 		needToReplicate = s.GetVolume(volumeId).NeedToReplicate()
 	}
 	if needToReplicate { //send to other replica locations
-		if r.FormValue("type") != "replicate" {
+		if !isReplicate {
 
 			if err = distributedOperation(masterNode, s, volumeId, func(location operation.Location) error {
 				u := url.URL{
 					Scheme: "http",
 					Host:   location.Url,
-					Path:   r.URL.Path,
+					Path:   urlPath,
 				}
 				q := url.Values{
 					"type": {"replicate"},
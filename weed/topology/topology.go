@@ -12,6 +12,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/sequence"
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
@@ -31,9 +32,16 @@ type Topology struct {
 
 	chanFullVolumes chan storage.VolumeInfo
 
+	// volumes removed from the writable lists, to tell the master clients
+	chanUnwritableVolumes chan needle.VolumeId
+
 	Configuration *Configuration
 
 	RaftServer raft.Server
+
+	// volume servers to be drained, kept across their reconnections
+	drainingNodes     map[string]bool
+	drainingNodesLock sync.RWMutex
 }
 
 func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, pulse int) *Topology {
@@ -44,18 +52,25 @@ func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, puls
 	t.children = make(map[NodeId]Node)
 	t.collectionMap = util.NewConcurrentReadMap()
 	t.ecShardMap = make(map[needle.VolumeId]*EcShardLocations)
+	t.drainingNodes = make(map[string]bool)
 	t.pulse = int64(pulse)
 	t.volumeSizeLimit = volumeSizeLimit
 
 	t.Sequence = seq
 
 	t.chanFullVolumes = make(chan storage.VolumeInfo)
+	t.chanUnwritableVolumes = make(chan needle.VolumeId, 1024)
 
 	t.Configuration = &Configuration{}
 
 	return t
 }
 
+// UnwritableVolumes receives the volumes which become full, read only, or lose replicas
+func (t *Topology) UnwritableVolumes() <-chan needle.VolumeId {
+	return t.chanUnwritableVolumes
+}
+
 func (t *Topology) IsLeader() bool {
 	if t.RaftServer != nil {
 		return t.RaftServer.State() == raft.Leader
@@ -113,26 +128,28 @@ func (t *Topology) NextVolumeId() (needle.VolumeId, error) {
 }
 
 func (t *Topology) HasWritableVolume(option *VolumeGrowOption) bool {
-	vl := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl)
+	vl := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl, option.DiskType)
 	return vl.GetActiveVolumeCount(option) > 0
 }
 
 func (t *Topology) PickForWrite(count uint64, option *VolumeGrowOption) (string, uint64, *DataNode, error) {
-	vid, count, datanodes, err := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl).PickForWrite(count, option)
+	vid, count, datanodes, err := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl, option.DiskType).PickForWrite(count, option)
 	if err != nil {
-		return "", 0, nil, fmt.Errorf("failed to find writable volumes for collectio:%s replication:%s ttl:%s error: %v", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String(), err)
+		return "", 0, nil, fmt.Errorf("failed to find writable volumes for collectio:%s replication:%s ttl:%s disk:%s error: %v", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String(), option.DiskType, err)
 	}
 	if datanodes.Length() == 0 {
-		return "", 0, nil, fmt.Errorf("no writable volumes available for for collectio:%s replication:%s ttl:%s", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String())
+		return "", 0, nil, fmt.Errorf("no writable volumes available for for collectio:%s replication:%s ttl:%s disk:%s", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String(), option.DiskType)
 	}
 	fileId, count := t.Sequence.NextFileId(count)
 	return needle.NewFileId(*vid, fileId, rand.Uint32()).String(), count, datanodes.Head(), nil
 }
 
-func (t *Topology) GetVolumeLayout(collectionName string, rp *storage.ReplicaPlacement, ttl *needle.TTL) *VolumeLayout {
+func (t *Topology) GetVolumeLayout(collectionName string, rp *storage.ReplicaPlacement, ttl *needle.TTL, diskType types.DiskType) *VolumeLayout {
 	return t.collectionMap.Get(collectionName, func() interface{} {
-		return NewCollection(collectionName, t.volumeSizeLimit)
-	}).(*Collection).GetOrCreateVolumeLayout(rp, ttl)
+		c := NewCollection(collectionName, t.volumeSizeLimit)
+		c.unwritableVolumes = t.chanUnwritableVolumes
+		return c
+	}).(*Collection).GetOrCreateVolumeLayout(rp, ttl, diskType)
 }
 
 func (t *Topology) ListCollections(includeNormalVolumes, includeEcVolumes bool) (ret []string) {
@@ -169,11 +186,11 @@ func (t *Topology) DeleteCollection(collectionName string) {
 }
 
 func (t *Topology) RegisterVolumeLayout(v storage.VolumeInfo, dn *DataNode) {
-	t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl).RegisterVolume(&v, dn)
+	t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl, types.DiskType(v.DiskType)).RegisterVolume(&v, dn)
 }
 func (t *Topology) UnRegisterVolumeLayout(v storage.VolumeInfo, dn *DataNode) {
 	glog.Infof("removing volume info:%+v", v)
-	volumeLayout := t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl)
+	volumeLayout := t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl, types.DiskType(v.DiskType))
 	volumeLayout.UnRegisterVolume(&v, dn)
 	if volumeLayout.isEmpty() {
 		t.DeleteCollection(v.Collection)
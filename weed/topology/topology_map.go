@@ -62,8 +62,9 @@ func (t *Topology) ToVolumeLocations() (volumeLocations []*master_pb.VolumeLocat
 			for _, d := range rack.Children() {
 				dn := d.(*DataNode)
 				volumeLocation := &master_pb.VolumeLocation{
-					Url:       dn.Url(),
-					PublicUrl: dn.PublicUrl,
+					Url:        dn.Url(),
+					PublicUrl:  dn.PublicUrl,
+					DataCenter: string(c.Id()),
 				}
 				for _, v := range dn.GetVolumes() {
 					volumeLocation.NewVids = append(volumeLocation.NewVids, uint32(v.Id))
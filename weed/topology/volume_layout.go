@@ -22,6 +22,9 @@ type VolumeLayout struct {
 	oversizedVolumes map[needle.VolumeId]bool // set of oversized volumes
 	volumeSizeLimit  uint64
 	accessLock       sync.RWMutex
+
+	// to report the volumes no longer writable, optional
+	unwritableVolumes chan<- needle.VolumeId
 }
 
 type VolumeLayoutStats struct {
@@ -106,7 +109,7 @@ func (vl *VolumeLayout) UnRegisterVolume(v *storage.VolumeInfo, dn *DataNode) {
 }
 
 func (vl *VolumeLayout) ensureCorrectWritables(v *storage.VolumeInfo) {
-	if vl.vid2location[v.Id].Length() == vl.rp.GetCopyCount() && vl.isWritable(v) {
+	if vl.vid2location[v.Id].Length() == vl.rp.GetCopyCount() && vl.isWritable(v) && !vl.hasDrainingLocation(v.Id) {
 		if _, ok := vl.oversizedVolumes[v.Id]; !ok {
 			vl.addToWritable(v.Id)
 		}
@@ -236,10 +239,22 @@ func (vl *VolumeLayout) removeFromWritable(vid needle.VolumeId) bool {
 	if toDeleteIndex >= 0 {
 		glog.V(0).Infoln("Volume", vid, "becomes unwritable")
 		vl.writables = append(vl.writables[0:toDeleteIndex], vl.writables[toDeleteIndex+1:]...)
+		vl.reportUnwritable(vid)
 		return true
 	}
 	return false
 }
+func (vl *VolumeLayout) reportUnwritable(vid needle.VolumeId) {
+	if vl.unwritableVolumes == nil {
+		return
+	}
+	select {
+	case vl.unwritableVolumes <- vid:
+	default:
+		glog.V(1).Infof("skip reporting unwritable volume %d", vid)
+	}
+}
+
 func (vl *VolumeLayout) setVolumeWritable(vid needle.VolumeId) bool {
 	for _, v := range vl.writables {
 		if v == vid {
@@ -270,7 +285,7 @@ func (vl *VolumeLayout) SetVolumeAvailable(dn *DataNode, vid needle.VolumeId) bo
 	defer vl.accessLock.Unlock()
 
 	vl.vid2location[vid].Set(dn)
-	if vl.vid2location[vid].Length() == vl.rp.GetCopyCount() {
+	if vl.vid2location[vid].Length() == vl.rp.GetCopyCount() && !vl.hasDrainingLocation(vid) {
 		return vl.setVolumeWritable(vid)
 	}
 	return false
@@ -187,11 +187,22 @@ func NormalizeUrl(url string) string {
 	return "http://" + url
 }
 
+// HttpStatusError is returned for the responses with a 4xx or 5xx status
+type HttpStatusError struct {
+	Url        string
+	StatusCode int
+	Status     string
+}
+
+func (e *HttpStatusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Url, e.Status)
+}
+
 func ReadUrl(fileUrl string, offset int64, size int, buf []byte, isReadRange bool) (n int64, e error) {
 
 	req, _ := http.NewRequest("GET", fileUrl, nil)
 	if isReadRange {
-		req.Header.Add("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+int64(size)))
+		req.Header.Add("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+int64(size)-1))
 	} else {
 		req.Header.Set("Accept-Encoding", "gzip")
 	}
@@ -203,7 +214,7 @@ func ReadUrl(fileUrl string, offset int64, size int, buf []byte, isReadRange boo
 
 	defer r.Body.Close()
 	if r.StatusCode >= 400 {
-		return 0, fmt.Errorf("%s: %s", fileUrl, r.Status)
+		return 0, &HttpStatusError{Url: fileUrl, StatusCode: r.StatusCode, Status: r.Status}
 	}
 
 	var reader io.ReadCloser
@@ -219,16 +230,16 @@ func ReadUrl(fileUrl string, offset int64, size int, buf []byte, isReadRange boo
 
 	for {
 		m, err = reader.Read(buf[i:])
-		if m == 0 {
-			return
-		}
 		i += m
 		n += int64(m)
 		if err == io.EOF {
 			return n, nil
 		}
-		if e != nil {
-			return n, e
+		if err != nil {
+			return n, err
+		}
+		if m == 0 {
+			return
 		}
 	}
 
@@ -245,7 +256,7 @@ func ReadUrlAsStream(fileUrl string, offset int64, size int, fn func(data []byte
 	}
 	defer r.Body.Close()
 	if r.StatusCode >= 400 {
-		return 0, fmt.Errorf("%s: %s", fileUrl, r.Status)
+		return 0, &HttpStatusError{Url: fileUrl, StatusCode: r.StatusCode, Status: r.Status}
 	}
 
 	var m int
@@ -19,6 +19,12 @@ type MasterClient struct {
 	masters        []string
 	grpcDialOption grpc.DialOption
 
+	// DataCenter is preferred when reading from replicas, optional
+	DataCenter string
+
+	// OnVolumeUnwritable is called when a volume becomes full or read only, or is removed from a server, optional
+	OnVolumeUnwritable func(vid uint32)
+
 	vidMap
 }
 
@@ -36,6 +42,11 @@ func (mc *MasterClient) GetMaster() string {
 	return mc.currentMaster
 }
 
+// LookupFileIdUrls returns the urls of all replicas of the file id, the ones in mc.DataCenter first
+func (mc *MasterClient) LookupFileIdUrls(fileId string) (fullUrls []string, err error) {
+	return mc.lookupFileIdUrls(fileId, mc.DataCenter)
+}
+
 func (mc *MasterClient) WaitUntilConnected() {
 	for mc.currentMaster == "" {
 		time.Sleep(time.Duration(rand.Int31n(200)) * time.Millisecond)
@@ -98,8 +109,9 @@ func (mc *MasterClient) tryConnectToMaster(master string) (nextHintedLeader stri
 
 			// process new volume location
 			loc := Location{
-				Url:       volumeLocation.Url,
-				PublicUrl: volumeLocation.PublicUrl,
+				Url:        volumeLocation.Url,
+				PublicUrl:  volumeLocation.PublicUrl,
+				DataCenter: volumeLocation.DataCenter,
 			}
 			for _, newVid := range volumeLocation.NewVids {
 				glog.V(1).Infof("%s: %s adds volume %d", mc.name, loc.Url, newVid)
@@ -109,6 +121,15 @@ func (mc *MasterClient) tryConnectToMaster(master string) (nextHintedLeader stri
 				glog.V(1).Infof("%s: %s removes volume %d", mc.name, loc.Url, deletedVid)
 				mc.deleteLocation(deletedVid, loc)
 			}
+			if mc.OnVolumeUnwritable != nil {
+				for _, vid := range volumeLocation.UnwritableVids {
+					glog.V(1).Infof("%s: volume %d becomes unwritable", mc.name, vid)
+					mc.OnVolumeUnwritable(vid)
+				}
+				for _, vid := range volumeLocation.DeletedVids {
+					mc.OnVolumeUnwritable(vid)
+				}
+			}
 		}
 
 	})
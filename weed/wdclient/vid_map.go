@@ -16,8 +16,9 @@ const (
 )
 
 type Location struct {
-	Url       string `json:"url,omitempty"`
-	PublicUrl string `json:"publicUrl,omitempty"`
+	Url        string `json:"url,omitempty"`
+	PublicUrl  string `json:"publicUrl,omitempty"`
+	DataCenter string `json:"dataCenter,omitempty"`
 }
 
 type vidMap struct {
@@ -66,6 +67,46 @@ func (vc *vidMap) LookupFileId(fileId string) (fullUrl string, err error) {
 	return "http://" + serverUrl + "/" + fileId, nil
 }
 
+// lookupFileIdUrls returns the urls of all replicas, the ones in the data center first.
+// The replicas in the same group are rotated to spread the reads.
+func (vc *vidMap) lookupFileIdUrls(fileId string, dataCenter string) (fullUrls []string, err error) {
+	parts := strings.Split(fileId, ",")
+	if len(parts) != 2 {
+		return nil, errors.New("Invalid fileId " + fileId)
+	}
+	id, err := strconv.Atoi(parts[0])
+	if err != nil {
+		glog.V(1).Infof("Unknown volume id %s", parts[0])
+		return nil, err
+	}
+
+	vc.RLock()
+	defer vc.RUnlock()
+
+	locations := vc.vid2Locations[uint32(id)]
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("volume %d not found", id)
+	}
+
+	index, err := vc.getLocationIndex(len(locations))
+	if err != nil {
+		return nil, fmt.Errorf("volume %d: %v", id, err)
+	}
+
+	var sameDcUrls, otherDcUrls []string
+	for i := range locations {
+		loc := locations[(index+i)%len(locations)]
+		fullUrl := "http://" + loc.Url + "/" + fileId
+		if dataCenter != "" && loc.DataCenter == dataCenter {
+			sameDcUrls = append(sameDcUrls, fullUrl)
+		} else {
+			otherDcUrls = append(otherDcUrls, fullUrl)
+		}
+	}
+
+	return append(sameDcUrls, otherDcUrls...), nil
+}
+
 func (vc *vidMap) LookupVolumeServer(fileId string) (volumeServer string, err error) {
 	parts := strings.Split(fileId, ",")
 	if len(parts) != 2 {
@@ -74,3 +74,30 @@ func BenchmarkLocationIndex(b *testing.B) {
 		}
 	})
 }
+
+func TestLookupFileIdUrls(t *testing.T) {
+	vm := newVidMap()
+	vm.addLocation(3, Location{Url: "a:8080", DataCenter: "dc1"})
+	vm.addLocation(3, Location{Url: "b:8080", DataCenter: "dc2"})
+	vm.addLocation(3, Location{Url: "c:8080", DataCenter: "dc2"})
+
+	for i := 0; i < 6; i++ {
+		urls, err := vm.lookupFileIdUrls("3,01637037d6", "dc2")
+		if err != nil {
+			t.Fatalf("lookup: %v", err)
+		}
+		if len(urls) != 3 {
+			t.Fatalf("urls: %v", urls)
+		}
+		if urls[2] != "http://a:8080/3,01637037d6" {
+			t.Errorf("other data center is not the last: %v", urls)
+		}
+	}
+
+	if _, err := vm.lookupFileIdUrls("4,01637037d6", ""); err == nil {
+		t.Errorf("found a missing volume")
+	}
+	if _, err := vm.lookupFileIdUrls("3", ""); err == nil {
+		t.Errorf("accepted an invalid file id")
+	}
+}